@@ -0,0 +1,400 @@
+// This is a utility application that inspects and repairs the diskqueue files
+// of an nsqd data directory while nsqd is not running, it can also recover the
+// readable messages of files that nsqd set aside as .bad and re-inject them
+// into a live nsqd
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nsqio/go-diskqueue"
+	"github.com/nsqio/nsq/internal/dirlock"
+	"github.com/nsqio/nsq/internal/http_api"
+	"github.com/nsqio/nsq/internal/version"
+	"github.com/nsqio/nsq/nsqd"
+)
+
+var (
+	showVersion = flag.Bool("version", false, "print version string")
+
+	dataPath   = flag.String("data-path", "", "nsqd data directory")
+	queue      = flag.String("queue", "", "queue name (<topic> or <topic>:<channel>), all queues when empty")
+	file       = flag.String("file", "", "a single data file to dump, verify or recover instead of --queue")
+	maxMsgSize = flag.Int64("max-msg-size", nsqd.NewOptions().MaxMsgSize, "--max-msg-size of the nsqd that wrote the data")
+	keyFile    = flag.String("encryption-key-file", "", "--encryption-key-file of the nsqd that wrote the data")
+
+	nsqdHTTPAddr = flag.String("nsqd-http-address", "", "nsqd HTTP address to re-inject recovered messages into")
+	batchBytes   = flag.Int("batch-bytes", 1024*1024, "max size of a batch of re-injected messages")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `Usage: nsq_diskqueue_tool [flags] <command>
+
+Commands:
+  list     list the queues, their metadata and data files
+  dump     print the unread messages of a queue (or every message in --file)
+  verify   check the checksum of every record and that it can be decoded
+  recover  read the messages that can still be read from .bad files, and
+           re-inject them when --nsqd-http-address is given
+
+Flags:
+`)
+	flag.PrintDefaults()
+}
+
+// <name>.diskqueue.<file num>.dat[.bad]
+var segmentRegexp = regexp.MustCompile(`^(.+)\.diskqueue\.(\d{6,})\.dat(\.bad)?$`)
+
+type segment struct {
+	queue    string
+	fileNum  int64
+	fileName string
+	bad      bool
+	size     int64
+}
+
+func findSegments(dir string, queueName string) ([]segment, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.diskqueue.*.dat*"))
+	if err != nil {
+		return nil, err
+	}
+	var segments []segment
+	for _, fileName := range names {
+		m := segmentRegexp.FindStringSubmatch(filepath.Base(fileName))
+		if m == nil {
+			continue
+		}
+		if queueName != "" && m[1] != queueName {
+			continue
+		}
+		fileNum, _ := strconv.ParseInt(m[2], 10, 64)
+		fi, err := os.Stat(fileName)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment{
+			queue:    m[1],
+			fileNum:  fileNum,
+			fileName: fileName,
+			bad:      m[3] != "",
+			size:     fi.Size(),
+		})
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		if segments[i].queue != segments[j].queue {
+			return segments[i].queue < segments[j].queue
+		}
+		if segments[i].fileNum != segments[j].fileNum {
+			return segments[i].fileNum < segments[j].fileNum
+		}
+		return !segments[i].bad
+	})
+	return segments, nil
+}
+
+func fileSegment(fileName string) (segment, error) {
+	fi, err := os.Stat(fileName)
+	if err != nil {
+		return segment{}, err
+	}
+	s := segment{fileName: fileName, size: fi.Size()}
+	if m := segmentRegexp.FindStringSubmatch(filepath.Base(fileName)); m != nil {
+		s.queue = m[1]
+		s.fileNum, _ = strconv.ParseInt(m[2], 10, 64)
+		s.bad = m[3] != ""
+	}
+	return s, nil
+}
+
+// validMessage reports whether data looks like a message written by nsqd, it is
+// used to find where records start again in a corrupt file
+func validMessage(data []byte) bool {
+	msg, err := nsqd.DecodeMessage(data)
+	if err != nil {
+		return false
+	}
+	for _, c := range msg.ID {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f') {
+			return false
+		}
+	}
+	return msg.Timestamp > 0 && msg.Timestamp < time.Now().Add(24*time.Hour).UnixNano()
+}
+
+var keys *diskqueue.Keyring
+
+func readSegment(s segment, fn func(offset int64, data []byte) error) error {
+	return diskqueue.ReadSegment(s.fileName, nsqd.MinValidMsgLength,
+		nsqd.BackendMaxMsgSize(*maxMsgSize), keys, fn)
+}
+
+func printMessage(fileName string, offset int64, data []byte) error {
+	msg, err := nsqd.DecodeMessage(data)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s:%d %s %s attempts=%d %q\n", filepath.Base(fileName), offset, msg.ID,
+		time.Unix(0, msg.Timestamp).Format(time.RFC3339Nano), msg.Attempts, msg.Body)
+	return nil
+}
+
+func list(segments []segment) error {
+	last := ""
+	for _, s := range segments {
+		if s.queue != last {
+			last = s.queue
+			fmt.Printf("%s\n", s.queue)
+			md, err := diskqueue.ReadMetaData(diskqueue.MetaDataFileName(*dataPath, s.queue))
+			if err != nil {
+				fmt.Printf("  meta: %s\n", err)
+			} else {
+				fmt.Printf("  meta: depth=%d read=%06d:%d write=%06d:%d\n",
+					md.Depth, md.ReadFileNum, md.ReadPos, md.WriteFileNum, md.WritePos)
+			}
+		}
+		status := ""
+		if s.bad {
+			status = " (bad)"
+		}
+		fmt.Printf("  %06d %d bytes%s\n", s.fileNum, s.size, status)
+	}
+	return nil
+}
+
+// dump prints the unread messages of the queues, messages before the read
+// position have already been consumed
+func dump(segments []segment) error {
+	for _, s := range segments {
+		if s.bad {
+			continue
+		}
+		md, err := diskqueue.ReadMetaData(diskqueue.MetaDataFileName(*dataPath, s.queue))
+		if err != nil {
+			return err
+		}
+		if s.fileNum < md.ReadFileNum || s.fileNum > md.WriteFileNum {
+			continue
+		}
+		err = readSegment(s, func(offset int64, data []byte) error {
+			if s.fileNum == md.ReadFileNum && offset < md.ReadPos {
+				return nil
+			}
+			if s.fileNum == md.WriteFileNum && offset >= md.WritePos {
+				return nil
+			}
+			return printMessage(s.fileName, offset, data)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func verify(segments []segment) bool {
+	ok := true
+	for _, s := range segments {
+		var count int
+		err := readSegment(s, func(offset int64, data []byte) error {
+			if !validMessage(data) {
+				return &diskqueue.CorruptRecordError{
+					FileName: s.fileName,
+					Offset:   offset,
+					Err:      fmt.Errorf("invalid message"),
+				}
+			}
+			count++
+			return nil
+		})
+		if err != nil {
+			ok = false
+			fmt.Printf("%s: %d messages, %s\n", filepath.Base(s.fileName), count, err)
+			continue
+		}
+		fmt.Printf("%s: %d messages, ok\n", filepath.Base(s.fileName), count)
+	}
+	return ok
+}
+
+func recoverSegments(segments []segment) error {
+	for _, s := range segments {
+		if !s.bad && *file == "" {
+			continue
+		}
+
+		var body bytes.Buffer
+		var count int
+		flush := func() error {
+			if body.Len() == 0 {
+				return nil
+			}
+			err := inject(s.queue, body.Bytes())
+			body.Reset()
+			return err
+		}
+		corrupt, err := diskqueue.ScanSegment(s.fileName, nsqd.MinValidMsgLength,
+			nsqd.BackendMaxMsgSize(*maxMsgSize), keys, validMessage,
+			func(offset int64, data []byte) error {
+				count++
+				if *nsqdHTTPAddr == "" {
+					return printMessage(s.fileName, offset, data)
+				}
+				if body.Len()+len(data) > *batchBytes {
+					err := flush()
+					if err != nil {
+						return err
+					}
+				}
+				msg, err := nsqd.DecodeMessage(data)
+				if err != nil {
+					return err
+				}
+				nsqd.WriteImportMessage(&body, msg, 0)
+				return nil
+			})
+		if err == nil {
+			err = flush()
+		}
+		if err != nil {
+			return err
+		}
+		for _, c := range corrupt {
+			log.Printf("%s", c)
+		}
+		log.Printf("%s: recovered %d messages", filepath.Base(s.fileName), count)
+	}
+	return nil
+}
+
+// lockDataPath makes sure nsqd is not running on the files that are read,
+// except for recovering the .bad files that nsqd has set aside for good
+func lockDataPath(command string, segments []segment) (*dirlock.DirLock, error) {
+	if command == "recover" && (*file == "" || segments[0].bad) {
+		return nil, nil
+	}
+	dir := *dataPath
+	if *file != "" {
+		dir = filepath.Dir(*file)
+	}
+	dl := dirlock.New(dir)
+	err := dl.Lock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock data-path, is nsqd running? - %s", err)
+	}
+	return dl, nil
+}
+
+var client *http_api.Client
+
+func inject(queueName string, body []byte) error {
+	if client == nil {
+		client = http_api.NewClient(nil, 2*time.Second, 30*time.Second)
+	}
+	topicName, channelName := queueName, ""
+	if i := strings.IndexAny(queueName, ":;"); i >= 0 {
+		topicName, channelName = queueName[:i], queueName[i+1:]
+	}
+
+	v := url.Values{}
+	v.Set("topic", topicName)
+	endpoint := fmt.Sprintf("http://%s/topic/import?%s", *nsqdHTTPAddr, v.Encode())
+	if channelName != "" {
+		err := client.POSTV1(fmt.Sprintf("http://%s/topic/create?%s", *nsqdHTTPAddr, v.Encode()))
+		if err != nil {
+			return err
+		}
+		v.Set("channel", channelName)
+		err = client.POSTV1(fmt.Sprintf("http://%s/channel/create?%s", *nsqdHTTPAddr, v.Encode()))
+		if err != nil {
+			return err
+		}
+		endpoint = fmt.Sprintf("http://%s/channel/import?%s", *nsqdHTTPAddr, v.Encode())
+	}
+	return client.POSTV1Body(endpoint, body)
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if *showVersion {
+		fmt.Printf("nsq_diskqueue_tool v%s\n", version.Binary)
+		return
+	}
+
+	if flag.NArg() != 1 {
+		usage()
+		os.Exit(2)
+	}
+	if *dataPath == "" {
+		*dataPath, _ = os.Getwd()
+	}
+	if *keyFile != "" {
+		var err error
+		keys, err = diskqueue.LoadKeyring(*keyFile)
+		if err != nil {
+			log.Fatalf("ERROR: failed to load --encryption-key-file - %s", err)
+		}
+	}
+
+	var segments []segment
+	var err error
+	if *file != "" {
+		var s segment
+		s, err = fileSegment(*file)
+		segments = []segment{s}
+	} else {
+		segments, err = findSegments(*dataPath, *queue)
+	}
+	if err != nil {
+		log.Fatalf("ERROR: %s", err)
+	}
+
+	dl, err := lockDataPath(flag.Arg(0), segments)
+	if err != nil {
+		log.Fatalf("ERROR: %s", err)
+	}
+	if dl != nil {
+		defer dl.Unlock()
+	}
+
+	switch flag.Arg(0) {
+	case "list":
+		err = list(segments)
+	case "dump":
+		if *file != "" {
+			err = readSegment(segments[0], func(offset int64, data []byte) error {
+				return printMessage(segments[0].fileName, offset, data)
+			})
+		} else {
+			err = dump(segments)
+		}
+	case "verify":
+		if !verify(segments) {
+			os.Exit(1)
+		}
+	case "recover":
+		if *nsqdHTTPAddr != "" && *file != "" && segments[0].queue == "" {
+			log.Fatalf("ERROR: can not tell the queue of %s", *file)
+		}
+		err = recoverSegments(segments)
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatalf("ERROR: %s", err)
+	}
+}
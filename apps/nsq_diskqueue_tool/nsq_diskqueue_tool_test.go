@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nsqio/go-diskqueue"
+	"github.com/nsqio/nsq/internal/dirlock"
+	"github.com/nsqio/nsq/internal/test"
+	"github.com/nsqio/nsq/nsqd"
+)
+
+// writeQueue writes the messages to a diskqueue like nsqd would
+func writeQueue(t *testing.T, dir string, name string, bodies ...string) {
+	logf := func(lvl diskqueue.LogLevel, f string, args ...interface{}) {
+		t.Logf(f, args...)
+	}
+	dq := diskqueue.New(name, dir, 1024*1024, nsqd.MinValidMsgLength,
+		nsqd.BackendMaxMsgSize(*maxMsgSize), 1, time.Second, logf)
+	var buf bytes.Buffer
+	for i, body := range bodies {
+		var id nsqd.MessageID
+		copy(id[:], fmt.Sprintf("%016x", i))
+		buf.Reset()
+		_, err := nsqd.NewMessage(id, []byte(body)).WriteTo(&buf)
+		test.Nil(t, err)
+		test.Nil(t, dq.Put(buf.Bytes()))
+	}
+	test.Nil(t, dq.Close())
+}
+
+func TestVerify(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nsq-test-")
+	test.Nil(t, err)
+	defer os.RemoveAll(dir)
+	*dataPath = dir
+
+	writeQueue(t, dir, "test", "a", "b", "c")
+	segments, err := findSegments(dir, "test")
+	test.Nil(t, err)
+	test.Equal(t, 1, len(segments))
+	test.Equal(t, "test", segments[0].queue)
+	test.Equal(t, true, verify(segments))
+
+	// damage the last message
+	data, err := ioutil.ReadFile(segments[0].fileName)
+	test.Nil(t, err)
+	data[len(data)-1] ^= 0xff
+	test.Nil(t, ioutil.WriteFile(segments[0].fileName, data, 0600))
+	test.Equal(t, false, verify(segments))
+}
+
+func TestRecover(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nsq-test-")
+	test.Nil(t, err)
+	defer os.RemoveAll(dir)
+	*dataPath = dir
+
+	writeQueue(t, dir, "test:ch", "a", "b", "c")
+	segments, err := findSegments(dir, "test:ch")
+	test.Nil(t, err)
+	test.Equal(t, 1, len(segments))
+	badFileName := segments[0].fileName + ".bad"
+	test.Nil(t, os.Rename(segments[0].fileName, badFileName))
+
+	opts := nsqd.NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.TCPAddress = "127.0.0.1:0"
+	opts.HTTPAddress = "127.0.0.1:0"
+	opts.DataPath, err = ioutil.TempDir("", "nsq-test-")
+	test.Nil(t, err)
+	defer os.RemoveAll(opts.DataPath)
+	n, err := nsqd.New(opts)
+	test.Nil(t, err)
+	go n.Main()
+	defer n.Exit()
+
+	*nsqdHTTPAddr = n.RealHTTPAddr().String()
+	defer func() { *nsqdHTTPAddr = "" }()
+
+	segments, err = findSegments(dir, "test:ch")
+	test.Nil(t, err)
+	test.Equal(t, true, segments[0].bad)
+	test.Nil(t, recoverSegments(segments))
+
+	topic, err := n.GetExistingTopic("test")
+	test.Nil(t, err)
+	channel, err := topic.GetExistingChannel("ch")
+	test.Nil(t, err)
+	test.Equal(t, int64(3), channel.Depth())
+}
+
+func TestLockDataPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nsq-test-")
+	test.Nil(t, err)
+	defer os.RemoveAll(dir)
+	*dataPath = dir
+
+	writeQueue(t, dir, "test", "a")
+	segments, err := findSegments(dir, "test")
+	test.Nil(t, err)
+
+	// nsqd holds the lock while it runs
+	dl := dirlock.New(dir)
+	test.Nil(t, dl.Lock())
+	_, err = lockDataPath("verify", segments)
+	test.NotNil(t, err)
+
+	// the .bad files can be recovered from a running nsqd's data directory
+	badFileName := filepath.Join(dir, "test.diskqueue.000000.dat.bad")
+	test.Nil(t, ioutil.WriteFile(badFileName, nil, 0600))
+	segments, err = findSegments(dir, "test")
+	test.Nil(t, err)
+	_, err = lockDataPath("recover", segments)
+	test.Nil(t, err)
+	test.Nil(t, dl.Unlock())
+
+	locked, err := lockDataPath("verify", segments)
+	test.Nil(t, err)
+	test.Nil(t, locked.Unlock())
+}
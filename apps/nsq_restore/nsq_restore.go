@@ -0,0 +1,62 @@
+// This is a utility application that rebuilds an nsqd data directory from a
+// snapshot archive (GET /snapshot on nsqd).
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/nsqio/nsq/internal/version"
+	"github.com/nsqio/nsq/nsqd"
+)
+
+var (
+	showVersion = flag.Bool("version", false, "print version string")
+
+	dataPath        = flag.String("data-path", "", "nsqd data directory to restore into (must not contain nsqd data)")
+	snapshot        = flag.String("snapshot", "", "path to the snapshot archive (\"-\" for stdin)")
+	maxBytesPerFile = flag.Int64("max-bytes-per-file", nsqd.NewOptions().MaxBytesPerFile, "--max-bytes-per-file of the nsqd that is restored")
+	maxMsgSize      = flag.Int64("max-msg-size", nsqd.NewOptions().MaxMsgSize, "--max-msg-size of the nsqd that is restored")
+	diskCompression = flag.String("disk-compression", "", "--disk-compression of the nsqd that is restored")
+	encryptionKeys  = flag.String("encryption-key-file", "", "--encryption-key-file of the nsqd that is restored")
+)
+
+func main() {
+	flag.Parse()
+
+	if *showVersion {
+		fmt.Printf("nsq_restore v%s\n", version.Binary)
+		return
+	}
+
+	if *snapshot == "" {
+		log.Fatal("--snapshot is required")
+	}
+
+	var r io.Reader = os.Stdin
+	if *snapshot != "-" {
+		f, err := os.Open(*snapshot)
+		if err != nil {
+			log.Fatalf("ERROR: failed to open snapshot - %s", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	opts := nsqd.NewOptions()
+	opts.DataPath = *dataPath
+	opts.MaxBytesPerFile = *maxBytesPerFile
+	opts.MaxMsgSize = *maxMsgSize
+	opts.DiskCompression = *diskCompression
+	opts.EncryptionKeyFile = *encryptionKeys
+
+	err := nsqd.RestoreSnapshot(r, opts)
+	if err != nil {
+		log.Fatalf("ERROR: failed to restore snapshot - %s", err)
+	}
+	log.Printf("restored %s", *snapshot)
+}
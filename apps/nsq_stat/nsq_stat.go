@@ -52,7 +52,7 @@ func (nv *numValue) Set(s string) error {
 
 func init() {
 	flag.Var(&nsqdHTTPAddrs, "nsqd-http-address", "nsqd HTTP address (may be given multiple times)")
-	flag.Var(&lookupdHTTPAddrs, "lookupd-http-address", "lookupd HTTP address (may be given multiple times)")
+	flag.Var(&lookupdHTTPAddrs, "lookupd-http-address", "lookupd HTTP address, or srv://<name> or dns://<host>:<port> resolved periodically (may be given multiple times)")
 	flag.Var(&countNum, "count", "number of reports")
 }
 
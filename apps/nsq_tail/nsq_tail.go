@@ -12,6 +12,7 @@ import (
 
 	"github.com/nsqio/go-nsq"
 	"github.com/nsqio/nsq/internal/app"
+	"github.com/nsqio/nsq/internal/discovery"
 	"github.com/nsqio/nsq/internal/version"
 )
 
@@ -30,7 +31,7 @@ var (
 
 func init() {
 	flag.Var(&nsqdTCPAddrs, "nsqd-tcp-address", "nsqd TCP address (may be given multiple times)")
-	flag.Var(&lookupdHTTPAddrs, "lookupd-http-address", "lookupd HTTP address (may be given multiple times)")
+	flag.Var(&lookupdHTTPAddrs, "lookupd-http-address", "lookupd HTTP address, or srv://<name> or dns://<host>:<port> resolved periodically (may be given multiple times)")
 	flag.Var(&topics, "topic", "NSQ topic (may be given multiple times)")
 }
 
@@ -121,7 +122,7 @@ func main() {
 			log.Fatal(err)
 		}
 
-		err = consumer.ConnectToNSQLookupds(lookupdHTTPAddrs)
+		err = discovery.ConnectToNSQLookupds(consumer, lookupdHTTPAddrs, discovery.DefaultInterval, consumer.StopChan, nil)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/nsqio/go-nsq"
+	"github.com/nsqio/nsq/internal/discovery"
 	"github.com/nsqio/nsq/internal/lg"
 )
 
@@ -65,7 +66,7 @@ func NewFileLogger(logf lg.AppLogFunc, opts *Options, topic string, cfg *nsq.Con
 		return nil, err
 	}
 
-	err = consumer.ConnectToNSQLookupds(opts.NSQLookupdHTTPAddrs)
+	err = discovery.ConnectToNSQLookupds(consumer, opts.NSQLookupdHTTPAddrs, discovery.DefaultInterval, consumer.StopChan, logf)
 	if err != nil {
 		return nil, err
 	}
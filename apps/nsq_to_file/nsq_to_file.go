@@ -61,7 +61,7 @@ func flagSet() *flag.FlagSet {
 	topics := app.StringArray{}
 	consumerOpts := app.StringArray{}
 	fs.Var(&nsqdTCPAddrs, "nsqd-tcp-address", "nsqd TCP address (may be given multiple times)")
-	fs.Var(&lookupdHTTPAddrs, "lookupd-http-address", "lookupd HTTP address (may be given multiple times)")
+	fs.Var(&lookupdHTTPAddrs, "lookupd-http-address", "lookupd HTTP address, or srv://<name> or dns://<host>:<port> resolved periodically (may be given multiple times)")
 	fs.Var(&topics, "topic", "nsq topic (may be given multiple times)")
 	fs.Var(&consumerOpts, "consumer-opt", "option to passthrough to nsq.Consumer (may be given multiple times, http://godoc.org/github.com/nsqio/go-nsq#Config)")
 
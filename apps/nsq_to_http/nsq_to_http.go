@@ -24,6 +24,7 @@ import (
 	"github.com/bitly/timer_metrics"
 	"github.com/nsqio/go-nsq"
 	"github.com/nsqio/nsq/internal/app"
+	"github.com/nsqio/nsq/internal/discovery"
 	"github.com/nsqio/nsq/internal/http_api"
 	"github.com/nsqio/nsq/internal/version"
 )
@@ -62,7 +63,7 @@ func init() {
 	flag.Var(&customHeaders, "header", "Custom header for HTTP requests (may be given multiple times)")
 	flag.Var(&getAddrs, "get", "HTTP address to make a GET request to. '%s' will be printf replaced with data (may be given multiple times)")
 	flag.Var(&nsqdTCPAddrs, "nsqd-tcp-address", "nsqd TCP address (may be given multiple times)")
-	flag.Var(&lookupdHTTPAddrs, "lookupd-http-address", "lookupd HTTP address (may be given multiple times)")
+	flag.Var(&lookupdHTTPAddrs, "lookupd-http-address", "lookupd HTTP address, or srv://<name> or dns://<host>:<port> resolved periodically (may be given multiple times)")
 }
 
 type Publisher interface {
@@ -286,7 +287,7 @@ func main() {
 		log.Fatal(err)
 	}
 
-	err = consumer.ConnectToNSQLookupds(lookupdHTTPAddrs)
+	err = discovery.ConnectToNSQLookupds(consumer, lookupdHTTPAddrs, discovery.DefaultInterval, consumer.StopChan, nil)
 	if err != nil {
 		log.Fatal(err)
 	}
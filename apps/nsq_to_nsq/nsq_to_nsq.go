@@ -20,6 +20,7 @@ import (
 	"github.com/bitly/timer_metrics"
 	"github.com/nsqio/go-nsq"
 	"github.com/nsqio/nsq/internal/app"
+	"github.com/nsqio/nsq/internal/discovery"
 	"github.com/nsqio/nsq/internal/protocol"
 	"github.com/nsqio/nsq/internal/version"
 )
@@ -51,7 +52,7 @@ var (
 func init() {
 	flag.Var(&nsqdTCPAddrs, "nsqd-tcp-address", "nsqd TCP address (may be given multiple times)")
 	flag.Var(&destNsqdTCPAddrs, "destination-nsqd-tcp-address", "destination nsqd TCP address (may be given multiple times)")
-	flag.Var(&lookupdHTTPAddrs, "lookupd-http-address", "lookupd HTTP address (may be given multiple times)")
+	flag.Var(&lookupdHTTPAddrs, "lookupd-http-address", "lookupd HTTP address, or srv://<name> or dns://<host>:<port> resolved periodically (may be given multiple times)")
 	flag.Var(&topics, "topic", "nsq topic (may be given multiple times)")
 	flag.Var(&whitelistJSONFields, "whitelist-json-field", "for JSON messages: pass this field (may be given multiple times)")
 }
@@ -391,7 +392,7 @@ func main() {
 	}
 
 	for _, consumer := range consumerList {
-		err := consumer.ConnectToNSQLookupds(lookupdHTTPAddrs)
+		err := discovery.ConnectToNSQLookupds(consumer, lookupdHTTPAddrs, discovery.DefaultInterval, consumer.StopChan, nil)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -53,7 +53,9 @@ func nsqadminFlagSet(opts *nsqadmin.Options) *flag.FlagSet {
 	flagSet.String("acl-http-header", opts.AclHttpHeader, "HTTP header to check for authenticated admin users")
 
 	nsqlookupdHTTPAddresses := app.StringArray{}
-	flagSet.Var(&nsqlookupdHTTPAddresses, "lookupd-http-address", "lookupd HTTP address (may be given multiple times)")
+	flagSet.Var(&nsqlookupdHTTPAddresses, "lookupd-http-address", "lookupd HTTP address, or srv://<name> or dns://<host>:<port> resolved periodically (may be given multiple times)")
+	flagSet.Bool("lookupd-tls", opts.NSQLookupdTLS, "query lookupd over HTTPS, verified with --http-client-tls-root-ca-file")
+	flagSet.String("lookupd-auth-secret", opts.NSQLookupdAuthSecret, "shared secret of lookupd (its --auth-secret)")
 	nsqdHTTPAddresses := app.StringArray{}
 	flagSet.Var(&nsqdHTTPAddresses, "nsqd-http-address", "nsqd HTTP address (may be given multiple times)")
 	adminUsers := app.StringArray{}
@@ -0,0 +1,14 @@
+// +build !windows
+
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+//SIGUSR1触发排空
+func notifyDrain(c chan<- os.Signal) {
+	signal.Notify(c, syscall.SIGUSR1)
+}
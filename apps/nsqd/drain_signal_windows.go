@@ -0,0 +1,11 @@
+// +build windows
+
+package main
+
+import (
+	"os"
+)
+
+// There is no SIGUSR1 on Windows, drain via the HTTP API instead.
+func notifyDrain(c chan<- os.Signal) {
+}
@@ -90,6 +90,18 @@ func (p *program) Start() error {
 			p.Stop()
 			os.Exit(1)
 		}
+		//排空完成
+		if p.nsqd.IsDraining() {
+			p.Stop()
+			os.Exit(0)
+		}
+	}()
+
+	drainChan := make(chan os.Signal, 1)
+	notifyDrain(drainChan)
+	go func() {
+		<-drainChan
+		p.nsqd.Drain(opts.DrainTimeout)
 	}()
 
 	return nil
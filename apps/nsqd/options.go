@@ -115,13 +115,25 @@ func nsqdFlagSet(opts *nsqd.Options) *flag.FlagSet {
 	flagSet.String("https-address", opts.HTTPSAddress, "<addr>:<port> to listen on for HTTPS clients")
 	flagSet.String("http-address", opts.HTTPAddress, "<addr>:<port> to listen on for HTTP clients")
 	flagSet.String("tcp-address", opts.TCPAddress, "<addr>:<port> to listen on for TCP clients")
+	flagSet.String("mqtt-address", opts.MQTTAddress, "<addr>:<port> to listen on for MQTT 3.1.1 clients (disabled if empty)")
+	flagSet.String("kafka-address", opts.KafkaAddress, "<addr>:<port> to listen on for Kafka protocol producers (disabled if empty)")
 	authHTTPAddresses := app.StringArray{}
 	flagSet.Var(&authHTTPAddresses, "auth-http-address", "<addr>:<port> to query auth server (may be given multiple times)")
 	flagSet.String("broadcast-address", opts.BroadcastAddress, "address that will be registered with lookupd (defaults to the OS hostname)")
+	tags := app.StringArray{}
+	flagSet.Var(&tags, "tag", "key:value tag (such as zone:us-east-1a) that will be registered with lookupd (may be given multiple times)")
 	lookupdTCPAddrs := app.StringArray{}
-	flagSet.Var(&lookupdTCPAddrs, "lookupd-tcp-address", "lookupd TCP address (may be given multiple times)")
+	flagSet.Var(&lookupdTCPAddrs, "lookupd-tcp-address", "lookupd TCP address, or srv://<name> or dns://<host>:<port> resolved every --lookupd-resolve-interval (may be given multiple times)")
+	flagSet.Bool("lookupd-tls", opts.NSQLookupdTLS, "connect to lookupd over TLS, verified with --tls-root-ca-file and presenting --tls-cert")
+	flagSet.String("lookupd-auth-secret", opts.NSQLookupdAuthSecret, "shared secret of lookupd (its --auth-secret)")
+	flagSet.Duration("lookupd-resolve-interval", opts.NSQLookupdResolveInterval, "duration of time between resolutions of the srv:// and dns:// lookupd addresses")
 	flagSet.Duration("http-client-connect-timeout", opts.HTTPClientConnectTimeout, "timeout for HTTP connect")
 	flagSet.Duration("http-client-request-timeout", opts.HTTPClientRequestTimeout, "timeout for HTTP request")
+	flagSet.String("audit-log", opts.AuditLogPath, "path to append the HTTP requests changing topics, channels and options to, served by /audit (kept in memory only if empty)")
+	flagSet.Int64("audit-log-max-size", opts.AuditLogMaxSize, "number of bytes of --audit-log before it is rotated to <audit-log>.1, replacing the previous one (never rotated if 0)")
+	flagSet.String("audit-topic", opts.AuditTopic, "topic of this nsqd to publish the audit log to (disabled if empty)")
+	flagSet.Float64("admin-rate-limit", opts.AdminRateLimit, "HTTP requests per second changing topics, channels and options to accept, the rest are queued (disabled if 0)")
+	flagSet.Duration("admin-rate-limit-wait", opts.AdminRateLimitWait, "maximum duration of time a request over --admin-rate-limit is queued for, the rest are answered 429")
 
 	// diskqueue options
 	flagSet.String("data-path", opts.DataPath, "path to store disk-backed messages")
@@ -129,6 +141,9 @@ func nsqdFlagSet(opts *nsqd.Options) *flag.FlagSet {
 	flagSet.Int64("max-bytes-per-file", opts.MaxBytesPerFile, "number of bytes per diskqueue file before rolling")
 	flagSet.Int64("sync-every", opts.SyncEvery, "number of messages per diskqueue fsync")
 	flagSet.Duration("sync-timeout", opts.SyncTimeout, "duration of time per diskqueue fsync")
+	flagSet.String("encryption-key-file", opts.EncryptionKeyFile, "path to a file of <id>:<base64 AES key> lines to encrypt disk queues with (the last key encrypts, all of them decrypt)")
+	flagSet.String("disk-compression", opts.DiskCompression, "compression of the messages written to disk queues: none, snappy or deflate (can be changed per topic with /topic/compression)")
+	flagSet.Bool("durable-writes", opts.DurableWrites, "write messages straight to disk and acknowledge publishes only once they are fsynced (deferred publishes are refused)")
 
 	flagSet.Int("queue-scan-worker-pool-max", opts.QueueScanWorkerPoolMax, "max concurrency for checking in-flight and deferred message timeouts")
 	flagSet.Int("queue-scan-selection-count", opts.QueueScanSelectionCount, "number of channels to check per cycle (every 100ms) for in-flight and deferred timeouts")
@@ -139,6 +154,7 @@ func nsqdFlagSet(opts *nsqd.Options) *flag.FlagSet {
 	flagSet.Int64("max-msg-size", opts.MaxMsgSize, "maximum size of a single message in bytes")
 	flagSet.Duration("max-req-timeout", opts.MaxReqTimeout, "maximum requeuing timeout for a message")
 	flagSet.Int64("max-body-size", opts.MaxBodySize, "maximum size of a single command body")
+	flagSet.Duration("drain-timeout", opts.DrainTimeout, "maximum duration to wait for channels to empty when draining before exiting")
 
 	// client overridable configuration options
 	flagSet.Duration("max-heartbeat-interval", opts.MaxHeartbeatInterval, "maximum client configurable duration of time between client heartbeats")
@@ -148,6 +164,7 @@ func nsqdFlagSet(opts *nsqd.Options) *flag.FlagSet {
 	flagSet.Duration("min-output-buffer-timeout", opts.MinOutputBufferTimeout, "minimum client configurable duration of time between flushing to a client")
 	flagSet.Duration("output-buffer-timeout", opts.OutputBufferTimeout, "default duration of time between flushing data to clients")
 	flagSet.Int("max-channel-consumers", opts.MaxChannelConsumers, "maximum channel consumer connection count per nsqd instance (default 0, i.e., unlimited)")
+	flagSet.String("channel-dispatch-policy", opts.ChannelDispatchPolicy, "how channels distribute messages among their consumers: any (first to read), round-robin, least-in-flight or weighted (by the capacity consumers IDENTIFY with), can be changed per channel with /channel/dispatch")
 
 	// statsd integration options
 	flagSet.String("statsd-address", opts.StatsdAddress, "UDP <addr>:<port> of a statsd daemon for pushing stats")
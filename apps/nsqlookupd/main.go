@@ -11,6 +11,7 @@ import (
 	"github.com/BurntSushi/toml"
 	"github.com/judwhite/go-svc/svc"
 	"github.com/mreiferson/go-options"
+	"github.com/nsqio/nsq/internal/app"
 	"github.com/nsqio/nsq/internal/lg"
 	"github.com/nsqio/nsq/internal/version"
 	"github.com/nsqio/nsq/nsqlookupd"
@@ -30,10 +31,37 @@ func nsqlookupdFlagSet(opts *nsqlookupd.Options) *flag.FlagSet {
 	flagSet.String("tcp-address", opts.TCPAddress, "<addr>:<port> to listen on for TCP clients")
 	flagSet.String("http-address", opts.HTTPAddress, "<addr>:<port> to listen on for HTTP clients")
 	flagSet.String("broadcast-address", opts.BroadcastAddress, "address of this lookupd node, (default to the OS hostname)")
+	flagSet.String("dns-address", opts.DNSAddress, "<addr>:<port> to answer DNS queries on over UDP and TCP, such as SRV <topic>.topic.<dns-domain>, <topic> matched ignoring case (disabled if empty)")
+	flagSet.String("dns-domain", opts.DNSDomain, "domain the DNS queries are answered for")
 
 	flagSet.Duration("inactive-producer-timeout", opts.InactiveProducerTimeout, "duration of time a producer will remain in the active list since its last ping")
 	flagSet.Duration("tombstone-lifetime", opts.TombstoneLifetime, "duration of time a producer will remain tombstoned if registration remains")
 
+	flagSet.String("data-path", opts.DataPath, "path to persist registrations, topics/channels created via HTTP and tombstones to (disabled if empty)")
+
+	flagSet.String("cluster-name", opts.ClusterName, "name of this cluster, marks the registrations other clusters import from this nsqlookupd")
+	federatedLookupdHTTPAddrs := app.StringArray{}
+	flagSet.Var(&federatedLookupdHTTPAddrs, "federated-lookupd-http-address", "HTTP address of a lookupd of another cluster to import registrations from, served by /lookup?scope=remote|all (may be given multiple times)")
+	flagSet.Duration("federation-interval", opts.FederationInterval, "duration of time between imports from --federated-lookupd-http-address")
+
+	replicaHTTPAddrs := app.StringArray{}
+	flagSet.Var(&replicaHTTPAddrs, "replica-http-address", "HTTP address of a lookupd of this cluster to replicate the topic and channel metadata with (may be given multiple times)")
+	flagSet.Duration("replication-interval", opts.ReplicationInterval, "duration of time between pulls of the metadata from --replica-http-address")
+	flagSet.Duration("metadata-tombstone-lifetime", opts.MetadataTombstoneLifetime, "duration of time deleted metadata is remembered for the replicas, a replica that is behind by longer brings it back (kept forever if 0)")
+
+	flagSet.String("audit-log", opts.AuditLogPath, "path to append the HTTP requests changing the registrations to, served by /audit (kept in memory only if empty)")
+	flagSet.Int64("audit-log-max-size", opts.AuditLogMaxSize, "number of bytes of --audit-log before it is rotated to <audit-log>.1, replacing the previous one (never rotated if 0)")
+	flagSet.String("audit-topic", opts.AuditTopic, "topic to publish the audit log to via --audit-nsqd-http-address (disabled if empty)")
+	flagSet.String("audit-nsqd-http-address", opts.AuditNSQDHTTPAddress, "HTTP address of the nsqd to publish --audit-topic to")
+	flagSet.Float64("admin-rate-limit", opts.AdminRateLimit, "requests per second changing the registrations to accept, the rest are queued (disabled if 0)")
+	flagSet.Duration("admin-rate-limit-wait", opts.AdminRateLimitWait, "maximum duration of time a request over --admin-rate-limit is queued for, the rest are answered 429")
+
+	flagSet.String("tls-cert", opts.TLSCert, "path to certificate file, serves TCP and HTTP over TLS only")
+	flagSet.String("tls-key", opts.TLSKey, "path to key file")
+	flagSet.String("tls-client-auth-policy", opts.TLSClientAuthPolicy, "client certificate auth policy ('require' or 'require-verify')")
+	flagSet.String("tls-root-ca-file", opts.TLSRootCAFile, "path to certificate authority file, verified client certificates are authorized like --auth-secret")
+	flagSet.String("auth-secret", opts.AuthSecret, "shared secret required to IDENTIFY over TCP and for HTTP requests changing the registrations (disabled if empty)")
+
 	return flagSet
 }
 
@@ -1,19 +1,28 @@
 module github.com/nsqio/nsq
 
+go 1.27.1
+
 require (
 	github.com/BurntSushi/toml v0.3.1
 	github.com/bitly/go-hostpool v0.0.0-20171023180738-a3a6125de932
 	github.com/bitly/timer_metrics v0.0.0-20170606164300-b1c65ca7ae62
 	github.com/blang/semver v3.5.1+incompatible
 	github.com/bmizerany/perks v0.0.0-20141205001514-d9a9656a3a4b
-	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/golang/snappy v0.0.0-20180518054509-2e65f85255db
 	github.com/judwhite/go-svc v1.0.0
 	github.com/julienschmidt/httprouter v1.2.0
 	github.com/mreiferson/go-options v0.0.0-20190302015348-0c63f026bcd6
 	github.com/nsqio/go-diskqueue v0.0.0-20180306152900-74cfbc9de839
 	github.com/nsqio/go-nsq v1.0.7
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/stretchr/testify v1.2.2 // indirect
 	golang.org/x/sys v0.0.0-20181221143128-b4a75ba826a6 // indirect
 )
+
+replace github.com/nsqio/go-diskqueue => ./nsqio/go-diskqueue@v0.0.0-20180306152900-74cfbc9de839
+
+replace github.com/nsqio/go-nsq => ./nsqio/go-nsq@v1.0.7
@@ -0,0 +1,383 @@
+// Package audit records the HTTP calls that change nsqd and nsqlookupd: who
+// made them, from where, with which parameters and how they were answered.
+// The entries are appended to a file rotated at a maximum size, kept in memory
+// for /audit and can be forwarded to a topic
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/nsqio/nsq/internal/http_api"
+	"github.com/nsqio/nsq/internal/lg"
+)
+
+// DefaultSize is how many of the latest entries are kept for /audit
+const DefaultSize = 1000
+
+// DefaultMaxFileSize is the size the file is rotated at by default
+const DefaultMaxFileSize = 10 * 1024 * 1024
+
+// CallerHeader names the caller as claimed by the client or a proxy in front,
+// like nsqadmin's --acl-http-header. Anyone can set it, so it is recorded apart
+// from the caller of a verified client certificate
+const CallerHeader = "X-Forwarded-User"
+
+const (
+	maxBodySize  = 4096 //更大的或二进制的body不记录
+	queueSize    = 1024 //等待转发的entry，满了就丢弃
+	defaultLimit = 100
+)
+
+// Entry is one audited call
+type Entry struct {
+	Time          int64               `json:"time"`                     //UnixNano
+	Caller        string              `json:"caller,omitempty"`         //验证过的客户端证书的CN
+	ClaimedCaller string              `json:"claimed_caller,omitempty"` //CallerHeader，没有验证
+	RemoteAddr    string              `json:"remote_addr"`
+	Method        string              `json:"method"`
+	Path          string              `json:"path"`
+	Params        map[string][]string `json:"params,omitempty"`
+	Body          json.RawMessage     `json:"body,omitempty"`
+	Status        int                 `json:"status"`
+	Error         string              `json:"error,omitempty"`
+}
+
+// Log is the append-only audit log
+type Log struct {
+	sync.RWMutex
+	size    int
+	entries []*Entry //最近的size个，按时间顺序
+
+	path        string
+	maxFileSize int64    //file超过后重命名为path.1，0为不轮转
+	file        *os.File //没有配置路径时为nil
+	fileSize    int64
+
+	forward func([]byte) error //转发到topic，没有配置时为nil
+	queue   chan []byte
+	dropped int64
+	wg      sync.WaitGroup
+
+	logf lg.AppLogFunc
+}
+
+// New opens the log appending to the file at path, if any, whose latest
+// entries are loaded for Query. The file is renamed to path.1, replacing the
+// previous one, before it grows over maxFileSize bytes (0 for never). forward,
+// if not nil, is called with each entry as JSON from a queue so that a slow
+// destination does not hold up the calls
+func New(path string, maxFileSize int64, size int, forward func([]byte) error, logf lg.AppLogFunc) (*Log, error) {
+	l := &Log{
+		size:        size,
+		path:        path,
+		maxFileSize: maxFileSize,
+		forward:     forward,
+		logf:        logf,
+	}
+
+	if path != "" {
+		for _, p := range []string{path + ".1", path} {
+			err := l.load(p)
+			if err != nil {
+				return nil, err
+			}
+		}
+		err := l.openFile()
+		if err != nil {
+			return nil, err
+		}
+		if l.maxFileSize > 0 && l.fileSize > l.maxFileSize {
+			// written before the limit was lowered
+			l.rotate()
+		}
+	}
+
+	if forward != nil {
+		l.queue = make(chan []byte, queueSize)
+		l.wg.Add(1)
+		go l.forwardLoop(l.queue)
+	}
+	return l, nil
+}
+
+// load keeps the latest entries of the file at path, reading at most the last
+// maxFileSize bytes of it
+func (l *Log) load(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to open audit log %s - %s", path, err)
+	}
+	defer f.Close()
+
+	partial := false
+	if l.maxFileSize > 0 {
+		fi, err := f.Stat()
+		if err != nil {
+			return fmt.Errorf("failed to stat audit log %s - %s", path, err)
+		}
+		if fi.Size() > l.maxFileSize {
+			_, err = f.Seek(fi.Size()-l.maxFileSize, io.SeekStart)
+			if err != nil {
+				return fmt.Errorf("failed to seek audit log %s - %s", path, err)
+			}
+			partial = true
+		}
+	}
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	if partial {
+		// the first line is cut
+		scanner.Scan()
+	}
+	for scanner.Scan() {
+		var e Entry
+		if json.Unmarshal(scanner.Bytes(), &e) != nil {
+			continue
+		}
+		l.append(&e)
+	}
+	return scanner.Err()
+}
+
+func (l *Log) openFile() error {
+	f, err := os.OpenFile(l.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log %s - %s", l.path, err)
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("failed to stat audit log %s - %s", l.path, err)
+	}
+	l.file = f
+	l.fileSize = fi.Size()
+	return nil
+}
+
+// rotate renames the file to path.1 and starts a new one, the entries keep
+// being written to the old one if that fails
+func (l *Log) rotate() {
+	err := os.Rename(l.path, l.path+".1")
+	if err != nil {
+		l.logf(lg.ERROR, "AUDIT: failed to rotate %s - %s", l.path, err)
+		return
+	}
+	old := l.file
+	err = l.openFile()
+	if err != nil {
+		l.logf(lg.ERROR, "AUDIT: %s", err)
+		return
+	}
+	old.Close()
+}
+
+func (l *Log) append(e *Entry) {
+	l.entries = append(l.entries, e)
+	if len(l.entries) > l.size {
+		l.entries = l.entries[len(l.entries)-l.size:]
+	}
+}
+
+// Record appends e to the log
+func (l *Log) Record(e *Entry) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		l.logf(lg.ERROR, "AUDIT: failed to marshal entry - %s", err)
+		return
+	}
+
+	l.Lock()
+	l.append(e)
+	if l.file != nil {
+		line := append(data, '\n')
+		if l.maxFileSize > 0 && l.fileSize > 0 && l.fileSize+int64(len(line)) > l.maxFileSize {
+			l.rotate()
+		}
+		var n int
+		n, err = l.file.Write(line)
+		l.fileSize += int64(n)
+	}
+	if l.queue != nil {
+		select {
+		case l.queue <- data:
+		default:
+			atomic.AddInt64(&l.dropped, 1)
+		}
+	}
+	l.Unlock()
+	if err != nil {
+		l.logf(lg.ERROR, "AUDIT: failed to write entry - %s", err)
+	}
+}
+
+func (l *Log) forwardLoop(queue chan []byte) {
+	defer l.wg.Done()
+	for data := range queue {
+		err := l.forward(data)
+		if err != nil {
+			atomic.AddInt64(&l.dropped, 1)
+			l.logf(lg.ERROR, "AUDIT: failed to forward entry - %s", err)
+		}
+	}
+}
+
+// Close forwards the queued entries and closes the file
+func (l *Log) Close() {
+	l.Lock()
+	queue := l.queue
+	l.queue = nil
+	if l.file != nil {
+		l.file.Close()
+		l.file = nil
+	}
+	l.Unlock()
+
+	if queue != nil {
+		close(queue)
+		l.wg.Wait()
+	}
+}
+
+// Query returns up to limit of the latest entries since the UnixNano time
+// since, of caller (verified or claimed) and path if they are not empty, oldest
+// first
+func (l *Log) Query(since int64, caller string, path string, limit int) []*Entry {
+	l.RLock()
+	defer l.RUnlock()
+	results := []*Entry{}
+	for i := len(l.entries) - 1; i >= 0 && len(results) < limit; i-- {
+		e := l.entries[i]
+		if e.Time < since {
+			break
+		}
+		if (caller != "" && e.Caller != caller && e.ClaimedCaller != caller) || (path != "" && e.Path != path) {
+			continue
+		}
+		results = append(results, e)
+	}
+	for i, j := 0, len(results)-1; i < j; i, j = i+1, j-1 {
+		results[i], results[j] = results[j], results[i]
+	}
+	return results
+}
+
+// Dropped returns how many entries could not be forwarded
+func (l *Log) Dropped() int64 {
+	return atomic.LoadInt64(&l.dropped)
+}
+
+// Caller identifies who made req by the common name of its verified client
+// certificate, empty without one
+func Caller(req *http.Request) string {
+	return verifiedCommonName(req.TLS)
+}
+
+// ClaimedCaller returns who req says made it in CallerHeader, which is not
+// verified
+func ClaimedCaller(req *http.Request) string {
+	return req.Header.Get(CallerHeader)
+}
+
+func verifiedCommonName(state *tls.ConnectionState) string {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return state.VerifiedChains[0][0].Subject.CommonName
+}
+
+// Audited records the calls of f, including the ones that fail
+func (l *Log) Audited(f http_api.APIHandler) http_api.APIHandler {
+	return func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+		e := &Entry{
+			Time:          time.Now().UnixNano(),
+			Caller:        Caller(req),
+			ClaimedCaller: ClaimedCaller(req),
+			RemoteAddr:    req.RemoteAddr,
+			Method:        req.Method,
+			Path:          req.URL.Path,
+			Params:        req.URL.Query(),
+			Status:        200,
+		}
+		if len(e.Params) == 0 {
+			e.Params = nil
+		}
+		if req.Body != nil {
+			// the handler still reads the whole body
+			body, _ := io.ReadAll(io.LimitReader(req.Body, maxBodySize+1))
+			req.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(body), req.Body), req.Body}
+			switch {
+			case len(body) == 0 || len(body) > maxBodySize:
+			case json.Valid(body):
+				e.Body = body
+			case utf8.Valid(body):
+				// such as PUT /config/log_level
+				e.Body, _ = json.Marshal(string(body))
+			}
+		}
+
+		response, err := f(w, req, ps)
+		if err != nil {
+			e.Status = 500
+			if httpErr, ok := err.(http_api.Err); ok {
+				e.Status = httpErr.Code
+			}
+			e.Error = err.Error()
+		}
+		l.Record(e)
+		return response, err
+	}
+}
+
+// DoQuery serves /audit?since=<UnixNano>&caller=..&path=..&limit=..
+func (l *Log) DoQuery(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	var since int64
+	if s, _ := reqParams.Get("since"); s != "" {
+		since, err = strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, http_api.Err{400, "INVALID_ARG_SINCE"}
+		}
+	}
+	limit := defaultLimit
+	if s, _ := reqParams.Get("limit"); s != "" {
+		limit, err = strconv.Atoi(s)
+		if err != nil || limit <= 0 {
+			return nil, http_api.Err{400, "INVALID_ARG_LIMIT"}
+		}
+	}
+	caller, _ := reqParams.Get("caller")
+	path, _ := reqParams.Get("path")
+	if path != "" && !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return struct {
+		Entries []*Entry `json:"entries"`
+		Dropped int64    `json:"dropped"`
+	}{l.Query(since, caller, path, limit), l.Dropped()}, nil
+}
@@ -0,0 +1,168 @@
+package audit
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/nsqio/nsq/internal/http_api"
+	"github.com/nsqio/nsq/internal/lg"
+	"github.com/nsqio/nsq/internal/test"
+)
+
+func testLogf(t *testing.T) lg.AppLogFunc {
+	return func(lvl lg.LogLevel, f string, args ...interface{}) {
+		t.Logf(f, args...)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nsq-test-")
+	test.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	l, err := New(path, 0, 3, nil, testLogf(t))
+	test.Nil(t, err)
+	for i, p := range []string{"/topic/create", "/topic/delete", "/topic/create", "/channel/create"} {
+		l.Record(&Entry{Time: int64(i + 1), Caller: "ops", Path: p})
+	}
+	l.Record(&Entry{Time: 5, Caller: "deploy", Path: "/topic/create"})
+
+	// only the latest 3 are kept
+	entries := l.Query(0, "", "", 10)
+	test.Equal(t, 3, len(entries))
+	test.Equal(t, int64(3), entries[0].Time)
+	test.Equal(t, int64(5), entries[2].Time)
+
+	entries = l.Query(0, "ops", "", 10)
+	test.Equal(t, 2, len(entries))
+	entries = l.Query(0, "", "/topic/create", 1)
+	test.Equal(t, 1, len(entries))
+	test.Equal(t, "deploy", entries[0].Caller)
+	entries = l.Query(4, "", "", 10)
+	test.Equal(t, 2, len(entries))
+	l.Close()
+
+	// the file has all of them, the latest are loaded again
+	data, err := ioutil.ReadFile(path)
+	test.Nil(t, err)
+	test.Equal(t, 5, strings.Count(string(data), "\n"))
+	l, err = New(path, 0, 3, nil, testLogf(t))
+	test.Nil(t, err)
+	defer l.Close()
+	entries = l.Query(0, "", "", 10)
+	test.Equal(t, 3, len(entries))
+	test.Equal(t, int64(3), entries[0].Time)
+}
+
+func TestRotate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nsq-test-")
+	test.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	// an entry is about 75 bytes, the file is rotated every 2
+	l, err := New(path, 200, 10, nil, testLogf(t))
+	test.Nil(t, err)
+	for i := 0; i < 10; i++ {
+		l.Record(&Entry{Time: int64(i + 1), Path: "/topic/create"})
+	}
+	l.Close()
+
+	for _, p := range []string{path, path + ".1"} {
+		fi, err := os.Stat(p)
+		test.Nil(t, err)
+		test.Equal(t, true, fi.Size() > 100 && fi.Size() <= 200)
+	}
+
+	// the latest are loaded again from both files
+	l, err = New(path, 200, 10, nil, testLogf(t))
+	test.Nil(t, err)
+	entries := l.Query(0, "", "", 10)
+	l.Close()
+	test.Equal(t, 4, len(entries))
+	test.Equal(t, int64(7), entries[0].Time)
+	test.Equal(t, int64(10), entries[3].Time)
+
+	// a file written without a limit is rotated when opened with one, and
+	// only its end is read
+	data := []byte("{\"time\":1}\n")
+	for i := 0; i < 1000; i++ {
+		data = append(data, []byte(`{"time":2,"path":"/topic/create"}`+"\n")...)
+	}
+	test.Nil(t, os.Remove(path+".1"))
+	test.Nil(t, ioutil.WriteFile(path, data, 0600))
+	l, err = New(path, 200, 1000, nil, testLogf(t))
+	test.Nil(t, err)
+	defer l.Close()
+	entries = l.Query(0, "", "", 1000)
+	test.Equal(t, true, len(entries) < 10)
+	test.Equal(t, "/topic/create", entries[0].Path)
+	fi, err := os.Stat(path)
+	test.Nil(t, err)
+	test.Equal(t, int64(0), fi.Size())
+}
+
+func TestAudited(t *testing.T) {
+	var mtx sync.Mutex
+	var forwarded []string
+	forward := func(data []byte) error {
+		mtx.Lock()
+		defer mtx.Unlock()
+		forwarded = append(forwarded, string(data))
+		if len(forwarded) > 1 {
+			return errors.New("unavailable")
+		}
+		return nil
+	}
+	l, err := New("", 0, DefaultSize, forward, testLogf(t))
+	test.Nil(t, err)
+
+	var body []byte
+	handler := http_api.Decorate(l.Audited(func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+		body, _ = ioutil.ReadAll(req.Body)
+		if req.URL.Query().Get("topic") == "" {
+			return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
+		}
+		return nil, nil
+	}), http_api.V1)
+
+	req := httptest.NewRequest("POST", "/topic/config?topic=orders", strings.NewReader(`{"retention":"72h"}`))
+	req.Header.Set(CallerHeader, "ops")
+	handler(httptest.NewRecorder(), req, nil)
+	test.Equal(t, `{"retention":"72h"}`, string(body))
+
+	req = httptest.NewRequest("PUT", "/config/log_level", strings.NewReader("debug"))
+	handler(httptest.NewRecorder(), req, nil)
+
+	large := strings.Repeat("x", maxBodySize+1)
+	req = httptest.NewRequest("POST", "/topic/import", strings.NewReader(large))
+	handler(httptest.NewRecorder(), req, nil)
+	test.Equal(t, large, string(body))
+	l.Close()
+
+	entries := l.Query(0, "", "", 10)
+	test.Equal(t, 3, len(entries))
+	test.Equal(t, "", entries[0].Caller)
+	test.Equal(t, "ops", entries[0].ClaimedCaller)
+	test.Equal(t, "192.0.2.1:1234", entries[0].RemoteAddr)
+	test.Equal(t, []string{"orders"}, entries[0].Params["topic"])
+	test.Equal(t, `{"retention":"72h"}`, string(entries[0].Body))
+	test.Equal(t, 200, entries[0].Status)
+	test.Equal(t, `"debug"`, string(entries[1].Body))
+	test.Equal(t, 400, entries[1].Status)
+	test.Equal(t, "MISSING_ARG_TOPIC", entries[1].Error)
+	test.Equal(t, 0, len(entries[2].Body))
+
+	test.Equal(t, 3, len(forwarded))
+	test.Equal(t, true, strings.Contains(forwarded[0], `"path":"/topic/config"`))
+	test.Equal(t, int64(2), l.Dropped())
+}
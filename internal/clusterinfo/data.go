@@ -10,6 +10,7 @@ import (
 	"sync"
 
 	"github.com/blang/semver"
+	"github.com/nsqio/nsq/internal/discovery"
 	"github.com/nsqio/nsq/internal/http_api"
 	"github.com/nsqio/nsq/internal/lg"
 	"github.com/nsqio/nsq/internal/stringy"
@@ -35,17 +36,36 @@ func (l ErrList) Errors() []error {
 }
 
 type ClusterInfo struct {
-	log    lg.AppLogFunc
-	client *http_api.Client
+	log               lg.AppLogFunc
+	client            *http_api.Client
+	lookupdScheme     string           //nsqlookupd开启TLS时为https
+	lookupdAuthSecret string           //nsqlookupd的--auth-secret
+	resolver          *discovery.Cache //解析srv://和dns://形式的nsqlookupd地址
 }
 
 func New(log lg.AppLogFunc, client *http_api.Client) *ClusterInfo {
 	return &ClusterInfo{
-		log:    log,
-		client: client,
+		log:           log,
+		client:        client,
+		lookupdScheme: "http",
+		resolver:      discovery.NewCache(nil, discovery.DefaultInterval, log),
 	}
 }
 
+// SetResolver replaces the cache that resolves the nsqlookupd given as names
+func (c *ClusterInfo) SetResolver(resolver *discovery.Cache) {
+	c.resolver = resolver
+}
+
+// SetLookupdAuth makes the requests to nsqlookupd use https if useTLS and
+// authenticates the ones changing the registrations with secret
+func (c *ClusterInfo) SetLookupdAuth(useTLS bool, secret string) {
+	if useTLS {
+		c.lookupdScheme = "https"
+	}
+	c.lookupdAuthSecret = secret
+}
+
 func (c *ClusterInfo) logf(f string, args ...interface{}) {
 	if c.log != nil {
 		c.log(lg.INFO, f, args...)
@@ -71,6 +91,7 @@ func (c *ClusterInfo) GetVersion(addr string) (semver.Version, error) {
 // GetLookupdTopics returns a []string containing a union of all the topics
 // from all the given nsqlookupd
 func (c *ClusterInfo) GetLookupdTopics(lookupdHTTPAddrs []string) ([]string, error) {
+	lookupdHTTPAddrs = c.resolver.Resolve(lookupdHTTPAddrs)
 	var topics []string
 	var lock sync.Mutex
 	var wg sync.WaitGroup
@@ -85,7 +106,7 @@ func (c *ClusterInfo) GetLookupdTopics(lookupdHTTPAddrs []string) ([]string, err
 		go func(addr string) {
 			defer wg.Done()
 
-			endpoint := fmt.Sprintf("http://%s/topics", addr)
+			endpoint := fmt.Sprintf("%s://%s/topics", c.lookupdScheme, addr)
 			c.logf("CI: querying nsqlookupd %s", endpoint)
 
 			var resp respType
@@ -120,6 +141,7 @@ func (c *ClusterInfo) GetLookupdTopics(lookupdHTTPAddrs []string) ([]string, err
 // GetLookupdTopicChannels returns a []string containing a union of all the channels
 // from all the given lookupd for the given topic
 func (c *ClusterInfo) GetLookupdTopicChannels(topic string, lookupdHTTPAddrs []string) ([]string, error) {
+	lookupdHTTPAddrs = c.resolver.Resolve(lookupdHTTPAddrs)
 	var channels []string
 	var lock sync.Mutex
 	var wg sync.WaitGroup
@@ -134,7 +156,7 @@ func (c *ClusterInfo) GetLookupdTopicChannels(topic string, lookupdHTTPAddrs []s
 		go func(addr string) {
 			defer wg.Done()
 
-			endpoint := fmt.Sprintf("http://%s/channels?topic=%s", addr, url.QueryEscape(topic))
+			endpoint := fmt.Sprintf("%s://%s/channels?topic=%s", c.lookupdScheme, addr, url.QueryEscape(topic))
 			c.logf("CI: querying nsqlookupd %s", endpoint)
 
 			var resp respType
@@ -166,8 +188,69 @@ func (c *ClusterInfo) GetLookupdTopicChannels(topic string, lookupdHTTPAddrs []s
 	return channels, nil
 }
 
+// GetLookupdTopicMetadata returns the metadata of the topics by name, the
+// newest of the given lookupds'
+func (c *ClusterInfo) GetLookupdTopicMetadata(lookupdHTTPAddrs []string) (map[string]*Metadata, error) {
+	return c.getLookupdMetadata("topics", lookupdHTTPAddrs)
+}
+
+// GetLookupdChannelMetadata returns the metadata of the channels of topic by
+// name, the newest of the given lookupds'
+func (c *ClusterInfo) GetLookupdChannelMetadata(topic string, lookupdHTTPAddrs []string) (map[string]*Metadata, error) {
+	return c.getLookupdMetadata("channels?topic="+url.QueryEscape(topic), lookupdHTTPAddrs)
+}
+
+func (c *ClusterInfo) getLookupdMetadata(uri string, lookupdHTTPAddrs []string) (map[string]*Metadata, error) {
+	lookupdHTTPAddrs = c.resolver.Resolve(lookupdHTTPAddrs)
+	metadata := make(map[string]*Metadata)
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	var errs []error
+
+	type respType struct {
+		Metadata map[string]*Metadata `json:"metadata"`
+	}
+
+	for _, addr := range lookupdHTTPAddrs {
+		wg.Add(1)
+		go func(addr string) {
+			defer wg.Done()
+
+			endpoint := fmt.Sprintf("%s://%s/%s", c.lookupdScheme, addr, uri)
+			c.logf("CI: querying nsqlookupd %s", endpoint)
+
+			var resp respType
+			err := c.client.GETV1(endpoint, &resp)
+			if err != nil {
+				lock.Lock()
+				errs = append(errs, err)
+				lock.Unlock()
+				return
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+			for name, m := range resp.Metadata {
+				if old, ok := metadata[name]; !ok || m.UpdatedAt > old.UpdatedAt {
+					metadata[name] = m
+				}
+			}
+		}(addr)
+	}
+	wg.Wait()
+
+	if len(errs) == len(lookupdHTTPAddrs) {
+		return nil, fmt.Errorf("Failed to query any nsqlookupd: %s", ErrList(errs))
+	}
+	if len(errs) > 0 {
+		return metadata, ErrList(errs)
+	}
+	return metadata, nil
+}
+
 // GetLookupdProducers returns Producers of all the nsqd connected to the given lookupds
 func (c *ClusterInfo) GetLookupdProducers(lookupdHTTPAddrs []string) (Producers, error) {
+	lookupdHTTPAddrs = c.resolver.Resolve(lookupdHTTPAddrs)
 	var producers []*Producer
 	var lock sync.Mutex
 	var wg sync.WaitGroup
@@ -185,7 +268,7 @@ func (c *ClusterInfo) GetLookupdProducers(lookupdHTTPAddrs []string) (Producers,
 		go func(addr string) {
 			defer wg.Done()
 
-			endpoint := fmt.Sprintf("http://%s/nodes", addr)
+			endpoint := fmt.Sprintf("%s://%s/nodes", c.lookupdScheme, addr)
 			c.logf("CI: querying nsqlookupd %s", endpoint)
 
 			var resp respType
@@ -238,6 +321,7 @@ func (c *ClusterInfo) GetLookupdProducers(lookupdHTTPAddrs []string) (Producers,
 // GetLookupdTopicProducers returns Producers of all the nsqd for a given topic by
 // unioning the nodes returned from the given lookupd
 func (c *ClusterInfo) GetLookupdTopicProducers(topic string, lookupdHTTPAddrs []string) (Producers, error) {
+	lookupdHTTPAddrs = c.resolver.Resolve(lookupdHTTPAddrs)
 	var producers Producers
 	var lock sync.Mutex
 	var wg sync.WaitGroup
@@ -252,7 +336,7 @@ func (c *ClusterInfo) GetLookupdTopicProducers(topic string, lookupdHTTPAddrs []
 		go func(addr string) {
 			defer wg.Done()
 
-			endpoint := fmt.Sprintf("http://%s/lookup?topic=%s", addr, url.QueryEscape(topic))
+			endpoint := fmt.Sprintf("%s://%s/lookup?topic=%s", c.lookupdScheme, addr, url.QueryEscape(topic))
 			c.logf("CI: querying nsqlookupd %s", endpoint)
 
 			var resp respType
@@ -633,8 +717,7 @@ func (c *ClusterInfo) TombstoneNodeForTopic(topic string, node string, lookupdHT
 	var errs []error
 
 	// tombstone the topic on all the lookupds
-	qs := fmt.Sprintf("topic=%s&node=%s", url.QueryEscape(topic), url.QueryEscape(node))
-	err := c.nsqlookupdPOST(lookupdHTTPAddrs, "topic/tombstone", qs)
+	err := c.TombstoneTopicProducer(topic, node, lookupdHTTPAddrs)
 	if err != nil {
 		pe, ok := err.(PartialErr)
 		if !ok {
@@ -653,7 +736,7 @@ func (c *ClusterInfo) TombstoneNodeForTopic(topic string, node string, lookupdHT
 	}
 
 	// delete the topic on the producer
-	qs = fmt.Sprintf("topic=%s", url.QueryEscape(topic))
+	qs := fmt.Sprintf("topic=%s", url.QueryEscape(topic))
 	err = c.producersPOST(producers, "topic/delete", qs)
 	if err != nil {
 		pe, ok := err.(PartialErr)
@@ -669,6 +752,22 @@ func (c *ClusterInfo) TombstoneNodeForTopic(topic string, node string, lookupdHT
 	return nil
 }
 
+// TombstoneTopicProducer tombstones the given node for the given topic on all the given
+// nsqlookupd, leaving the topic on the node intact
+func (c *ClusterInfo) TombstoneTopicProducer(topic string, node string, lookupdHTTPAddrs []string) error {
+	qs := fmt.Sprintf("topic=%s&node=%s", url.QueryEscape(topic), url.QueryEscape(node))
+	return c.nsqlookupdPOST(lookupdHTTPAddrs, "topic/tombstone", qs)
+}
+
+// MigrateTopic starts moving the backlog of the given topic from the nsqd at node to the
+// nsqd at target (both HTTP addresses)
+func (c *ClusterInfo) MigrateTopic(topic string, node string, target string) error {
+	endpoint := fmt.Sprintf("http://%s/topic/migrate?topic=%s&target=%s",
+		node, url.QueryEscape(topic), url.QueryEscape(target))
+	c.logf("CI: querying nsqd %s", endpoint)
+	return c.client.POSTV1(endpoint)
+}
+
 func (c *ClusterInfo) CreateTopicChannel(topicName string, channelName string, lookupdHTTPAddrs []string) error {
 	var errs []error
 
@@ -875,10 +974,10 @@ func (c *ClusterInfo) GetTopicProducers(topicName string, lookupdHTTPAddrs []str
 
 func (c *ClusterInfo) nsqlookupdPOST(addrs []string, uri string, qs string) error {
 	var errs []error
-	for _, addr := range addrs {
-		endpoint := fmt.Sprintf("http://%s/%s?%s", addr, uri, qs)
+	for _, addr := range c.resolver.Resolve(addrs) {
+		endpoint := fmt.Sprintf("%s://%s/%s?%s", c.lookupdScheme, addr, uri, qs)
 		c.logf("CI: querying nsqlookupd %s", endpoint)
-		err := c.client.POSTV1(endpoint)
+		err := c.client.POSTV1Auth(endpoint, c.lookupdAuthSecret)
 		if err != nil {
 			errs = append(errs, err)
 		}
@@ -102,10 +102,39 @@ type TopicStats struct {
 	NodeStats    []*TopicStats   `json:"nodes"`
 	Channels     []*ChannelStats `json:"channels"`
 	Paused       bool            `json:"paused"`
+	Migration    *MigrationStats `json:"migration,omitempty"`
+
+	// per node, ratios are not aggregated
+	Compression      string  `json:"compression,omitempty"`
+	CompressionRatio float64 `json:"compression_ratio,omitempty"`
 
 	E2eProcessingLatency *quantile.E2eProcessingLatencyAggregate `json:"e2e_processing_latency"`
 }
 
+// MigrationStats is the progress of moving a topic's backlog off of a node
+type MigrationStats struct {
+	Target        string           `json:"target"`
+	State         string           `json:"state"`
+	Error         string           `json:"error"`
+	StartTime     int64            `json:"start_time"`
+	EndTime       int64            `json:"end_time"`
+	MessagesMoved int64            `json:"messages_moved"`
+	Remaining     int64            `json:"remaining"`
+	ChannelsMoved map[string]int64 `json:"channels_moved"`
+}
+
+// Metadata is what nsqlookupd records about a topic or channel for the people
+// operating it
+type Metadata struct {
+	Owner       string            `json:"owner,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Retention   string            `json:"retention,omitempty"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+	UpdatedAt   int64             `json:"updated_at"`
+	UpdatedBy   string            `json:"updated_by"`
+}
+
 func (t *TopicStats) Add(a *TopicStats) {
 	t.Node = "*"
 	t.Depth += a.Depth
@@ -157,6 +186,9 @@ type ChannelStats struct {
 	Clients       []*ClientStats  `json:"clients"`
 	Paused        bool            `json:"paused"`
 
+	// per node, ratios are not aggregated
+	CompressionRatio float64 `json:"compression_ratio,omitempty"`
+
 	E2eProcessingLatency *quantile.E2eProcessingLatencyAggregate `json:"e2e_processing_latency"`
 }
 
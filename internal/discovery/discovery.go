@@ -0,0 +1,281 @@
+// Package discovery resolves the addresses given as DNS names, so that the
+// nsqlookupd can scale or move without changing the flags that list them:
+//
+//	srv://_nsqlookupd._tcp.example.com  the targets and ports of the SRV records
+//	dns://nsqlookupd.example.com:4161   the A/AAAA records of the host with the port
+//
+// any other address is used as it is
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nsqio/nsq/internal/lg"
+)
+
+const (
+	srvScheme = "srv://"
+	dnsScheme = "dns://"
+)
+
+// DefaultInterval is how often the names are resolved again by default
+const DefaultInterval = 60 * time.Second
+
+// how long a lookup may take
+const lookupTimeout = 5 * time.Second
+
+// Resolver looks up the DNS records, *net.Resolver implements it and tests
+// use a stub
+type Resolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// IsName reports whether addr is a name to resolve
+func IsName(addr string) bool {
+	return strings.HasPrefix(addr, srvScheme) || strings.HasPrefix(addr, dnsScheme)
+}
+
+// Validate checks the syntax of the names in addrs
+func Validate(addrs []string) error {
+	for _, addr := range addrs {
+		if addr == srvScheme {
+			return fmt.Errorf("missing name in %s", addr)
+		}
+		if strings.HasPrefix(addr, dnsScheme) {
+			_, _, err := net.SplitHostPort(strings.TrimPrefix(addr, dnsScheme))
+			if err != nil {
+				return fmt.Errorf("invalid %s - %s", addr, err)
+			}
+		}
+	}
+	return nil
+}
+
+func lookup(ctx context.Context, r Resolver, addr string) ([]string, error) {
+	var addrs []string
+	switch {
+	case strings.HasPrefix(addr, srvScheme):
+		_, records, err := r.LookupSRV(ctx, "", "", strings.TrimPrefix(addr, srvScheme))
+		if err != nil {
+			return nil, err
+		}
+		for _, srv := range records {
+			host := strings.TrimSuffix(srv.Target, ".")
+			addrs = append(addrs, net.JoinHostPort(host, fmt.Sprint(srv.Port)))
+		}
+	case strings.HasPrefix(addr, dnsScheme):
+		host, port, err := net.SplitHostPort(strings.TrimPrefix(addr, dnsScheme))
+		if err != nil {
+			return nil, err
+		}
+		hosts, err := r.LookupHost(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range hosts {
+			addrs = append(addrs, net.JoinHostPort(h, port))
+		}
+	default:
+		return []string{addr}, nil
+	}
+	if len(addrs) == 0 {
+		return nil, errors.New("no records")
+	}
+	return addrs, nil
+}
+
+type entry struct {
+	addrs      []string
+	resolvedAt time.Time
+}
+
+// Cache resolves the names at most every interval, a name whose lookup fails
+// keeps what it resolved to last
+type Cache struct {
+	sync.Mutex
+	resolver Resolver
+	interval time.Duration
+	logf     lg.AppLogFunc
+	entries  map[string]*entry
+}
+
+// NewCache returns a Cache using r, net.DefaultResolver if r is nil
+func NewCache(r Resolver, interval time.Duration, logf lg.AppLogFunc) *Cache {
+	if r == nil {
+		r = net.DefaultResolver
+	}
+	return &Cache{
+		resolver: r,
+		interval: interval,
+		logf:     logf,
+		entries:  make(map[string]*entry),
+	}
+}
+
+// Resolve returns addrs with the names replaced by what they resolve to, in
+// order and without duplicates. It blocks while names that are due are looked
+// up, but not the other callers
+func (c *Cache) Resolve(addrs []string) []string {
+	var results []string
+	seen := make(map[string]bool)
+	for _, addr := range addrs {
+		resolved := []string{addr}
+		if IsName(addr) {
+			resolved = c.resolve(addr)
+		}
+		for _, a := range resolved {
+			if !seen[a] {
+				seen[a] = true
+				results = append(results, a)
+			}
+		}
+	}
+	return results
+}
+
+func (c *Cache) resolve(name string) []string {
+	c.Lock()
+	e, ok := c.entries[name]
+	c.Unlock()
+	if ok && time.Since(e.resolvedAt) < c.interval {
+		return e.addrs
+	}
+
+	// the lock is not held during the lookup, which may take lookupTimeout
+	ctx, cancel := context.WithTimeout(context.Background(), lookupTimeout)
+	defer cancel()
+	addrs, err := lookup(ctx, c.resolver, name)
+	if err != nil {
+		if c.logf != nil {
+			c.logf(lg.ERROR, "DISCOVERY: failed to resolve %s - %s", name, err)
+		}
+		if ok {
+			return e.addrs
+		}
+		return nil
+	}
+	if c.logf != nil && (!ok || !equal(e.addrs, addrs)) {
+		c.logf(lg.INFO, "DISCOVERY: %s resolved to %v", name, addrs)
+	}
+	c.Lock()
+	c.entries[name] = &entry{addrs: addrs, resolvedAt: time.Now()}
+	c.Unlock()
+	return addrs
+}
+
+func equal(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Connector is a go-nsq Consumer following nsqlookupd
+type Connector interface {
+	ConnectToNSQLookupd(addr string) error
+	DisconnectFromNSQLookupd(addr string) error
+}
+
+// ConnectToNSQLookupds connects c to the nsqlookupd that addrs resolve to and,
+// if there are names among them, follows their records every interval until
+// stopChan is closed, logf may be nil to use the standard logger
+func ConnectToNSQLookupds(c Connector, addrs []string, interval time.Duration,
+	stopChan chan int, logf lg.AppLogFunc) error {
+	if len(addrs) == 0 {
+		return nil
+	}
+	if logf == nil {
+		logf = func(lvl lg.LogLevel, f string, args ...interface{}) {
+			log.Printf(lvl.String()+": "+f, args...)
+		}
+	}
+	cache := NewCache(nil, interval, logf)
+	connected := cache.Resolve(addrs)
+	if len(connected) == 0 {
+		return errors.New("no nsqlookupd resolved")
+	}
+	for _, addr := range connected {
+		err := c.ConnectToNSQLookupd(addr)
+		if err != nil {
+			return err
+		}
+	}
+
+	hasName := false
+	for _, addr := range addrs {
+		hasName = hasName || IsName(addr)
+	}
+	if !hasName {
+		return nil
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-stopChan:
+				return
+			}
+			connected = follow(c, connected, cache.Resolve(addrs), logf)
+		}
+	}()
+	return nil
+}
+
+// follow connects to the addresses that were added and disconnects from the
+// ones that were removed, it returns the ones c is connected to
+func follow(c Connector, connected []string, resolved []string, logf lg.AppLogFunc) []string {
+	if len(resolved) == 0 {
+		return connected
+	}
+	var results []string
+	for _, addr := range resolved {
+		if !in(addr, connected) {
+			err := c.ConnectToNSQLookupd(addr)
+			if err != nil {
+				if logf != nil {
+					logf(lg.ERROR, "DISCOVERY: failed to connect to nsqlookupd %s - %s", addr, err)
+				}
+				continue
+			}
+		}
+		results = append(results, addr)
+	}
+	for _, addr := range connected {
+		if in(addr, resolved) {
+			continue
+		}
+		err := c.DisconnectFromNSQLookupd(addr)
+		if err != nil {
+			if logf != nil {
+				logf(lg.ERROR, "DISCOVERY: failed to disconnect from nsqlookupd %s - %s", addr, err)
+			}
+			results = append(results, addr)
+		}
+	}
+	return results
+}
+
+func in(s string, lst []string) bool {
+	for _, v := range lst {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
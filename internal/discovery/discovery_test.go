@@ -0,0 +1,186 @@
+package discovery
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nsqio/nsq/internal/test"
+)
+
+type stubResolver struct {
+	sync.Mutex
+	srv   map[string][]*net.SRV
+	hosts map[string][]string
+	err   error
+	calls int
+}
+
+func (r *stubResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	r.Lock()
+	defer r.Unlock()
+	r.calls++
+	if r.err != nil {
+		return "", nil, r.err
+	}
+	return name, r.srv[name], nil
+}
+
+func (r *stubResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	r.Lock()
+	defer r.Unlock()
+	r.calls++
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.hosts[host], nil
+}
+
+func (r *stubResolver) set(srv map[string][]*net.SRV, hosts map[string][]string, err error) {
+	r.Lock()
+	defer r.Unlock()
+	r.srv = srv
+	r.hosts = hosts
+	r.err = err
+}
+
+func TestValidate(t *testing.T) {
+	test.Nil(t, Validate([]string{"127.0.0.1:4161", "srv://_nsqlookupd._tcp.example.com", "dns://example.com:4161"}))
+	test.NotNil(t, Validate([]string{"srv://"}))
+	test.NotNil(t, Validate([]string{"dns://example.com"}))
+}
+
+func TestResolve(t *testing.T) {
+	r := &stubResolver{}
+	r.set(map[string][]*net.SRV{
+		"_nsqlookupd._tcp.example.com": {
+			{Target: "lookupd1.example.com.", Port: 4160},
+			{Target: "lookupd2.example.com.", Port: 4160},
+		},
+	}, map[string][]string{
+		"lookupd.example.com": {"10.0.0.1", "10.0.0.2"},
+	}, nil)
+	c := NewCache(r, time.Hour, nil)
+
+	addrs := c.Resolve([]string{
+		"10.0.0.1:4161",
+		"srv://_nsqlookupd._tcp.example.com",
+		"dns://lookupd.example.com:4161",
+	})
+	test.Equal(t, []string{
+		"10.0.0.1:4161",
+		"lookupd1.example.com:4160",
+		"lookupd2.example.com:4160",
+		"10.0.0.2:4161",
+	}, addrs)
+	test.Equal(t, 2, r.calls)
+
+	// resolved again only once the interval passed
+	c.Resolve([]string{"dns://lookupd.example.com:4161"})
+	test.Equal(t, 2, r.calls)
+
+	c.interval = 0
+	r.set(nil, map[string][]string{"lookupd.example.com": {"10.0.0.3"}}, nil)
+	addrs = c.Resolve([]string{"dns://lookupd.example.com:4161"})
+	test.Equal(t, []string{"10.0.0.3:4161"}, addrs)
+
+	// a failed lookup keeps the last result
+	r.set(nil, nil, errors.New("SERVFAIL"))
+	addrs = c.Resolve([]string{"dns://lookupd.example.com:4161"})
+	test.Equal(t, []string{"10.0.0.3:4161"}, addrs)
+	addrs = c.Resolve([]string{"srv://_nsqlookupd._tcp.example.com"})
+	test.Equal(t, 2, len(addrs))
+	addrs = c.Resolve([]string{"dns://unknown.example.com:4161"})
+	test.Equal(t, 0, len(addrs))
+}
+
+// delayedResolver holds up the lookup of host until release is closed
+type delayedResolver struct {
+	*stubResolver
+	host    string
+	started chan struct{}
+	release chan struct{}
+}
+
+func (r *delayedResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if host == r.host {
+		close(r.started)
+		<-r.release
+	}
+	return r.stubResolver.LookupHost(ctx, host)
+}
+
+func TestResolveConcurrent(t *testing.T) {
+	r := &delayedResolver{
+		stubResolver: &stubResolver{},
+		host:         "slow.example.com",
+		started:      make(chan struct{}),
+		release:      make(chan struct{}),
+	}
+	r.set(nil, map[string][]string{
+		"slow.example.com": {"10.0.0.1"},
+		"fast.example.com": {"10.0.0.2"},
+	}, nil)
+	c := NewCache(r, time.Hour, nil)
+
+	slowChan := make(chan []string)
+	go func() {
+		slowChan <- c.Resolve([]string{"dns://slow.example.com:4161"})
+	}()
+	<-r.started
+
+	// the slow lookup does not hold up the others
+	fastChan := make(chan []string)
+	go func() {
+		fastChan <- c.Resolve([]string{"dns://fast.example.com:4161"})
+	}()
+	select {
+	case addrs := <-fastChan:
+		test.Equal(t, []string{"10.0.0.2:4161"}, addrs)
+	case <-time.After(time.Second):
+		t.Fatal("resolving waited for another lookup")
+	}
+
+	close(r.release)
+	test.Equal(t, []string{"10.0.0.1:4161"}, <-slowChan)
+}
+
+type stubConnector struct {
+	addrs []string
+}
+
+func (c *stubConnector) ConnectToNSQLookupd(addr string) error {
+	if addr == "bad:4161" {
+		return errors.New("bad address")
+	}
+	c.addrs = append(c.addrs, addr)
+	return nil
+}
+
+func (c *stubConnector) DisconnectFromNSQLookupd(addr string) error {
+	for i, a := range c.addrs {
+		if a == addr {
+			c.addrs = append(c.addrs[:i], c.addrs[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("not connected")
+}
+
+func TestFollow(t *testing.T) {
+	c := &stubConnector{}
+	connected := follow(c, nil, []string{"a:4161", "b:4161"}, nil)
+	test.Equal(t, []string{"a:4161", "b:4161"}, connected)
+	test.Equal(t, []string{"a:4161", "b:4161"}, c.addrs)
+
+	connected = follow(c, connected, []string{"b:4161", "c:4161", "bad:4161"}, nil)
+	test.Equal(t, []string{"b:4161", "c:4161"}, connected)
+	test.Equal(t, []string{"b:4161", "c:4161"}, c.addrs)
+
+	// nothing resolved, stay connected
+	connected = follow(c, connected, nil, nil)
+	test.Equal(t, []string{"b:4161", "c:4161"}, connected)
+}
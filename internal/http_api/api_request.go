@@ -1,6 +1,7 @@
 package http_api
 
 import (
+	"bytes"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
@@ -97,13 +98,30 @@ retry:
 //GETV1是V1版本的http post方法
 //将get的内容解析成json
 func (c *Client) POSTV1(endpoint string) error {
+	return c.POSTV1Body(endpoint, nil)
+}
+
+// POSTV1Body is POSTV1 with a request body
+func (c *Client) POSTV1Body(endpoint string, data []byte) error {
+	return c.postV1(endpoint, data, "")
+}
+
+// POSTV1Auth is POSTV1 sending token as "Authorization: Bearer <token>"
+func (c *Client) POSTV1Auth(endpoint string, token string) error {
+	return c.postV1(endpoint, nil, token)
+}
+
+func (c *Client) postV1(endpoint string, data []byte, token string) error {
 retry:
-	req, err := http.NewRequest("POST", endpoint, nil)
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(data))
 	if err != nil {
 		return err
 	}
 
 	req.Header.Add("Accept", "application/vnd.nsq; version=1.0")
+	if token != "" {
+		req.Header.Add("Authorization", "Bearer "+token)
+	}
 
 	resp, err := c.c.Do(req)
 	if err != nil {
@@ -0,0 +1,57 @@
+package http_api
+
+import (
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// RateLimit returns a decorator letting through perSecond calls on average,
+// in bursts of up to one second's worth, shared by all the handlers it
+// decorates. The calls over the limit are queued in turn for up to maxWait,
+// the rest are answered 429. perSecond <= 0 lets everything through
+func RateLimit(perSecond float64, maxWait time.Duration) Decorator {
+	if perSecond <= 0 {
+		return func(f APIHandler) APIHandler { return f }
+	}
+
+	burst := math.Max(1, math.Ceil(perSecond))
+	var mtx sync.Mutex
+	tokens := burst //排队的调用预先取走token，可以为负
+	last := time.Now()
+	return func(f APIHandler) APIHandler {
+		return func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+			mtx.Lock()
+			now := time.Now()
+			tokens = math.Min(burst, tokens+now.Sub(last).Seconds()*perSecond)
+			last = now
+			wait := time.Duration((1 - tokens) / perSecond * float64(time.Second))
+			allowed := wait <= maxWait
+			if allowed {
+				tokens--
+			}
+			mtx.Unlock()
+
+			if !allowed {
+				return nil, Err{429, "TOO_MANY_REQUESTS"}
+			}
+			if wait > 0 {
+				t := time.NewTimer(wait)
+				select {
+				case <-t.C:
+				case <-req.Context().Done():
+					// the caller gave up, its turn goes to the next one
+					t.Stop()
+					mtx.Lock()
+					tokens++
+					mtx.Unlock()
+					return nil, Err{429, "TOO_MANY_REQUESTS"}
+				}
+			}
+			return f(w, req, ps)
+		}
+	}
+}
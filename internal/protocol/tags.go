@@ -0,0 +1,31 @@
+package protocol
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseTags parses "key:value" pairs, such as the --tag flags of nsqd or the
+// tag params of nsqlookupd's /lookup, into a map
+func ParseTags(pairs []string) (map[string]string, error) {
+	tags := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		i := strings.Index(pair, ":")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid tag %q, expected key:value", pair)
+		}
+		tags[pair[:i]] = pair[i+1:]
+	}
+	return tags, nil
+}
+
+// MatchTags returns how many of want are in tags
+func MatchTags(tags map[string]string, want map[string]string) int {
+	n := 0
+	for k, v := range want {
+		if tv, ok := tags[k]; ok && tv == v {
+			n++
+		}
+	}
+	return n
+}
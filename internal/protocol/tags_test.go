@@ -0,0 +1,21 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/nsqio/nsq/internal/test"
+)
+
+func TestParseTags(t *testing.T) {
+	tags, err := ParseTags([]string{"zone:us-east-1a", "rack:", "url:http://x"})
+	test.Nil(t, err)
+	test.Equal(t, map[string]string{"zone": "us-east-1a", "rack": "", "url": "http://x"}, tags)
+
+	_, err = ParseTags([]string{"zone"})
+	test.NotNil(t, err)
+	_, err = ParseTags([]string{":us-east-1a"})
+	test.NotNil(t, err)
+
+	test.Equal(t, 1, MatchTags(tags, map[string]string{"zone": "us-east-1a", "rack": "r1"}))
+	test.Equal(t, 0, MatchTags(nil, map[string]string{"zone": "us-east-1a"}))
+}
@@ -260,7 +260,7 @@ func indexHtml() (*asset, error) {
 	return a, nil
 }
 
-var _mainJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x0d\x7b\xdb\x36\xb2\x28\xfc\x57\x68\x74\x57\x26\x2a\x88\x96\xec\xa4\xdd\x52\x66\x74\xb3\x4d\xba\x9b\x7b\xdb\x24\x27\x49\xf7\xdc\xf7\xba\x3a\x3e\xb0\x04\x59\x6c\x28\x40\x25\xa1\x38\x5e\x4b\xf7\xb7\xbf\x0f\x3e\x09\x90\xa0\x2c\xa7\xd9\xde\xb3\x7b\xba\xfb\x34\x16\xf1\x8d\xc1\x60\x30\x33\x98\x19\x1c\x2d\x36\x74\xc6\x73\x46\x23\x16\xe7\xa8\x42\x25\xbc\xb3\x29\xb3\x98\x20\x0a\xef\xf2\x45\x7c\x54\x5d\x90\xa9\xfa\x95\xcb\x5f\x1f\x70\x19\xf1\x0c\x98\xa2\x20\xcb\xf8\xed\x9a\xb0\x45\x54\x92\x5f\x36\x79\x49\x7a\x3d\xfd\x63\x2c\xea\xd0\x5e\x8f\xc3\x92\xf0\x4d\x49\x23\x1e\x13\x74\x34\x84\x22\x7d\x69\xd2\x96\x3a\x4d\xb4\x8a\x33\x4a\x6e\xa2\xe7\x65\xc9\xca\x18\x7c\x8b\x29\x65\x3c\x5a\xe4\x74\x1e\xad\xd8\x7c\x53\x90\xe8\x18\xf4\x49\x1f\x1c\x03\x38\xe6\xcb\x92\xdd\x44\x38\x99\xb1\x39\xc9\xc0\x0f\xaf\x9e\xfd\xf8\xfd\xf3\xcb\x97\xaf\xde\x5d\x7e\xf7\xea\xc7\x97\xcf\x00\xc2\x3b\xd1\x5e\x91\x89\xb1\x67\x77\xe4\xe3\x9a\x95\xbc\x4a\xef\x76\xbb\xb1\x98\xc3\xc5\x70\x9a\xcc\x70\x51\xc4\x45\xa2\xb3\x90\x99\x4d\x4c\xe1\x9d\x1e\xd9\x2c\x96\x65\x47\xd3\x0b\x3a\xdd\x6e\x29\xdc\xa1\x02\xd5\x15\x18\x52\x20\xdb\xe9\xd2\xa2\x27\x93\xb9\x5b\xb0\x32\x16\x03\x58\x1e\x02\x26\x44\xb3\xe1\x98\x9e\x97\x49\x41\xe8\x35\x5f\x8e\x69\xbf\x0f\x67\x71\x79\x41\xa7\x70\x6c\x86\xb2\x8b\xef\x46\xe9\x45\x3d\x48\x44\x10\x87\x77\x34\x06\x49\x72\x92\x24\x27\x3f\x57\x27\xbc\xc4\xb4\xca\x45\x6e\xf2\x73\x05\x20\x72\xf3\x70\x41\x4a\xde\x4e\xbe\xda\x70\x1e\x2a\x3e\xc3\x25\xdb\x54\xa4\x08\xe4\xb0\xa2\xc0\xeb\x8a\xb4\x73\xe6\x25\x5b\xcf\xd9\x4d\xa0\xb5\x15\x9b\xe3\x40\x53\x9c\xb1\x82\xe7\xeb\x76\xc6\x9a\xad\xd9\x07\x52\xb6\x33\xaa\x59\xc9\x8a\xa2\x5a\xdf\x06\x1a\xc3\x57\x81\x59\x2f\x16\xf9\x47\x99\xbc\x43\x77\x81\xe4\xf4\x14\x05\x40\x94\x9e\xa1\x10\x84\xd2\x47\x28\x0c\xa0\xf4\x31\x0a\xc3\x27\xfd\x0a\x85\xc1\x93\x7e\x8d\x02\xd0\x49\xff\x84\x82\x30\x48\xbf\x41\x1d\x20\x48\x47\x43\xd4\x02\x41\x3a\x1a\xa1\x20\x90\xd3\x91\x3b\x5d\x1f\x5b\xd2\xd1\xd9\x6e\x8a\x4e\xdb\x08\x66\x29\x44\x5c\xc2\x3b\xb0\xa9\x48\x54\xf1\x32\x9f\x71\x20\xb7\xeb\x2c\x73\xcb\xc3\x3b\xbe\xcc\xab\x84\xad\x45\x42\x95\x95\x09\xf9\xc8\x09\x9d\xc7\x77\x3b\x34\x4b\x9e\x3d\xff\xee\xe9\x8f\xdf\xbf\x7b\x8b\x08\x44\xb2\xd8\x1f\x38\x2e\xaf\x09\xcf\xca\xd8\xad\x95\xa8\x54\x98\x30\x1a\x03\x35\xd9\xe4\xaa\x4a\xd4\x92\xcd\x31\xc7\x03\xbc\xce\x01\x2a\x93\x75\xc9\x3e\xde\xaa\xaa\xb3\x25\x99\xbd\x7f\xcd\xd4\x6c\x64\xe3\x50\xd5\x9f\x15\xf9\xec\xfd\xc3\xaa\xff\x7b\xce\x97\xcf\x3f\x10\xca\xbf\x67\x6c\xad\xdb\xd2\xe3\x25\x05\x59\x11\x2a\x06\x4c\x75\x92\x6c\x96\xcc\x33\xba\x29\x0a\x95\xb2\xa1\xeb\x9c\x3a\xdf\xeb\x9c\x52\x32\x7f\xb5\x58\x54\x84\x3b\xc9\x5e\x97\x31\xdc\x8d\x2d\xd5\xe5\x31\xb6\xe4\x47\x16\x25\x78\xb6\x8c\x2d\x94\x15\xed\xa5\x1a\x68\x10\x91\x8c\xca\x69\xc5\xc0\xcc\x12\x40\xc4\x33\xc0\xae\x7e\x26\x33\x5e\x53\x1c\xdc\xeb\xe1\x31\xd9\x6e\x5b\xa5\x45\x0b\xe4\x26\x9a\xc9\xf6\x10\x87\x10\x01\xb1\xc0\xf4\xda\xab\x4b\x2e\xf0\x34\x86\x3b\xb8\x9b\x25\x7f\x7b\xfe\xe6\xed\x8b\x57\x2f\x33\x70\x96\x9c\x25\x5f\x03\x34\x4b\xde\x3c\x7f\xfb\xfc\x5d\x06\x64\x7b\x91\xfc\x77\xc0\xd9\x5a\xff\xba\x62\x9c\xb3\x15\x70\x10\x20\xbb\x63\x12\x1c\xe9\x10\xa9\xb5\x4e\x6f\x72\x3a\x67\x37\x02\x49\xd6\x25\xe3\x4c\x74\x9b\x5c\x13\xfe\x96\x63\x4e\x32\x1f\x21\x11\x56\x10\x28\x32\x17\x87\x12\x85\x28\xef\xd8\x3a\x86\x88\x65\xde\x72\x25\xaa\xb7\x18\xa2\xdc\xaf\xb3\x24\xf9\xf5\x92\xc7\xf2\x2c\x12\x2b\x73\x94\xf1\x5e\x0f\x70\xb6\x16\x33\x77\x56\xd7\x1c\x53\xc5\xb9\xc9\x17\x35\x80\x9e\x58\xb8\xac\x6e\x6f\x72\x14\x17\xfd\x1a\x2f\xce\x33\x96\x70\xb6\x86\xbd\x9e\xa9\x9d\x8a\x02\xf9\x79\x46\x07\xd8\x49\x95\x5b\xab\x92\xe8\xe2\x37\x8f\xca\xac\x9a\x14\xa9\x6c\x65\xec\x77\xd5\xeb\x15\xe7\x19\x9f\xc8\xf1\xa5\x2a\x0d\xf7\x7a\x74\x80\xcf\xb3\xb2\x1f\x57\x93\x3c\x25\x4e\x0f\x2d\x58\xbf\x76\xf1\xd4\xc1\xb6\x7c\x11\xb7\xf0\x18\xba\xe8\xe9\x66\x8c\x7d\xc0\x97\x64\xc5\x3e\x90\x6f\x0b\x5c\x55\xb1\xc6\x12\x98\xe0\xf9\x5c\xa5\x00\x8d\xad\x63\x85\xd0\x9d\xcb\x49\x3a\x96\x73\xdc\x35\x8a\x8c\x08\xf0\x0c\xa8\x3f\xc7\xee\x4d\xee\x4e\xb7\x22\xfc\x5d\xbe\x22\x6c\xc3\xe3\xfb\x28\x0c\x1a\xc1\x3d\x3d\x84\x60\x68\xa7\x90\x57\x31\x48\x3f\xe4\x55\x7e\x55\x10\x00\xcd\x96\xf6\xcb\x18\xec\x34\xf3\x37\xe4\x51\x4d\x1f\xe9\x59\x22\x9c\x91\x44\xad\x29\x2a\xb2\x1f\x30\x5f\x26\x2b\xfc\x31\x2e\xe3\x39\x9b\x6d\x44\x3b\xb0\x6e\xa8\x4e\x4c\xae\xd8\xfc\xb6\xce\x81\x63\x43\x2e\x8e\xcc\x96\x27\xbd\x5e\x8c\x33\x9e\x11\x88\x02\xec\x0b\xef\xf5\x62\x3d\x00\x7f\x66\x30\x58\x1c\xcb\xc6\xcc\x38\x9b\x35\xe4\xfa\xeb\x3d\x6b\x36\x7d\x5c\x20\x2a\xf7\xfa\xd8\xc0\x4e\x6f\x80\xa3\x8c\xc1\x3b\x8d\xf1\x76\x5b\xf5\x7a\x3e\xe8\x66\x02\xbb\xc4\x3e\x40\x40\xa3\x57\xae\xa9\x13\xe8\xc7\x6c\x02\x06\xa0\xcf\x52\x00\x20\x12\x47\x94\x44\x83\x38\xef\x83\xa4\x26\xa0\xe3\xd6\x8a\xf1\x32\xbf\xbe\x26\x65\x5c\x41\x54\x25\x79\xf5\x8c\x2c\xf0\xa6\xe0\xaf\x4b\x22\x6a\x93\x79\x0c\xf5\x9e\x18\x7b\xc7\x02\x73\xcf\x84\x9a\x60\xb0\x89\x99\xac\xbb\xeb\x62\x98\xd6\xe7\xc3\x81\x7b\x28\x87\x76\x64\x79\x52\x92\x75\x81\x67\xc4\x6c\x2c\x04\xf4\x30\x00\xf4\x26\xb7\x73\x06\xd2\x84\x9c\xde\x5b\x77\x9c\xad\xd3\x62\x40\x07\x78\x07\x77\x3b\xbd\x41\xcb\x64\x41\x55\x1b\xe3\xfa\x67\xc6\x51\xfd\x91\x7c\xcb\x68\xc5\xcb\xcd\x8c\xb3\x32\x9b\xb9\x19\x94\x7d\xcb\xe8\xa2\xc8\x67\x1e\x6d\xd1\x1b\xd8\x69\x4d\x6d\xad\x1d\x2a\x63\x75\x24\xa8\x53\xbc\x60\x78\x0e\x90\x5b\x31\x3e\xbe\x90\x67\x79\xb5\xbe\x35\x2b\x3b\x3d\x86\x87\x1e\x95\x70\x4c\xf4\x4c\x33\xf3\x63\xbb\xbd\xdb\x21\x85\x57\x26\xe9\xcf\x12\x4a\xbd\x5e\x6c\x12\x34\xfe\x36\x0a\xc0\x46\xb5\x77\x6c\xed\xd6\xe1\x6c\xed\x66\x41\xc4\x95\xd8\x21\xb8\xa5\x9d\xf8\x7f\xfc\xf3\xbf\x6d\x48\x79\x2b\x18\xd4\xdd\x14\x9d\xed\x63\xc0\x58\x80\x01\x23\x99\x06\xc5\x3c\xaf\x56\x79\x55\x65\x40\xb2\xb1\x60\x7a\x8c\xf2\xcc\x95\x68\x58\x4c\x1d\x9e\x08\x88\xb6\x25\x61\x2b\x58\x45\xe0\x6e\x9c\xb7\xce\xf5\x3c\x79\xf7\xe6\xe9\xcb\xb7\x2f\xde\xbd\x78\xf5\xf2\xf2\xd9\x8f\x6f\x9e\x8a\x1f\xd9\xe8\xf1\x10\xe5\x2e\xd1\x13\xf5\xbd\x8e\xd4\xa8\x98\x06\xba\x00\x31\xe6\xbc\x8c\x81\x1c\xa5\xa2\xef\x42\x7a\xdb\x6e\x63\x9e\xc5\x75\xf6\xb2\x24\x0b\x00\x61\xaf\xc7\x2d\x22\x9f\x24\x5f\xc6\x93\xec\x8b\x8b\xff\xf8\xa9\x9a\x7e\xf9\x07\x78\x22\xb6\xb3\x91\x12\x59\x0c\xbe\x00\x59\x96\xf1\xc9\xc5\x34\xe5\xb0\xe6\x9f\x8a\x18\xde\xe1\x64\x4e\xb8\xc0\x85\x7a\x7b\x00\x39\xd0\xb9\xdc\x07\x12\x40\x50\x6f\xad\x18\xee\x68\xaf\x47\x93\xb5\xda\xcb\x7a\x67\xc7\x10\x61\x2d\x8c\x6d\xb7\x92\x78\xc9\xfa\x15\x8f\x81\xa9\x2f\x4a\x98\xd6\x69\xc6\x34\x21\x51\xfd\x38\xdd\x40\x44\x83\x04\x43\x34\xeb\xed\x6e\x90\x53\x00\x11\x4b\xaa\xcd\x5a\x48\x90\x49\xcd\x9f\xf7\x7a\x38\x59\xe2\x4a\x17\x5b\xe0\x39\x01\x70\x82\x13\x46\x89\xe0\xe3\xde\xd9\x72\xcf\xe9\x1c\xa0\x02\x26\x64\xb5\x29\x30\x27\x5e\x46\x1c\x5c\x4e\x98\x16\x31\x84\x66\x87\x33\xb9\x11\xc5\xb0\xc7\xf5\xcf\x7a\x6d\xf9\x21\x7c\x29\x0b\xf2\xa5\x0a\x14\x4d\xfe\x53\xa6\x6a\xfe\x33\x8f\x35\xab\xdd\xe2\x3e\xb9\xe0\x3e\xb9\x96\xd6\xa9\xd8\x32\xa8\x1e\x9d\x47\x72\x72\x37\x63\x2f\xc9\x71\xa6\x67\x48\x0e\x73\x8e\x4c\x5f\x74\x90\xcd\xd5\xa2\x03\x69\xe3\x7f\x73\x17\x3f\x7a\xe8\x2e\x2e\x42\x62\x94\x95\x37\x98\x95\x37\x8c\x64\xc5\x1c\xc9\xaa\x68\x4b\x56\x79\xf5\x3d\xc3\xf3\x9c\x5e\x67\x47\xa3\x87\x8b\x16\xc1\x25\x54\x62\xf0\xc1\xb2\x85\x2e\xae\x17\xb7\x70\x84\x0b\xce\xae\xaf\x0b\x02\xb2\x0c\x4f\x04\x03\x21\x3e\x62\x98\x0a\x11\x23\xa9\xcc\xe9\x8f\xc5\x2a\x17\x2d\x8a\x54\x38\x22\x44\xa1\x26\xf8\x8e\x7c\xe4\x29\xd0\x1f\x49\x92\x00\x01\x8f\x7a\x75\xaa\xb6\x10\x61\x08\x14\x98\xe7\x15\xbe\x2a\xc8\x1c\x20\xee\xb3\x5e\x08\x67\x8a\x45\xcb\xe9\x7a\xc3\x01\x9c\x80\x0f\xb8\x00\x29\x58\xf2\x55\x01\x50\x91\x71\x73\x8a\xd0\x7e\x06\xc4\x00\x00\x52\x9c\x7a\x91\x94\x44\x90\x79\xf2\x91\x0b\x32\xa6\x80\x61\x93\x00\xe2\x52\x88\x82\xc8\x61\x32\x99\x66\x32\x9d\x45\x90\xa5\x74\x83\x17\x74\x3a\x71\x17\xfe\x82\x4e\x53\x91\x08\x11\x70\x00\x00\xb2\x8c\x4e\xe2\xe6\xca\x0f\x11\xaf\x19\x05\x02\x15\x99\x25\x88\x40\xd1\xe5\x5a\xa9\xdc\x60\xea\xd7\x12\x7c\x5d\x13\x81\x90\xcf\x84\x10\x43\x38\x9f\xca\xf6\xea\xd6\x46\x10\xee\x34\x67\x3c\x84\xfe\x32\xa8\x65\xce\x5a\x98\x76\x34\x6c\x71\xf7\x86\xcc\xea\x53\x4d\xd7\x04\x0a\x9f\x2a\x71\xca\x0b\xe6\x8c\x68\xda\x6c\x34\x91\x7e\x1b\x8b\x9c\xce\xed\xea\x8d\x41\x89\xe7\x39\x13\xd8\xaa\x86\x0a\xc4\x90\x00\x9c\xc4\xe6\x5b\xf2\xed\x82\x4d\xea\xf5\x62\x9a\x1d\x8d\x20\x22\xba\x89\x04\xcf\x78\xfe\x81\xd8\xb3\xc2\x08\x2e\x3a\xb5\xc1\xaa\x39\x92\x8d\x2e\x00\x53\xd5\xf8\x15\xfb\xd8\xea\x5f\x80\xba\x39\x80\xa3\xac\x29\x04\x58\xaa\x6f\x9a\xb4\x63\x6c\xf0\xa7\x12\x4e\xcd\xee\x51\xb3\x07\x74\x5f\xf3\x10\x09\x6e\xda\x39\x35\x97\x98\x5e\x13\x00\x77\xa4\xa8\x48\xd4\x98\xaf\x3c\xb7\x71\x99\xe3\xc1\xba\x24\x55\x25\xda\x3f\xba\xbf\x83\x03\x06\xee\x1d\x48\x6a\xe9\xc7\xce\xef\x8c\x23\xe7\xcb\x3b\x02\x0a\x2f\xe7\xfe\x33\x40\x37\x78\xef\x21\xa0\x1b\xac\x4f\x01\x0f\x3f\xff\xc3\x20\xa8\x60\xbb\x42\xbc\x10\xb5\x5a\xad\x9a\x8d\xb8\xe2\x54\xf0\x41\xea\x5c\x23\x96\x30\x42\xe4\x16\xcf\xab\xf8\x58\x22\xf2\x85\xc0\x9a\x4c\xe3\xf2\x14\x45\x6e\xa2\xc5\xb1\xe9\xb1\x60\x2b\x02\xbc\x0c\xa9\xe9\x19\x32\x94\x5c\x90\x5f\xb3\xcc\x0b\x36\xdb\x54\x00\xa6\xc4\xdd\x3b\x46\x42\xd5\x35\x6a\x81\x35\x59\xe4\x65\xc5\x5d\xe6\x4a\xd7\x87\x3b\x05\x36\xf9\x19\x00\x5b\x74\x55\x6c\xca\x5f\x01\xce\x3d\x80\xf4\x11\x49\x8d\x07\x9d\xfc\x87\xfc\x11\xe7\x14\x4e\xfe\x70\x92\x70\x51\x9e\x26\x02\x68\xb0\xcd\x7a\x3f\xde\x77\x68\x6f\x02\x87\xf6\x7a\xef\xa1\xbd\xb1\x87\xf6\x1f\x72\x3a\xcf\x67\x98\xb3\xb2\x0a\x12\xaa\xc4\xe8\x92\x07\x75\x41\xd0\x38\xf0\x35\xb7\xbe\xc6\x9b\xca\x53\x37\x56\x45\x2e\xc9\x74\x9d\x92\x53\x4e\xca\x0f\xb8\x70\x92\xfe\xa0\xf6\x95\x9b\x92\x73\xb2\xaa\x9c\x04\xa3\x5d\x78\x4f\x6e\xaf\x18\x2e\xe7\x2d\xc1\x90\xc6\xe0\x3d\xb9\x95\xfa\xeb\xab\xca\x8e\x18\xa0\x8d\xab\x25\xd1\x25\x8c\xd6\x14\x2c\xd9\x07\x52\x1a\x0d\x99\xe9\x42\xce\xa1\xd7\x3b\x8a\x01\xa3\x9c\x6d\x66\xcb\x8a\xe3\x92\x83\x9c\x46\x56\x3d\x61\x7e\x3c\xd7\x3a\x82\xd0\x68\x56\x62\x00\x82\x97\x2e\xf7\x0c\x48\xf6\xe5\x2a\x84\x65\xad\x82\xe0\x0f\x64\x4f\xad\xd9\xed\xac\x30\xb5\x1c\xf6\x29\x8f\x8b\x43\xd8\xa7\x4d\x88\x7d\xb2\x3d\x09\x06\x6a\xe3\x70\x6f\xeb\x9a\x7b\x33\xd2\x29\x6a\x31\x58\x45\xaf\x57\x40\x84\xb3\x16\x6f\x5c\x4c\x8a\x94\x4b\x24\x20\x0d\x06\xac\x9e\x9a\x62\xc1\xd6\x0e\x0b\x46\x37\xab\x2b\xb5\x2e\xa6\x15\x71\x44\xc7\x05\x4c\xf1\x44\x29\x7a\x53\x6e\xf1\x48\x30\x66\x12\x8c\x31\x54\x80\x91\x6a\xe0\x75\x8b\x39\x5b\x07\xc5\xc5\xaf\x86\x43\x67\x8a\xd9\x9d\x69\x35\x7d\x4c\xce\x90\x6c\x36\xd5\x58\x82\x6e\x4a\xbc\x4e\x8f\x86\xc8\xa0\x60\x7a\x34\x14\xe0\xa9\xb9\x08\x8d\x5d\x1e\x2f\x97\x2f\xe2\xa3\x13\x49\xad\xb6\x9c\x7c\xe4\xb8\x24\xf8\x24\xb7\x1b\x5d\x29\x13\x39\xbe\x7e\x89\x57\x04\xc2\xbb\xea\x26\xe7\xb3\x65\x4c\x93\x9b\x65\x3e\x5b\xc2\xbb\x19\xae\x48\x74\xf6\xb5\x62\x82\x04\xd1\x8c\xe1\xf8\xaa\x24\xf8\xfd\x58\xe5\x7c\xa3\x72\x28\xf9\xc8\x6d\xce\x5c\x11\xd5\x54\x21\xc2\xae\x4d\x6c\x77\xfe\xa8\x25\xcc\xb2\xc0\xdd\x22\x15\x12\xb0\xb3\xa9\xed\xa1\x5e\x43\x7e\x56\x10\x5c\xbe\xd0\x9f\xb1\x97\xe9\xd3\x07\xa7\xce\x91\xd3\xa6\x65\xe7\x0c\x4d\xa8\x08\xb7\xcd\x79\x28\x2f\xa6\xa8\x99\xb7\x60\xbb\xfa\xdc\xf6\xa7\x76\x4d\xf8\x0b\x4e\x56\x2f\xe8\x9c\x7c\x0c\xcd\xd0\x23\x35\xc9\x1a\x97\x42\x40\x86\xc9\x6c\x99\x17\xf3\x92\xd0\x18\x24\x22\xcf\xf2\x51\xb2\x60\x92\x8b\xd6\x62\xba\xdd\xba\xa4\x0b\x06\x3b\xfe\x8e\x95\xcf\xf2\x92\xcc\x7c\x7d\xab\xa4\xc5\x0e\x5b\xe8\x8e\x32\x26\x92\x7d\x8c\x81\x58\x34\xc1\x36\xf7\x7a\xc3\x2c\xcb\xf8\x76\x0b\xa8\x66\xa4\x7b\x3d\x6e\xb8\x30\x35\x20\xc5\x69\x0e\x46\xd0\xc0\xd6\xc0\x46\x20\xac\x51\x85\x13\xad\x96\x88\x79\xbf\x6e\x7c\x32\x18\xa5\x23\x08\xff\xd8\x6e\x6d\xdc\x86\x50\x42\x7e\x11\x72\x8f\x37\x4f\xce\x02\x82\x8b\xda\xc7\x81\xb9\x79\xb4\x3e\x78\xd0\x88\x8e\x2c\x4f\x2b\x21\x71\x14\xd3\x27\xa1\xc9\x6e\xb7\xf4\x7c\x08\x3d\x45\xbf\x3e\x6a\x26\x4d\x52\x4c\x62\x20\xb2\x7c\x7a\xea\x50\x45\x49\x5b\x84\xdc\x9e\x72\x01\x93\x1a\x3d\x6b\x82\x92\xda\x0e\x48\xcc\xcf\xe9\x44\x2d\x46\xaa\x00\x89\x1a\x40\xa2\xb0\x01\x25\xd9\xd8\x41\x3b\x6c\xd8\xe4\x3e\x35\x5c\x24\xf6\x47\x72\x27\x03\xa8\x61\xd0\xeb\x6d\x82\xaa\x98\x0e\xc5\x70\xa8\x70\x42\xe8\x5c\xf7\xa8\x26\x2a\x24\xae\xc6\x29\x7d\xef\x16\xf7\xe7\x2a\x46\xda\xb8\x5a\x38\x72\x57\xa7\xb5\x62\x24\x56\xc0\x6c\xc2\xac\x24\x1f\x1e\xda\x8e\x02\x8f\xdf\x8e\xcc\xea\xde\x7b\x7b\x11\x10\xe1\x8c\xe8\x6d\x1e\xd8\xd0\x31\x45\x1c\x22\x7d\xcd\x67\x40\x82\x58\x66\x37\xea\x04\x14\x64\x21\xb0\xa4\xcc\xaf\x97\x1c\xe8\xdb\x3d\x81\xd4\x38\x24\x77\x04\x50\x39\x3b\x1a\xe9\x9b\x36\x7c\x31\x9c\xa2\x32\xdb\x18\x25\x9e\x9c\x96\x8f\xd1\x77\x25\x29\x30\x27\xf3\x77\xea\xca\xb2\x42\x73\x33\xd2\x94\xed\xf6\xdc\x18\x94\x10\x1d\x95\xe1\x2b\x03\x7b\x31\x64\xc7\x33\x44\x85\xe6\x75\xf4\x0e\x69\x71\x90\x56\xf0\x6d\x65\x1c\x24\xb2\xea\x4b\xfb\x4d\xdc\xaa\x6e\xa9\x32\xbc\x68\x93\x16\x0c\xa7\x70\x3c\xeb\xf5\x66\x01\x21\x77\xa7\xac\x5c\x3c\xd8\x3d\x08\x74\x7a\x65\xc2\xdb\xad\x4b\x9e\x94\x2b\x24\xa4\x78\x5c\x0f\x89\x42\x24\x16\x52\x6b\xdb\xff\x3d\x9f\xf3\xa5\xab\x03\x61\x10\x61\xef\x8b\x77\xa8\x50\x9d\x7d\xe1\xab\x68\x2f\x28\x62\xd3\xe4\x67\x96\xd3\x18\x44\x00\xc2\x00\x34\x1a\xda\x92\x0b\x93\xe1\x57\x44\xb9\x83\x83\xae\x3e\xc8\xdd\x92\x6d\x5c\x5a\xc2\x1d\x1a\x0a\x21\x2b\xa8\xe1\x0d\x72\x60\x10\xa6\x31\xef\x50\x5f\xe0\xe0\xf8\xfd\x0d\xd2\x14\xd7\xed\x48\xa0\x45\x55\x4d\xc4\x15\xb5\x32\xa2\xfb\x46\x48\xd7\x06\x05\xc6\xde\x57\x96\x23\xef\xdb\x13\xe0\xd7\x8d\xbc\xbd\x22\xbc\xdf\xac\x16\xe2\xf5\xb5\x48\xeb\xe0\x14\x8c\xb7\x66\xcd\xb1\xf8\x15\xba\x96\xd8\x6e\x63\x92\xf1\xe6\x8d\x04\x09\xde\x48\xf4\xed\x8d\x44\x83\xe4\xd4\xbc\xbe\x31\x55\x70\x19\x7e\x6c\xb8\x7c\xa3\x46\x94\x06\x0b\xee\x60\x24\x6a\x0f\x38\x03\x70\xcc\x7a\xbd\xb8\xa8\x0f\x0b\xc1\x22\xe6\x4a\x61\x80\x51\x01\x11\xeb\xf5\x70\x48\xc6\x10\x27\x2e\x83\x28\xc4\x9b\x8e\x37\x5d\x2a\x0e\x0b\xf2\x5a\x2a\x07\x17\xf5\x80\xa6\x00\x91\x87\xd5\x18\x70\x26\x2b\xa1\xcd\xfe\xdb\xbc\x8d\x51\xf6\x95\xe2\x2c\xa9\xc1\xd7\x7d\xa3\xa7\xd7\x71\x9c\x9b\xbb\x34\x23\x3a\x85\xae\xd4\xbe\xda\x27\xd7\xe7\x01\xb9\xbe\xda\x2b\xd7\xe7\x2d\x65\x7c\xee\xac\x6e\x15\x32\x73\x52\x7b\x26\xcb\x9b\x4a\x4d\x63\x2a\x06\xa6\x17\x02\xd7\x32\xf0\xc5\x71\x9f\x26\xf9\xbc\x7f\x0c\xa6\xa8\xb3\xa8\x83\xb0\x5e\x8d\x63\xdd\x5f\x4d\x3d\x7d\xc5\x40\x2d\x7f\x0b\x3e\x5c\xb3\x71\xea\xc3\x32\x93\xaf\x35\x8f\xae\x18\x32\x3c\x9f\x3f\x2d\x73\xfc\x94\xce\xbf\xd5\xdd\x6b\x6a\xe1\xab\xcb\xbd\x59\x36\xe4\x07\x35\x7e\x4d\x28\x8c\xb6\x7f\xe7\xde\xd6\x39\xdb\x93\x76\x6e\x49\xfa\xb0\x2d\x69\x8e\x94\x3c\xe6\x70\x67\xfb\x2a\x0f\xbb\xfe\xc8\x83\xf2\xbb\x81\xbf\x90\xdf\x3b\x16\xbc\x5b\x7e\xc7\xbd\x1e\x86\xe3\x23\x22\x55\xab\x1a\x22\x27\xd5\x92\xdd\x6c\x97\xf9\x9c\x68\xc5\x14\x56\x2a\x61\xbd\xe2\x52\x0d\xed\x4b\xf4\x66\x08\x5a\xa2\xaf\x0e\xb4\xd8\xaa\x5a\xa2\x7a\x15\x14\xd5\xcf\x1e\x0f\x9d\xd9\x64\x77\x6a\x20\x52\x0a\xaf\x1c\x8e\x6f\x9e\xaf\x08\xad\x1a\x16\x2e\x9e\x38\xd3\x3e\xac\x6f\xc4\x51\x0c\xe0\x44\xff\x48\x81\xb2\x3c\x01\x7e\xcb\x02\x20\x41\x9e\xd4\xc3\x68\x23\x85\x05\xba\xc9\xa9\x35\xa6\xb1\xf7\x0a\x0a\xc1\x0d\x1f\xa1\xbe\x5c\x09\x74\x8d\xa9\xa4\x98\xae\x50\x4a\x51\x64\xa0\x2d\xa0\xaa\x05\x25\x22\xb0\xce\xc8\x06\x31\xcd\x48\x08\x3b\x60\xaf\x47\xfd\xf1\x42\xc3\x10\xe7\x96\x41\x5a\xb2\x9b\xc4\xab\xd5\xcd\x3d\x72\x88\x8e\x78\x07\xf7\xe8\x0f\xa8\xb4\x8a\xe4\xa5\xe4\x8c\x10\xdd\x6e\x43\x43\x94\x77\x55\xf6\x1a\x5d\xf6\x6a\x97\x34\x86\x7b\x2c\xb8\x6a\x1a\xe4\xf2\x3d\x2e\x9c\x04\xc6\x0d\xa1\x7b\x2f\x40\x3e\xae\x31\x9d\xcb\x8b\x81\x61\x83\x1c\x86\xdb\x9e\x83\xfb\xea\xfb\xe4\x6d\xd4\xbc\x3e\x6d\x90\xeb\x60\x2f\x72\xb0\xed\x39\x90\x48\x20\x90\x98\x04\x00\xc1\xce\x86\x1d\xcc\x90\x5c\x51\xea\x2f\xe9\x4e\x22\x4d\x1e\xe0\x69\xad\x39\xa1\x5a\x30\x75\x92\x29\x1b\x28\x71\x9e\xad\x48\xf1\x2d\xae\x48\x7c\xa1\x8d\x5f\x01\xc2\x86\x73\x1c\x08\x66\x23\x20\x7e\xb7\x18\xd8\x5c\xab\x76\x0a\xa3\xfa\x0c\xf2\x8b\x41\x32\x20\xe7\xef\xf5\x71\x31\x9c\x5e\xb0\x29\xdc\xed\x76\xfe\x76\x5d\x7a\x92\x5f\xf7\x76\x3d\x60\xb7\xd6\x7b\x63\x69\xe4\xae\x03\xf6\x06\x85\xe8\x28\x6c\x5b\xe1\xaa\x4a\xba\xb1\xfb\x82\x34\x67\x4a\xa6\x31\x84\xb5\x04\xf1\x57\x49\xa5\x3a\x6f\xf9\x3c\x6c\x0a\x62\xb3\x44\xa8\x0e\x7c\x1e\x35\xf7\x43\x1b\x1f\xbb\x37\xc3\x68\xcf\x66\xe0\xad\xcd\xb0\x1f\x8b\x1f\xb2\x43\x80\x73\xe7\xb3\xcc\xe7\x73\xf2\x60\xac\xe7\x2e\xd6\xb7\xa0\x3f\x84\xf7\x60\x34\xff\x04\x8c\x6e\x22\x6e\xfb\x36\x5a\xb4\x79\xb1\x0f\x4f\x27\x8a\xa8\xa6\x72\xa7\x03\x71\xae\x7a\x2d\x5a\xce\x29\x70\x26\xe6\x71\x80\xfb\x82\x4a\x5a\xef\xe6\x07\x65\xba\x66\xce\xc0\x71\x3f\xd0\x84\x62\xf9\x14\x0f\x93\x37\xad\x08\x1c\x3d\x4c\x1e\x13\x0d\xe8\x4e\x76\xae\x10\xc7\x0c\x37\xb7\xf7\x30\x11\xac\x4d\x63\x86\x5d\x75\x83\xaa\x1f\xda\x80\xde\x98\x86\xd1\x98\x43\x44\xfc\x7b\xbb\x1a\xf3\xd1\x11\xef\x40\x7e\x6e\xaf\x87\x73\x29\xfe\xe9\x2a\x63\xef\x2b\x2b\x91\xf7\xed\xc9\x98\x55\x23\x6f\xaf\x8c\xe9\x37\x6b\x2e\x8a\xf3\x4e\x29\xca\x34\xda\x71\xb7\xe9\xae\x72\xe8\xae\x58\x73\x9f\xe3\xa0\xd5\x9c\xe0\x07\x9b\x22\x9d\xde\xf3\x45\x4c\xa0\x34\x5c\x09\x30\x25\x13\x73\xb7\x9c\x12\x63\xba\xa2\xb9\x05\x8e\x70\x5b\x60\xfa\xfa\xe1\x02\x53\x63\x8a\xc6\xd5\x45\x4c\x11\xfb\xf7\x35\x9e\x15\xa2\x00\x98\x2d\x8b\x1c\x19\xc1\x15\x11\xca\x1a\x38\x41\x01\x61\x4c\xa4\x88\x10\x92\x12\x4e\xbe\xb8\x78\x3a\xf8\x3f\x78\xf0\xf7\xa9\xe6\xb1\x49\xa7\xe8\xe0\xdb\x17\xf2\x8c\xf4\x7a\x72\xe7\x18\xb2\x25\x58\x77\xc5\x6b\x4d\x78\x5a\x5f\x66\xec\x1c\xff\x39\x0c\xef\x70\xaf\x77\x96\x65\x19\x56\x17\x4c\xdb\x6d\x9c\xc7\xc0\xce\x70\x70\x85\x67\xef\xc5\x87\x63\x7b\x88\xf2\xb8\xea\x92\x72\x6b\x41\x44\x39\x9c\x64\x0d\x3d\x9a\xd2\x76\x10\x67\xb3\xb1\x35\xa1\xd2\x44\x04\xf7\x7a\xda\xce\x53\x0c\x46\xec\xe0\x5e\xaf\xe3\x9a\x0c\x37\xaf\xc9\x7a\xbd\x3c\x99\x31\xca\x71\x4e\xab\x98\x5c\x0c\xa7\xc8\x14\x91\xb2\x98\x3d\x00\x70\xfb\xd4\x76\xd6\x52\x9a\x47\x76\xd8\x3d\x76\x90\x03\xb0\xc0\x85\x94\xb0\x88\x7f\x26\xa9\x59\x39\x86\xce\x75\xaf\xfa\x00\xf2\xfa\x85\x10\xee\x20\xdc\xe1\x96\xd8\x83\xf7\x9c\x01\xad\xfd\x27\xce\x31\x65\x35\x91\x18\x43\x31\x14\xa5\xd6\x66\xcc\x72\xf5\xa5\xd9\x77\xcd\x55\x10\x2d\xb0\x18\xa2\x23\x0c\xef\x0e\xbe\xe9\x16\xc2\x8d\x63\xd9\x40\xf1\x87\x2b\x5c\x0e\x28\x76\xaf\x25\x6a\xd2\x93\xcc\x4a\x82\x39\xd1\x75\x63\x30\xcf\x3f\xf8\x4a\xc9\x10\xe6\xe5\xb4\x22\x25\x7f\xba\xe0\x02\x94\x31\x6f\xf8\x4a\x01\xc4\xa0\x66\xa9\x83\xd8\x26\x98\x31\xc7\xea\xb5\x25\xd3\xd4\x2b\x51\x74\x5a\xbe\x1a\x53\xf9\xb6\xfd\x49\x07\x5e\xf0\x72\xa3\x34\xab\xde\x59\xd1\x85\x16\x96\x19\xf7\xc6\xa2\x7d\x34\x8f\x46\xbb\x9d\x87\x08\x1d\x97\xca\x27\xf1\xd9\x9f\xb6\x8f\x86\xdb\xd3\xaf\xb7\x67\xa7\xd0\x9a\x8e\xa8\x7b\xe3\x5e\xef\x01\x77\xce\x2d\xb4\x0a\x98\xe7\xd0\xa4\xe2\x6c\xfd\xba\x64\x6b\x7c\x8d\x15\x0d\x40\xbf\x1e\xfb\x8e\x70\xaf\x77\xfa\xf5\x51\x46\x0d\x35\x92\xdf\x99\xf9\x36\x1c\x99\x93\x24\x88\x9c\x64\x4c\xaa\xb6\x6d\x0f\x22\xae\x2d\xb5\x40\x15\x83\x28\xf6\x76\xc7\xa2\xdb\x8a\xd0\x4d\x54\xe4\x29\x65\x3c\xb6\x13\x80\xc6\x80\x28\xc2\x6a\x7c\x85\x67\xc4\xc7\xb2\xc2\xdc\xfd\x1a\x72\x33\x3e\xfb\x93\x33\xb4\xe1\x39\xeb\xf5\xd8\x60\x80\x1e\x0d\x9d\x54\x76\x5e\xd8\x4b\xcb\x5e\x8f\xf5\xfb\xe8\xff\xb2\xed\x36\x66\xd9\x10\xa2\x22\x21\xbf\xc4\xac\x3d\x15\x21\xca\xb8\x2c\x84\x19\xf7\xd8\xfb\x7a\xa0\xe1\x73\x50\x6d\x64\x51\xb0\x69\xfb\x5c\xe3\xa6\x52\xe6\xe0\x87\x98\x3f\x7b\xc3\xf4\x38\x1b\xdc\xc8\xbb\x9f\xb3\xb1\xb3\xbd\x97\xb3\xb1\x8d\xd6\x9c\x0d\xbb\xbf\x48\x8d\x15\xd1\x82\x95\x2b\xe0\xb1\x3c\x01\xbc\xdf\xdd\xdf\x64\x15\xa0\xe4\xb0\x69\xa1\x74\x6f\x3d\x5d\xf6\x90\x8a\x0d\xcc\x06\xc1\x76\x1a\x4c\xd4\x9f\x1e\x6a\x02\x9e\xef\xf3\xa4\xd5\xe6\x5f\x7f\xb8\x62\xf3\xdb\x8c\x35\xfc\xb8\x1a\xde\xa9\xb5\xb5\xf8\x1f\xe6\x39\x2e\xd8\x75\xf8\x2a\x56\x7a\x1d\x0f\x54\x09\x6b\x82\x61\xce\x09\xd7\x9e\xac\x7a\xbb\x94\xf8\x51\x2b\x92\xcb\xfc\x3a\xa7\xb8\xf8\x33\x9b\xdf\xbe\xc6\x9e\x35\x9a\xd4\x58\x5c\xe1\x52\xde\xbd\x19\x49\x33\xbf\xa6\xac\x24\x7f\xd6\x4d\x7f\x2b\x56\xd6\x5e\x2c\x19\x99\x46\x1c\xf8\x9c\x34\x95\x05\xde\x50\x05\x4f\x42\x28\x17\x27\x21\xc3\xf3\x38\x50\x1d\x85\xac\xa9\xc3\x1a\x1b\xd1\x84\xf2\x06\x91\x8d\x03\x2b\xfc\x38\x8c\x67\x25\x2f\x59\x3e\xd5\x60\x5e\xb7\x8b\xb8\x67\xac\x9f\x1f\xae\x2e\xf6\x09\x86\x6a\xce\x73\x96\xe8\x50\xfd\x2a\x13\xae\x02\xa6\x5c\x6a\x54\xa5\x61\xfd\x92\xdd\xc4\x85\xa0\x1d\x87\xba\xf9\x9c\x0d\x87\x28\x4f\xfe\xfc\xf4\xdb\xff\xf5\xec\xcd\xab\xd7\x97\xdd\x9e\x40\xb5\xbe\xd8\xe0\x4e\xc3\x86\x0b\x89\xce\xa5\x16\x39\xdf\xcf\x7f\xb9\x70\xd6\x48\xa7\x2e\x29\x04\x77\x69\x6d\x44\xc4\x4c\x28\xf4\x1b\xf3\x15\xc7\x5c\x2d\x8b\xd2\x6d\x22\xc7\x33\xc7\xf0\x28\x1a\x94\x4d\xfe\x66\xd7\xd4\xad\x39\x7a\x26\x77\x50\x62\x59\x3a\x18\x5b\x6f\xc3\x1c\x0d\x1d\x57\xef\xb7\x66\x67\x98\x2b\xfd\x8a\xf0\x56\x9a\xdc\xde\x0e\xfb\xa6\xf0\x5e\x1d\xe8\xaa\x04\xa9\x66\x78\x6d\xcd\x02\x4a\x52\xe5\x7f\xaf\x8d\x04\x5c\xeb\x48\x45\x3f\xb5\x4f\x98\x33\xe9\xa6\xb3\x98\x4a\x9e\x1e\xdb\xad\x63\x21\xee\xbb\xbe\x2b\x3a\x51\x9b\x50\x2a\x0a\xd9\x6a\xde\xbb\x39\xf7\x95\x94\xb2\xda\x66\xbd\xaf\x52\xc3\xc2\x37\xaf\xe2\xba\x11\x29\xd5\x84\x49\xc9\x50\xde\xfa\xa9\x81\x1a\x24\x0c\x6c\xd2\x0e\xc7\xaa\x80\xc6\x47\x79\x58\x8d\x9d\x3c\x6b\xbb\x66\x5c\xc2\x9c\x3c\xbc\x5e\x13\x3a\x7f\xc7\xc4\x60\x15\x3d\x76\x32\x25\xbe\x42\xc7\x9f\x79\xa8\xae\xdd\x7f\xde\x54\xfc\x99\x84\x69\x2c\x4d\xee\xb1\xab\x6e\xf5\x0c\x18\x70\x48\xf5\x28\x5d\xc5\x70\x42\xe8\x82\x95\x33\xf2\x9d\x34\x72\x86\xfa\xe2\x9b\xb5\x18\xe1\x6e\x84\xa7\x13\xec\xac\xed\xbd\xb6\x10\x6d\x3a\x6a\x18\x77\x93\x40\x3a\xad\x13\x3a\xfc\xcf\x0e\x6d\xb4\xb1\xe5\x7d\xe5\xb3\x60\x25\x82\x3e\x7c\xce\xee\x37\x32\xaa\xa5\xcb\x87\xec\xf5\x5e\xaf\x43\xc3\x5c\x3b\xca\xe8\xcd\x3e\xda\xbb\x41\x3d\xbf\x82\xc5\x42\x4f\x31\xa7\xdd\x03\x6a\x79\x06\xaa\x7a\x1d\xfb\x5a\xe7\x76\xee\xb1\xe6\x36\xb6\x85\xc3\xfb\xb8\xcb\x0d\xb1\x4b\x45\xaa\x5d\x12\x0f\xb9\x9a\x68\xd1\x99\x1f\x44\x8f\x7b\xb5\xba\x1d\x98\xe3\xb7\x10\x37\x31\xc4\xdd\x1b\x2e\xf3\x7b\xc0\x52\xd4\x3e\x0c\x6e\x72\x9b\xbb\xa0\xf0\xce\xb4\x95\x09\x91\x44\x11\x2e\x63\xb9\x5a\xef\xe7\xee\x4c\x01\x43\x87\xe2\x19\xea\xd2\xc1\xb4\x18\xf1\xc5\xb0\x2a\xfe\x7c\x25\xe6\xb5\x74\xff\x16\x91\x3d\x7e\xc9\x9c\xcf\xad\x25\xab\x59\xe1\x36\x9d\x0e\xcd\xdf\x17\x1e\xeb\xd3\xda\x8c\x31\xf5\x4f\xce\x46\x77\x02\xfc\x9d\xfd\x35\xe6\xa7\xf6\x52\xd7\xfc\x26\xcc\xb3\x31\x51\x85\x03\x63\x57\x43\xc4\x74\x5e\x90\x1f\xd7\x73\xcc\xcd\x31\x97\x3a\xf5\xc5\xa8\x9a\x0d\x04\xc8\x8f\x44\xbb\xb6\x4f\x9b\x68\xaf\xc1\x48\x28\x90\x74\x1f\x50\x54\x9f\xfc\xde\x9e\xf7\x0e\x7f\xaa\x3c\x0b\x9f\xca\x73\x43\xb4\x59\xc5\x36\xd1\x65\x23\x68\x00\x6b\x6a\x35\x99\x99\x4a\x0b\xb2\xa2\x5b\x73\xa8\xb6\xaf\x52\xcd\x90\xcd\xfe\x37\xdf\xb5\x12\x33\x20\x3c\xf8\x5d\x5c\xb5\x1a\x0f\x9a\x33\x77\x93\x16\xf5\x37\x05\xc0\x5e\x07\x86\x11\xdb\x74\x64\x78\xc0\x0e\x3a\x56\x5f\x2a\xda\x91\xb1\xc3\xd5\x6a\x6a\x69\x4c\xcd\x08\xf4\x39\xac\xb9\x80\x9a\x93\x7b\x08\x5f\x16\xda\x5b\x5d\xc2\xd3\x64\x8f\x54\x95\x1a\x62\x22\x69\xce\x6c\x53\x0a\xbe\x45\x1d\xf8\xbd\x5e\x0c\x2a\x8e\x79\x3e\x6b\x7a\xe4\x98\x99\x4c\x9a\x84\x2b\x51\xee\x53\x0e\xad\x8d\xad\x93\x27\x44\xb8\x89\x10\x2d\xdb\x4b\x1f\x5d\x1a\xec\xcb\x91\xb9\x13\x1c\xe3\x49\xa3\x64\xf8\xe8\xa0\x9d\xe7\xc3\x3e\x39\x05\xa6\x34\xd6\xee\x8b\xf6\x86\xda\xc7\x9d\x3f\xd4\x48\x13\x44\x70\xf7\x26\xab\x65\x6d\xd0\xdc\x3e\x8a\x9d\x93\x21\x9d\x7e\xc5\x19\x7a\x0f\x24\xba\x7d\xfc\xf7\x43\xa2\x30\x90\xd0\x43\xf4\x69\x9a\x43\x15\x5b\x44\xc0\xe7\x58\xfd\x8a\x6e\x5e\x98\x1e\x7a\x38\xa5\x78\x61\x75\xb7\xfe\xa4\x4b\x11\x9e\xcc\x8a\x9c\x50\x7d\x03\xdf\x20\xa7\xb3\xaa\x8a\xef\xd6\x78\x3e\xcf\xe9\xf5\xf7\x64\xc1\x53\xb5\xa8\x62\xcb\xbd\xa8\x5e\x7d\x20\xe5\xa2\x60\x37\xd2\xf4\x40\x0b\x90\xbe\x5a\x22\x05\x00\xe9\xda\x6f\x44\xeb\x69\x57\xed\xa3\xae\xea\x6d\x02\xea\xd3\xe6\x6e\x6b\x94\xd6\xd0\x9b\x63\x69\x37\xee\x8b\x8f\x6d\xf8\xaa\x63\x2b\xc9\x29\x25\x6a\x80\x2a\xe4\xa2\x21\xaf\x9d\x00\xbe\x26\xfc\xcf\x6c\x43\x45\xd7\xdf\x4a\x58\xbf\x21\x33\x1e\xc3\x31\xcd\x48\x22\x2d\xea\x07\x32\x9a\x10\xbe\xaa\xa4\xb7\xf5\x82\xc3\x5d\x18\x50\x99\xa7\x8d\xd2\x0b\x27\x47\x72\x4e\x83\x6a\x21\x99\xb6\x22\xb8\xda\x94\xc4\x39\xbc\x1a\x32\xbd\x73\xb0\xb5\x27\xbd\xc6\x65\x45\x5e\x50\xee\x50\x5b\x15\xf5\x47\x03\x73\xa0\x7c\x02\xe0\x76\x3b\x44\xa3\xa1\x16\xec\x9b\x7a\x2b\x7f\xdc\x15\xbf\x2d\x48\xe2\x2e\xc6\x76\x0b\xf4\xa5\x64\x00\x39\xf6\x77\x8c\x68\x3f\x30\xf3\x10\xda\x04\x27\x79\x4f\xe3\xa1\xd9\x34\xda\x6e\x82\xb7\x0d\xc2\xbd\xa7\xdd\x98\x26\x33\x41\x96\x5e\xe2\x15\xd1\x4a\x82\x81\x9d\xcb\x40\x37\x0e\x3c\xad\x85\x3c\xff\x62\x6a\xa4\x50\xea\x9e\x04\x03\xea\xa2\x85\xef\xfc\x24\x6a\x0b\xb2\xa0\x69\xed\x32\x2f\x44\x2b\x88\x78\x3e\xe0\x72\x04\xe3\xfa\x67\x56\xa1\xfa\x23\x14\x03\x44\x65\xdc\xef\xff\xad\x5a\xbb\xd7\xfd\x5b\x35\xd7\x79\xa5\x6f\xb5\x28\x87\xc4\xc1\x51\xb7\xd3\x08\x67\x2c\xee\xb8\xe4\xe7\x5d\x41\x70\xac\xc9\x39\x2a\x32\xdc\x52\x35\xd6\xd7\xfc\xb5\xc6\x51\x29\x46\xd3\xa3\x93\x2f\xf4\x1d\x96\x74\xa6\x75\xac\xd0\x8d\x45\x00\x1c\xab\xfb\x26\x0c\x60\x47\x34\x1c\xe5\xe9\xe5\xeb\xd0\x7c\x65\x7e\x58\x2b\xa6\x6b\x36\xd9\x50\xdf\x45\xcc\x8f\x88\xd6\xeb\xb5\xef\x06\xa5\x8e\xa7\xb2\xc6\xef\x8a\x09\x69\xd9\x2e\x7c\xb3\x4f\xed\x5e\x04\xd4\xee\x2c\xa4\x76\xcf\x69\xce\x63\xa0\x23\x70\x02\x24\x63\x35\x49\xb2\x5a\x08\x9c\xd1\x11\x35\xa1\x0a\x40\xeb\x84\xab\x7d\xad\x2a\x98\xe8\xae\x55\xe4\x06\x38\x1d\xb3\x96\xea\x95\xd5\xda\xd3\xc2\x0b\xe8\x52\x77\xe2\xe2\x76\xad\x3a\xbe\x93\xa8\x21\xf0\xd4\x7a\x3f\x69\x70\xa5\xe6\x1a\x57\x6b\xcb\xc5\x01\xc3\xc9\x6a\x2d\x78\x85\xf4\xf8\x7c\x9e\x7f\x88\xe4\xc6\xce\xec\xec\xa2\x92\x09\x14\xd6\xfd\x81\x27\x6e\x19\x5c\x96\xec\x06\x3c\x39\x3f\x99\xe7\x1f\x9e\x9c\x2f\xcf\x1a\x75\x07\x3c\xe7\x05\x11\xf9\xcb\xb3\x27\x81\xb6\xad\xca\xde\xb4\x20\xff\x3d\xde\x41\x14\xab\xc0\x27\x8a\x58\x1d\x36\x79\x5b\x1c\xc2\x64\xe6\x6c\x78\x06\x7d\x43\x2c\x8d\x82\x55\x70\xc7\x1b\x08\xee\x10\xf3\xcf\x9a\x6f\xd5\x48\x3b\xd8\x17\x9e\x3b\x81\x08\xaf\x09\x7f\x27\xe6\x2d\xe4\x1e\x9b\xa2\xeb\x8b\xf3\xd3\xdc\x5a\xf8\x40\xd2\x5e\x55\x86\xe5\x5e\xf2\x55\x31\x51\x11\x65\x52\xc0\xc9\x47\x0e\xa6\x31\x11\xf2\x5b\xa3\x72\x7d\xe9\x51\xfb\x67\xd5\xc1\xad\xa4\xa1\x56\xa8\xe1\xd6\x25\xa2\xed\x4b\xd1\xe9\xba\x53\xae\xe4\x48\x87\xcf\x15\x8c\x68\xc4\xd9\x3a\x52\xe1\xce\x22\x71\xf6\x47\x12\xcb\xa4\x39\x63\x7b\x8c\x7a\x82\xb2\xeb\x58\xda\x28\x75\x15\xd0\xaa\x01\xe6\x71\x9d\x55\x00\xf6\xee\x09\x51\xc3\xbb\x76\x16\xb4\xe0\xf6\x1b\xbb\xbe\x6f\x21\xad\xcf\x82\x1f\x54\xd1\x86\xd2\x74\xe9\xb1\x05\xfd\x76\x1b\x07\x82\x1a\x92\x44\x17\x98\xd8\x5f\xfa\x8e\xf6\x62\x38\x85\xa9\x4d\x6c\x0c\x51\x6e\xa9\x4e\xd3\x79\x95\xeb\xfc\xd6\x53\x96\x08\x68\x3d\xfc\xe4\xae\xb4\x56\x70\x72\xc7\x68\x50\x8f\xdd\x8f\xba\x97\x83\xfc\x1e\x8a\xd0\x2d\x96\xa1\x12\xdd\x71\x9f\x8e\x48\xaf\x77\x32\x27\x15\x2f\xd9\xad\xef\xce\xb0\xdd\xc6\xfe\x1d\x96\x25\xa8\xea\x16\x8b\x1d\xe2\xc0\x20\xb5\x15\xee\xac\xbc\xd3\x9e\xf9\x59\x7b\xcf\x7b\x0f\x34\xfa\xc4\x6f\x9c\x1e\xa3\xe1\x43\x6e\x6d\x5d\x27\x96\xda\x4f\x68\xdf\x15\xad\x62\xa1\x9e\x3b\x17\xb5\xe2\xe0\xf3\x8b\xd6\x9a\xac\xf4\xc1\x71\xbf\x2a\x52\x10\x09\x97\x50\x44\x65\xc1\xce\xc2\x3e\x88\x12\x8a\x3f\x44\x45\x1e\x3d\x89\xb0\x61\x25\x25\xa7\x56\x65\x17\x53\x6d\x83\x27\xcb\xd7\xdf\xca\x37\x50\x69\x11\x5a\xb7\xbd\x4a\x44\xb3\x56\xc5\xde\x14\x1b\x31\x9c\x6d\xe8\xea\x86\x3e\x6e\x5d\xb2\x19\xa9\x2a\xef\xc6\xa9\x24\x8b\x92\x54\x4b\xa3\x5c\xd2\x45\x5c\x83\x3b\xf2\x2b\xa2\x99\xd5\x23\x39\x34\xa0\x99\x33\xf6\x76\x4c\xb3\x7d\xee\x37\xfb\xc3\x98\xe9\x48\xc8\xa3\xa1\x1f\x30\xeb\xda\x08\x06\x1a\xba\x5d\xf4\xc2\x83\x76\x53\xb8\xde\x6e\x6d\x3c\x58\x9f\xd5\x76\x0b\xa1\x4e\x01\xd1\x2d\xd5\x88\xe7\xa5\x17\x27\x4c\x64\x11\xce\x80\x9a\x16\x40\x45\x36\x1c\x1f\x84\x62\x1e\x2e\x19\x2a\xef\x82\x20\x86\x88\x25\x79\xf5\xef\x72\x6b\xc4\xe1\xd9\xcb\x98\x8a\x82\xfb\x50\x1a\x11\x60\xbc\xc7\x1b\x48\xe9\x04\x17\xf6\x2e\x5e\x25\x79\xf5\xf6\x11\x4c\x56\x38\x74\x95\xd8\x42\x29\xd7\x40\xd7\xe3\xf2\x79\x76\xf2\x1f\x5f\x24\x8e\xf5\x29\x0b\x1b\x95\x8a\x5f\x4d\x1e\xf8\xe2\x42\x05\x8c\x4b\x38\x5b\xf7\x0b\x44\xa6\xd3\xed\x56\xaa\x56\x61\x52\xb1\x92\x37\xac\xbd\xcd\x11\x76\x31\x9c\x0e\xc8\xc5\x70\xba\x6b\x9b\x95\x1a\x99\xac\x4a\xd6\x9b\x6a\x29\x27\x21\xa0\x86\x8c\xce\xd0\x4d\x1f\x4d\x35\xe5\x75\x63\x06\xc8\x3d\xd8\x5a\x75\xeb\x6b\xd5\x09\x66\xdf\x7e\xdd\x46\x30\xee\x58\x67\x1c\x0a\x78\xdc\xe7\x83\x50\x27\x36\xb8\xb1\x5e\x6a\x3d\x41\x13\x03\x5c\xcf\xcb\x44\xfe\x76\x09\x99\x55\x01\xbb\xa8\x27\xe3\x68\x37\xe8\x0f\x3e\xcf\x88\xb1\x95\xcb\x8f\xb2\x98\x66\xec\x82\x59\x9b\xb3\xa9\x09\x4e\x24\xdb\xc6\x9c\x08\x11\x38\x5f\xc4\x79\xaf\x47\xce\x0b\x01\x5e\x77\xc3\x76\x50\x52\x19\x04\x22\x86\xe3\x05\x2b\x63\x71\xa0\x9b\x57\x20\x06\x83\x31\xcc\x8f\x32\x76\x41\xa7\xbd\x1e\x79\x22\xe3\x01\xf6\x7a\xf1\x07\x96\xcf\xa3\x61\x26\xe3\x03\xf6\x47\xd3\xed\x56\xf4\x24\x7e\xb5\xc6\x22\x6a\x36\x56\xd1\xe4\x65\x2d\x55\xb3\x3f\x38\x7f\x64\x8e\x3f\x8d\xd9\x1c\xfd\x63\xdf\x15\xf5\xb8\x4f\xa5\xe3\xaa\xf6\x56\x70\x8a\x29\xe7\x56\x9d\x7f\x8c\xc4\xc9\x47\xa0\xbe\x53\xaf\x62\x50\xe4\x20\xe4\x49\x3f\xb6\xd7\xee\x4d\xfb\xab\xda\x16\x36\xe6\x99\x63\x2f\x5b\xe4\x8e\xc1\x5d\x28\x1c\x1f\x72\xae\x45\x0c\x1c\xfc\x83\xa9\x01\x2c\x39\x7d\xff\xf6\xb0\x41\x20\xf4\x2c\x7e\xa4\x3c\x2f\x42\xa7\x2e\xba\x2f\x22\x83\xa7\xe7\xb0\x03\x19\xfb\x9f\x19\x41\x7e\x42\x28\xe8\x5d\x9d\x79\xbf\xde\xa3\x6e\xb9\xd6\x7d\x34\x9d\xb4\x3d\xc8\x38\xca\x0f\x0f\x1c\x6e\x20\x66\xed\xb0\xd6\xed\xb7\xcd\xac\x73\xc3\x01\x7e\xdb\xa3\xc0\x6b\x27\x35\x33\x56\xdd\x63\x42\xa7\x71\xda\x18\xc5\x55\x31\x75\x4d\xbb\xc8\x61\x36\x9d\x55\x88\x77\xe0\xf8\xaa\x65\xce\x29\xd2\x0e\x0d\x64\x1b\x3f\xc4\x0e\xab\x19\x6e\xb9\xe9\x23\xeb\xc8\x36\xb5\x68\x43\xeb\x2d\xb1\x29\xb4\x11\xae\xbb\x81\xa0\x3c\x98\x9a\x67\x97\x24\x88\x26\x2a\x73\xcb\x95\xe2\x9e\xa8\xcc\xe8\xc8\xfa\x44\xa8\xfd\x1c\x88\xc0\xa2\xaf\xe4\x1a\xc1\x35\xd3\x02\x57\x3c\xc2\x40\x90\xf0\xaa\x69\xac\x21\xe1\xda\xb0\x5b\xa1\xf2\x70\x43\xac\x2e\x6d\x94\x52\xa1\xd2\x58\x96\x56\x21\x1a\xcc\xde\x2f\xe4\x91\xa7\x3f\x18\x44\x47\xac\xc3\xc6\xe3\xa8\xd8\xe3\x5e\x98\x0b\xf4\x30\xae\x55\x96\xf8\xbb\xd4\x08\x40\xcb\x99\xdb\x02\xb9\x58\x4e\x6d\x4d\xe4\x1b\xd7\x98\x01\xdd\x89\x85\x4e\x1d\x7d\x99\x9c\x56\x10\x06\xb4\x59\xc9\x9a\xfc\x04\xea\x28\x48\xec\xa4\x3b\x5c\xbe\xff\x40\x50\x9b\xcd\x5f\xae\x27\x51\x1d\xb8\xa7\x10\xc7\x64\x15\x0e\x8f\x84\x2d\x71\x6e\x07\xad\xde\x6e\x8f\x8e\x9c\xf6\x54\xa2\xb1\x02\x1f\x3b\xae\x34\xcd\xc0\x2b\x16\x89\xea\xca\xbe\xb1\xb9\x33\xba\x8e\x8a\x52\x57\x12\x74\xbb\x13\xb0\x12\x34\xab\xd3\xd5\x92\x06\xce\x92\x4f\x68\x69\x08\x51\x31\x91\xea\x01\xef\x96\x94\x36\x2e\x46\x61\x1a\x50\xc8\x48\xbd\xcb\xbd\xc7\x21\x3d\xfc\x2c\xfc\x64\x78\x0c\x21\x12\x3c\x52\x0c\x77\xf5\x4a\x17\x9d\xd1\xc8\xd9\xc3\x6c\x7a\x98\xd4\x34\xb7\xae\x5d\xcd\x19\x59\x49\xe5\x20\xbe\x1a\x9b\x1f\x19\x41\xe6\x67\xe3\x16\xc0\x26\xef\x3d\x0b\x6d\x3b\x6e\xec\x18\xee\x1b\x96\x85\xa2\xa9\xca\xe3\xcb\x9c\x0e\xca\x29\x14\xee\xc6\x55\xd7\xf5\x81\x18\x47\xe7\xe5\x81\x02\x37\x7a\x50\x9d\x75\x2e\xcf\x59\xc4\x5b\x87\xe6\xde\x17\x9c\x16\x81\x43\xf3\x3a\xa4\x00\x97\x6a\xd9\x56\xe8\x10\x27\x85\x50\xe9\xd3\xe1\xa4\x70\x15\xc1\xc8\x49\x91\x71\x1d\x55\xf8\xed\xf6\xc3\x16\x5e\xc4\xd2\x66\x21\xa5\x7f\x37\x2a\x69\xad\x7f\xbf\x6e\x1d\x99\xd7\x9d\x47\xe6\xb5\x23\x62\x63\x9a\xaf\xa4\x73\x41\x7a\x34\x44\x8e\xe2\x5c\x3e\x10\x62\x18\xb9\xf4\x68\xd4\xa1\x27\x37\xa3\xd8\xa7\x27\xd7\x89\x03\x5f\x5f\x1e\x28\x20\x6f\x69\x1b\xea\xf0\x5a\x75\x2f\x01\x16\xe9\xd8\xb5\x52\x69\x9a\x02\x80\xe6\xa4\xc0\xb7\xe9\x10\x2d\xf9\xaa\x10\xc3\xd4\xbe\x79\x44\x8e\xf9\x43\x4e\x6e\x04\x0d\x4e\xef\xec\x4c\x80\x10\x66\xed\xb5\x72\x3a\xdc\xed\xd0\xb5\x43\xee\x05\x6c\x5b\xa4\xde\xc8\x42\x66\x59\x8d\xed\xb2\xc2\x83\xc6\xca\x2d\x62\xd2\x50\x4b\x19\x41\xee\x95\xfa\x96\xee\xc4\xb2\x8a\x19\x9e\x2f\xd1\x99\xd4\x5e\x6f\x11\x2f\x92\xbc\xfa\xce\x9a\x6e\x87\x4a\xc1\x49\x30\xb9\xf6\x23\xf7\x87\xa7\x8d\x55\x5a\xc5\x0d\x7c\xb4\x46\xb5\xd5\x8b\x8f\x8b\x77\x72\x1f\x0a\x08\xcb\x45\x11\x3f\xe4\xba\xa4\x47\xa3\x1d\x6a\x9a\x34\xe4\xb4\xe2\x98\xce\x04\x8f\x57\xdf\xaa\xd6\xd4\xa8\x19\x37\xd2\x0a\x0f\xad\x0b\xa4\xff\x34\x59\xff\x19\xa9\xc2\xd1\x6a\x53\xf1\xe8\x8a\x44\xd5\x9a\xcc\xf2\x45\x4e\xe6\xd1\xcd\x92\xd0\x48\x2c\x62\x8e\x8b\xfc\xef\x39\xbd\x8e\x40\xdf\xae\x55\x1f\x44\x4c\x70\xb4\x24\xd2\x66\x01\x66\x3c\x91\x52\x71\x1d\x01\x25\x64\x3a\xc1\x3f\xac\xb8\xa2\xc3\x10\x54\xeb\x42\x6c\xbe\x08\xa8\x4b\x46\x2d\x8a\x16\x42\x14\xd5\x7e\x5a\xf8\xa2\x98\xca\x17\xb0\x8c\x87\x29\x83\xbc\xc3\xd0\xc9\x19\x1a\x0a\xc2\x00\x2d\x5c\x45\xa0\x22\x6f\x5a\x0f\x38\x36\x36\x3b\x60\x85\xe9\x06\x17\x40\xbe\xfd\xa3\x5f\xf3\x31\x91\x85\xd9\xc4\x09\x04\x0c\x52\x63\xb5\x09\x50\xd5\x2e\x23\xc3\xfe\x9a\x32\x6c\xc3\x41\x2b\x96\x86\x7c\x06\xe8\x81\x63\x96\x3d\xfb\xc6\xf2\x4e\x83\xd5\xc3\x1b\x94\xc3\x34\x26\x9e\xbb\x60\x05\xb5\x23\x2e\xcd\xf6\x5b\x38\x5a\x61\xb7\x3c\xba\xb3\xa4\x45\x83\xb0\xa6\x77\x00\xec\xf4\x4e\x59\xe4\x1f\xf5\xfd\x8a\x4f\x28\xf6\xdf\xa5\x5d\x3b\x77\x69\x8d\x5a\xaf\xcc\xb0\x5a\xce\x1d\x31\x6d\x8f\xd5\xe9\xa6\xe5\xc9\xa0\xef\xa8\x29\x84\x89\xa4\x82\xbd\x5e\x2b\x76\x31\x35\x39\xb1\xfe\x95\xdd\x49\x87\x13\xfd\x85\x84\x24\x61\x3e\x04\xc7\x1c\x98\x63\x41\xae\x31\x27\xad\x51\x1b\x1f\xcb\xbb\x9d\xd1\x48\x79\x43\xf5\xac\x18\xcc\x52\xf4\x7a\x0b\x25\x4a\x7a\xa9\xc8\x3f\x61\xf1\x05\x9d\x1e\x65\xa4\xd7\x8b\xf9\x05\x9d\x66\x44\x3a\x2f\xf8\xe3\x92\x48\x15\x30\x96\x14\x3b\xdf\xd2\x1a\xa5\xa0\xa9\xe9\xcc\x84\xa6\x8b\xb8\x61\xf4\x07\x6b\x19\xd5\x41\x43\xf5\x86\x82\x8a\xac\x45\x6e\x5a\x0d\x35\x1b\x71\x16\xca\x83\x55\x0c\x21\x3a\xb0\x47\x44\x20\x44\xde\xe8\x17\x4a\x7a\x93\x6f\x25\x69\xaa\x7b\x61\xb7\xaf\x34\x98\xbe\x5d\x93\x89\xbe\xfd\x37\x41\xa2\xa7\x32\x86\x2b\xd1\xf7\x61\x7e\x94\x89\xed\x16\xc8\x9a\xc4\x61\x3c\xa0\xfb\x91\x89\x7c\x49\x55\xc4\x79\x27\x55\x3b\x26\xec\x22\x31\xec\x8b\x68\xbc\x51\x03\x1d\x11\xbb\x01\x25\x1a\x6d\xb7\xcd\x14\x29\x96\xdb\x50\xc0\xda\xe9\x63\x6c\x1b\xcd\xc2\x01\x1e\xdb\xc3\xb5\xbe\x5a\x70\x87\x82\x5d\x34\xcf\xf2\xea\x85\x82\xdc\xbb\x72\xe3\xd9\xec\x19\x1a\x2f\x20\x1e\xb9\x07\x1b\xb4\xe6\xb3\x1a\xe4\xd4\x28\x28\x8f\x86\x63\xeb\x22\xed\x75\x22\xe9\xd1\x7f\x57\x5c\x14\xc7\xc4\x1e\x64\x1c\x69\x3f\x6d\x67\x19\x74\x5c\xd7\xc3\xd0\x4b\x34\x7f\x08\x7e\x09\x2a\x56\xe3\x97\xba\x47\xbf\x1f\xbf\xd4\xe0\x9b\x08\x66\x6e\xe1\x83\x7d\x34\x10\x2c\xac\x6e\x5a\x34\x15\x2f\xcd\xe5\x54\xec\xbf\xbd\xd9\x8f\x8d\x5e\x5a\xb3\x97\x5d\x5e\x99\xd6\x78\x6c\x51\x07\xa0\x68\xf2\x5a\x09\xbb\xa1\xa4\x7c\xd6\x71\x69\xd5\x62\xcd\xb4\xc7\x7d\xd8\x32\xe9\xc8\xc0\x74\x5c\x87\x2c\x36\xb4\x5e\x99\x7c\x14\x96\xf0\xff\xf8\xe2\x59\xec\x4c\x52\x6b\x81\x6b\x43\x04\x84\xb5\xbc\x9c\x4b\xfb\xdc\x66\xd0\xa8\x5a\x94\x9e\x93\x6a\x56\xe6\x57\x64\x7e\x75\x5b\x17\x34\x2b\x61\x65\x95\x5e\xcf\x0d\xce\xaa\x1d\xd2\x14\xfb\x15\x7a\x8f\xd1\x6d\xc3\x8a\x38\x93\x70\xb2\xc3\x38\xcb\xb0\xc7\x2d\x90\xa5\xe1\x7a\x28\xcf\x4e\x7e\xaa\x26\x78\xc3\xd9\xe4\xa7\x6a\x72\x92\xa3\x2a\xd3\x61\x0f\x18\x1c\x57\xbd\x5e\xcc\x32\x66\xb5\x84\x39\x02\x92\x24\x0b\x29\x4b\x00\xc7\xda\xac\x48\x13\x58\xce\xd6\xe9\x10\x15\x64\xc1\xd3\x21\x9a\xe7\xd5\x5a\x88\x38\xe0\xaa\x60\xb3\xf7\x60\x07\xdd\x20\xbd\xe1\x1d\xcc\xdb\x91\xea\xad\x58\x34\xc1\x0d\x63\xfc\x56\x09\x98\x62\x2f\x0c\x86\x2f\x3f\x74\x05\x79\x53\x35\x94\xd3\xb0\x87\xf0\x62\x59\xca\x3a\x84\xa6\x7d\xd4\x17\xcd\xb2\x56\x38\xe2\xa5\x9b\xa4\xad\x9b\xf3\x45\x5c\xa9\xad\xb5\xc9\x18\x5a\xb7\x5b\xf2\x65\x29\x38\x66\x99\xf7\x84\x63\xa9\x1f\x26\xec\x2f\x9f\xac\xf5\x4f\xfd\x0c\xac\x7e\xcc\x56\x96\xe1\x6c\x3d\x58\x9e\xaf\xc5\xdf\x89\x7d\x7d\x56\x5b\x8f\xe9\x22\xf2\xa3\x3f\x7b\xb2\x4e\x64\x9c\x46\x1b\x60\x5b\xfe\xd1\x65\xc4\xef\xc1\xec\x7c\x2d\x7f\x4c\x74\xfd\x94\x35\xb4\x36\x1b\x6f\x0d\x65\x84\xe8\x79\x6d\x2b\x85\x8b\x99\x54\x09\x99\x87\x2f\x19\x2a\xd1\x0c\x2d\x8d\x2e\x75\xbd\x2e\x6e\x5f\x1b\xac\x8b\xe7\x26\x22\xc9\x2a\xa0\xf7\x76\x48\xdb\x98\xef\x0b\xcc\x07\xfa\x5c\xad\x16\xe2\x2d\xbd\x84\xa6\x93\x54\xdd\xc6\xe2\x0f\x24\xe6\x70\x37\x5e\xec\xb1\xde\xe7\xf9\xfa\xf0\x07\xf9\x56\x1d\x2a\xb0\xa0\xf6\x02\xa6\xab\xb8\x49\x84\x7d\x80\x74\x07\x5b\x57\x24\x0b\x67\x2d\x17\xd2\xc2\x4d\xd2\xd7\xfe\xcc\xb1\xa1\x56\x46\xc6\x2b\x5c\x5e\xe7\x74\xa0\x36\xec\x68\x08\x51\xde\x55\x44\xe2\x83\x2c\x33\xce\xab\x97\xf8\x65\xcc\xa0\xdc\xfd\xa2\x8e\xfc\xce\xc5\x77\x2e\xbe\xa9\xbc\xbc\xce\x18\xa2\x12\x61\xfa\x59\x8e\x16\xe6\x69\xcc\x8a\x70\x8d\x00\x62\x78\xa8\x96\x0c\x04\x0b\x78\x9d\x7a\x77\x39\x35\xd9\x90\x36\x0d\x25\xdb\xd0\x79\x2c\x1b\x87\x8a\x8c\x78\xc9\xca\x5c\x5d\xc0\x91\x42\x34\x84\xa8\xe9\x3a\xab\xa3\x56\xb5\x00\x55\xb6\x01\x35\xd6\x7b\x88\xf4\x7a\xe5\x51\x56\x48\x39\x83\xb3\x75\xa6\x26\x56\x0c\x4a\x13\x52\xdd\x60\xf7\xdf\xf4\x36\x55\xfe\x00\x64\xfe\x8c\x14\x1c\xc7\x04\x51\x54\xa1\x12\x8e\x67\x6a\xe3\x18\x70\xa8\xcf\x54\x83\x69\x26\xdf\x75\x56\xf1\xd4\x4f\x38\x5b\x6f\xd5\x3e\xb5\xe6\x03\x68\x93\x2d\x27\xa7\x5f\xaa\x4a\x03\xdc\xaf\x52\xf1\x21\x76\x76\xd1\x2f\xd1\x3a\x5b\x4e\x80\x33\x1d\x90\x02\x77\x26\x60\x6c\xdf\x77\xa5\xd6\xc8\x46\x12\xeb\xa7\x65\xc9\x6e\xe2\x0d\x92\xa1\x1e\xd7\x53\xb4\x6c\x48\x82\x6e\xb1\x96\xea\x48\xed\x59\xd9\x82\x22\xee\xdc\xd2\x0d\xa9\x61\x7b\x3c\xfc\x32\x1e\x0d\xe8\x09\x81\x7d\xf0\x47\x60\x8b\x28\xfa\x24\x0b\x8a\x93\xa2\xc1\x76\x7c\xa2\x41\x66\x6d\x7c\xe9\x2b\xdc\x0e\x35\xbe\x6c\xdb\x41\x0a\xf6\x39\x64\x0a\xd9\x1c\x71\xcb\x1f\xd9\x73\x6e\x33\xce\x66\x3c\x5f\x8b\x0d\xba\x68\x5e\x73\x79\xc7\x89\x63\x56\xa6\x64\x84\xa3\x06\x0b\xc7\xed\x61\x8a\x88\xa5\x79\x82\xb3\x6b\x38\x0f\x3f\x0d\x33\x1d\xc1\x10\x8e\xa0\x4f\x34\x7d\xd4\xae\x41\x9d\x51\x37\x31\x44\x47\xe1\xc8\x5e\x75\x88\xc7\xf6\xb3\xa6\x1d\xd4\x34\xe0\xff\xf4\x40\x97\xa7\x0e\x2a\x5a\x18\x5d\x42\x48\x09\xed\x2f\x9d\xd1\x7f\xdc\x63\x32\x3a\xb6\x61\xcb\xb4\x39\xeb\x76\x6b\x6e\x78\x8f\x6a\x65\x84\x63\x3f\x6a\x1c\x34\x8c\xf9\xab\xb4\xab\xef\xce\x47\xed\xe6\x6d\x40\x2c\x5d\xa2\xb5\x4f\x1e\x62\x3c\xdb\x52\x7c\xb4\x5f\x2a\xb7\x68\x1b\xd3\xac\xe9\xac\x0b\x25\xab\x98\x81\x3f\xbf\x7a\xf6\xff\x49\xa1\x42\x47\xb8\x92\x0f\x90\x77\xfa\x12\xc9\x57\x2c\xb1\x62\x27\xe4\x23\xe0\xae\xfa\x07\xa3\x3b\x99\x91\x62\xed\x70\x84\x25\x5d\x43\xca\xa6\x27\xc5\x9a\x97\x19\x60\x41\xe1\x76\xd0\x38\xdf\x69\xcd\xe6\xdb\xbf\xfd\xc5\x06\x4d\x23\xae\x30\xd7\xca\x47\x2c\xe3\x13\x8f\xe5\xdc\xa5\xc5\x44\x8c\x2d\xa5\xf6\x35\x79\x94\x67\x77\xca\x7e\x21\xe5\x93\x7b\x6c\xe2\xde\xb1\xf5\x76\xdb\xf0\x1e\x32\x19\x29\x75\x0d\x9f\x76\xa8\x12\x7d\xab\x69\x2e\xac\x85\x85\xfc\x8e\xa1\x99\x69\x9d\x61\xcc\x99\x76\xf2\x29\x70\xa3\x62\xf2\x81\x96\xa3\x0a\xb1\xf6\x72\x36\xb9\xaa\x06\xa1\x46\xd6\x58\xb2\xe6\x1d\xa9\x82\x8a\x7c\xc7\xbd\x4f\x74\xe7\x0a\x44\xca\xe5\xab\x4f\xd4\x50\x4f\x4e\x07\xfc\xe4\x74\x67\xf8\x49\xb7\xde\x00\xef\xad\xa0\x59\xc7\x60\x4f\x27\xa7\x03\x7c\x72\xea\x56\x1f\xf0\x5d\x7a\x50\x41\xd3\xcf\xae\x05\x86\xe0\xf1\x1b\x80\x85\x8e\x71\xe7\x61\xc5\xd8\x3a\x88\xd6\xcc\xb6\x31\x1e\x76\x5f\xa9\x6f\xdf\x63\x84\xaf\x1c\xf4\x15\xcc\x76\x3b\x34\x06\x68\xfb\xb8\xfa\x7c\x11\x9f\xc8\x7d\xb0\x15\xc3\x31\x21\xe7\xb4\xb1\x41\xa5\x5e\xdb\x1f\xb0\x41\xae\xf1\x0b\x95\x2a\xa9\x5f\x27\xf5\xf1\xb8\x3a\xcf\x25\x87\x5f\x48\x26\x45\xfe\x1e\x54\x69\xf9\x44\xfe\xea\xe7\x1a\xa0\xf2\x55\x09\x5b\xc0\x26\x0f\x4a\xe5\x1e\x7a\xa7\x38\x1a\xbd\x26\x0c\x2d\xf5\xcf\x3e\xeb\xf3\xf1\xec\x3c\x57\x2c\x4c\x21\xff\x64\xb9\x16\x09\xd2\xe5\x93\x5c\xed\x63\xd9\xba\x93\xd7\xcf\xd5\x62\x0d\x96\x26\x0a\x5f\x54\xb4\xd6\xed\x10\x12\x7c\x88\xd5\x7e\x93\xea\x76\x19\xef\xcb\xec\x89\xfe\xeb\x1b\xee\xcb\xa4\xf6\x06\xfb\xf1\xc5\x33\x8f\x54\x2e\x58\x19\x8f\x69\x3f\xfb\xbf\xff\x37\x1e\x91\xaf\xbe\x54\x0c\x28\xa6\x73\xb6\x8a\x21\xac\xcd\x6a\xaf\x89\xa1\x1e\x7f\xbe\x7d\x21\x1d\xe4\xac\xea\xb8\xa1\x8c\xe6\xf9\x3a\x18\x4f\x5b\xb0\x0d\xf6\xdd\x29\x59\xa8\x61\x68\xa6\xef\x2d\x21\x1a\x1d\x65\xb5\x80\xa2\xcd\x2a\x5a\xb7\x4c\xee\x65\xd1\x7f\x9a\xba\xfe\x85\xd3\x8c\xd1\x2a\xaf\x78\x24\x20\xf5\x11\xcf\x78\x71\x1b\x8d\x22\xc9\x66\x92\x0f\xa4\x88\xf4\x72\x1c\x01\x5f\x09\x2e\x7a\x6d\x48\x2e\xbf\xde\xd9\xc1\xbf\x5a\x85\x4d\x35\x39\xbe\x6a\x47\x72\x76\x6e\x31\xfd\xe2\x3a\x12\x61\x77\xf9\x86\xe6\x53\x5d\x47\x3d\xd7\xb9\x9d\xb5\xdc\xaf\x50\x03\x1d\x7c\xe1\x98\xf6\x7a\x71\x4c\xb2\x43\xf5\xa4\xf0\xb7\xd6\x91\x42\x44\x27\xb5\x42\x34\x99\xa9\x10\x03\x8d\x04\xd4\x52\x7f\x4e\xf4\xf5\x45\x4c\x60\xaa\xb5\xc7\x31\x81\x6a\x63\xb5\xb5\xf6\x93\xba\x48\x5a\x57\x6c\xac\x9b\xf2\x2e\xe9\x88\xb5\xe1\x69\x5a\xb9\x63\x8a\x60\x38\xc0\x7c\x4e\x1a\xd1\x36\xbc\x00\x24\x09\xe8\xeb\x37\x61\x35\xdf\xfa\xac\x86\x07\x35\x4c\xb1\xde\x84\xea\x6f\xcd\x7f\xab\x6f\xc5\x59\x52\x83\xd1\xe6\xcb\x5e\x7e\x9b\x04\xd7\xf4\x61\x67\x8d\x5a\x16\x8e\xc7\xdb\xd8\xfd\x78\xa0\xff\xce\x22\x68\xac\xa8\xcd\x15\x3e\x8b\xff\x8e\x35\xc8\x50\x68\x78\x7d\xb0\xff\xce\xa2\xc3\xa9\x2f\xbb\xf6\xb3\xf6\xda\xea\x78\xa0\xe9\xf0\xdf\x39\xdb\x67\xfd\x82\x1b\xd6\x2f\x58\x34\x18\x12\x2a\x02\x1b\xf6\x68\xa4\xed\xd5\xc7\x3a\x52\xe7\xfd\xe1\xb2\x88\xa0\x3f\x96\x42\x86\x55\xf4\x64\xbb\xc5\x31\xaf\x25\x32\xdc\xf5\x8a\xa0\xd4\x64\x28\xd9\x05\xc7\x9e\xd9\x60\xbb\xc2\xe1\xbe\xde\x57\x8c\xf1\x8a\x97\x58\xe0\x07\xc9\xee\xfe\x9d\x5c\xbd\xcf\x79\x3d\xa3\x14\xdc\x34\x52\xe4\x1c\x7f\x60\x7f\x77\xcb\xd4\x1d\x13\x91\xfb\xca\xcd\x63\x5e\xd5\x88\x35\xca\xf2\xce\x66\x76\xd6\x4c\x81\x0b\x19\x5c\xde\x5b\x29\xb3\xf7\xa3\x2c\xa3\xca\x59\xff\x82\x9b\xbb\xab\x3b\x42\xe7\xa9\xf8\xde\xd5\x77\x58\x52\x25\xdf\x61\xa1\x28\x45\xd6\x44\xda\x54\xe0\x22\x69\x2c\x62\x76\x77\x95\xd3\xf9\xbb\xdb\x35\x49\xbb\x96\x03\xcd\x35\x61\xdd\x5f\x4a\x45\xd4\x48\x1b\xa1\x85\xb1\x1f\xe2\x4e\x5d\xf4\x5b\x56\x46\xd5\x79\x75\xf5\xb3\xfe\x55\x2a\xf5\x9f\xd6\xd7\x97\xd7\x72\x1d\x2b\xb8\x0b\x19\x4c\x3f\x6a\x63\x7f\xd8\x61\x0d\xbb\x8f\x64\x0a\xaa\x76\x79\x49\xaa\x1f\xd8\x7c\x53\x90\x09\x4d\xef\xcc\x13\xb7\x74\xb7\x73\x7d\xdc\xe4\xe8\x1b\xc5\xed\xc0\xf5\xcd\xcd\x9d\x64\xa2\x05\x81\x3b\xca\x28\xf4\xd6\x90\xc2\x57\x92\xfe\xf8\xf2\xeb\xab\x1b\xfa\xba\x64\x6b\x52\xf2\x5b\x63\x14\x2e\x63\xfd\x89\xd5\xcc\xa8\x58\xe2\xb1\xbd\xf7\xd2\xe3\xca\x28\x22\x3b\xee\x0c\x22\x3b\x1a\x6a\xf6\xbc\x88\x69\x0c\x92\x13\x05\xba\x2b\x5c\x56\x27\x57\x58\x3e\xcb\x83\xf2\x0c\xb7\xf2\x2a\xbc\x20\x03\x4d\xb9\x20\x44\x55\xa0\x08\xf9\x38\x23\xf2\xf0\x14\x05\xca\x40\xfb\x1b\x9e\x17\x95\xc8\x9c\x05\x32\xcb\x0d\x15\x67\x91\xc8\x5e\x06\x1a\xa7\x6c\x30\xd3\xf4\x0e\x40\x47\xf1\xb3\x89\xed\x1d\x2b\xb9\x89\x58\xf2\x57\x5b\xe5\x39\xfd\x90\x97\x8c\x4a\x7d\x84\x06\x4b\x69\x84\x43\x82\x18\x44\x24\x79\x8b\x17\xe4\xad\x9c\x53\x96\x1b\x88\x21\x92\x3c\x37\x13\xc9\x2a\x27\xf5\x47\x31\xfa\xac\x44\x26\x7c\xd8\xf3\x8f\xf2\x25\x7f\x51\xac\x6c\x25\x21\x92\xfc\xed\x87\x6c\x86\x88\xe5\x38\x43\xf1\x3b\x67\x36\x57\x2a\xa8\x77\x88\xec\xd4\x63\xe9\x9b\x18\x8e\xd7\x9a\x02\x65\x1b\xb4\x34\xc3\x88\xd7\x10\xad\xed\xda\xae\x11\x77\x7e\x93\x84\x7c\x14\x3b\xab\xca\x6c\xea\x0e\xdd\x05\x56\x38\x1d\x3d\x46\x5d\x2b\x97\x8e\xfe\x84\xba\x01\x9f\x9e\x36\x73\xcd\xaa\xa5\xa7\xdf\xa0\x6e\x84\x49\xcf\x86\x28\x84\x0a\xe9\xd9\x48\xec\xc5\xc0\x6b\xf2\xbf\x76\x2f\x36\x50\x1e\xf1\x30\x62\x64\x4b\xad\x28\x91\xe8\xa6\xf1\x13\x31\x83\x7f\x1e\x46\xe7\xaa\xd0\x92\x14\x6b\x22\x1f\x9d\xaf\x54\xc2\x9c\xcc\x58\x69\x1e\xa2\x2f\x4d\xd5\x82\x89\x43\x4a\x3e\x42\x54\xdb\x62\x3e\x4a\x86\xc9\x68\x04\xc6\x3c\xf9\xf6\xd5\x0f\xaf\x5f\x7c\xff\xfc\xcd\xe5\x9b\xe7\x7f\x7b\x21\x33\xbf\x1e\xf3\xc4\x7c\x5c\x7e\xfb\xd7\xa7\x2f\xff\xf2\xfc\x6d\x76\x37\x4a\xc1\x79\x16\x8d\x92\x61\x52\xce\x92\x53\x80\x4e\x53\x90\xa9\xef\xe1\xa0\x9c\x25\x67\x00\x9d\xf9\x29\x8f\x00\x7a\xa4\x53\x3e\x26\x1f\x01\x7a\x2c\x3f\x4e\x65\x36\x2e\xd6\x4b\x2c\x12\xbf\x4a\xc1\x13\x93\x78\x45\x38\x4e\x46\x00\x7d\x2d\xd3\xc4\x08\x87\x60\xa7\xf5\xf4\xe0\x42\xb1\x41\x91\xa2\x46\x53\x67\x29\x96\x9e\x4e\x5b\x03\x25\xa3\xdb\xad\xb1\x55\x5a\xe3\x92\xe7\xb8\xa8\x32\x52\xa7\xd5\xa0\xca\xb8\x4c\xcd\x93\x92\x5c\xe7\x15\x27\xa5\x56\x91\xfe\x55\x35\xa4\xd9\xb3\xaa\x99\xfd\xcc\x36\xa0\x4a\xec\x96\x4e\x68\x84\x3b\x87\xcd\x4f\x97\x48\xad\x40\x5a\xda\x2d\x5c\xb0\xeb\xfa\x2b\x29\xd8\x35\x32\xad\xab\x5e\x53\xff\xb6\x48\x46\x5d\xe7\x4c\xd1\x07\x13\x52\x3c\xcb\xb2\x99\xcc\x22\x8e\xac\xc8\xec\xf6\x04\x4f\xcb\xeb\x88\x32\x1e\xe9\x53\x8e\xcc\xa3\x9b\x9c\x2f\xa3\xd5\x46\x70\x62\x05\x89\x2c\xf6\x8c\x6d\x20\x07\x17\x80\x88\xea\xa0\x53\x6e\xa2\x34\x7c\xda\xa1\x0d\xed\x1c\x2e\xbc\x13\x87\x2c\x6f\x55\xdb\xd9\x19\xbe\x56\xab\x71\xf0\x14\xfd\xd1\x99\xb5\x44\x14\x5a\x9b\x20\xeb\x58\x17\x86\xc4\xf1\x53\x2e\x68\x1b\xcf\xe9\x75\xc4\x59\x64\xc6\x11\xe1\x48\x37\x16\x89\xee\xc8\x3c\xd2\xbe\x6e\x11\xae\xa2\x0d\x9d\x93\x45\x4e\xc9\xfc\x58\x5f\x6f\x9a\x7e\x15\x04\x5c\x10\xb4\xe7\xe3\xc3\xc0\xa9\x59\x03\xc1\x62\xcf\x6f\xb1\xd2\x2e\x59\x68\x2c\x76\x9d\xe5\xaf\x77\x9d\xde\x5a\xf2\xd0\xd0\xfd\x19\x7b\x95\x75\xcc\xfe\x4d\xe6\xe1\xfb\x58\xfe\x9b\x6d\x90\x61\x6e\xbf\x2b\xf1\x8a\x64\x85\xfb\x85\x64\x99\x6b\x52\xd6\x55\xd5\x29\xe2\xcc\x27\x1d\x7d\x85\x3c\xea\x68\x4e\x0d\x8d\xdc\xe9\x48\x1e\x06\x9a\x04\xa6\xa7\x5f\x23\x4b\x5c\x35\xc5\x0f\xbc\x33\xea\x51\xfc\x36\xf9\xee\x24\x04\xde\xb9\x5a\xaf\x0f\xd5\x32\x23\x46\x45\x93\x4c\x9f\xe4\xb4\xc8\x29\x91\x84\x3e\xc6\x59\x01\x7b\x3d\xec\x9e\x24\xb8\x3e\x49\xf0\xae\x39\x79\x53\x39\x1d\x7d\x2d\x26\x12\x78\xff\x67\xdf\x44\xf4\x65\xaa\x18\x90\x3d\x6e\xc6\xf5\x21\xee\xfb\x52\xb4\xd6\x3e\x06\xba\xf3\x5a\x74\x2a\x10\x43\x39\xb2\xa2\x97\x55\x83\x33\xbb\x03\xb6\xdb\xb8\xfe\xc8\xee\x76\x28\xfc\x04\x79\x6e\xcb\x8c\xeb\x9f\x59\xe5\x5a\xa0\xa2\xba\x1d\xf3\x24\xa2\x8a\xe6\x60\x9f\x10\xad\x2b\x72\x84\x77\xd0\xa9\x70\x41\x13\x5c\x5e\x57\x17\xc3\xe9\x34\xa3\xc9\x42\x70\xa5\xd2\xac\x2a\xcc\xb2\x34\xb0\x25\xf0\x3e\xc0\x01\x40\xbe\x00\xea\x26\x4f\xa1\x28\x02\x8b\xbc\x20\x2f\xf1\x8a\x00\x04\x04\x10\x5f\x2a\xe3\x58\x04\x56\xa4\xaa\xf0\xb5\x48\xa6\x2a\x97\x9a\x9c\x8a\xe3\xd9\x7b\x30\xf5\x9e\x57\xaa\x21\xa6\x9e\x94\x64\x33\x84\x33\x45\x0b\x51\xa1\x7f\x8c\x79\xaf\x17\xd3\x7e\x06\xa2\x41\x04\xfa\x31\xce\x78\x22\x5f\xb3\x49\x44\xbf\xb0\x0f\x52\xd0\x8f\x0b\x9b\x38\x63\xc5\x66\x45\x61\x6d\x6c\xce\x32\xa9\x7c\x74\x5d\x6b\x9d\xd8\x3e\xb5\x45\x12\x15\x3c\xc9\x70\x9c\x9f\x9b\x87\xce\xc7\x79\xbf\x2f\xcd\xcb\x2f\xaa\x8b\x7c\x3a\xcd\x98\xfa\x3b\x56\xcd\xcd\xf0\x9a\x6f\x4a\xf2\x56\x4c\xea\x5d\x89\x67\xa4\xd7\xeb\xc8\x50\xcd\x97\x70\xcc\xcb\xdb\x3b\x6e\xd4\xa9\x35\xd0\x32\x8c\xb4\x78\xa2\xe8\xb5\x11\x4a\x26\xc1\x54\xd5\x1a\x50\xd3\x04\xe8\xee\x03\x2e\x36\x24\x2d\x10\xa1\x9b\x15\x29\xf1\x95\x7a\x37\x55\x5b\x52\xa9\x52\x59\x01\x77\x33\xcc\x67\x4b\xb1\x11\x76\x3b\x07\x14\x99\xd5\xcd\x3a\xbc\x6f\xd9\x81\x48\x02\x77\x02\x41\xae\x3e\x3f\x6f\x19\x66\x62\xbc\xed\x5c\x38\x94\x09\xb9\x64\x0a\xe5\xee\x47\xe5\x7e\x94\xee\xc7\xcc\xfd\x58\xb6\xe8\x5c\x61\x65\x26\xd5\xf9\x89\x34\x10\x1b\xa8\xaf\xc1\x2a\xaf\x2a\x2d\xb9\xb1\x66\x41\x82\x67\x4b\x5f\xea\xd3\x19\xed\xba\x55\xb3\x48\xbe\x50\xb2\x5e\x23\x59\x3e\x93\x21\x6d\xba\x9a\xe9\xec\xfd\x66\xed\x8b\x78\x3a\x4b\x1c\x9e\x00\x42\x2d\xb1\xec\x9b\x43\x7a\x3a\x44\xcd\xe1\xa7\xa7\x23\xd4\x3d\xf2\xf4\xf4\x14\xf9\x83\x4e\x4f\xcf\x50\x63\xbc\xe9\xe9\x23\xd4\x1e\x6a\x7a\xfa\x18\x35\x47\x99\x9e\x7e\xb5\x9b\xa2\xd3\x40\xfc\x9b\x7b\xa9\x52\x7e\x3f\xe9\x67\xe2\x14\xf3\x39\xbd\x58\x59\xfb\xa9\x8f\x1f\xf4\xa4\x50\x88\x8a\x93\x24\xa7\x1f\x48\x59\xa9\xab\xe6\x05\x95\xb7\x74\x82\x49\xb3\x6f\x4a\x62\xe7\xcd\xae\x91\xc8\x50\x71\x32\x9c\x12\xbc\x2e\x91\x27\x79\xf5\xb4\x2c\xf1\x6d\x4c\xad\xda\x65\x78\x4e\xcd\xe3\x6e\x31\x49\xf2\x79\xa5\x2c\x81\xe7\x55\x76\x41\x12\x41\x41\xa7\x02\xbf\x35\xc0\x94\xfa\x55\x0c\x10\xa6\x4e\xbb\x2a\x90\x9e\xa0\x9f\xf9\xbc\x32\xf7\x8b\xb9\xcb\x86\xe8\x22\x82\x73\x92\xb1\xa1\x3e\xf2\xd7\x98\x2f\xb3\x5c\x9b\x2b\x7e\x5b\xa7\xe9\x92\x6e\x31\xa4\x06\x22\xf5\x74\x22\x2f\x2d\x76\x3b\x3b\x7d\x29\x68\x1f\x7c\xf6\x9c\x06\x1c\xeb\xef\x5d\x65\x8c\xd6\xfe\x3a\x6b\x1e\xc4\x13\x28\xd1\xfc\x7e\xe6\xe3\x5e\xd6\xc0\xe0\x87\xdc\x04\x35\x46\x60\xa4\x14\x50\x47\xd4\xe1\x5d\xe7\x35\xef\xfa\xc3\xa6\xe2\xd1\x1a\x57\x55\x94\x73\x22\x59\x0b\xc1\xa3\x7f\xa1\x28\x81\x91\x88\xe5\x29\x9d\x51\x8b\x51\x3c\x1b\x22\x96\x01\x80\x72\x73\xde\x55\xe6\xbc\xf3\x0f\x48\xe9\x24\xd7\xeb\xc5\x79\xf2\x9e\xdc\x66\x14\xe5\xea\xad\xaa\x8c\xa0\x3c\x59\xe4\x65\xc5\x33\x29\x36\xa0\x3c\x29\x70\xc5\xb3\xa3\x23\x8e\x2a\x59\xdc\x5d\xe9\xaa\x4f\x05\xb1\xea\x67\x45\x8c\x2f\xe8\x14\xa9\x85\xcc\x91\xdc\x09\xaf\x71\x89\x57\x55\xba\x4e\x9c\xaf\xf8\x42\x96\xa3\x53\x74\x51\xf5\xa9\x7c\xb9\x59\x86\x65\x91\x36\xcd\x0a\xdd\xa8\x46\xd7\x2a\x5b\x07\xf0\x88\xb6\xf1\x48\x56\xb8\x18\x4e\x61\x1f\x24\x00\xa2\xb5\xeb\x7f\x27\x9f\x20\xc7\x19\x76\xde\x68\x85\xc8\xf4\x14\xe7\xd9\xda\x43\x67\x95\xa1\x02\x41\xb7\xef\x16\x60\xbe\x88\xd7\x76\xaf\x61\x68\x95\x81\xb3\xda\xab\x8c\x9f\xcf\xc6\x5c\x9c\xef\x51\x4e\x23\xdc\xeb\x95\x31\x47\x1c\x71\xf9\x82\xa2\x09\xb0\xa2\xa5\x33\x65\x14\x67\xd6\x46\x37\xb5\x91\xf5\x20\x6e\x28\x12\xe3\x0d\xb4\x51\x52\x8e\xb2\x6c\x29\x1a\x5e\x22\x3e\x18\x09\x1a\xbd\x41\xa2\xc7\x71\x20\x17\x1d\x0d\xed\x05\xb5\x58\x4d\x2e\x8d\x1a\x89\x81\x03\xdb\xbf\xc3\x9a\x92\x43\x63\xcf\x05\xfc\x72\x0f\xd8\x73\xa1\x3d\x76\x00\x83\x7f\xf0\x1e\x5b\x76\x90\x5f\xb9\xd3\x46\x47\x59\x66\x35\xce\xe6\x3a\x39\x28\x17\xeb\x06\xb4\x26\x20\x15\xf2\xaf\xad\x78\xd1\x6c\x62\x30\x9a\x4a\x52\xd6\x3f\x06\xc7\xf7\x50\x2d\x0f\xa6\x02\x8a\x81\xfb\x9d\xfb\xa1\x78\xff\xf9\xc4\xe1\x1d\x6f\xc1\x26\x5f\x34\xcf\x23\x43\x6f\xdd\x2d\x43\xa1\x7c\x0c\x9e\x7a\x5b\xe6\x48\xaa\xb6\x97\x49\x4e\x67\xc5\x66\x4e\xfe\x0f\x29\x59\xaf\x77\x44\xb7\x5b\x51\xf5\xf9\x6a\xcd\xc5\xe1\x33\xb1\x07\x9b\xaa\x96\x8a\xc3\x4d\xab\x80\x20\x6a\x8f\x67\x43\x0b\x52\x55\x1d\x63\xe2\xf6\x74\xca\x17\x2e\x3b\x8d\xee\x16\x34\xad\x4f\x50\xfd\x57\x76\x85\xc4\x10\x53\x35\xd2\xdd\x43\x8e\x8f\xfb\xae\x19\xda\xbc\x64\x0b\xe0\x85\x60\x1e\x9b\x13\x14\x1c\x0b\x0a\x39\x31\x65\x17\x6a\xb7\x4e\x11\xc9\xf6\xe2\x95\xa0\xe7\x63\x7e\xde\xce\x91\x54\x86\xaa\xf0\x56\x75\x0b\x7c\x6a\x64\xbe\xd1\x58\x5d\x59\xe8\x75\x93\x66\x0e\x13\xec\x7d\xa6\xe6\x80\x37\x25\x25\x65\x95\x59\x92\x68\xba\x09\x10\xd1\x8b\xe1\x34\xc3\xa8\x48\x0a\x76\xad\x6f\x70\x0a\x44\xf7\x40\x59\x00\xf6\x3e\x9d\xf1\x01\x80\x0d\xee\x72\xcd\xf7\x85\x31\x47\xc8\x05\x17\x64\xba\x7f\x64\x0f\xd4\x6d\x38\x0a\xe8\xc3\x55\x02\x66\xb4\x92\x1f\x6d\x8c\xb5\xb8\x6f\xcb\x99\x57\x7b\x35\x7f\x58\x38\xfb\xac\xf6\x9b\xf2\xb6\xdb\xd8\x84\x37\x11\xcb\xe6\xdc\x31\xd5\x4c\x5c\xaf\x17\x0b\x4a\x1b\x62\xe1\xa0\x77\xae\x17\x87\x72\x70\xfa\xe4\x85\x88\xc7\x54\x9f\xfe\xd8\x3b\xfd\x0b\xff\xf4\x17\x07\x3f\x16\x84\xde\x69\x66\x0a\x1f\xb4\x59\x3f\x45\x99\x83\x9b\x57\x07\x77\x2b\xc2\x97\x6c\xfe\x03\x5e\xa7\x17\x60\x4e\xae\x36\xd7\x00\x81\x9c\x2e\x18\x40\xe0\x06\x97\x14\x20\x40\x84\xfc\x0a\xa6\x48\xed\x15\x9d\xa9\x10\xef\x7b\x99\xd4\xb8\x05\x6d\x59\x0c\x58\x7d\x0f\x56\xac\xd5\xab\x45\xcc\x12\xdb\xaf\x74\x24\xf8\x9e\xdd\x90\xf2\x5b\x5c\x91\x18\xc2\x31\xcd\x86\xe7\x19\x99\x90\xd4\x3a\x29\x50\x34\xaa\x0f\x70\xba\x93\xba\xf1\x46\xaf\x34\x63\x89\x33\x28\x21\x76\x02\xab\xa1\xad\xed\x78\x67\x8c\x56\xac\x20\xbd\x9e\x5f\x9a\xe9\xdd\x7d\x9e\xd5\xe1\xbd\xeb\x21\x5e\xd0\xe9\x58\x57\xbc\x20\x53\x69\xac\x23\x49\x5a\xad\x04\xe1\xad\xe3\x14\xe1\x4c\xf1\x47\xa3\x73\x3e\xe1\x83\x51\x3a\x14\x7c\xf5\x68\x5c\x9c\xf3\x71\xd1\xef\x43\x7c\x51\x0c\x46\x53\x87\xe8\x15\x6e\x1f\x9a\xb0\xe8\x04\x84\xe1\x6e\xe7\x1e\xfd\xac\xfb\xfa\xcc\x43\x91\x80\x2a\x2a\xee\x34\x9a\x38\x8c\x06\x29\xe0\x58\x1e\x0b\x4f\x70\xaa\x4c\x5c\x65\x1c\xf4\xfa\xda\x6a\x7c\xcf\x13\xa5\x6e\xd9\x4c\xba\xe8\xc4\x5e\x12\x87\x88\xee\xc2\x7b\x01\x3a\xc7\x60\x68\x8d\xaf\x0b\x76\x85\x8b\x89\xfa\x93\x86\x4a\x54\xa4\x58\x4c\xc4\x3f\xc1\x5c\x35\x9f\x89\xfa\x93\xde\xed\xf4\x25\xfc\xe9\x7d\xca\x99\x36\x00\xe5\x0b\x0f\x6f\xc8\x87\xbc\x0a\x5b\x63\x4b\x0a\x3d\x9c\x6e\xb7\x23\xc4\xb3\x75\xfb\x9a\x4d\x4a\x9f\x47\x59\xa6\xe2\x78\x90\x73\x1b\xba\x69\xdd\xba\x7c\xbb\xe0\x53\x54\x84\xd2\xc9\x74\x5c\x73\x76\x9b\x5a\xaa\x7a\xa7\xef\x72\xa3\x1b\x5c\x45\xeb\x92\xcc\xd8\x6a\x9d\x17\xe6\x62\x00\xd3\x88\x15\x73\x52\x46\x82\xae\xca\xc8\x4d\x8b\xa8\x5e\x9d\x88\x2f\xb1\x0a\x46\xa1\x0d\xce\x22\x7d\xb3\x9a\x44\xaf\x0b\x82\x2b\x12\x6d\xe4\x63\x23\xd1\x2d\xdb\x94\x4e\xe3\x52\x70\xc3\x62\x24\x4e\xcb\x31\xe8\xe3\x3e\x80\x11\x2b\xa3\x39\xbb\xa1\xd7\x25\x9e\xeb\x8a\xba\x51\x59\xa9\x39\x9e\x18\xf4\x8b\x3e\x80\x09\x80\xbb\x4f\x99\x5e\x63\x0c\xbf\x62\x76\xee\x20\xdb\x33\xa3\x17\xa3\xa9\x1e\xe6\x0e\xf1\xc0\xf5\x7a\x85\x4a\x25\xfc\xba\x41\x43\x9c\x79\xbc\x64\x11\xa9\x6f\x80\xa5\x18\x4c\xe6\xa2\x33\xd3\x94\x7e\x11\xba\xda\x6e\x8f\xaa\x64\x85\x73\x1a\x6e\xe7\x47\xfa\x9e\xb2\x1b\x6a\xab\xe9\xb0\x21\x69\x04\xfa\x66\x53\xc0\xb1\x6a\xa0\xbe\x9c\xc9\x54\xc2\xe5\x1c\x95\xc9\xdf\x7e\xf0\xd1\x39\xae\x12\xb3\xaa\x50\x6b\x8b\xee\xd4\x4e\x68\xdf\x57\x1d\xc5\x44\xd9\x89\x04\xc7\x76\x0c\x8e\xfb\x42\x66\x90\x17\x54\x7a\x37\x8a\xe2\xc7\x7d\x5a\x1b\xd4\x0a\x46\x46\x9f\x3a\x8d\xf6\x6b\x1a\x4c\x6b\xda\x3b\x1c\xe3\x73\x3e\xc6\xfd\xbe\x10\x56\xe9\x05\x9e\x1a\xee\x4e\xfc\xbe\x20\x36\x76\xa7\xfe\xdc\xa1\x02\xaf\xae\xe6\x38\x0d\x31\x52\x01\x2b\x63\x3a\xd1\x3c\x0a\x81\x29\xdd\xa1\xa6\x3d\x45\xba\x6c\x9b\x58\xe4\xf4\x03\x7b\x4f\x42\x37\x9b\xf2\x5e\x5a\xf2\xa3\x82\x08\x66\x4b\xe7\x16\x83\x20\x95\x21\xa4\x06\xc5\xb7\x70\xcd\x6a\x64\x47\x43\x79\x54\xca\x85\x29\x49\xc5\x8a\x0f\xa6\x71\x4f\x4a\x10\xad\xeb\xa3\x5f\x16\xf5\x86\xd1\x2e\xa9\xfd\x3a\x7a\xbd\xd2\xac\xae\xec\xd3\x5e\x8c\x70\xa5\x34\xcb\x6c\x76\x4c\x51\x8d\x08\xda\x1a\x15\x95\xea\xe9\xf2\x66\xad\x58\x74\x01\xc7\x77\xd6\x8e\x08\xab\xf8\x44\x92\x2d\xa0\xbc\x5e\xca\x22\xc3\x26\x5a\xcd\x4f\x4a\x30\x1e\xa2\xbc\x8e\x9e\xca\xce\xf3\x5e\x2f\x2e\x2e\xd8\x74\xbb\x65\x7d\x21\xcc\xe6\x70\xcc\xfa\x7d\x28\x92\x32\xd3\x5c\x5f\x7c\x8d\x05\x9b\xf7\x33\xcb\xa9\x6a\xc9\x2a\xd6\x3a\x88\xc6\x92\xd8\x0b\x5f\xd0\x57\xa3\xee\x83\x68\xc6\x36\xc5\x5c\x62\xe7\x15\x89\x6a\x42\xb2\x24\x54\xec\x7e\x2a\xe4\xe3\x9c\x1a\x42\x30\x60\xb4\xb8\x8d\x56\x6c\x4e\xe4\x9e\x5f\xd0\xb4\x4d\xf7\x2b\xc1\x51\x38\xc6\x4f\x76\xbb\x5d\xd0\x3e\xb8\x9c\x83\x29\x22\x3b\xb4\x2e\xd9\xb5\x64\x1d\x2f\xa6\xe6\x77\xda\xf4\x76\x40\x85\x09\xdd\xa3\xee\x91\x75\x15\xc1\x5e\x6a\xbf\x84\x85\xe8\xd6\xf6\xb5\xdd\x16\xdb\x2d\xde\x6e\xf9\x84\x65\x73\xb3\xf0\xb9\x6d\x2b\x55\x0f\xac\x37\xdb\x72\x8a\x4a\x6d\x09\x92\xfc\x6d\x7b\x17\x8e\x69\xaf\x47\x06\x83\x31\x14\x2c\xfc\xa5\x8a\x69\xe7\x58\xc4\xaf\x48\x79\x4d\xd2\x90\x22\x98\x6e\xb7\x64\x5c\x4b\x2d\xa4\xd7\xa3\x47\x99\x8a\xa3\xd2\xd8\x0e\x14\xca\x88\x2a\x02\x7d\x34\x4f\x9e\xea\x6b\x9c\x8a\xe0\x22\xbe\xdb\x41\x44\x19\x5b\xa7\x12\xd7\xc5\x2f\x64\x70\xf3\x05\x5d\xb0\xb4\x46\x55\x27\x64\xe8\xac\x5e\x98\x26\x17\x77\x9e\x8d\xb6\x5b\x6b\x3b\x50\x33\x6b\xa3\xe9\xe4\x6e\x97\xba\xdf\x92\xfd\x91\x02\xc7\x2c\xb9\xac\x08\xdf\xac\x63\x22\xd5\x05\x1a\x9f\x7a\xbd\x2a\xd9\x54\xd2\x02\x59\x4e\xcb\x07\x03\xe9\xf5\x40\xc9\x18\x07\xb9\x5c\xa4\x38\x26\x19\x99\xf8\x3a\x39\x02\x05\x2b\x92\x88\x52\x99\xb3\xa2\x3b\x69\x56\x67\xb6\xb8\xbd\xda\x93\x9d\xfd\xb9\x96\x38\x26\x17\xd3\xb4\xa9\xfe\x64\xf5\x1d\x12\x00\x7d\x45\xea\xe3\x5c\xac\xb2\x35\xf0\xa8\xef\x48\x11\x47\x85\xe0\x43\x6d\x78\x3d\x31\x19\xb2\xe6\xcb\xca\xc8\xc8\xf2\x63\x42\x8f\xec\xef\x8b\xe1\x74\x72\x41\xa7\x42\xc4\x99\x61\x1e\x9b\x64\x98\x9a\x5f\xe9\x05\x9d\x42\x14\xb3\x6c\x15\xab\xde\xe5\xf5\xb0\xc9\xdd\x6e\x2f\xa6\xa2\x57\x08\x95\x16\xdc\x5a\xa1\xe5\xd5\x3b\xb6\x16\x0c\x96\x81\x74\x33\xaa\x9f\x1a\xf2\x24\xce\x6b\x4b\x9f\xc0\x8c\x32\x5a\x4f\x2e\x77\x8d\x7d\x9c\x03\xb0\x82\xa9\xd3\x4a\x9e\x48\x14\x8e\xeb\xd6\x4a\xf3\x0b\x22\x09\x91\xd7\x66\xb1\x63\xa7\x9f\xba\x9a\xed\xaf\xac\xef\xa6\x21\x8a\xdd\xaa\xdb\xad\x06\xad\x1d\x81\x6c\xcc\x19\x5e\xdd\x9c\x63\x99\x51\xba\x63\x86\x70\x27\x60\x23\xdf\x15\x09\x38\x48\xe5\x8b\xb8\x89\x1e\xbd\xde\x11\x0f\xf3\x0d\x2b\xab\x7c\x97\xf2\xab\x20\x8f\x78\x55\x29\xa6\xc3\x43\x82\x23\x7c\x5f\x03\x8a\x20\x44\x6a\x75\x6b\x0f\x93\xb9\xc4\x39\x49\xb3\x08\x1a\xca\x21\xee\xd0\x4c\x70\x4b\x37\x25\x5e\xbf\x56\x64\x28\x9b\x4b\xbd\x99\x7b\xcc\xb5\xfc\x95\xcd\xa1\xbc\xdd\x2a\xca\xbd\xdd\xc6\xea\x47\x46\x11\x75\x8f\x23\x3a\x85\x29\xcd\xc0\xff\xd0\x09\x03\x15\x9d\x22\xcb\x32\x55\x7c\xa2\xc2\x53\x5d\x00\x3f\x7f\x9a\xb6\x4f\x34\x87\xba\x71\xff\x68\xf5\x47\x67\xdd\xd2\xb0\x56\x43\xe0\x70\x17\x63\x6c\xba\x10\xf8\x8d\xf5\x99\x8f\x5b\x1a\x87\x0c\x6b\x36\x60\xbb\x6d\x67\x9a\x70\x22\x7a\xbf\xe3\x64\x21\x83\x8e\x2c\x04\x4d\xcd\x7b\x3d\xcf\xc6\x5c\xd4\x6d\x28\xff\xb1\xbe\xcb\xd2\x72\x6d\xb2\xa0\x63\x96\x75\x8c\x37\x20\xd3\x7c\x3a\x09\xf5\x55\x35\x8d\x51\x69\xf5\x8c\x7e\xd5\xaa\x3d\x90\x42\x6a\x5e\x08\x14\x0b\x61\x56\x49\x82\xce\x6e\x43\xf7\x20\xc1\x0e\x5d\x73\xb6\xe2\x2e\x36\xd7\x07\x4a\x24\x65\x52\x1d\xc5\x14\xcb\x52\x67\x84\x11\xdd\x67\x1e\x70\x90\x79\x58\xb0\x0d\x9d\xab\xcd\xe3\x85\x29\x32\x1a\x30\xcb\x96\xc6\x4a\xe8\x47\x5c\x1e\x62\x59\xae\x6f\x0d\x96\x59\x53\xed\xf1\x5f\xc4\x68\x3b\x76\xf5\x4a\xe6\x06\xd1\xbb\xdc\xd8\xdc\x7f\xb9\xa1\x2f\x22\xb5\x65\x77\x7d\x50\xcd\x63\x8c\x28\x2a\x10\x43\x04\xe5\xa8\x82\x77\x36\x87\x7f\xa6\xa3\xdb\x78\x1c\x0a\x31\x8a\x66\x99\xda\x59\x54\xde\x56\x39\xac\x86\x92\x21\x32\x93\x1f\xf3\xcc\x39\xde\x04\x25\x2f\xe4\x40\xb1\x3d\x1e\x1c\x34\x53\x78\xbb\xdd\x32\x94\xf7\x7a\x17\xc4\xd5\x06\xda\x26\x72\x88\xb8\x39\xe7\x62\x9e\xad\xe2\x42\xf2\x65\x95\x38\x16\x21\x34\x1c\x59\x46\xa5\x6a\x66\xcd\x97\x59\x35\x31\xb3\x4d\x87\x88\xbb\x6d\x66\x64\xbb\x1d\x22\x5e\x5b\xf9\xe7\x71\x7d\xd6\xd7\xa9\xab\x9a\x91\x44\x4c\x21\x54\x7d\x90\x98\x90\x8c\x77\xbb\x31\x71\x0f\xc5\x58\xac\x02\x47\x82\x8a\x5d\x0c\xa7\x72\x5d\x30\x44\xcb\xda\x5a\x3d\xb7\x87\x35\xd1\x16\x69\xae\x25\x77\xcb\x10\xcf\x55\x59\x9d\xdd\x67\xa7\xe0\x5b\xc0\xa8\x60\x4d\xca\x26\x9e\xb6\x4c\x5d\xb0\xe7\xb0\xa7\x4d\xe7\xfd\xc4\xbf\xbe\xfb\xe1\xfb\xb6\x76\x0a\x68\x7f\x39\xd5\xf2\xce\x51\x85\xe1\x6e\x55\xfa\xd9\x03\x2f\xdf\x11\xd7\x10\xcb\x2a\xc4\x8d\x66\x34\xeb\x92\x6d\x87\x08\x5b\xf9\x76\xcc\xcf\xb1\xbc\xf7\x90\xb2\x2d\x9f\x4a\xb3\x56\x73\x5d\xa4\x31\x79\x30\x12\xc3\x6e\xd9\xfe\x77\xe8\x6a\x8f\x1c\x5d\xad\xa1\x2a\x0a\x38\xb5\xff\x8a\xfa\x8e\xa1\xa1\x28\xb5\x11\x86\x7a\xbb\xf9\xa8\xa6\x5e\x7d\x00\xc6\x34\x03\xa0\x4f\x77\x22\x83\x59\x8f\x67\x4b\x9e\x6c\xab\x26\xaa\x54\x21\xb0\x46\xc0\x41\x69\xf7\x03\xfe\x07\x47\xb4\xd7\x93\x3e\x42\xdb\xed\x51\x7c\xb4\x8c\x29\xdc\x6e\x95\xcf\x90\xbe\xc0\xdc\x35\x2c\x45\x03\x72\x97\xa0\xfd\x0e\xf3\x6c\x84\x14\x49\xc2\x1a\x1b\x28\x7c\xa5\x92\xac\xc5\xe9\x4b\x90\x3c\xf0\x5b\x77\x03\xc1\x4a\x31\x9d\xd0\x3e\x48\x40\x0a\x00\xec\xeb\xc7\x2f\x71\x76\x07\x7a\x20\x05\x3d\xbc\x5a\x8f\x01\x02\xe7\xe2\x77\xc1\xc5\xcf\x27\xe2\xe7\xb5\xf8\x79\x0c\x8e\x53\xd0\xfb\x65\xc3\x64\xfa\xb1\x48\xff\xe2\xe3\xe9\xd7\xe2\xe3\x3f\xd5\xc7\x57\x43\xf1\x91\xa9\x8f\xb3\x67\x63\xb0\x43\x45\x76\x72\xd1\x3b\x7f\x02\x8e\xff\x33\x9b\x9e\x5c\x23\xe6\x7e\x8e\x1d\x6a\x50\x9b\x8f\xe1\x0b\x3a\xad\x29\x42\x65\x1c\xa0\x15\xc4\x46\x63\xd2\xba\x74\x1b\x93\x7e\xdf\x3f\x4b\x6a\x6a\x4a\xa6\x87\x1d\x2b\x6e\x0d\x75\xc2\x48\x44\x76\x93\xdd\xc3\x86\xee\x54\x28\xae\x56\xdb\x66\x5b\x8f\x79\xbd\xc3\x4b\xed\x0f\xd0\x46\xa1\x90\x26\x67\x37\x9e\xc5\x27\x1f\x4f\xa0\xd2\xad\xd8\xbb\xa8\xec\xd0\xfa\xbd\x9e\xf5\x3b\x30\x75\xa7\x82\x97\x2c\x8d\x69\xb6\xbc\xf2\x75\x1b\xd6\x61\x80\xe4\xf5\x80\x31\xa3\xd8\x6e\x03\x08\x1f\x1f\xd1\xed\xd6\xd8\x5f\x38\x5b\xd4\xe9\x52\x56\x6e\xf4\x37\xe6\xa6\xd5\x6c\xa9\x49\x53\xc0\x46\xa1\xa6\x63\xca\xe0\x38\xaf\xf8\xc9\xec\xe7\xca\x71\x7b\x49\xac\x93\x53\xc3\xb6\x7a\x4f\xd1\x74\xf4\x48\xf4\x17\xb8\xcd\xf7\xfa\x0b\x79\x52\xb9\x9d\x84\x7c\x76\xce\x4e\x45\xcb\x81\x2b\x6a\x7b\xb1\x75\x85\x67\xef\xaf\x18\x25\xc6\x84\x69\x43\xe7\xa4\xac\x66\xac\x54\xd6\xd3\x94\xdc\xc4\x38\xf9\x81\xcd\x49\xfd\x2c\xa5\xee\xb3\x4a\x5b\x67\xc0\x9d\xf6\x83\x49\xf5\x5f\xf4\x97\x37\x4f\x5f\xff\xf5\xc5\xbb\xe7\x97\x3f\xbe\xf9\x3e\x75\x3f\x54\xce\xe5\xf3\x97\x4f\xff\xfc\xfd\xf3\x67\xa9\xf7\x85\xde\xbe\x7b\xfa\xee\xed\xb3\xcb\x17\x2f\xdf\x3d\x7f\xf3\xb7\xa7\xdf\xa7\x8d\x6f\x93\xff\xed\xab\x1f\x45\xca\xe5\x77\xaf\xde\xfc\xf0\xf4\x5d\x1a\x4c\x35\x65\xff\xf2\xf4\xc7\xbf\x3c\x6f\x94\x74\xd3\x4c\xb9\xd7\x6f\x9e\x7f\xf7\xe2\x7f\xa7\xde\x17\x7a\xf9\xf6\xdf\xbe\x7f\xf5\xea\x7f\xfd\xf8\xfa\x59\x5a\xff\x44\xd7\x25\x5e\x2f\x2f\x73\xca\x49\xf9\x01\x17\x29\x38\x5d\x02\xf4\xe2\xed\xe5\xd3\x67\x3f\xbc\x78\x99\x9a\x1f\xe8\xcf\x4f\xdf\x3e\xbf\x7c\xfd\xf4\xdd\x5f\x53\xfb\x6b\xb7\x43\x36\x62\x35\x49\x9b\x8e\xf4\x32\x5c\xf4\x12\xd3\x6b\x92\xfa\x3d\xb4\x6e\x81\xd9\x0c\x17\x6f\x39\x2b\xf1\xb5\x8c\x11\xf5\x82\x93\x55\x0c\x9a\x75\x08\xdc\x41\xfb\x34\xa2\xda\x00\x71\x21\x35\x3a\x58\xfe\x5a\xe1\xb5\x8e\xb7\x92\x88\x06\xa5\xb7\x66\x45\x70\x39\x5b\x26\x55\x91\xcf\x48\x3c\x82\x46\xad\xd8\x03\x10\x05\x76\xdc\x91\x3c\xf9\x74\x99\x0c\xc0\x1d\x84\x6e\x30\xaa\x18\x78\x8b\x0b\xe0\x44\x40\x4a\x06\xe3\x02\xf2\xdd\x15\xbe\xdd\x7a\x53\xb9\xee\x98\x0a\xdc\x6e\x89\x8d\x34\xd9\x9e\x28\x87\x3b\x24\x58\x26\x71\xa6\xa4\xed\x61\xc6\x31\x38\x91\xe2\xaa\x1d\x96\x5d\x0f\x31\x24\x90\x06\x33\x60\x9f\xc2\xfa\xa9\x97\x9f\x4e\xfe\x70\xa2\x03\x39\x9e\x80\x1d\xc2\xeb\xbc\xa3\x33\xe5\xaf\x61\x46\x13\x83\x13\xbc\xce\x41\x9f\xc2\xdd\x0e\xc2\xb1\x86\xf6\xd3\xf5\x5a\x05\x62\x72\x2f\x26\xd9\x0e\xdd\x99\x1d\xa9\x75\x4f\xa8\xde\x91\x3a\x45\x6c\xe9\x80\x71\x44\xe7\x96\x4e\x92\x13\xbc\x5e\x5f\x56\x5c\xde\x7c\x88\x4d\x2d\xd3\x56\x62\x53\x57\x27\x54\x6a\x5d\x51\x9e\xe1\xe4\x5b\x56\x14\x44\xb6\x69\xf7\xba\x2c\x94\x32\xa9\x11\xc4\xca\x3d\x45\xc6\xf4\x74\x1d\xb1\x3c\xe1\xd8\x69\x23\x6c\xdd\x1e\x76\xe0\x45\x9b\xb2\x68\xd3\x93\xa8\x48\x34\x90\x63\x20\x07\x5a\x01\xb8\x43\xf2\x8a\x3b\x04\x75\x9a\xc8\x32\xbb\x1d\x1c\xd7\x20\xcd\xb5\x1d\x40\x0d\x82\xf4\xec\x11\x6a\x02\x20\x7d\x74\x8a\x1a\x80\x17\x60\x0e\x58\x7a\x58\x30\x7b\x94\xb2\x68\x00\x9e\x05\x00\x9f\xfb\x80\xe7\x6c\x9d\xcf\xa4\x93\x61\xd1\x0d\xf9\xfc\x50\xc8\x17\x9f\x19\xf2\xcc\x81\xbc\x1c\xe9\x7e\xd0\x63\x49\x48\x64\xe0\xbe\x7c\x56\x05\xc8\xc4\x9d\x10\xed\x53\xba\xdb\x41\x7f\x79\xaa\xfb\x96\x47\x81\x29\x7d\x74\xd6\x5c\x9f\xf0\xc6\x08\x58\x78\xd8\x15\xfb\xf9\x97\x0d\x29\x6f\x43\x27\xdd\x18\x27\xf8\x67\xfc\xf1\x75\x49\x16\x79\xc1\x49\x19\xfb\xda\xd1\x25\xc1\xa2\x6c\x66\x4d\xfc\xab\xd8\x26\x4a\x2f\xc7\x3b\xf0\xbf\x07\x3f\x56\xa4\x7c\x7a\x4d\x28\x07\xe9\x8f\x6f\x9f\xbf\xb9\x7c\xfa\x97\xe7\x2f\xdf\xa1\xa7\x33\x21\xb9\xc9\x97\x86\x8b\x5c\xd1\xd7\x93\x0f\x74\x9e\xd0\xea\x97\xb1\xb9\xf4\xcc\x46\xc9\x10\xa8\x07\x8c\x74\x08\xe3\x53\xf2\x08\x51\xf3\x66\xee\xbb\xdb\x35\xc9\xbc\x06\x7e\xae\x18\x05\xf2\x8e\x5d\x4d\x69\x2f\x44\x02\x06\x0d\xd6\x47\xc9\x42\x64\xdd\xc2\x68\x09\xb1\xe5\x55\xb5\xb8\xad\xd9\x0d\x34\x0f\xa0\xf5\x2a\xe8\x6d\x64\xee\x26\xc0\x8c\x6d\xe4\x6b\x04\x59\x96\xd1\x09\xcf\xe6\x8a\xc6\x06\x0f\x6b\xe0\xd0\xda\x3f\x56\x27\xd7\x88\xc0\x14\x5c\xe3\xcd\x35\x01\xda\xdc\xa1\x59\xdf\x3d\xc2\x03\xb5\xe5\xbd\xc7\x22\xc0\x94\xde\x49\x5d\x80\x69\x1c\xe5\xf4\x72\x51\xe4\xd7\x4b\x7e\x29\x47\x6b\xd3\xe7\x64\x41\xca\x92\xcc\x1b\xc9\x25\xf9\x65\x43\x36\xc4\xa4\x9a\x19\x22\xbd\x7a\xad\x74\xed\x7f\xd4\x4a\x9f\xc9\xe8\x70\x95\x6d\x17\x7c\x79\x79\x75\xcb\x49\x05\xea\x94\xeb\xd9\xe5\x1a\x6f\x2a\x72\xf9\x65\x9d\x78\x3d\xbb\x2c\x37\xb4\x72\x1a\x5a\x12\xbc\xbe\x54\x87\x7b\xdd\x1a\x39\x25\x97\xfa\x91\xca\x9c\x5e\x5f\x16\x98\x13\x3a\xbb\x35\xd9\x3b\xe5\x37\x99\xb5\xee\x9d\x81\xda\x70\xca\x71\x40\x72\x22\x94\x14\xf2\x53\x65\x4b\xc8\x89\x6f\xb2\xdd\x02\x1f\x40\xc0\x11\xaa\x41\x49\xe6\x60\x67\x5c\x49\x81\x24\xb2\x8e\x97\x82\xc8\x46\xd7\x25\x21\x14\x5d\x15\x1b\x82\xd6\x9b\x72\x5d\x10\xa0\x1e\xd2\x70\x30\xc6\x14\x97\x45\xc1\xce\xc4\x6c\x2b\x36\x04\xec\x90\x2b\x6e\x48\x85\x0c\xb1\x77\x7b\x32\x2e\x9c\x8c\xa3\x9b\x5d\x4c\xd1\x26\x8b\x59\xc6\xb7\x5b\xf0\x25\x40\x79\x03\x85\x14\x8f\x27\xe9\x70\x1d\xaf\xf9\xe4\xe2\xa7\x24\x15\xb2\x20\xb8\x04\x10\x81\x04\x1c\x65\x59\x9c\x67\x79\x03\xc3\x2a\x08\x93\x6a\x73\xa5\x14\x02\x71\x6e\xae\xd2\x47\xf2\xfe\xa1\x9f\x49\x93\xf2\xdc\x08\x64\x0e\x60\x27\x71\x99\xad\xe2\x45\x5c\x40\xb4\xe9\xab\xf4\x44\x5a\x74\x24\xa0\x5f\x40\xb4\x54\xa6\x99\xa0\xda\xac\xde\x92\x32\x27\x55\x0c\xfa\x65\x1f\x40\x00\x61\xea\xad\x48\x77\x3b\xba\x54\x02\xfa\xe4\xa0\x56\xed\xfa\x4c\xe2\x59\xb6\xe9\x83\x15\x59\x89\x5a\x12\x01\x05\xb2\x89\xbc\xa2\xd7\x8b\x67\x19\x58\xb1\x0f\x39\xbd\x7e\xfa\x81\x08\x6e\x2d\x06\xfd\x59\x1f\xa0\x47\x8f\x21\xb0\x3d\xe8\x11\xcd\xa0\x68\x98\x9c\xea\x76\x97\xd9\x5a\x9e\x11\x38\x59\x93\x72\x46\x28\xcf\x0b\xe2\x1f\x14\x52\x7a\xb6\x7a\x86\x0c\x08\xa0\x63\x33\x93\x89\x3b\x41\x75\xcc\x78\xd3\xb4\x05\xd5\x74\xfb\xe0\x12\xf4\x47\xc3\xe1\x97\x34\xf9\x65\x83\x65\x67\x69\xb0\x85\x70\x59\x04\xbe\x04\xda\xe6\x5d\x90\x5e\x35\x57\x0b\x35\x22\xa1\x86\x40\x35\xc3\x85\x80\x80\x9e\x30\x81\x7d\x80\x86\xc9\x50\xfc\x6f\x04\xc1\x0e\xa6\x1e\x2a\xf7\x7a\x81\xd5\xfa\xd2\x4e\xe1\xcb\x7b\x57\x09\x2d\x77\xad\x57\xb6\x15\xb1\x95\xd1\xd6\xd6\xec\x26\x1e\x0d\x1d\x67\x4d\x37\x08\xf0\x97\x1c\x9e\xf0\x1d\x6e\x99\x97\xea\xf3\xac\xcb\x1a\x76\xbb\x25\x3b\xf5\x06\x59\xd3\x1a\x9c\xfc\xe2\xd7\x41\xf5\x13\x92\x54\x90\x81\x09\xb7\xf6\xdb\x29\xf7\x6d\x4d\x83\x2d\x2a\x7b\xee\x7d\xad\x1e\x7d\x42\xab\xf9\xe2\x9a\xef\x69\x93\x9c\x8b\x33\xe9\xa1\x4d\x16\xfb\x9a\xa4\xe7\x0f\x1f\xa5\x22\x1b\x20\xc0\x35\x45\x46\x51\x17\xae\xc7\x6e\x48\x39\xc3\x15\xe9\xa8\xea\xd9\x88\x86\xa1\xbe\x5e\xdf\xd3\xc2\x8f\xa2\xc4\x9e\x16\x16\xac\x6c\xc2\x02\x61\xd7\x2a\x05\x00\xc1\x0c\x8f\xd9\x79\x46\xc6\xac\x9f\x71\x58\xf4\xe5\x05\x59\xcc\x2c\xa2\x16\xc1\x96\x71\x23\x82\x94\x2e\xbc\x4e\x70\x51\xc4\xeb\x44\x8b\xd0\xb5\x56\x0c\x86\x07\xe8\x8d\xcf\x6d\x85\xde\x3e\xa0\x95\xe6\x8a\xfb\xc8\x1e\xac\x42\xbb\xeb\x1c\x75\xd5\xd9\x94\x05\xa1\x33\x41\x8b\x43\xeb\xa1\xb2\x7e\x7c\xf3\xe2\x5b\xb6\x5a\x33\x4a\x28\x57\xaa\xb2\xc0\xaa\x14\x0c\xf3\x77\xec\xb5\xa2\xb4\xc1\xc6\x24\x75\x58\x14\x8c\x95\xb1\xa4\x7d\xe1\x86\x04\x7a\xbc\xdd\x2c\x16\xf9\x47\xd0\xa6\xd5\x59\xab\x8d\x3f\x8e\xcc\xbb\x29\xd1\x48\x52\x01\x50\x71\x90\x9e\xaa\x9f\x74\x0e\xd2\x33\xf5\xb3\x9c\x83\x14\xf0\x25\x08\xf6\x39\x63\xab\x15\x5e\xdc\x86\x46\xad\x42\xf1\x0e\xa1\x55\x5c\xc6\xae\x78\xfe\xe7\x78\x92\xc5\x3f\xcd\xef\xce\x76\xb0\x1f\x4f\x8e\x7e\x9a\x43\x28\x8e\x70\x04\xc2\x73\xa3\x98\x32\xce\x96\x9b\x15\xa6\x81\xc9\x21\x9e\x01\x60\x26\x73\xf6\x15\x19\x8d\xce\x95\xc5\xad\x3b\x6b\x7a\x22\x73\x20\xa2\x7f\xcc\xe4\x2f\xc4\x33\xd2\x07\x4b\x00\xd1\x57\x64\x34\x0c\xd6\x10\x19\xb2\x82\xf8\x81\x78\x5f\x54\x58\x01\x88\x46\xe4\x1b\x49\x8e\xfa\x59\x4c\xb3\x22\xa6\x27\x23\xf2\x0d\x3a\x85\xb0\x0f\x2a\x90\x8e\xc8\x57\xcd\xcc\xaf\x54\xe6\x4a\xe6\x9e\x35\x73\xcf\x54\xee\xa6\x02\x29\xcf\x68\x1f\xd0\x0a\x20\x1e\x04\x43\xb5\xc6\xe5\xfb\x46\xd0\x81\x96\xa9\xd4\xdd\x8c\x15\xac\xfc\x3e\xaf\x78\x5a\xc6\x14\x15\x36\x5a\x2f\x38\x1d\x02\xa4\x22\xfa\x82\x91\xf8\xbd\xcc\xe7\xe4\x2f\x65\x3e\x4f\x01\x2f\x37\x44\x7d\x7f\x4f\xae\x09\xf5\x52\x9e\x7e\x24\x95\xf9\xbe\xba\x96\x8d\xa7\x60\xb1\x90\x67\xe7\x10\xa0\x85\x49\xba\x2a\xf0\xec\x3d\x40\x2a\xd4\x7e\x0a\x86\x00\xdd\xfe\xa0\x7f\x88\x21\xff\xc0\xe6\x44\x9c\xb2\x94\x92\x19\x27\x73\x80\xe6\x25\xbe\x79\xb9\x29\x8a\xa7\xd5\xff\x21\x25\x4b\xc1\x02\x17\x92\xb8\x95\x6c\x95\x82\x01\xe8\x6b\xde\xaf\xa9\x5a\x42\x42\xc2\x29\x52\x30\x18\xad\x72\x0a\x76\x2d\x26\xd1\xa8\x1e\x81\x58\x0d\x32\x03\x75\x10\x06\xfd\xde\xb7\xe2\x6d\x66\x0e\xe0\x02\xd8\x2b\x0e\xf5\x15\x2e\xf3\xbf\x0b\xa6\x81\xf6\x8f\x11\x38\xee\xe7\xfd\x63\x80\x00\xfe\x70\x0d\xe0\xf1\x0e\xa2\xb9\xa3\x32\xd3\xaa\x52\xd1\xe7\x49\x49\x84\x6c\x36\x01\x7d\x19\xf8\x04\xaf\x62\xd6\x71\x94\x88\xe1\x5c\xca\xe9\x7d\xda\x72\x3e\x7a\x5c\xaf\xe7\x9f\xc4\x52\xec\x5b\x9d\x6f\xe4\xff\xfe\x49\xd7\xc4\x65\xce\x04\x53\x26\xed\x16\x2c\x4f\x47\xfb\x00\x81\xfe\xe8\xa4\xbb\x4f\x68\xcd\x71\x7e\x83\x75\x2d\x85\xac\x1d\x38\x67\x8d\x81\x97\x9d\x24\xf0\x84\x4d\x00\xd5\xd3\xc8\x75\x83\xda\xd4\x26\xd6\x2e\x2a\x48\xc9\xf3\x1f\x72\x72\x53\x9d\xc8\xa4\x64\x79\x25\xcd\x11\x02\x55\x6e\x70\x49\xa5\x67\xa4\x5b\x49\x27\xb6\xab\x59\xcf\x76\xad\x06\x0d\x9e\x42\xf3\x5a\x4b\xaa\xfc\xc1\x3a\x94\x41\xcd\x01\xa6\x8f\x47\x28\x3c\x86\xf4\xab\xc7\xa8\xa1\xb7\x48\xcf\xce\xd0\x01\x8a\x92\x80\xaf\xc2\xa1\xca\x3e\x9c\xcc\x0a\x46\x49\x5c\xa8\xb7\x0c\x2a\xab\xe5\x7d\xbd\xb9\xaa\x36\x57\x9f\xa4\xe3\x7d\x14\xb8\xd6\xef\x50\x65\xb5\x14\x8f\x27\x25\xdb\x08\xd4\xb6\x31\xcd\x14\x9c\xf0\x7a\x2d\x43\x15\xfd\x21\xc3\x48\xc5\x30\xcb\xaf\x4e\xf0\xcf\xf8\xa3\xb2\x09\x04\xb0\x4e\xad\x6f\x93\x2e\xeb\x88\x56\xf2\x45\x77\xc4\x54\x58\x93\x58\x87\x72\x68\xb4\x91\x9e\xc9\x60\x40\x1d\x6d\xa4\x67\xd2\x7c\x41\x0f\x2f\x7d\x24\x23\x32\xd4\x83\x4b\x1f\x3d\x6e\xa9\xf9\xbc\x95\x13\x70\x09\xd8\x0c\xec\x59\xa7\x90\xf6\xdb\x81\x57\x9e\xb1\xc6\xf5\x56\x3e\x7f\xca\x79\x99\x5f\x6d\x38\x49\x75\xc0\x98\x0e\x9d\xab\xa9\xf9\x99\x15\xdd\x4a\x87\x7a\x02\xfa\x01\x7e\xaf\xbe\xa5\xd0\xea\x63\x41\x44\xee\x29\x29\xe7\x20\x0d\x1c\xef\xd3\x9c\x67\x46\x8d\x2b\xbf\xa4\x31\x69\xc0\x01\x47\xe6\x9a\x0b\x9f\x54\x06\xd3\x25\xc9\x9a\xa9\xe7\x76\x88\xb6\x1c\x4f\x15\xfc\x93\x25\xab\xb8\x18\x40\x6d\xbd\x50\x2d\xd9\xcd\xe5\x55\xc9\xf0\x7c\x86\x2b\x7e\x89\xe7\xf3\x92\x54\x55\x56\xf8\xe2\x8a\x94\xfd\xbd\x14\x54\x37\x76\x29\x03\x09\x17\x32\xce\x0e\x47\x54\xaa\x4e\xb5\x32\xcd\x4e\x41\x7f\x77\x4e\x42\xe5\x5f\xe6\x73\x79\x09\x65\x5a\x96\x36\xf8\x7a\x6a\x89\xa4\x9e\x63\x3d\x62\x5b\x5e\x06\x9a\xdf\x3b\xd2\x5e\xaf\x5d\x84\xfb\x29\x36\x04\x43\x49\x56\x8c\x13\x03\x05\x07\xaa\x0a\xa2\x35\xd8\xfc\xb9\x73\x39\xf7\x42\xcd\xfd\x90\x0b\x8f\x83\x28\x4e\xe0\xca\xdb\xf5\x19\x77\xf7\x51\x9b\xee\x14\x9f\xbc\x8f\x8a\x4f\xdc\x47\x6f\x18\xe3\x81\xbd\x84\x43\x97\x46\x9c\xad\xae\x2a\xce\x28\x79\x27\xf6\x4d\xe7\x9d\x9d\x0e\xfd\x1c\x2b\x77\xd4\xff\xf9\xf6\xd5\x4b\x6d\xd0\x94\x2f\x6e\xe5\x8b\x4b\xf9\x2c\x15\x30\x17\xd9\x32\xdc\xad\x7a\x31\xa7\x79\xab\xc1\x0e\x59\x03\x01\xf1\xc0\xa5\xff\x7f\x43\x5a\xf6\xdf\x82\x42\x7d\xb6\x5d\xfa\x10\x73\x8e\xd0\xd5\xaf\x3c\x9b\xd7\x92\x31\xd1\x17\xbf\x6f\xe4\x71\x5c\x63\x4d\xd0\x3a\x41\xc7\x55\x6f\x2f\x4d\xe1\xf2\x70\x8e\x3e\x7c\x04\x77\xea\xbe\x5e\x9e\xf6\x31\x8d\xc1\x09\x80\x08\x98\xdb\x44\xd4\xc8\xd3\xa8\x12\xa7\xf2\x07\x8c\x4f\x52\xad\x1a\x85\xb6\x56\xbb\x92\x89\x37\x85\x80\xfd\xd5\x28\x21\x11\x25\x3e\x49\xc5\x5f\xd9\x92\x26\x09\xcd\x72\x46\x1a\x80\xc8\x0a\x06\x70\x87\x24\xa7\xe3\xdf\x70\x2f\xf3\x8a\xb3\xf2\x56\x33\x41\x77\xeb\x4d\xb5\x94\x17\xf9\x32\xc6\x99\x20\x34\x62\x1a\xfe\xd6\xb2\x4f\x4c\xe9\x4c\x81\x60\xc0\x94\x6d\x78\xf7\x68\x73\x59\x32\x69\x56\x53\xb5\x10\x85\xa9\x93\xa3\x41\x64\xf2\xa4\x31\x77\xd3\xe3\xcf\x1b\x81\xce\x34\x23\x90\xc0\xf0\xf6\x9b\xee\x9f\xba\xfd\xab\x52\xaa\x4e\xda\x48\xb7\xc3\xda\x21\x0d\xb7\x8e\x9e\x4d\xae\xee\xda\xdb\x0d\x92\xb1\x54\xec\x64\x93\xf7\x77\xb1\x35\x3d\xfb\x26\x44\x44\x3b\x8c\x21\x98\xcb\x28\x1b\x86\x5c\xc5\x08\xcf\xd8\x98\x7a\x01\xdf\xeb\x18\x0d\x22\xf1\x8a\x7d\x74\xee\xeb\xdd\x1d\x64\x8e\x42\x6f\x0b\x99\x70\x8f\x96\xe9\x2e\x5d\x9b\x6c\x34\x33\x61\x85\xf1\x5c\xe6\x2e\xd5\xb7\xdd\x07\x1b\xe7\xdb\x5c\xc5\x26\x27\xe6\x9e\xc7\x5c\xba\xd6\xa8\xbe\x52\xdf\x06\x8f\x17\xf5\x27\x80\xe8\x5a\xd7\xb6\xd8\xfc\x21\x68\xee\x71\x15\xb6\x45\xb8\xf4\x93\xeb\x31\xdc\x66\x35\x79\x20\x45\x0a\xbe\xb0\xcf\x87\x02\x24\xe3\xc7\x57\xe9\x9d\x7a\xe5\x3e\x4a\x8a\x9c\xbe\x07\x29\x60\xf4\xfb\x9c\xbe\xff\x56\xbe\x8a\x8f\x4c\x9e\x3d\x86\x07\xb6\xd4\x3b\x93\xa4\x8a\x76\x19\x47\xb9\xd1\x12\xeb\x12\xe1\x93\x09\xe9\x70\x86\x15\x27\xf4\x1d\x8b\x31\xf2\x36\x9e\xca\x15\x3f\x25\x2f\xd0\x59\x3c\x58\x3a\x50\xd8\xdf\x83\xb6\xf8\xb7\x9a\x7a\xb5\x2b\xb8\x7b\xb0\x2e\xff\xbd\x4c\x0d\x14\x77\x76\x5f\x5d\xfa\xa5\x48\xec\x28\x1c\x2a\x1b\x1a\xb7\xbb\x21\x9d\x71\xab\xe4\x40\x05\x21\xa7\xa5\x25\xc1\xf3\x5b\x4f\xa5\xce\x62\x90\x48\xfd\x04\x6c\xbc\xc5\xe1\xdc\x50\xb1\x98\x88\xcd\x93\x77\x68\x53\x50\x91\xdd\x29\xad\x4d\x6a\x9e\x07\x56\x9f\x02\xbf\x8d\x9e\xe8\xf4\x4b\xac\x34\x3d\x56\x33\x24\xef\x3a\x65\xca\x82\x95\x2b\xcc\x53\xa0\x2c\x23\xc6\x4c\x9a\x73\xc4\x77\x82\x41\xc9\xf7\xaa\x5e\x80\x72\xcc\x2c\x1c\x8e\x4e\xb4\xb1\x06\x48\xfe\x31\x5f\x3b\x98\xcc\x85\x80\xdf\xbe\xaf\xe4\xf2\x01\x45\x6d\xdd\x9d\xc5\x24\xa3\x50\x79\x42\x68\x3a\x7e\x31\x9c\x4a\x37\x86\x35\xcb\x29\x57\x4e\x10\xed\xb4\x8b\xe1\xf4\x7c\x38\x01\x2f\x4f\x9e\x82\x34\x0e\x66\x9f\x60\x98\x70\xf6\x5d\xfe\x91\xcc\xe3\x53\x08\x77\x30\x59\xe0\xbc\x70\x1f\xbf\xd0\x03\x01\xcf\xdf\xbc\x79\xf5\x06\xa8\xb8\x28\xf6\x65\x4b\x31\x55\x21\xb0\xaf\x59\xc5\xdf\xc8\xaf\x96\xe1\xa1\xb2\xcc\x7e\xbb\xb9\x12\xcb\x1c\x0b\x8a\x3c\x13\x07\xe0\x92\xdd\xfc\x4d\xac\xbb\x3b\x73\x4d\x4b\xcd\xd3\x6e\xf1\x50\x3e\x2b\x2a\xa3\x7b\x2a\xef\x7f\x51\x43\xbf\x03\xe6\xa4\xe8\x17\x65\xe2\x76\xd9\x8c\x9a\x44\x7f\x14\xcd\x72\x7a\x40\xef\x5a\x67\xac\xc5\x5e\x51\x2a\x6e\xf3\xa4\x62\x3a\xcb\x9d\x5b\xbd\xae\x4d\xf6\x54\x57\x4c\x8f\xa8\x7d\x15\x2b\x13\x26\x82\xf2\xea\xe9\x7c\x95\x53\x83\x58\xc6\xd4\x53\x80\x7c\xec\x74\xb7\x31\x36\x5c\x54\x85\xa8\x71\x68\x82\xd3\xb7\x0d\xb0\xbe\xbf\xf7\x4b\x23\x7c\x10\x24\x47\xc1\x0f\x1d\xc5\x3a\x30\x8a\xef\x5b\x0c\xc2\x21\xe0\x13\x8c\x61\x77\x9f\xa6\xed\x97\x3e\x43\x71\x58\xd3\x2b\xb7\xf6\x43\x17\xe6\x83\x59\x18\x7f\xe2\x8b\x10\xf8\xdb\x0c\xca\x21\xc3\xbb\x16\xf5\x9d\xe3\xcc\xe7\x97\x92\x19\x2f\x8b\xff\x45\x6e\xb7\x5b\x9a\xac\x08\xc7\xf2\x67\x4c\x93\xb5\x7a\x0b\x54\x47\x8c\x95\xd2\x41\xc5\xd9\xfa\x75\xc9\xd6\xf8\x1a\xab\x5e\x50\x95\x50\xfc\x21\xbf\xc6\x9c\xc4\xac\xfd\x16\x95\xa2\x84\xcb\x92\x2c\x00\x44\x77\x9a\x93\x92\x7c\xa6\x1c\x90\x7f\x72\x36\x46\x75\x48\xf7\xda\xe3\x8d\x39\x6f\xb0\xa8\xe7\x8d\x34\x97\xc0\x03\x59\x86\x55\xc0\x19\x78\x5a\xca\x78\x1e\x51\xb5\xd1\x3f\x6e\x30\xe5\x11\x67\xd1\xb9\x10\xa2\xe9\xf5\x13\x7b\xda\x9f\x9f\xe8\x94\xe8\x9c\xac\x9e\x48\x0b\x8a\xf3\x13\xb2\x7a\x32\x01\x2a\xfc\xe7\x22\x2f\x57\x31\xf6\x44\xb9\x23\xed\x4f\xd8\x58\xe2\xc4\x17\xe4\x63\xde\x24\xcc\x96\x3a\x59\x73\xe6\x92\x14\x0c\xcf\xe3\xa3\xa1\xa5\x9a\x2a\x88\xbe\xd4\x4e\x3e\xfd\x9f\x4f\xff\xb7\x0a\x92\x7c\x95\xeb\xe8\xed\x10\xee\xbc\x0f\x8f\x53\xbd\xed\x54\x51\x37\xf8\xd4\x00\x1b\x95\x3e\x1a\x05\x0d\x4f\x83\xd6\x8e\x0e\x43\xa9\xd5\xa4\xca\x21\xec\x91\x8c\xcc\x5e\x37\x29\xf5\xa9\x86\xdd\x4b\x1f\xeb\xc8\xb9\x92\xd5\x4c\x1f\x9f\xa1\x9a\x71\x4c\x1f\x4b\x57\x32\xd5\xeb\xe3\xaf\x91\x65\x21\xd3\xc7\x32\x98\xbb\xee\xfa\xab\x53\x54\x33\xa7\xe9\x57\x8f\x90\x66\x88\x8d\x48\x6a\x99\xe6\x74\xd4\x56\xcd\xee\xb3\x97\xed\xb6\xbe\x0c\x28\x32\xda\x9c\xb7\xf6\xb9\x70\xee\x28\x50\x99\xb1\x44\x9e\x2b\x86\x33\x0d\xea\x36\x1a\x1a\x1e\xfd\x4e\xa0\x7e\xc8\x42\x37\xf0\x20\xad\xc7\x1e\xe3\xfd\x4a\x9d\x5b\x55\x76\x31\x75\x4f\x5e\xfd\x9a\x9e\x89\x23\xe3\x56\xdb\xa1\xea\x7d\xbe\x5e\x93\x79\xfb\x54\xde\xa1\xb2\x91\xa6\x4c\xf0\x9c\x86\x5f\xd1\x19\xd1\xe7\xac\xe9\x09\xba\x93\xf5\xda\xd6\x47\xac\x96\xc9\xe5\x51\xac\xcf\xd9\xca\x92\x02\xa3\x93\x51\x35\xbe\xe5\x1f\xe5\xeb\xd8\xfa\x99\x69\xf3\x92\x0d\xf1\x5f\x3a\x54\x4d\xcd\xb7\x5b\xfb\x46\x72\x42\x64\xb4\x39\x7d\xa4\x8b\x04\x75\xac\xc7\xe6\x15\xba\x9a\x0d\x89\xa5\x95\xa6\x0f\xaa\xa1\x79\x97\xd8\x19\x47\x20\x22\xc8\x9d\xba\x31\xd4\x6f\x0d\x7a\x7c\x5e\xed\x6d\xd0\x74\xd3\xc8\x3b\x2e\x1b\x55\x02\x9e\xf1\xfc\x03\xe9\x68\xaa\xd7\x93\x4e\x0b\x47\x59\xd6\xd5\x08\xad\x7e\x51\x9b\xcd\x8e\xa6\xf6\x17\x11\xa2\x98\x32\xf7\x35\x79\xda\x49\x06\xc0\x9d\x07\x4e\x49\x09\x26\x24\x2b\x6a\xe7\xd0\x3a\x3d\xe1\xec\x7f\xbe\x7d\xf5\x32\x86\x75\x34\x75\x6d\xf4\x2d\x0d\x0d\x9c\x3a\x77\x75\x56\xb3\xa8\x6d\x64\x07\xe5\x0b\xd7\x7e\x45\x0a\xa1\x0c\xa5\x12\xe4\x14\x77\xc8\x63\xd0\x82\xb1\x7f\xa2\x36\x23\xa7\xa2\xf7\x11\xd8\xa8\x5e\x35\xea\x7b\x7b\x48\x1a\xc2\x39\x7b\xd0\x66\x20\x0a\x51\x4c\x26\x0a\xb7\x62\xa2\x21\xf1\x07\x52\x40\x83\x66\x54\x2a\x09\x83\x5a\x44\xc3\x0a\x27\xe6\xd1\x32\x75\x61\x17\xc3\x84\x14\xf2\x58\xf5\x37\x86\x3b\x77\x19\xae\xc5\x1f\xa2\x76\xee\x83\x8d\x81\xb3\x75\x0c\x2d\xb3\x6b\x9a\x6c\x11\x0b\xbd\x6f\x0c\xbe\xb7\xb6\x64\x9b\x38\xa9\x12\x5d\x74\x49\xea\x4e\xe5\x59\xf6\x83\xba\x03\x0e\xec\x19\x25\x1d\xa4\x91\x38\x06\x55\x4c\x2c\x7d\x51\x2f\x7e\xd2\xea\x17\x2c\xf8\x3b\x69\x86\xfb\x48\x1c\xbf\x89\x94\xf6\xa4\x7a\x0b\xf2\xf2\xf6\x8e\x64\x52\x25\x2e\x7b\x8a\x45\x6e\xb5\x66\xb4\x22\xef\xc8\x47\x0e\x13\x7d\xf3\x5c\xc7\xf5\x0f\x75\x37\x27\x33\x36\x27\x91\x68\x46\xbe\x9d\x40\x6d\x35\xeb\xfe\x8c\x1a\x27\xb3\x37\x0d\x1c\x83\x2f\xf4\x75\x2f\x8a\xbe\x50\x97\xd8\x50\xbd\x6a\x09\x91\xc8\x94\x49\x51\x82\x0b\x52\x72\x00\x13\x4e\x3e\x72\xfb\xc4\x8d\x0f\x9e\x58\x60\xba\xad\x02\xa0\x64\x03\xc5\x7a\xa9\xfe\x05\xec\xdb\xfd\x87\x57\xca\xd2\x39\x29\x82\x55\xf1\x9d\x9e\x54\xda\xd9\xf3\xae\xc9\x58\x94\x1d\x8c\x45\xe3\xd2\x7b\xef\xed\x68\x58\x5f\xbc\xcf\x25\xa2\x61\xe0\xef\x8c\x07\xd7\xf4\xfe\x6e\x14\x0c\xa2\xa4\x16\xec\xf8\x7c\x9e\x7f\x88\x66\x05\xae\xaa\x0c\x94\xec\x06\x3c\xf9\x89\x46\x51\x14\xb9\xc9\x33\x56\x0c\x56\xf3\xc1\x57\x26\xaf\x99\x2f\x17\x2b\x92\xff\x0e\x8c\x91\x81\x53\x54\x16\x5f\x3e\x7a\xf2\x92\xf1\x7c\x46\xce\x4f\x96\x8f\x9e\x44\x2f\x59\xb4\x2e\xd9\x7c\x33\x23\x65\x15\x91\x8f\x79\xc5\xa3\x05\x2b\x25\xe9\x89\x24\xf3\x62\x58\xa4\xa4\xd1\xce\xfa\xc9\x5b\x42\xa2\x73\x6c\xfa\x96\x7a\xa8\x48\xf0\xd8\x19\x38\xee\xd3\x96\xfb\x77\x1c\x73\xab\xdf\xde\x6e\xe5\x93\x20\xf5\xa7\x09\x34\xac\x43\x3e\xeb\x28\x8b\x3a\x26\xee\x84\xa4\xd4\x0d\x85\x20\x19\x0e\xab\xb2\x46\x8a\xa5\x75\xcc\x23\x64\x04\xe2\x3b\x1d\x29\x4a\x10\xa3\xfe\x31\x78\xa2\x64\xb5\xf3\x13\xfc\x44\xce\x6f\xc5\x4a\x12\xe5\x54\xa9\x3b\x04\x29\x77\x00\x7a\x32\xcf\x3f\x18\xe0\xeb\xdf\xe6\xef\xf1\x0e\x9d\x05\xd6\x50\x06\x49\x50\xde\x20\xa8\xcc\xf6\x0d\x7b\x96\x35\xa6\x8a\x96\x59\x1b\x56\x63\x63\xa5\x27\x5b\x8a\xab\x8c\xdb\x00\xcc\x25\xb2\xcd\x27\x46\x8c\x24\x06\x06\xf9\xa2\x9e\xfd\x82\xa6\xd4\x84\x6d\x88\x1f\xa1\x02\x0d\xe5\x28\x6d\xb0\x66\xaa\x42\x54\x59\x20\x4d\x2a\xe9\x24\x7e\xfc\x13\x3d\x1c\x11\x47\xa7\x36\x73\xf9\xe8\x89\x16\xca\x25\x5e\xe9\x54\x2e\x58\x0a\x53\x49\x7d\xc8\x7f\x07\x57\xac\x94\x4c\x8a\xfe\x9c\x31\x3a\x27\xb4\x22\x73\x0f\xb3\x79\xd9\x44\x5e\xbe\x7c\xd2\xa3\x57\xd5\x7a\x7c\x7e\xc2\x97\xed\xcc\x68\xc6\x8a\x6a\x8d\x69\x06\x1e\x01\xdb\x2b\xf9\xc8\x07\x33\xf9\x04\x30\x78\xa2\xe9\x46\xf4\x6f\x1b\xb2\x21\xd5\x7d\x8d\x1c\xf7\xef\x5b\x01\x8f\xdb\xb9\x7f\x19\xbe\x09\x2d\x83\xc9\x1c\x8d\xea\xdc\xd6\xaa\x84\xa7\x23\x0e\x94\xbc\xe2\xf9\xcc\x4c\x65\xff\x88\xe3\x2a\x6b\xfc\x10\x73\xe8\xf0\x6f\x21\x70\x52\xb9\x2e\x06\x69\x05\xeb\x88\x1f\xd5\xbd\x53\x1d\x9d\x1d\x8c\x72\xa0\xde\x79\xde\x8a\x87\xd7\xff\xe5\xdb\x7f\x9b\x47\x7f\x65\x15\x0f\xaf\xde\x13\x19\x13\xaa\x23\xef\x07\xb2\x62\xe5\x6d\xd4\x8f\x9e\xe5\xd5\xfb\x8e\x32\x2f\xe8\xe0\x3b\xe9\xc3\xd4\xd9\xbe\x72\xd5\xe9\xc8\x7e\xa3\x7c\x9a\xba\xb2\xdf\xe5\x2b\x32\x8f\x5e\x6d\xba\x5a\xd7\x08\x1a\x42\x4d\xf0\xb9\xb1\x71\xf4\xf8\xf0\x25\x6a\x8f\xf4\x5b\xc5\xef\x08\x19\x50\x0f\xd6\x1f\x20\xc1\xb3\x65\x07\xfa\x3d\x08\xeb\xcc\x34\xd4\xc3\x1d\xe1\x89\x7c\xfd\xc9\xb8\x76\xdf\x98\xc5\x50\xd5\xbd\x01\x39\x64\x20\xc1\x0d\x1e\xa6\xb3\x0e\x96\x9b\xbd\x2d\xc3\x5b\xb7\x90\x62\xfe\xe4\x1d\xe3\xb8\x48\xcf\x4f\xf8\x3c\x90\x79\xdc\x5f\x8a\xa3\x55\x1b\x7b\xeb\x93\xd5\x7e\xcd\x60\x7b\x3e\xca\x67\xcf\xce\xc7\x9a\x89\xb7\xcf\x4d\xd0\xd1\x27\x78\x70\x9f\x2b\xb9\xf3\x2e\x1f\xd0\x75\xd4\x8f\x1e\xde\x8d\xe0\xea\x08\x9d\x3f\xa4\x9f\xcf\x36\xc5\xa6\xf3\xe3\x6f\xdb\x7b\xc3\xc5\xf2\xb7\xed\xdc\x77\xe4\xfc\x6d\xfb\xf6\x9d\x45\x7f\x6b\xac\x76\x1d\x52\x1f\xd2\xf7\x67\x27\xe6\xa7\x5f\x3d\x9c\x06\x7e\xfa\xc4\xb5\x11\xdf\xe7\x9e\xf7\x6f\xc9\xa0\x9c\xfe\xe9\x33\x1d\x1a\x9f\x61\xf1\xce\x0e\xe7\xcf\x81\x1e\x87\x60\x9b\x3b\x84\x14\xb0\x43\x8f\xba\xa3\xf5\x8e\xc3\xe2\x66\xa4\xc5\xbc\x01\x56\x67\xfa\x1e\xae\xff\xd4\x63\xd2\xaf\x36\x9c\x33\x6a\x8a\x5c\x71\x1a\x5d\x71\x3a\x58\x91\x79\xbe\x59\xc9\x9f\x46\x10\x8d\xc4\x54\x74\xf3\x19\x90\x9a\x4d\xf0\x44\x06\xdc\x52\xec\xf8\xf9\x89\x6a\xaa\x31\xab\xcf\x33\x88\x39\xa6\xd7\xa4\x6c\x8c\x41\x3d\x0a\x0b\x9e\x3c\x53\x8f\xc3\x5a\xf9\xe5\xc1\xe3\xa8\xd9\x6e\xe6\x20\xc4\x3e\x39\xb0\x46\x15\xe9\x96\x3e\x3f\x00\x49\x24\xb7\x16\x12\x1c\xbe\x56\x19\x35\xa6\x30\x17\x53\x5a\xb8\xf1\x78\x9f\x12\xe2\x70\x88\x56\x9b\x99\xd8\x91\x0d\x90\x6e\xa8\x9c\x0f\x78\xf2\x23\x7d\x2d\x7e\x04\x80\x7a\xbc\x43\x5f\x7f\x9e\x21\xac\xcb\x7c\x85\xcb\xdb\xc6\x10\xf4\x00\xf6\x74\xff\xcd\x9e\xee\xc1\x63\xb0\x43\xa3\x7d\x8a\x1a\xf0\x48\x94\x08\xa9\x01\x1a\x3b\xcc\x27\xb3\x9e\x5c\x19\x50\x8f\xd0\x44\x85\x81\xaf\x31\xa9\xf1\x63\x3f\x29\x64\x1e\x29\x64\x22\x41\x93\x42\x86\x88\x52\x81\x3c\x3f\x7d\x1e\xbd\xb6\x95\xa3\xef\x55\x65\x23\x39\xee\xd0\x68\x1f\x66\x00\x29\xdc\x60\x4e\x64\x79\x01\x81\xf0\x22\x6a\x83\xb1\x3d\xa8\x9f\xb7\x54\x20\x55\xb7\x0a\xc4\x3b\xab\x34\x20\x9f\x80\x7e\x25\xce\x2b\xdf\x39\x53\x1f\x5b\xcd\xc4\x5c\x9f\x5e\xcc\xd9\x72\xd6\x93\xdd\x6e\xba\xa6\x9f\x67\xe8\x00\xab\x36\x6b\xd3\x73\xed\xcd\xa9\x7b\x75\x13\x0e\xeb\xd1\x75\x08\x0d\x1e\x97\xa2\x3b\x23\x58\xed\xd0\x28\x84\xb3\xff\x20\xb5\x53\x7d\xdc\x05\x04\xf0\xc3\x98\x97\xb0\xe5\xef\x21\x6c\xcc\x70\x9f\x8a\xe4\xf4\xb4\x5b\x45\xd2\xc2\x94\xfb\x47\x79\x30\xe9\x3d\x7d\xc0\xf9\xdc\x00\xd8\xe7\xe3\xef\x7f\x17\xda\x7e\x17\xda\x7e\x17\xda\x3e\x07\x56\xff\x63\x84\xb6\xfc\x62\x34\x9d\x88\x7f\x7c\xee\x5f\xa4\xfc\x2e\xbd\xfd\xd3\x48\x6f\xbf\x66\x15\xcf\x46\x87\x0e\x6a\x87\x4e\x87\xdd\xec\x13\xca\xf7\x1e\xe6\x55\xeb\x30\x2f\xb3\x3a\x3a\x2c\x9a\x3d\x80\x9b\x02\xfd\x59\xac\x42\xbb\xba\xfc\x26\xf7\xdd\x6f\xb6\xdb\x5a\x94\xf1\x73\x52\x22\xa5\x8d\x0a\x66\x59\x56\x4e\x98\x82\x64\x6e\xe0\xe4\x15\x6d\xa3\x55\xca\x60\xff\x38\x8a\xbb\x6e\x10\x67\x7b\x2f\x0c\x2b\x68\x3a\x33\xbe\x69\x87\xdc\x03\x82\x93\xae\x09\x8b\x36\xbc\x79\x4a\xfb\xef\xbd\xd3\x93\x06\x60\xe1\x59\x81\x27\xc7\xbf\x45\x3f\xe0\xfc\x04\x3f\x81\x92\x3f\x3c\x3d\xfd\xac\xe8\xd4\xc6\x20\x34\x73\x70\x2c\x24\xe1\xc8\x1d\xd6\xb1\x96\xe5\x3f\x68\x2d\xcb\x4f\x83\xf1\xec\xa1\x6b\x19\xee\xe7\x13\x36\x49\xbb\xe7\x03\x36\x89\x5c\x66\xb5\xca\x61\xbd\x8e\x59\x8d\x73\x21\x5e\xda\x15\xc0\x57\xa4\x88\xe4\xbf\x56\x50\x7e\xa2\x38\xde\xf3\x13\x51\xf0\xc9\xf1\x0e\x9d\x7e\x75\x88\x24\xee\x9c\x36\x56\x1a\x67\xc5\x3c\x92\xce\x0a\x91\x8e\x12\xd1\x79\xe9\x2f\x4a\xe9\x35\x57\x3f\x3f\xe1\xb2\x5f\xd9\x54\xa2\xe6\x92\xba\x1c\x85\x28\x71\xa9\x2d\xcb\x81\xc5\x20\x15\xef\x21\x68\x11\xa0\xcf\x34\x01\x85\x3f\xdd\x2b\xcc\x47\xce\xba\xd7\xb7\x44\xf7\x6b\x78\x3e\x4d\x7a\x3f\xe0\xaa\xe9\xf4\x9b\xa6\x3a\xa8\x71\xce\xb0\x14\x08\x8c\x09\xeb\x3a\x3e\xb3\x94\x1e\xc2\x94\x06\xaf\x66\x73\x24\x8e\xf2\x9c\x17\x44\xa0\xcc\xff\x03\x41\x3e\x8d\x56\x39\x8d\xb2\x48\xcb\xf2\x4e\xf4\x22\xdd\xb3\x97\x12\xea\xd6\xb3\xb9\xf0\xa2\x1f\x05\xba\x43\xd1\x0a\x7f\xfc\x75\xdd\xe1\x8f\x07\x77\x27\xc9\xd5\xa7\x76\xa4\xe3\xf3\x1d\x3e\x37\x4d\x48\xda\x2b\x6d\xf8\xc5\x1d\x3a\x0b\xab\xd2\x3e\xdf\xc1\xd4\x44\x41\xe7\x3e\x55\x72\x70\x03\xc7\x84\xc5\x45\xcf\x0e\x79\xe2\x1c\xfb\xa7\x96\x13\x08\x48\x83\xd1\x4b\x71\xce\x2e\x63\x4a\x7e\x30\x95\x72\x18\x72\x55\xd5\x92\x2f\x15\x1b\xd4\xac\x82\x17\x8b\x28\x4c\xca\xf2\xd5\x75\x24\x83\x0d\x65\x32\x78\x54\xa4\xa2\x10\x65\x40\xfc\x8e\xaa\x72\x66\xa7\x63\x83\x54\xe9\xc9\x38\xdf\xbf\xc5\x54\x9c\x18\x59\x1d\x34\x19\x77\x2e\xcc\x7f\xe1\xf5\x6a\x68\x11\xfe\x35\x56\xae\x6b\x52\xbf\x66\x0d\xff\x2b\x2c\x56\x23\xd6\xee\xbf\xc4\x5a\x75\xcc\xe9\x9f\x7d\xa9\x3c\x15\xd5\xbf\xc6\x4a\x85\xa7\xf4\xcf\xbe\x50\x9e\x3e\xef\x5f\x63\xa1\xc2\x53\xfa\x67\x5f\x28\x3f\xaa\xdd\xbf\xc4\x42\x85\xa7\xf4\x2f\xca\x69\xe8\x68\x5c\xff\x1a\x2b\xd7\x9c\xcc\x03\xd6\x2c\x6c\x71\x91\x37\xa5\xee\xcf\x70\x79\x7e\xaf\xd2\xf7\xf4\x00\x41\x3c\xa0\x85\xde\xa1\xb3\x7b\x74\x76\x6d\x1d\x5c\xb5\x57\x5e\x2a\x9b\xf2\x52\xd8\x10\x61\xee\x1a\x22\xe4\xbf\x81\xdd\x41\x40\x3c\xac\xb7\x52\x7e\xc0\x56\x2a\x35\xf6\x55\x48\x86\xc1\xdf\x87\x79\x5d\x03\x45\x00\xc8\xca\x81\xcc\xcf\xb5\x97\xf4\x56\xca\xef\xd9\x4a\xbf\xd1\x64\x0e\xd9\x4b\xc1\xab\xea\xe3\x1d\x3a\xdb\x63\x3e\xf6\xbb\xcc\xfe\xbb\xcc\xfe\x2f\x71\x92\xfe\x2e\xb3\xff\x13\xb1\xad\xbf\xcb\xec\xff\x34\x4b\xf5\xbb\xcc\xfe\x4f\xb2\x50\xbf\xcb\xec\xff\x24\x0b\xf5\xbb\xcc\xfe\xcf\xcd\x69\xfc\x2e\xb3\xff\x97\x93\xd9\x5b\xbe\x14\x07\xcb\xec\x61\x1b\xf9\xdf\x65\xf6\x7f\x4e\x99\xfd\x5f\x49\x68\x3f\xc8\xa9\x26\x3a\x24\x62\x48\x23\x46\xc8\x4b\x16\xe9\x5d\x1f\xd9\xd7\x68\x22\xce\x54\x8c\x90\x99\x71\xb0\xa9\x03\x64\xec\x31\x39\x69\x0b\xfe\xbf\x26\x40\x44\x14\xf4\x51\x88\x8c\x83\xba\x1c\x73\x57\xa8\x00\x53\xea\xc7\x8a\x94\x03\xf9\xdc\xec\x9e\x42\x36\xf8\x7b\xc8\x2f\x3f\x3a\x20\x30\x41\x74\x40\x70\x81\xc8\x46\x10\xc0\xf3\xdb\x48\x86\x58\xdc\x53\xea\xbb\x9c\xe6\xd5\x32\x18\x67\x20\xba\x3f\x14\x41\x74\x7f\xb8\x81\xc8\xf7\xf3\x0f\x47\x34\x50\x74\xd1\x27\xec\x0f\x31\x8f\xaa\xad\x91\x0f\x73\xb0\x7f\x34\x7c\x30\xe1\xd6\x8e\x92\x60\x87\x1e\xed\x35\xb9\x7d\x28\xa1\xf6\x0c\x22\xf7\xb9\xd3\x34\x48\x79\x18\x5d\xe7\xb5\x49\xd4\xd2\x18\x1b\x1a\x4d\x5b\x9c\x67\xbc\xf1\xbe\x85\x67\x6d\xe8\x67\x49\x73\xc3\x3c\x2d\x95\xb9\x61\x6e\xe8\x96\xb1\x87\xf3\xca\x06\xec\x0d\x73\x6d\x43\x14\x1e\xc5\x43\x4c\x1e\x3b\xc7\x70\x9f\xc9\x63\x0e\x83\x8c\x42\xd5\xf4\x2f\xb2\x2f\x89\x1c\x12\x00\x67\x74\x18\xe2\x04\xf8\xc8\xc8\xda\xd9\xef\x1b\x95\x77\xf4\x6e\x2a\x52\x5e\x62\x41\x54\xd4\x71\x7b\x30\x63\xf2\xe8\xec\x57\x0f\xd3\x35\x70\xef\x04\x1f\x5e\xad\x0b\x72\x59\x62\x7e\x88\x77\xf2\xa3\xc3\xb8\xa5\xfb\x3a\xe5\xc5\x21\x0e\x60\x8f\xbe\xfe\x2c\x9d\xcd\xc9\xa2\x38\x6c\x76\x8f\x0f\x23\x29\xf7\x82\x94\xe2\xf5\xfa\xf6\x90\xfe\x0e\xbb\x2f\xba\xaf\x3f\xbc\xe1\xcb\xc3\xdc\x86\x1f\x1d\xd4\x5f\xdb\x84\xb3\x1b\xc5\xba\xcc\xbc\x97\x7b\xcd\xbc\x1d\x1e\xea\x81\x66\xde\x61\x5a\xd4\x6d\xe6\xdd\x49\x79\x3a\xcc\xbc\xf7\xd3\xbc\xcf\xda\x0f\xe8\x90\x61\xa3\x83\x9c\x79\x6a\x10\xd6\xa3\xf9\x5c\x1e\x6c\x9f\x3e\x02\x19\xdb\xf0\xff\x59\xef\x0b\xc9\x08\xfd\x3f\x9c\xfc\xe7\xf0\xa2\xfb\xf4\xfe\x3f\xdd\x9b\x6d\x4f\xff\xf7\xd8\x0e\x87\xc6\x61\x25\x83\x87\x58\x0f\xb7\x75\x36\x56\xd0\x7e\xb4\xc7\x6e\xd8\x7a\x33\xc5\x20\xe4\x73\x50\xb3\x65\x59\x16\xf2\xdb\xb0\x7c\x83\xb7\xab\x1d\x6e\x42\x12\xc5\xa6\x97\x82\xf1\xdc\xd8\xc7\x24\x1a\xe0\x9b\xa6\x3a\xfc\x39\xa0\x98\xdf\xfd\x01\x04\xc0\x79\xb5\xc2\x45\xf1\xe4\x93\x26\x59\xb3\x20\xde\x2c\x3d\xce\xe4\x57\x4e\xb3\x6e\xab\xd3\x6f\x45\x4f\x60\x87\x1e\xed\xd1\x9b\x74\x39\x11\x58\xa4\xe8\x72\x6b\x51\xf1\xb2\xde\x4a\x66\x66\x1e\x05\xfd\x4f\xee\x03\x93\xc3\x09\x79\x70\xf2\x38\xa4\x5f\x0b\x28\xa7\xb1\x0e\x48\xfd\xd1\x5a\xd2\x0b\x58\xed\x89\xac\xf0\xc9\xb0\xb2\x51\x58\x7e\x4d\xac\x12\x5e\x54\x97\x26\xf8\xf2\x01\xac\xdc\x9f\x0e\x63\x66\x9f\xbc\xfb\xfe\xad\x37\xff\x3d\xee\x38\xff\x30\xd7\xc8\x63\x2b\x7f\x85\x1d\xf7\x9c\xa9\x7b\x88\xe2\x81\x64\x9f\x1b\x9f\x53\xb0\x03\x09\x3a\xbd\x31\x45\xd5\x59\xbe\x5e\x92\xf2\xb2\xda\xe4\x0d\x4c\x6d\x66\xde\x3f\x0a\xb7\xf4\xa7\x0c\x85\x92\x6b\xc6\x73\xcc\xc9\xfc\x52\x86\x54\x9e\xb1\xa2\x35\xa2\x40\x99\xfb\x07\x16\xa8\xd4\x35\xbe\xd5\x86\x6f\x70\x91\x3e\x70\x98\x97\x79\x75\xa9\x6a\x1e\x32\xe0\xba\xf4\x27\x0d\xbd\xae\xde\xe5\x59\x78\xbc\x43\x8f\xc3\x3a\x89\x4f\xdd\xe5\x73\xf5\x4a\x05\x78\xf2\x4c\xc9\x3f\x76\x5b\x89\xae\xc2\xe6\x66\x9f\xda\x95\xf5\x29\x7c\x2b\x25\x1f\xaf\xa7\x4f\xb6\x1f\xfa\x64\xf2\x66\xa2\x11\x75\x78\x9d\x89\xff\xed\xbf\x7e\x30\x32\xd5\x65\x3e\x27\x94\xe7\xfc\xf6\x72\x53\x16\x87\x88\x57\x87\x09\xc7\xc7\xdd\xe3\xf2\xe6\x74\x5d\xdc\xae\x97\xf9\x8c\xd1\xc8\xfe\x1a\x88\x73\x36\xba\x59\xe6\xd2\xd9\x52\x51\xa9\xa7\x52\xfa\x7b\xe0\x8c\x0e\x99\xcd\x61\xd2\xb7\x54\x45\x77\xb8\x7e\xfd\x83\xc1\x7d\x88\xdb\x63\x2b\x0a\x8b\x07\x6e\xe7\xa4\x79\x7c\x00\x57\xe2\xdc\x7b\x7c\x02\x7f\xd1\x9a\xa3\x47\x78\x02\x10\xf8\xb5\xbc\x46\xab\xc9\x6e\xb7\xe6\x1d\x7a\x7c\x3f\xa7\x01\xa2\x17\x06\x7d\x3e\x89\x0f\xf5\xc6\xd3\x3d\xf9\xcf\x3c\xf1\xe0\xa4\x77\xe8\xf1\xde\xc8\x5f\x42\x34\x07\x3b\x34\x63\xab\x75\x5e\x90\x32\xbd\xf8\x1a\x81\x27\x59\xf4\x28\x19\x26\x43\x30\x45\x2b\x9c\xd3\xfb\x82\x30\x09\x0e\x63\xcf\x50\x97\x2d\xf6\x64\x13\xba\x32\x5c\xb7\x5d\xed\xeb\x90\x15\x34\xc9\xe9\x07\xf6\x9e\x98\xd7\xe4\x71\x62\xc2\xf8\xdb\xdd\x63\xdf\x97\xd7\x4f\xb2\xe9\x47\xc3\x53\x8e\xd6\xaa\x52\x95\x62\x34\x27\x33\x56\x62\xce\xca\x2a\xa5\x49\xfd\x51\xc7\xcd\xd8\xd7\xa5\x8c\xa5\x5f\x77\xa8\xdf\xc0\xff\x55\xdd\x1d\xff\x44\xcf\x59\x61\xfd\xcb\x4b\x82\xe7\xb3\x72\xb3\xba\x52\x24\xfd\xbc\xc8\x3b\xd5\x4e\x9b\xbd\x6a\xa7\xa5\x16\x56\x67\x08\x9c\x1c\x1a\x2c\xfe\x2d\x2f\x09\x5e\x55\xe6\xba\xed\xf3\x74\xae\x9f\x02\x3a\x54\xe9\x25\x1b\xde\x94\x85\x7a\xf0\x57\xb7\xec\x7c\xd7\x4d\x37\xee\xf0\x6b\x2a\x6a\x4b\x77\x7a\x0a\x6f\x0c\xf7\x64\xc2\x87\xc5\xa5\x7c\x61\x7b\x9d\xcf\x7c\x0e\x49\x35\x0c\xe1\xa4\x4c\x97\x82\x0b\x5a\x4f\x4a\xd3\xbd\xe1\x82\x94\x8b\x7e\x6b\xdf\x95\xb0\x7f\xec\x00\xd2\x5e\x3c\xca\xb8\x2a\xdd\x63\x10\x8d\xfa\x5a\x37\x69\x80\xb0\x6f\x04\x54\xbe\xcb\xdc\x31\x80\x22\x97\x51\x10\x59\xf1\xe4\x27\xfa\x90\x10\xf7\xfe\x5b\x0b\x57\x05\x9b\xbd\xff\x65\xc3\x38\x69\x2a\x2e\xd6\x4f\xd4\x6b\x78\xf6\xcd\xae\xdf\x0a\xb8\xc0\xbe\x09\xd6\x1a\x91\x79\x25\xcf\x8e\x09\xfc\x16\xc0\x0e\x0d\xe8\xfc\xa4\x05\xb9\x66\x64\xca\x66\x60\x9e\x0d\x2d\x48\x55\xf9\x38\xee\x05\x16\xd2\x61\xb9\x43\x61\x83\x54\xdd\xae\x20\xdd\xfb\x22\xcc\x9d\xed\x89\xc1\xff\x13\x95\xaf\x1c\xa8\x6b\x65\x2f\xfc\xb9\x7c\xf0\xe0\xe1\x0f\x27\x1c\x3f\x6c\xc2\xf6\xa2\xf4\xc1\x53\x3e\x0b\x06\x48\xb7\xb9\xdf\xdc\x13\x55\x2f\x10\x44\x74\x53\x99\xd3\x20\x3d\x1a\x8a\xaf\x67\xa2\xa2\xfe\x49\xd6\x7c\x59\xe9\x67\x9c\xef\x1a\x4f\xa3\xa4\x67\x67\xbb\xa9\x10\xf1\xeb\xb7\x54\x38\xb2\x0f\x9c\xe2\x8c\x77\x3e\x35\x8c\xc7\x3c\xf4\xd4\x30\x77\x9f\x1a\x66\xe2\xb3\xf9\xac\x70\xae\x13\xfd\x57\xd0\xb8\xf3\xb2\x70\x99\x55\xf5\xdb\x67\x62\xa1\x5e\x2a\x3d\x9e\xda\x3f\x91\x7d\xa3\x77\xb0\x28\x36\xf9\x1c\xd4\x0f\x90\xc9\x46\xaa\x75\x4e\x29\x31\x8f\xa9\x35\x5f\xf1\x6d\xc4\xd5\x8d\x54\x20\x52\x60\x5b\x7f\xaa\xce\xf9\xae\xe7\xd0\xaa\x4f\x7f\xae\x57\x1b\x7e\x5d\x93\xb4\xf1\xc0\x96\xfb\x4e\x98\xf7\x60\x99\x7a\x1a\x4b\x3e\xaa\xa5\xdf\x16\x01\x70\xec\xe4\x2c\x08\x9f\x2d\xe3\xc0\x13\xae\xde\xbb\x66\x0a\xb4\xe6\xd9\x18\x05\x15\xf7\xf9\x54\xfb\xa8\x8f\x7d\xb3\xc8\x3e\xc2\x49\x76\xfe\x9b\x81\xad\x67\x06\xed\x63\x42\x5e\x29\x5c\xdc\xe0\xdb\x2a\xb6\x8f\x64\xab\x4c\xe6\xbd\xb4\x0b\xe1\x0e\x79\x20\xff\xf4\x77\x1e\x71\xfb\x85\xc9\xb9\x7c\xd3\x51\xad\xb1\x7c\xf0\xf1\xfe\x47\x1d\xf5\xeb\x8d\xfe\x83\x8e\xcd\x65\xd0\xaf\x44\x4a\x8e\xa0\x99\x27\x49\x30\x0c\xbd\x00\xc9\x83\x2f\x40\xc6\x26\xe0\xb1\x7c\x16\x1d\xab\xa7\x7d\x9d\x46\x37\x65\x11\x43\x74\xb7\x22\x7c\xc9\xe6\x29\x78\xf6\xfc\xfb\xe7\xef\x9e\xb7\x5f\xec\x6d\x3d\x0c\x99\xe5\xf5\xc3\xf5\xf6\xf9\x79\xd0\x57\xac\xc7\x8f\x6f\x5e\x7c\xcb\x56\x6b\x46\x09\xe5\x71\xc7\xec\xe0\xc1\x0f\x4a\xa6\x58\x3e\x79\xd7\x1e\xb5\x7c\x4e\x4b\xbd\x97\x9f\x2f\x6e\xe3\x3b\xb5\x12\x02\x9f\xfe\x11\xef\x5a\xb6\x1f\xb6\xa4\xf7\xbe\x3f\x15\x78\xd8\x32\xf4\x12\xa5\x7a\xa0\xea\x91\x7c\x56\xd2\x25\x2c\xe9\x57\xc3\x07\xbd\x20\xf9\xcd\x3f\xf6\xb1\x2a\x79\x2c\xe4\xab\xeb\x28\x9f\x67\xe0\x2a\xbf\xd6\x26\x78\xd6\xb8\xee\xf1\xb0\xf6\x88\x0b\x87\x85\xba\xc7\x7e\xf0\x13\x82\x44\x99\xc7\x3b\x11\xf8\x12\x48\x6b\x3b\xf0\xc9\xb6\xd3\x27\x4a\x91\xf5\x40\x51\xb0\x75\x7d\xc3\x6f\xa5\xed\xd1\x15\x9b\xdf\x46\x77\x8a\x19\xb8\xc2\xb3\xf7\xd7\x25\xdb\xd0\xf9\x60\xc6\x0a\x56\xa6\x5f\x0c\x87\xc3\xf1\x4f\x74\xf7\x13\x3d\xf9\xf2\x27\x1a\x7d\x19\x25\x74\xb3\xba\x22\x65\x15\xa5\xd1\xbb\x25\xa9\x0f\x1f\xf9\x6e\x95\xce\x14\x05\x4f\x04\xcb\xf1\x85\x29\xac\x9b\x97\xcf\x03\xe1\x22\xbf\xa6\xa9\x7a\x24\x68\xac\xd2\x57\xb8\xbc\xce\xe9\x80\xb3\x75\x3a\x1a\x0e\xd7\x1f\x55\x8f\x3f\xd1\xc4\xab\xbf\x60\x94\x0f\x16\x78\x95\x17\xb7\x69\x74\xfc\x5d\x89\xe9\x8c\xe5\x55\xf4\x8a\x92\x63\x14\x55\x98\x56\x83\x8a\x94\xf9\x62\x6c\xcb\x56\xe2\xb4\x8a\xbe\x56\x0d\x46\x91\x9a\x91\xd2\x55\xc9\x04\xf9\x6b\x50\xad\xf1\x8c\xa4\x11\x65\x37\x25\x5e\xcb\xf4\x35\xab\x72\xb9\x41\xa3\x92\x14\x58\x48\x02\x32\x79\x9e\x97\xfa\xa5\xc5\xa8\xe0\x6a\xe8\x1f\x48\xc9\xf3\x19\x2e\xf4\xa4\xa2\x55\x3e\x9f\x17\xaa\xb4\xc2\x35\xdb\xbd\x9c\x4f\x13\x8a\x41\x20\x12\x3c\x5b\x46\x0d\x40\x9a\xf2\x12\x0e\xd2\x5c\x34\x8d\x1e\x9b\x79\x35\x7a\xda\x33\xfe\x6a\x5d\xe0\xdb\x34\xca\x69\x91\x53\x32\x90\x4c\xaf\xcc\x51\xf0\x4f\xa3\x53\xdd\x80\x32\x6f\x1c\x94\x78\x9e\x6f\xaa\x34\x7a\x6c\x93\x3f\x0e\xaa\x25\x9e\xb3\x9b\x34\xfa\xe2\x9b\x6f\xbe\x89\x86\xd1\x60\xb4\xfe\x18\x0d\xd5\x7f\x28\xfa\xe2\xd1\xa3\x47\xd1\x30\x72\xd2\x5a\x33\xff\x3e\xe7\xbc\x20\x0a\xf4\x51\xc5\x37\x8b\x45\x15\xf1\x25\xe6\x91\x10\x5c\x23\xbe\x24\x11\x67\xeb\x08\xd3\xb9\xfc\x7d\xc5\x38\x67\xab\x26\x18\xd2\x2b\xb2\x60\x25\x51\xd0\x10\xc0\x23\x94\xa7\xd1\xf1\xb1\x3f\xcb\x7a\x7a\x1a\x60\x67\x0d\x78\x7d\x65\xa6\x65\x91\xde\x45\x8e\x1a\x88\xf8\xaa\x62\xc5\x46\x27\x17\x64\xc1\xd3\x68\x28\x7f\x0b\x7c\x8d\xce\x0c\xd4\xff\x3e\xc8\xe9\x9c\x7c\x4c\xa3\xd3\x16\xb0\x72\x5a\x11\x1e\x95\xd7\x57\xf1\xe8\x6c\x88\x22\xf3\x0f\x8c\x86\xe2\xff\xeb\x8f\x02\x62\x1e\xe0\xd5\x00\x05\x1c\x2b\x56\xe4\xf3\xe8\xaa\xc0\x7a\x2e\xba\x84\xec\x7a\x4f\xbe\x02\x5c\xa8\x88\xbb\xb1\x52\xbc\xe0\x06\xab\x3e\x2f\x1c\xc5\x5c\x4f\x87\x43\x14\x99\x7f\xe0\x3e\xa0\xea\xf9\xfe\x83\xa1\xaa\x96\xee\x1f\x00\xd4\x28\xd2\x60\xd5\x38\x2e\xf6\xf4\x1a\x53\x52\x54\xad\x0d\x2c\xc4\x69\x64\xb7\x7f\xa2\x5a\x54\x0b\x10\x00\xb8\x81\xf0\x99\xd9\x81\xcd\xad\xef\x10\xd4\xc8\xa1\xa8\xec\x03\x29\x17\x85\x80\xd1\x32\x9f\xcf\x09\x0d\x6d\xea\xb3\xc0\xaa\x0d\x6e\xc8\xd5\xfb\x9c\x0f\x04\x79\xc0\xe5\xe0\x5a\x94\x15\x9c\xd1\x37\xc3\x39\xb9\x46\x12\xd4\x02\xc8\xea\x3f\xa8\x12\xbe\x19\xa2\x48\xfd\x07\x61\xbb\x41\xf6\xf9\xda\x6a\x36\xf4\x69\xed\xe8\x43\x41\x40\xd0\x23\xcd\xee\x02\xa9\xf5\x18\xac\xd8\xdf\x07\x12\xdf\xf2\xbf\xe7\xf4\x3a\xad\xb1\x40\x01\xce\x00\x6b\x5f\x11\xb6\x2f\x77\x4f\x96\xa4\xcf\x2d\xba\x5e\xa3\xa9\xc4\x65\x8f\x3e\x47\x8d\x02\x72\x4b\xb5\x29\x78\x0d\x87\x7a\x2b\xd6\xa4\xfa\x81\xe4\xc3\xc7\x5f\x6f\xcc\xc1\xf6\xf6\x8f\x5a\x97\x79\xe0\xc0\xcf\x74\x33\xee\xfe\x7b\x2d\xf6\x5e\x84\x69\xae\x5e\xcf\xac\x77\xe1\x89\xda\x9b\x94\xdc\xc8\x53\x46\xee\x51\x99\xe5\xae\x7e\x4a\xf9\x72\x50\xe0\x8a\x0f\xd8\x62\x20\x24\xdb\xf8\x11\x54\x33\xec\xa0\x5e\x96\x44\x0d\xc7\x76\x1c\xb2\x1f\x56\xcc\x1f\xd4\xcf\x99\xee\xc7\x8e\x7c\x30\xdf\x94\x58\xcf\x35\x39\xad\xc6\x7e\xa6\xe4\x16\x45\x07\x8d\xf4\x45\x5e\x14\x03\x21\x7e\xa4\x82\x9f\xb8\xc1\xe5\xbc\x59\x93\xe7\xab\x9c\x5e\x0f\x0c\x8f\x98\x46\x04\x57\x64\x90\x53\x49\xc9\x9c\x09\x8d\xc6\x62\x36\x7a\x2b\xf0\x12\xd3\x6a\xc1\xca\xd5\x80\x95\xb9\x64\x17\x86\xd1\x68\x38\xfc\xa3\xb7\x19\xf6\x17\x62\xfb\xf3\xf7\x64\x4a\xb0\xfe\x8f\xf7\xe4\x76\x51\xe2\x15\x91\x8f\xbf\x6a\xa6\xb0\x34\xf8\xd7\x1c\x66\x1a\x55\x33\xfc\xff\xb3\xf7\xad\xcb\x6d\xdb\xfa\xbe\xdf\xd7\x53\xa0\xec\x39\x11\x59\xd3\xb2\x24\xcb\x5e\xad\x6c\xa9\x93\x95\x5e\xd7\x74\x65\xb5\x8d\x7b\xe6\xec\xe9\xee\x78\x68\x11\xb6\xd8\x52\x04\x4b\x82\x49\x3c\x8d\xde\x63\x7f\xd8\x4f\xb7\x9f\x64\x0f\x71\x21\x01\x12\x20\x41\x8a\xb2\x9d\xb4\x5f\x12\x8b\x37\xdc\x81\xff\xf5\xf7\x0b\xe1\x7f\xd8\x53\x87\x89\x99\xb5\x4a\x2a\x1e\x41\x4d\x77\xb5\xb7\x76\xff\x19\x01\x7a\x76\xb5\xd4\x65\xd2\x5e\x97\x49\x63\x5d\x26\xfa\xba\x4c\x8a\xba\xec\x2a\x93\x9d\x2d\x53\xc5\x3c\x64\x0b\xbd\x36\x15\x67\x86\x53\x9e\xcf\x90\x4e\x53\x96\x16\x5a\xb9\xe5\x43\x72\xf8\x29\x5e\xea\x3b\x9f\x51\x86\x95\xf3\xb7\xda\x61\xda\xd9\x3d\x51\x4c\x3c\x71\xc7\x33\x98\x7b\x93\x8e\xf3\x63\xaa\x1c\xc3\x7c\x23\xd1\x8f\xa1\x72\x2f\x99\xb2\x01\x2c\x46\xea\x78\x5a\xec\xde\x2c\xc7\x0b\xfa\xbc\x32\x44\x5b\x62\x0a\xdf\xcd\xcd\x8d\xa4\x95\x2d\x0a\x61\x43\xd4\xaa\x66\xf3\x18\x8b\x17\x99\x5a\xf6\x0d\x0c\x5f\xc3\x5c\x23\xa2\x65\x71\x0b\x5a\xe2\x61\x68\x54\xd6\xec\x4c\x55\xd6\xc4\xa4\xac\xcb\x13\xae\xd0\xca\x39\x06\x0f\xe0\x91\xac\xc6\xc4\x1f\xd8\x23\x89\x24\x2a\xe4\xc0\x5f\x5a\x4c\x4d\xb6\x56\xb2\x95\x5f\xbc\xcc\x8d\xe4\x31\xbf\x59\x4c\x82\x82\x77\x38\xe5\xfc\x4b\xd0\xbf\x3c\x89\xa5\x87\x85\x71\xb4\x56\xf4\xe6\x3e\x91\x7a\x5d\xa9\x60\x4d\xd3\x4e\x5a\x1d\x00\x7a\x9b\xff\xd9\x44\x6d\x92\x0a\x97\x91\x6d\x95\x84\xeb\xc4\x38\x1f\x95\x5e\x00\x97\x98\xac\x2a\xe6\x7b\x44\xae\x71\xf3\x7d\xb0\x44\x4a\xf3\x3d\xb5\x07\x35\x98\xef\xc9\x47\xd8\x63\xcc\x50\xad\xb6\xc2\xa3\xfe\x56\x78\xcf\xc8\x0a\x4f\x7f\xa4\xd8\x4b\xb0\xed\xec\x5c\x4a\x8c\x2f\xd6\x60\x1d\x42\x2f\xb9\xa2\xf8\x06\x8c\x87\x1f\x85\x61\xfe\x66\xfd\x0e\xdd\xb6\x51\xe2\xb8\x62\xbd\xe9\x37\xd5\x75\xde\xb9\xa4\x68\xb1\x40\xa1\x0c\xe2\x83\x72\xa5\x4f\x0b\x97\x7c\x18\x62\x6f\x39\x61\xcd\x46\x28\x0e\xa2\xbb\xe5\x47\x53\xfa\x9b\x82\xc3\x7f\x8f\xc2\xf0\x5b\xd6\xee\xe5\x14\xce\xe9\x3d\x66\x42\x7f\x99\x6d\x97\xc7\xec\xf1\xa0\x78\x68\xc2\x3e\x48\x3a\xed\xa7\x24\x14\x0a\xcc\x62\xdf\xc3\xf0\x15\xf6\x70\x6a\xf3\x9a\x7f\x87\x50\xbc\xa8\x79\x24\x8a\xcf\x45\xae\xd8\x9c\x14\x62\xa9\xbf\x84\x0f\x0a\x76\x5d\x37\x72\x76\xae\x70\x4b\xec\x9c\xe0\xd6\x4e\x89\x09\xdb\x1b\x7b\x71\xc0\x2c\xdf\xdc\xfe\x58\x33\x39\x47\xe4\x85\x8f\xd8\x78\xe7\xa3\xe0\xd3\xa9\x0f\x97\xe1\x38\x81\x7e\xb6\x86\x76\x94\x0f\x3e\x4e\x5d\x71\x85\x70\x6b\x2b\x88\x8e\x2a\x81\xfe\x3b\x77\xe2\x5c\x04\xb7\xf6\xf1\x74\xb9\x5c\x56\x3a\xd3\xa9\x76\x2e\xa4\x8d\x7f\x93\x04\x18\x92\xb4\xd2\xd4\xae\xbe\x72\x01\xc3\x14\x82\xe0\xd6\x86\x2b\x3a\x8c\x5e\x4a\xae\x93\x5a\xe2\x25\x3c\x16\xaf\x5e\x08\xc3\x8e\x4f\x6c\xa9\xa7\x4f\xa6\xf0\xd4\x39\x39\x9b\xc8\xb3\xe5\xdd\x3b\xfa\x0a\xd5\xbf\xbf\xca\xcf\x79\xdb\xd9\x55\xeb\x29\x96\xe1\x8a\x3f\x96\xf0\x82\x39\x07\xc5\xa2\x2e\xbc\x4b\xcd\x14\x7b\xf6\xcc\xae\x3c\x7b\x94\x57\x4b\x58\x63\xf9\x74\xb1\x3d\xc7\x4d\x6d\xeb\x63\x7e\x58\x81\x8f\xe9\xa1\xe1\x8c\x37\x81\x0f\x6d\xc7\xb5\xac\x8f\x96\xcb\xe2\x84\x7d\xf6\xcc\x16\x9e\x06\x63\x92\x33\x6d\x39\xe3\x7c\xf3\xb5\x8b\xa7\xa4\x4f\x5a\x0e\x49\x54\xb4\x1d\x67\xa7\xf0\x6a\xd5\x66\x2b\x9b\x1a\xef\xde\xd9\x4d\x4b\x5a\xb7\x0c\xcb\x76\x4d\xe1\x9c\x3d\x56\x71\x61\xd8\x51\xc5\x73\x41\x2a\x5b\x5a\xef\x1d\xe6\x55\x66\xf5\xe1\x4b\xef\xdd\x3b\x5b\x5e\x8a\xd5\xb7\x3c\x8c\x13\xdb\x4a\x93\xb5\xe5\x50\x17\x59\xb4\xfc\x97\x87\x37\xe3\xdb\x10\xa1\xc4\x9e\xc2\xf3\x4f\xc8\xcf\xc4\x8b\x7c\xb4\xb5\x1d\xe7\x42\xff\x01\x79\xd1\x1f\x59\xcf\xae\xb3\x28\xf8\x7d\x69\x1d\x45\xce\x6e\xe7\x8a\x13\xa8\xb6\x57\x95\x33\xe9\x68\x59\xce\xd0\xf6\xb9\x5f\xe9\xd1\xea\xe6\x20\x96\x29\xee\x0e\x53\x78\x7a\x72\x3e\x71\x76\xae\xf0\x6d\x69\x07\xba\x45\x89\x4d\x97\x4f\xec\x25\x29\xfc\x36\xc2\x76\xe4\x4e\x27\xce\x18\xa3\x57\xc4\x51\x65\x3b\x2e\xcc\xfb\x92\xdb\xe0\x2d\xe7\xe7\xc9\x2f\xae\x27\x5e\xe2\x76\x2c\xcb\x71\xc3\xe5\xe4\x22\xbc\xc4\x6c\x88\x2e\xc2\xa3\x23\x4e\xe3\x80\xc7\xeb\x8d\x97\x3c\xc7\x76\x48\xf6\x04\x8f\x3d\xb2\x62\xfe\x88\x60\x99\xda\xde\xcf\xe1\x2f\xce\x45\xc0\xa6\xa3\x1b\x8c\x6f\xf3\x86\x58\xb9\x60\xcb\xa7\x30\x12\x2e\x53\x49\xb8\xbc\xb3\x23\xdb\x43\x6a\x43\x27\x3f\x3e\xf2\x73\x76\x24\x45\xad\xb2\x4a\xae\xa4\x8b\xf9\xb7\x57\xa3\x23\x74\x34\x62\x21\x97\xd2\x5d\x56\x04\x79\x80\xf3\x08\x94\xa1\x99\xce\x4e\xd9\x09\x24\xb7\xdc\x96\xb7\xc8\x68\xb5\xe4\x9d\xf2\xec\x19\xa9\x22\x5d\xbe\x3b\xe2\x97\x2b\x5d\x5b\x81\xc6\x2f\x27\x3b\xe1\x04\x59\x60\x31\xff\x4c\x76\xab\xb9\xa5\x90\x52\x3a\xda\xce\xa6\x87\x75\xb4\x11\x91\x7b\x69\x15\xa6\xcb\x08\x45\xf0\xc2\xea\xed\x9b\x6a\xcb\x73\xd7\xb0\x03\x5b\x44\x08\xa6\xfb\x63\x25\xb2\x57\x0c\x5b\x51\x24\xa6\x09\xd1\x68\xad\x41\x39\xed\x71\xc7\x6d\x11\x35\x45\xbc\x91\x06\xe2\x82\xd1\x00\x57\x42\xa5\x4c\x23\x7b\xa5\xb4\x50\xd6\x3e\x29\x6c\xaa\x68\x33\x49\x0e\xad\x05\xb3\x56\xd3\x44\xd9\xe3\x9a\x4c\x51\x31\x7a\x4a\xa0\x20\x56\xc6\xe2\xb4\x0b\xdf\xb3\x03\x4d\xd3\x4e\xb3\xaa\x16\x8b\x5d\xc6\x03\xfa\x09\x8a\x7d\xf4\x26\xd2\x45\xf0\x17\x61\xd8\x1f\x5b\xd5\x57\x8e\x31\xba\xbb\x0b\x21\xa3\x01\xa6\x3f\x84\x0f\x82\x04\xe5\xbf\x59\xdc\x0d\xf0\x92\xc0\x3b\x86\x6f\x63\x2f\xf2\xa1\xbf\xb4\x6e\xbd\x30\x85\x95\x9d\x4b\x15\x84\x1f\x07\x6b\x9c\x25\xcc\xad\x56\x84\xbf\xf7\x9b\x3a\xb2\x22\x20\xcd\x20\xf9\x96\xe9\x44\xaa\x6a\x16\xca\x0c\x67\x39\xd1\x60\xed\x25\x10\x17\xed\xa8\x03\xcb\x14\xfd\x9e\x85\xb5\xfe\xde\xc2\x28\xd3\x67\x5a\x28\x06\xf5\x78\x03\x3d\x3f\x5f\x77\x5f\xe7\x15\x05\xf9\xf9\x4a\x8c\x3d\x2c\x2c\x53\x87\x1f\x92\xd6\x14\x5a\xde\x44\x33\xb4\x90\x8e\xc8\xcc\x52\x23\x4e\xb2\x50\xc9\x6b\x43\x2a\x6c\xed\xdc\x7e\xc9\x34\x34\x90\x98\x4e\xe4\x5f\xbd\xd7\x5e\xba\x4e\x82\x18\x2f\x2e\xf2\x73\xb0\x89\x13\x1a\x12\x5c\x26\x96\x3c\xce\xab\x70\x98\x13\x40\x89\x74\xa2\x4f\x9b\xbb\x8c\xbc\xd2\x1a\xe2\xbd\xbe\xc9\xa5\x3f\xf2\xdf\x31\xeb\x76\xfe\x33\x3f\x73\x83\xf5\x31\x91\x0a\x54\xbb\xb8\xac\xa3\x6b\xf6\x72\xf6\x2d\x3e\x9d\xe4\x01\xe2\x84\xe1\xf9\x92\x2b\xd7\xbb\xfc\x26\xdd\x1d\xc0\x1a\x85\xa1\x17\xa7\xd0\xaf\x6c\x1a\xfc\x3a\xbf\xcc\xa8\xcd\x3e\xa6\x6f\x2b\x61\xb1\xc4\x45\x95\x26\xc7\x28\x0a\xef\xad\xd5\x15\x2d\x26\xf2\x5e\x07\x34\x76\x4c\xcf\x94\x24\xbe\x4f\x76\x1a\x52\xd0\xa3\x3c\x5f\x61\xbe\x2f\x2e\x7b\x95\x4e\xbc\xc9\x25\x79\x53\xa6\x46\x11\xf6\xc1\x34\xfe\x9e\x44\x16\xb1\xe8\x21\xf3\xaf\xd3\x19\x76\x12\xa5\xbf\x5f\xdf\x84\x19\x1c\xc7\xd1\x9d\x61\x81\x1c\x1f\xec\x54\x80\x07\x3b\x9d\x58\xab\x97\xaf\x7e\x90\x77\x46\xf1\x24\xae\x4f\x61\x3a\x77\xf8\x84\x2f\xe7\x12\x31\x20\x2a\x67\x90\xb0\xbb\xe6\xeb\x88\xbd\x19\x79\xaf\x95\x53\xad\x21\x0d\x61\xf8\x31\x10\x72\x20\xd8\x96\x73\x98\xfa\x98\x43\x81\x8c\xac\xd5\xcb\xfc\xe1\x83\x57\xa9\x08\x24\x33\xab\xd4\x0b\xfa\xf8\xc1\xab\x15\x22\xf4\x5b\x16\x9b\xd6\xea\x3b\xf2\xb4\x50\x29\xb5\x39\xb9\x7e\xc6\xc2\xc8\xbb\x09\x8d\xa0\x65\x0c\x85\x76\x79\x8b\xa9\x9d\xab\xda\x35\xc0\xff\x24\xfe\xea\x86\x05\x41\x7b\x72\x83\x71\xbc\x38\xc9\x57\xff\x38\x40\x27\xd6\xea\x0b\xb4\x26\xc6\x4d\xb6\xfd\xb6\x8d\x4d\xf9\x91\x74\x71\x72\x72\x17\xe0\x4d\x76\x33\x5e\xa3\x6d\xfe\xc1\x00\xe5\xff\x5a\xab\xaf\x03\xfc\x4d\x76\xd3\xfc\x2d\xde\x14\x1a\x16\x72\xfc\x36\xb5\x56\xa5\x91\x9f\x1f\x42\xf0\x2d\xae\x88\x9c\xca\x5c\xd6\xd7\xf9\xec\x68\x13\x26\x55\x49\xe9\x62\x42\x7a\xb0\x48\xea\x02\xa3\x3e\x11\x3d\x70\x04\x75\x3c\xd6\x36\x54\x31\x8c\xf2\x25\xb5\xce\x12\x79\x5d\x74\x96\xd3\x06\x9d\x45\x72\x18\x84\x92\xc3\x00\x29\x1c\x06\x81\xe4\x30\x48\x97\x81\xca\x61\xc0\xc4\x8a\x8a\x7f\x80\x5e\xd5\x44\xf7\x4b\x32\x31\x08\x03\x6b\x61\xa1\x88\x48\xb9\xdc\x18\xf9\x22\x7f\x50\x1b\xde\x1f\xf4\x77\x2c\x20\x23\xc7\xc2\xce\xbd\x83\xf8\x47\xf2\xf7\x0b\xfc\x56\x2c\x9a\xd9\x96\x3d\xde\x13\x62\x55\xc4\x77\x34\x95\xf9\xa3\x2a\x91\xff\x6c\x4d\x37\x96\x6b\xcd\xf2\x7f\xa6\xe4\xdf\xd9\x3c\xff\x77\xfe\x29\xb9\x72\x4e\xfe\x43\xb7\xb7\xd6\x2f\x6e\x45\xcb\x41\x34\x1a\xbc\xd2\x0c\x27\x9f\x19\x28\xfa\x11\xa6\xb0\xee\x98\x60\xa9\x04\xac\x4f\xfe\x8f\x6d\x8d\xab\xda\xa0\x53\x5c\xb1\xc9\x77\xea\x83\x52\x49\x00\xa8\x45\xfb\xbb\x68\x9c\x2a\xea\xe5\x86\x76\xc4\x8c\xce\xcc\x40\xe6\x54\x4c\x4d\xa9\x89\xa9\x49\x98\x56\x8b\xb3\x99\x89\xa5\x69\x7e\x58\x4b\x53\x2b\x2c\x28\x5b\xcc\x32\x38\x28\x88\xd2\xdf\x3d\x7f\x1b\x44\x20\x48\x41\x84\x30\x20\x39\x08\x77\x59\x02\x7d\xf0\x26\xc0\x9b\xfc\x3e\x3d\xb8\x7c\xb0\x41\x29\x4e\x4b\xd3\xc5\x68\xe7\xaa\x40\x70\xa4\x84\xda\x3e\xb6\x2a\xbd\x91\x68\x2e\xe9\x15\x07\xc4\x1e\x15\x1a\xad\x41\x04\xad\x41\x67\xa6\x92\xea\x2b\xd2\xf5\x97\x1f\x33\xc3\xc8\x34\x66\xc6\x1f\x09\x95\x61\x30\x99\x40\x99\x99\xd7\xb1\x87\x47\x8d\x0c\xfb\x45\xca\xa3\x09\x1c\xe0\x79\x53\xe2\xda\x74\xd2\x31\x71\xad\x8d\xfc\x9f\xe0\xa9\xf1\x54\xa4\x76\xf1\x67\x66\x4e\xf7\xdf\x44\xdc\x6d\x55\xe7\x14\x45\xe2\x32\xb2\x07\x50\xe8\x73\x86\x99\xa5\x22\xf3\x16\x56\x53\x0d\x7c\x57\xb3\xe0\x29\xac\x92\x3c\x5d\xff\x01\x43\xf4\x26\x5f\xe2\x1e\xc0\x09\x84\x00\xdd\x82\x2b\x9a\xe8\xc3\x92\x4c\x59\x8c\xb9\x97\x40\xc0\x7c\x2b\xe1\x3d\x08\x22\x1a\x81\x00\xec\x60\x0c\xc7\x64\x7b\x88\x13\xe4\x67\x6b\xe8\x03\x14\x01\x2f\xba\xcf\xb7\x07\x1f\x04\x11\x89\x45\x5f\x87\x59\x8a\x61\x02\x6e\x32\xfa\xa1\xbc\xdd\x30\xc2\xfc\x36\x5d\x04\x44\x33\x77\x1a\x54\x32\x22\x86\xe8\xd6\x95\x21\xce\x51\x65\x7a\x36\xac\x36\x65\x66\xa5\x72\xb5\x59\x15\x49\xc9\xda\xb9\x2a\xf8\x85\x7d\xf1\x04\x04\xb8\xa3\xd8\x14\x94\x56\x2d\xe3\x31\x73\x0a\x77\xd8\xe0\xe8\x98\x64\x10\xe4\x1b\x23\x4d\x24\x3b\x26\xfd\x74\x4c\xf5\x19\x66\x47\x89\x03\xa2\xb5\xc7\xfd\xf2\x8c\xb3\x2e\x49\xdd\x16\x60\xce\x91\xd8\xf3\x7d\x12\xe3\x3b\x21\x91\xf2\x2c\xdc\x75\x01\x26\x17\xd6\xea\x7f\xfe\xfb\xbf\x0a\xb3\x06\xd0\xe9\x60\xf1\xa1\x92\xf8\x1f\xa4\x1b\x56\x0f\xd2\xdd\x96\xda\x46\x4c\x97\x9b\xa5\x59\x6e\xeb\x3a\x93\x80\xd9\xb2\xfa\xb4\xfb\xb2\xaa\x2c\x2f\xf9\x12\xb7\x99\xaa\xa0\xc5\x84\x15\xb7\xe4\x3b\xac\x11\x6d\x59\xfb\x1a\xea\xb0\x96\x78\x9e\xb0\x6a\x35\x25\x76\xca\xfa\x37\xf8\x79\xfa\xcb\xe7\xf9\x3f\x74\x2c\xf3\xbf\x18\x64\x3f\x7d\x85\x7d\x85\xbf\x04\xf9\xcd\xa1\x56\x4a\xd2\xb8\x52\x7a\x24\xed\x75\x5d\x51\xed\x5d\xc1\x9f\xe2\x6d\x5f\x89\x5d\xa1\x99\xc5\xf2\x24\x99\x1a\x81\x72\xf5\x80\xd1\x2f\xce\x43\x7a\x76\x0a\x82\xf0\xb4\xd1\x9f\xd0\x57\xc2\xbd\x45\xc9\xb6\x34\x48\xc0\xc4\x4b\xd6\x9b\xfb\x9a\x14\x1b\xc2\x3b\x18\xf9\xab\x17\x09\xf4\x30\xab\x1a\x3f\xd5\x2f\x4f\xd8\xcd\xca\x2b\x1d\x24\x07\xc0\xd0\x31\x36\x41\x9a\x1f\xfe\xaf\x03\x1f\xe6\x52\xc4\x1b\xef\x1e\x60\x04\x52\x88\xb3\x18\x78\x20\x25\xd6\x46\x50\x54\xb2\xfe\x0d\x96\x91\x96\xc2\xe4\x75\xb0\xce\x3f\x91\x40\xe0\xc3\x38\x44\xf7\x94\x8d\x80\x0a\x16\x24\xa1\x57\x59\x81\x6f\x6f\x49\x8a\x35\x6b\x18\x78\x49\xdc\x4f\x70\xbb\xca\x85\x1a\xb8\x8d\xf1\xbd\x0b\x7e\xcd\x52\xcc\x13\xe4\x82\x75\x7e\x63\x4d\xfa\xc4\x1f\x57\xb7\x13\x59\xe4\xa8\x76\x09\x09\x72\xbe\x4b\x50\x16\x2b\x3b\x23\x88\xe2\x0c\x33\x2f\x05\x31\x05\x81\x7c\x02\x2f\x59\x36\x34\x88\x43\x6f\x0d\x37\x28\xf4\x61\xb2\xb4\xc8\x68\x90\xca\x76\xfa\x14\x27\x7c\x91\x3f\x26\xb6\xbd\x36\x0b\x54\x6d\xaa\xed\x5a\x20\xdf\xb9\x38\xc8\x1c\x2b\x37\xcd\x6e\xb6\x01\xb6\xd8\xfc\x51\xf8\x10\x2e\x4f\xf2\x0e\xd1\x28\x17\xdd\x23\x19\xea\x12\xed\x9f\x03\x1c\xa9\x23\xb8\x48\xd1\xfb\x9b\x59\x61\x0b\xde\xcc\xb4\x2a\x9e\x1e\x8b\xa4\x5d\x64\x96\x61\x59\x04\x6d\xf5\xa1\xb0\x59\xf6\xc6\x23\x39\x3b\x6b\xc0\x23\xa9\x98\x1a\xb1\x64\x6a\xac\x43\x8b\x04\x2a\x0c\x92\x2a\xde\x08\x7d\x84\x20\x18\xa4\x27\x0c\x06\xc1\x5d\xcb\x97\xf9\x22\x76\xdc\x8d\x1a\x9f\x84\x29\x44\x7b\xc1\x93\x14\x3b\x6e\x09\x4c\x82\x22\xba\x94\xc9\xde\xf3\x82\x53\x47\x15\x16\xcf\x9a\xe8\x9f\xbf\xf1\x05\xb9\x7a\x45\x61\x8a\x2a\x8f\x4a\x92\x4d\xf9\x30\xff\xf2\x10\xc8\x27\x1c\x98\x83\x5f\xf8\x79\xf2\x0b\x57\xe4\x2f\x42\x0a\x74\x81\x84\x48\x5d\x2a\x73\x7c\xce\x8f\xe3\x25\x4e\x32\xa8\x0c\xdb\x55\xe0\x9a\xd0\x4e\x57\xc1\x9a\x30\xad\xd1\x1b\x6f\xbd\xd8\x8e\x98\x12\xa9\x0c\xe8\xfd\x83\xb1\x69\x15\x22\x71\xb4\xdb\x39\xee\xa1\x60\x51\x02\x19\x16\x25\xa8\xc1\xa2\xa8\x06\xbc\x3f\x3a\x4a\x69\x1e\x1d\xe7\xfb\xfe\x18\x86\x90\x8c\x09\xed\x10\x67\xfc\xda\x0b\x6d\xc7\xc5\xfa\xe7\x58\xaf\xb0\x27\x2f\x2c\x82\x57\xf2\xec\x19\xf9\x1f\xbf\x7b\x17\x52\x08\x90\xfa\x78\x5a\x75\x18\x10\x0e\x88\xc6\x3e\xb9\xc0\x87\xc1\x03\xc9\xbb\x50\x58\x01\x3d\xfa\xce\x8d\xe0\x1b\x90\xd8\x74\x62\x88\x06\x66\x0a\x2c\xc3\x76\x88\x9d\x33\xf6\x61\x8a\x13\x74\x6f\xff\x91\xdf\xb8\xba\x8f\xe1\x82\x1e\xfc\xed\x30\x2d\x7b\xb5\xab\xff\xac\x20\x2d\x5b\xf3\xa1\xd0\x36\xcd\xd5\xb4\xbc\xd8\x04\x1f\xbc\xed\x12\xaa\xcb\xc6\x1c\xd5\xa5\xb6\x7f\x2f\xe6\x53\xb7\xb6\xdb\x2f\xe6\xa7\x55\x8f\x80\xb0\xb3\x2c\xce\xe6\x2a\x00\x18\x03\x27\xc1\xf9\x21\xe3\xfc\x1a\x8d\x51\x41\xcd\x18\x95\x36\xc5\x0d\xa9\xc4\x19\x85\x30\xf3\x29\x60\x7f\xa0\xdb\xdb\x14\xe2\xe3\x42\xb6\x51\x99\xef\xb7\x19\x16\xad\xf4\xb2\x7d\xfe\xb2\xc2\x36\x20\x00\xbb\xa6\x06\x80\x34\x01\xd3\x66\x91\xcb\xe8\x3d\xa2\x0a\x21\x08\x87\x9c\xf9\xe4\xfa\xe6\x1e\xc3\xce\x74\x8f\x3c\x44\x67\x7b\x77\x1c\xa3\xd0\x4b\x50\xe0\x17\x71\x29\x33\x01\x4d\xe7\x71\xeb\x7a\x52\x0b\x15\xbc\xdc\x9c\x71\xeb\x82\x02\x2b\xc1\x5a\x7d\xfd\x02\x7c\x9f\x8f\x7d\x96\xe4\x6a\xf0\x99\xa4\x17\x48\x23\xf2\x50\xe3\x73\xb7\xbe\x8e\xbd\x2c\x85\xd7\x9f\xbc\x17\x43\xd4\xa7\xba\xfd\x46\x29\x2f\x05\x7c\x5f\x32\x43\x3e\x99\xe1\x4a\xb2\xe8\xfd\x58\x4e\x9d\xeb\xda\x6b\xa0\x7e\xcc\xa2\x27\x32\x36\x1b\xe8\xc5\xd7\xe8\xe6\x57\xb8\xee\x4e\x6f\xfb\x28\x03\xd4\xaf\xc2\xdd\x47\xe9\x1b\xe8\xc5\xe0\xdf\xb4\x1c\xf0\x6d\x74\xfc\x53\xda\xb8\xf9\x31\x17\x1e\xa8\xb8\x62\x15\xb6\x12\x35\x6b\x4c\x57\xcb\x64\xc5\xcc\x53\xf1\xe8\xbf\x44\xd4\x00\x96\x02\xf8\x36\x48\x31\x40\x11\xa5\xfa\xcc\xbb\x72\x5c\x77\xbc\x8d\x76\xae\x0a\x46\xbd\xe2\xc6\xaf\xa3\x5c\x77\x74\x6d\x89\xc6\x77\xbd\xeb\x5e\xe9\xab\xaf\xfb\xe9\x25\x46\xdd\x99\xb5\x2a\x41\x7b\x93\x1a\xb9\x2e\x37\x6e\x94\xf6\x66\xa6\xe8\xe5\x7f\x4b\x16\x0e\x62\x6f\x2e\x11\x1d\xb9\x91\x57\xc5\x4c\x5d\x96\xfe\x77\x4b\x4b\x5e\x2d\x54\x4a\x4f\x0a\xa5\xc6\x81\xc6\x5e\x78\xcd\xb4\xc8\xd4\x94\x15\x8a\xd7\x5b\x20\x05\x6d\xaa\x96\xd5\xb3\x5a\x35\xbe\x4f\xb3\x5a\x51\xdc\xdd\x6a\xa5\xb8\xf3\xdb\xc0\xff\xd4\xc1\xa9\xab\x8c\x3a\xd0\x7b\x9f\x24\x96\xd1\x16\x27\x3c\xf5\xe9\xd6\x57\xc3\xa6\x71\x35\x64\x35\xd9\x5a\x81\x11\x3f\x6a\x9a\xef\x9b\x72\xc6\x9d\x5a\x14\xab\x5a\x15\x95\x82\x37\x2b\x62\x2e\xd3\xdc\xfb\x17\xdc\xa2\xe4\x1e\x1c\x81\x2f\x82\xf4\x37\xf5\x33\x65\x39\x73\x32\xb3\xd5\x9f\xd1\xd2\xce\x12\xca\x59\x66\x16\xa9\xdc\x16\x76\x49\xd6\xca\xe2\x10\x51\xf8\x1d\xa4\x45\x76\xaa\xb4\x9e\x37\x38\xe5\x30\xda\xde\xa4\x18\x45\x50\xf4\xc7\xe5\x1b\x20\x0d\xd9\xad\xec\x11\x6d\x5e\xb9\xc2\x91\xb7\xd6\x43\x82\x5f\xd7\xbc\xb5\x22\x23\x3b\xf1\xd9\x66\x12\x14\xf7\x46\x02\x07\xbf\x1e\xd4\x51\xfe\x48\x35\xb5\x6a\x31\x52\xaa\x0d\xa8\x3e\x92\xea\xa8\x9e\x8d\x5b\x1d\x1d\x09\x15\x83\x8c\x52\x5b\x8c\x4f\x87\xf0\x0e\x55\xb5\xd6\x0d\x3b\x64\xe6\xc8\xd5\xa4\xb4\xa5\x31\xde\x98\xed\x8c\x46\x5d\xd5\xbd\x06\x5b\xb2\xc2\xaf\x3b\x54\x04\x1c\xf5\x68\xe8\x8d\xb7\xfe\x0d\x46\x7e\x87\x72\x46\x6d\x07\xe9\x5c\x7b\x90\xd6\x47\x46\x1d\xa1\x36\xc4\x84\xf9\xec\x61\x27\x4c\x0f\xfa\xc7\x03\x4c\x1c\x19\xa6\xbe\x88\xf3\x50\xb9\x7f\x3a\x10\x53\x2a\xcf\x76\xd1\x33\xd5\xa3\xf8\x66\xef\x53\xa3\xfb\x69\xda\xe4\x7f\x6a\x20\xd2\x19\xd2\x68\xd6\x5b\x8f\x63\x04\x12\x35\xbd\xa8\x1a\x1b\x44\xb4\x24\xb2\x28\xbb\xea\x73\x4c\x75\x9b\xce\x2a\xaa\x5b\x1a\x7b\xc9\x6f\x61\x10\x71\x0a\x13\xe1\x77\xa7\xfa\x89\x67\x8c\xa2\x96\xc5\x67\x75\x75\x3c\xf1\x56\xa3\x9d\xab\x26\x00\x7a\x9f\xc6\xa9\xd6\x0f\x3d\x21\xae\x1f\x69\xd4\xd4\xb5\x35\x1c\xbd\x69\x2b\x26\x39\x68\x57\xf7\xa6\xd3\xba\x30\xd8\x35\x96\x27\xd7\x91\xf9\x46\xc3\xb4\xe4\x5b\x94\x50\x35\x99\x34\x79\xac\x8c\xa7\x50\xee\x6f\x79\xbb\x5a\xe2\xe0\x2f\x38\x0e\x50\xff\x90\xd6\x4e\xd1\x77\x53\xd3\x18\xf2\x9d\x3b\x55\x45\x36\x0f\xcf\x89\x65\xa6\xe2\x70\xf3\xd1\x99\x4e\xf3\x90\x94\xfe\x22\xc6\xe9\x10\x6a\xd0\xea\xdb\xe8\xf8\x2b\xc2\xcb\xad\xfd\xfe\x2d\x4c\x12\xe8\x6b\x6e\xff\x48\x79\xa5\x75\xb7\xaf\x82\x2d\xf4\xc1\xbf\x33\xdd\xd7\xdb\x34\x2c\x91\xbd\xc6\x50\xc9\xd2\x59\x8f\x68\x08\x7a\xa3\x68\x36\x53\xa9\x5f\xa3\xa3\x4c\x95\xdc\x26\xe9\x1b\x6c\xd6\xd6\x35\x0e\xf1\x46\x11\xd1\x5a\x8b\x66\x15\x9f\x32\xd2\x38\x80\x56\x99\x10\x2d\x09\xc4\x1e\x6f\x94\x20\x79\xd6\x57\x1a\xdc\x53\xf5\x59\x17\x92\xec\x69\x2e\xc9\x9e\xd6\x24\xd9\xd3\x76\x49\x76\xba\xa7\xee\x93\x75\xb4\x0e\x0d\xaf\xfb\x74\xad\x41\x4f\xdd\xa7\x6b\x31\xdd\x75\x1f\x25\x55\x7a\xaf\x26\x06\xd1\xf5\x2d\xd9\x95\xf6\x24\x8c\xef\x57\xba\xcf\xf6\xbc\x47\x29\x7c\x18\xa6\xfe\x7e\x65\x63\x8a\x28\xf6\x28\x65\xf7\x50\x11\x9f\xc2\xee\xb3\xa7\x22\xdd\xa5\x97\xcc\x59\xd6\x7a\x6f\x4a\xad\xba\xec\xc0\x8c\x6f\xb3\x46\x55\x76\xd6\xa4\xca\x4e\xd5\xa4\xb8\x5c\xb5\x69\xe5\x83\xa6\xa7\x23\xcb\x21\xcf\x85\xdb\xa7\xae\x1b\xf3\x38\x1d\x63\x3d\x46\x27\x86\x0c\xa0\x39\x73\xf4\x91\x7e\xfa\xd8\xc1\x5a\x62\xaa\x9f\x3d\x75\xf5\x7a\xc0\x0e\x62\x6b\xf3\x83\x18\xec\x6a\x5b\x0c\x87\x7b\x76\x28\x75\xbc\x57\x72\x0d\x6b\x04\x58\x53\xdd\x86\x66\x85\x10\x8d\x9c\x53\xab\x75\xd2\xc9\x67\x6a\x57\xbb\xcc\x8d\xdd\xd0\xc4\x8d\xce\xf9\xc5\x08\x44\x75\x79\xe1\x78\xb3\xfa\x29\x85\xc9\xf1\xf3\x3b\x18\xe9\x1e\xd0\x5c\x7e\x8e\x71\x12\xdc\x64\x58\xab\xf6\xb5\xe9\xa4\x3f\x42\xcf\xbf\x07\x04\xcf\xa6\x9f\x5a\xfa\x55\x10\x05\xe9\x46\x7b\xdb\x4c\x2b\xad\xbd\x5e\x3b\x3c\x51\x1f\x03\x48\xd5\xed\xdc\x04\x1c\x37\x37\x41\xb6\xd9\xb9\x33\x75\x5a\x77\x7f\x9c\x35\x21\x79\x77\xd3\xd3\x0a\xa2\xd5\xc6\x71\x80\x43\xe2\xbe\xdc\xd8\x2a\x1c\x99\x04\x6e\x11\x86\xd7\x9e\xef\xe7\x85\x49\xca\xb6\x7c\xab\x40\x95\x59\x2e\x97\xeb\x1a\xb2\x8c\xfc\xac\x06\x8a\xd0\x5a\xe9\x6a\xb1\x41\x29\xae\x29\xfb\xfc\x62\x73\xc9\xfc\x29\x75\x99\x6d\xd8\x47\xe9\x06\xbd\x61\xa1\x09\xd7\x81\x89\x6e\x3f\x3b\x33\xc5\xcc\xd7\xc8\xf1\x4d\x35\xb2\x85\xe3\x72\x9c\xa5\x30\xb9\xf6\xf2\xed\x20\x17\x04\x11\x17\x04\x51\x7b\x15\xff\xbe\x57\x15\xb5\x5a\x40\x73\xdd\x49\x6f\x7a\xdb\x38\x84\xd7\x89\x87\x4d\xd8\x07\x66\x9f\x19\xd5\xb3\xad\x50\x1c\x9a\x20\x4a\x9c\x9a\xa1\x56\xb5\x15\xe6\xc3\xdb\xd0\xac\x75\xa7\x46\x9b\x49\x7b\x97\x46\x5e\x1c\xdf\x9b\x94\x77\x3e\x48\x79\x5e\x86\x37\x46\x46\xae\xd3\x4f\xbb\xc3\x6c\x6a\x17\xc5\xa6\x41\x6d\x2b\xb1\xd0\x0e\x65\xcf\xe8\x5a\x3a\x49\xa1\x79\xa4\x92\x87\x33\x66\x74\x2d\xfb\x96\x1c\xf2\x8f\x52\xf4\x70\xb6\x0c\x56\x76\xe4\x45\x08\xa3\x4d\xb6\xf5\x22\x56\xbe\x74\x45\x55\x87\x42\xbe\x2c\xcb\x17\xde\xe9\xe2\xd8\xde\xb9\x33\xb5\xae\x2d\x0b\x99\xb6\x12\x0b\x46\x61\x3a\xe7\x87\x06\x59\xd3\xc5\x59\x26\xdb\xcd\xcb\x13\x8e\xac\xcc\x1a\xb4\x30\x6a\x97\xa9\x78\xa7\xf3\x4f\x29\x0e\x5c\xe4\x1c\x59\x4e\xde\xbe\x06\xdd\xbf\xa4\xc9\xdd\x7a\x61\xa8\x06\xbc\x69\x6b\x64\x79\x34\x4a\xad\x94\x4e\xcc\x3d\x9b\x59\x7e\x4b\xd3\xce\xcb\x13\xd6\x80\x9d\x3b\x6b\xd0\x7f\x1b\xc1\x23\x74\x46\x15\x1a\xd8\xf6\x8a\x9c\xa6\xbe\x31\xd8\xb4\xd4\x45\xc2\x51\x2c\xf5\x91\x74\x44\xef\xdb\x49\xc2\xc7\x34\xbd\xf4\x7f\x4b\x70\xff\x9d\x7b\xda\x90\x63\xd4\xab\x9f\xb8\x5a\x08\xf6\xe1\x4c\xc2\x61\x7a\x5d\x20\x27\xb6\x1f\x7b\x86\xe8\xd2\xab\xab\xef\x5e\x49\x6d\x57\x23\x3d\x30\x9d\xa1\x51\x63\x48\x6b\x1a\x43\x22\x6a\x0c\x4d\x30\xcd\x85\xf0\x5f\xc4\xf5\x49\x93\x44\x68\xba\x1c\xd8\x27\x76\x09\x69\x53\x9a\x0b\xdf\x09\x9f\x1a\x41\x11\xd9\x57\x3e\xa8\x99\x00\x24\xb8\x49\x57\xf6\x3a\x88\x37\x30\xb9\x4e\xb3\xa0\x32\x4b\xab\x37\xdb\x6b\x21\x3e\xdd\xa7\x2a\x11\xbc\x43\x38\xf0\x30\xf4\xaf\x49\xfe\xf1\x1a\x85\xb5\x1a\x29\x9e\x69\xaf\x98\xe2\x25\x5d\xfd\xb6\x19\xce\xbc\x70\xd1\xb1\x9a\xd7\x41\x7a\x4d\xdf\x34\xa9\x70\xf9\x74\xaf\xaa\x97\xaf\xab\x1b\x31\xb2\x46\x3b\xf7\xb4\x09\xf6\xac\xdb\x0a\xe7\xa0\x13\xab\x2f\xa8\xe0\x5d\x2c\xa9\xbc\x18\x55\xcc\x75\xbf\x62\x0a\x2b\xf6\x2b\x22\x6e\x4b\xa5\xa8\xb0\x83\x8c\x16\x6e\xaf\x2d\xad\x40\x81\xad\x1b\xc7\x80\x14\xc1\x28\x6e\x74\x41\x45\x80\xbf\x0e\x7c\x18\xe1\x00\xdf\x5f\x67\x49\x68\xb2\xa9\x99\x69\x62\x23\x75\x9d\x5a\x49\x14\xf2\xb3\x94\x31\x28\x70\x73\xc4\x73\xa2\x66\x74\x6c\x8d\x41\x4b\xe6\x86\xe0\xc4\x0d\xd0\xe9\x87\xeb\xe6\xf9\xa9\xd9\xd9\xa1\xe9\x66\xf1\x34\x31\x90\x38\x04\x73\x79\x0f\xf9\xa1\xd6\x3e\x69\x73\x51\xb4\x7e\x5f\x59\xa2\xf6\x49\xdd\x06\xb3\x1a\xed\xdc\x79\xbb\x24\x61\x81\x6f\xf9\xb4\xe9\x25\x63\x4a\xf5\xd1\x37\x7e\xe0\x86\x2b\x1b\xbd\x73\xe7\x4d\x09\x60\x04\xdc\xaa\x3b\x31\xc4\xc1\xa2\xb5\xfe\x1c\xb8\x3c\xa8\x80\x2f\xbf\x49\xa0\xe7\xaf\x93\x6c\x7b\x53\x24\xa9\x37\x00\xbf\x67\xc6\xa0\x83\xfd\x20\xf2\x8b\x1a\x14\x7e\x14\xe2\x7e\xb7\x56\x26\x81\x57\xcd\xd0\x81\xdd\x60\x03\x09\xac\xda\xe5\x09\x0a\x1b\x10\x58\xd7\x7b\x72\x97\x9a\xe1\xdd\x76\x47\x51\xd2\xc3\x22\x29\x1d\xf6\x3c\xc7\xac\xb3\xbf\xfe\xb4\xc9\x5d\x7f\xd6\x00\x73\x6b\x40\xc9\x6a\x8a\x81\xf4\x77\x01\x3a\x02\xba\x51\x09\x1d\x01\xeb\x78\x46\x21\xbb\x28\x93\xb2\xc2\x76\x52\xd6\x7c\x2e\x37\x20\x16\xc1\x3a\x62\xd1\xe0\x8c\xac\x61\x07\x46\x56\x82\xd9\x31\xbe\x85\x78\xbd\xb1\x5b\xf1\x81\x48\xe5\x49\x96\xac\x02\x1d\xa8\x86\xee\xb3\x17\xa6\x8f\x27\x63\xfa\x78\x55\x4c\x1f\x09\xbc\x44\x47\x7d\xa7\x00\x2f\x91\x00\x49\x8a\xa6\x2c\xce\xce\x15\x70\x24\xf9\x9c\xf9\xf4\xa0\x98\xe4\x75\x6c\x4f\xbc\x61\x60\x66\x3e\x78\x81\xa2\x28\x1d\x33\x57\xa1\x65\x80\x25\x7e\x30\x30\xdd\x2a\x80\xb3\x41\x08\x29\xca\xf0\x35\xba\xbd\xf6\xcd\x5c\x08\xe6\x90\xde\x4a\x5f\x3a\x31\x77\xaa\x21\x62\xdb\xfd\x6d\x5a\xa8\xd8\x06\x7f\x5b\xa2\x4e\xd7\xe2\x95\xd9\x1f\x86\xd7\xfc\x44\xd4\xa3\xf0\xde\x24\xc8\xf3\xd7\x5e\x8a\x95\x3e\xcf\xda\xdd\xe6\xce\xa8\x3d\xae\x39\x0d\x17\xda\x81\xc0\x38\xbe\xce\x57\x87\x3c\x12\xfc\x6a\xcb\x50\xf0\xc7\x3a\x43\x04\x3f\x4a\x27\x50\x06\x15\xed\xf4\xd0\x75\x11\x5e\x2b\x7a\x88\x5f\x6c\xae\x19\x7f\x4a\x5b\xa1\xce\x95\x39\xe4\x78\xf5\xa9\x4f\x1b\x0d\x8c\xb6\x2e\x7a\xab\x9d\x50\x93\xe6\x0d\x4d\x01\x91\x20\xa0\x40\xd6\x60\x12\x7a\x61\xfe\x1b\x62\x3f\x77\xa9\x6d\x9b\xc0\xa6\x97\x38\xcd\x45\x4e\x05\x3a\x75\xdd\x1d\x55\xb8\x83\x1a\xcd\x65\x95\x34\x8c\x91\x01\xd4\x40\x2f\x4e\x4e\xe5\xd4\x03\x40\xe4\x81\x5b\xa3\x28\x3a\x26\x3e\x38\x50\x01\x85\x25\x7f\xbf\x4d\xa5\x04\x5b\x9b\x0b\xce\xf0\x77\xb6\xa9\x97\x3f\xd5\x88\xd1\x49\x7d\x62\xcd\xf2\x89\x35\xab\x4c\xac\x99\x3c\xb1\x14\xe3\x2b\x87\xa3\xc0\x46\xd1\x1c\xfe\xbe\x77\x12\xf8\xa8\x8d\xc8\xb1\x7b\x15\x19\xa8\x88\x16\xb5\x3a\xd7\x5c\xd4\x24\xc1\x9a\x37\x40\x2d\xff\x59\x4d\x3b\x52\xaf\x97\x41\xe4\xd4\x5e\x51\xdb\x00\xa8\x71\x88\x85\x75\xa3\x21\x4d\x28\x04\xc6\x7c\xfa\xf1\x15\xa2\xcb\xf6\xe4\xc9\x74\x06\xfc\x1a\x37\xc9\xc9\xca\xd2\xe6\x1d\x76\xf3\x57\xdd\x78\xfe\x1d\x34\x9c\x1f\x48\xb9\x45\x09\xa1\x3f\x0c\xcb\x9c\x1b\x02\xf7\x8a\x8c\xeb\x00\x8c\x32\x35\x72\x7a\x69\xe1\xcc\x87\x24\x20\x75\x37\x0d\x08\x28\x95\x6d\xab\x3b\xd7\x5e\x47\x54\xfa\xb5\x32\x98\x8d\x7c\xa4\x0e\x94\x21\x86\xb1\x6d\x6a\x61\x6c\x2c\xa7\x56\x1d\x37\x27\xca\xcd\xd4\x4f\xa0\xb1\x50\x4b\x71\x51\x1c\xcb\xc4\x28\x65\xad\x66\x9a\x96\x93\xd9\x1c\x85\x09\x7d\xf0\x3a\x18\xc6\xad\xad\x1e\xaa\xe3\x19\x6b\x80\xa5\xc9\xd4\x2d\x76\x1f\xd9\x19\xdd\x92\x4a\x61\xc9\x1e\x27\x6d\xa2\x84\xec\xc1\x2d\x53\x8c\x09\x3e\x7d\x41\xb5\x53\x76\xb0\x84\xd7\xd5\x83\x29\xbe\xab\x04\xf1\xe7\xb0\xf2\xee\x81\xbe\xfe\xf2\xd5\x0f\x3e\x20\x66\x59\x60\x77\x96\x0e\xd6\x28\x0c\x69\xa6\xae\x4a\x2e\x70\x1a\x31\xdd\x7b\x57\xba\x1d\x5e\xad\xc2\x94\x66\xce\x8c\xf6\x0d\xb3\x1a\x28\x42\xc0\xf9\x23\xff\xe0\x6a\x24\x78\x4e\x65\x8f\x86\x67\xaf\x5e\x7c\x0f\xbe\x47\x89\x2a\x5c\xbd\x28\xf1\xea\xaa\xf5\x99\xff\x47\x95\x20\xf6\x44\x33\x7f\x59\x27\xb4\xfb\x01\xac\xd6\x4a\x23\x58\x81\x2d\xa7\xe1\x95\xd3\x01\xa2\x25\xae\x7a\x6a\x19\x9c\xfd\x4a\x13\x75\x1b\xd3\x55\x13\xaf\xdc\x00\x26\xeb\xcf\x1a\x4c\xd6\x05\x48\x7f\xa8\x32\x60\x23\x85\x01\x3b\x90\x0c\xd8\x29\x7b\xa2\xec\xa6\x94\xd9\x9d\x1c\x37\x51\x13\x88\x92\xdb\xdd\xac\xdb\x55\x3c\xfe\x52\xb5\xa2\x48\xfc\xf9\xd5\x17\x28\x8a\x48\x52\xc6\xe3\xd1\x88\xd2\x1f\x65\x57\x2c\x23\xf8\x06\xa4\xd5\xab\x9d\x0d\xe5\xe9\xe1\x2d\xe5\xa1\x6c\x29\x0f\x55\xe8\xf7\x52\x27\xb7\x61\x9c\x7b\x02\x46\x79\x44\xe8\x3f\xc7\x94\x72\xd4\xae\x98\xdd\x13\xad\xd9\xbd\x3e\xa5\x16\xa7\x67\x86\x06\xf9\x94\x59\xe4\x3f\x6d\x05\x08\xdf\xfd\xe2\x9e\x4f\xf6\xb6\xcf\x77\x94\x1e\x94\x9c\x45\x37\xd9\xcd\x4d\x18\x44\x77\x5f\x17\x47\x10\x51\x8c\x02\x5f\xb8\x75\x3d\xad\x84\x62\xa8\x9e\x99\x19\x3c\x73\x2a\x3c\x23\x60\x94\x9a\x30\x0e\x9f\x4f\x1f\x8e\x62\xb5\xf9\x40\x3e\x97\x85\x88\x39\xdd\xf0\x39\x34\x27\xf8\x21\x83\x19\x4d\x7c\x13\x9e\xda\x1b\xf5\x95\x71\x33\x26\x1a\x48\x9b\xca\xfb\xf1\xea\x15\x84\xa0\x39\xb8\x64\x68\xb2\xb0\xbe\x14\xe8\xa4\x29\x5b\x94\x40\x10\x44\xb7\x28\xd9\x7a\x15\x9e\x28\x35\x5b\xf5\x30\x34\xb5\xee\xba\xa6\xcf\x36\xe4\x76\x0d\x47\x4f\xda\x81\x07\xb6\x9f\xa8\xd8\x3e\x2d\xfb\x51\xec\x1a\x8a\x5f\x92\x1c\x06\x67\xf0\x3a\x4e\xd0\x1a\x92\x0e\xbe\xce\xd7\x62\xb4\xbe\xa7\x32\x59\x5c\x42\x9a\x2f\xd2\x4e\x42\x5a\x07\x6b\x56\x29\x9c\xa9\x52\x41\x89\x0a\xd0\x90\xf1\xb9\x2f\xd8\x50\x43\x62\x65\x3b\xe3\x6d\xd7\x78\x8b\x0e\xde\x48\x45\x8e\xa7\x8c\xed\xda\x26\xa7\xf6\x1b\x64\x13\x4b\x56\x0f\x2f\x43\x07\xd9\x9a\x9c\xd4\x66\x26\x35\x73\xaa\x4b\x31\xef\xb3\x05\xfa\x76\x75\x85\xb0\x17\x2e\x34\xc9\x2f\xa3\xc6\xc4\x9b\xb5\x53\x6f\xcf\x10\x58\x35\x5d\xcb\xec\x09\xc7\xd3\xb5\x98\x21\xe1\x78\xba\x37\x71\x88\x7c\xa6\xc1\x97\xf8\x69\x07\x5d\x6f\xdf\x6e\x18\x16\x3b\xb4\xb9\x2b\x1e\xf2\xec\x98\xf5\x62\x86\x56\x6c\x30\x43\x8c\x67\x07\x1c\x32\x60\xa2\xb7\x37\xe4\xbd\x6b\x08\x7e\xa8\x0c\x79\xec\x51\xad\xa7\x41\xbe\x90\x2d\x51\x6a\x06\xca\x2d\xf4\x83\x6c\x0b\x44\x67\x0f\x05\xda\xa6\x9f\x5f\x5a\x84\xd0\xd3\x5a\x7d\x99\xff\xc7\xa5\x12\x89\x97\x52\x12\xf8\xf6\xae\x84\x9f\xeb\xcf\x49\xa5\x0e\x94\xfd\xce\x5a\x51\x02\x2d\xc0\x20\xd7\x3b\xd7\x62\x9f\x8c\x26\x63\xa0\xba\x5a\x2e\xbb\xe4\xfa\xac\xdb\xfc\x95\xec\xfa\x96\x9a\x08\xa2\x0e\x08\xd2\xda\x9f\x3c\xf7\x41\xee\xd0\x2c\x22\xed\xb1\x56\x3f\x45\x94\xab\xa6\xda\xa5\xa3\x66\xe7\x60\x87\x0a\x70\xa3\xbc\x5c\x01\x56\xbc\xb6\xf0\x0e\xd9\x7f\x2d\x10\xfd\xfb\x0c\x7a\x57\x71\x6e\xa2\x75\xf8\xcc\x74\x0e\x9f\x56\x74\xcd\x4e\x4e\x4e\xc9\xdb\xa9\xdf\x8c\x91\xb4\x19\xa3\x9a\x3b\x74\x64\xad\xbe\x9c\x7d\x09\xbe\x2f\x5e\x06\xdf\xd1\x97\xd5\x68\x22\xa3\x16\x9e\x67\xeb\xdc\x6a\xa1\x65\xb6\xce\x74\xf8\xa7\x65\x40\x3e\xde\xac\x7e\x24\xd9\x4a\x78\x43\x1c\xc9\xea\x4c\xa5\x81\x01\x92\x94\x16\x63\x8b\x62\x0b\xdd\x86\xc8\xc3\x57\x88\xd1\x3c\xb1\xc3\xb9\x7a\xb1\x44\x19\x2a\x07\xe6\xf7\xcc\x23\x5d\x5f\x4e\x29\xf9\x2d\xf5\xa9\x9c\x66\x31\x2f\x39\x1f\xbd\x57\xd9\xed\x6d\xf0\x96\x95\x2a\x5e\x30\x2b\xb1\x7c\x43\x47\x16\x92\xc5\xab\x32\x22\x74\xda\xc2\xf6\xfe\x80\xd8\xb4\xca\x70\xae\xbe\xfc\x13\x06\xe1\xfb\xc8\xaf\x84\xef\x13\x64\x28\x6d\xf8\x3e\x21\x4d\xd2\x70\x47\xc8\x0c\x16\x59\x07\xee\x8b\x8e\xac\x13\x3a\xcd\x7f\x5d\x85\x69\x51\x84\x25\xf6\xc4\x13\x54\xc1\xe5\xb5\xfa\x5f\x06\x04\x88\x9d\xf5\x17\xb4\x87\xc3\xe4\x7c\x78\x14\xd2\x3f\x01\xd0\x6a\x7f\xcd\xae\x0d\x53\xb3\x3f\x37\xc5\xac\xa3\x1a\xd0\xbf\x07\x1e\x42\xa9\xab\x95\xf5\x3e\x2a\x75\x43\x0c\xeb\xe9\xc4\xb4\x52\xcd\x08\x8d\x07\x43\x1f\x50\xec\xa0\xea\xc4\x76\x9e\x52\xa0\x08\xac\x16\xef\x34\x27\xac\x4b\x8f\x6a\x72\x47\x81\xad\xcb\x41\x68\x0e\x11\x4b\x1d\x5e\x58\xd7\x1c\x04\x75\x83\xf5\xe7\xb4\xb6\x79\x9a\xb3\x9a\x65\xc4\x3e\x44\x39\x24\x1a\xca\xa1\xf8\x35\x0d\x81\x91\x3d\xa6\x93\x92\x25\xb0\x3d\xca\x4f\x37\x96\xc9\x81\xc6\x32\xe9\xd7\xc7\xeb\xae\x63\xa9\x2e\xa7\xc7\x22\xa9\x97\x6c\xb0\x48\xca\xa0\x37\x0d\x14\x26\x1f\x8d\xee\x90\xc0\x1a\xd8\x23\x7d\x44\x7a\x21\x23\xa3\xd0\x07\x04\x65\x06\x50\x17\xbc\xde\xef\x48\x01\x6f\xc8\x98\xd3\x3f\x7b\xf8\x1b\xbb\xf3\x9b\x70\x14\x44\x25\x10\x0e\x57\x9d\x7d\x86\x30\xda\x0e\x8e\x04\x7a\x86\xf5\xf6\x53\xa9\x4d\x90\x31\x4d\xc0\xde\x76\xee\xac\x01\x2b\x63\x40\x20\x60\xc5\x4c\xd1\x85\xc9\x93\x39\x5a\xa0\xe0\x3c\x82\x2a\xbc\x00\xdb\x20\x02\x4b\xc0\xb4\xe1\x16\xe0\x31\x55\xb1\x92\xdb\xb7\x0d\x72\xcc\x05\x5b\xef\xed\x7e\xc5\x79\x6f\x8d\x8b\x23\xdb\x55\xdf\x82\xbc\xd7\x30\xf1\xee\x60\x17\x38\x35\x0d\x62\x47\x99\x24\x70\xaa\x36\xed\x0c\x77\x30\xa9\xa6\xa0\xe0\x96\x23\x52\xdc\xb1\xe0\x49\xaf\x4e\xd3\xe6\xf4\x48\xe9\x04\x6b\x81\xb7\x16\xce\xb1\xbd\x59\xb4\xfa\xb3\x46\x25\x2d\xc0\xd5\x43\xd6\xd2\x04\x9e\xba\xa9\x7f\x9f\x48\xd7\x0f\x4d\xdc\x75\xd8\x21\xe8\x4f\xdc\xd5\x73\x28\xd8\x39\xd9\x08\xc0\x33\xa4\x0d\x79\x20\xe0\xd8\x2a\xce\x69\xd2\x0e\x79\x97\x6f\x48\x75\xc9\xb7\x63\x92\x4c\xc3\x99\x28\xda\xe5\x83\x07\x30\xc3\x6b\xd3\x07\xf9\xda\x0a\x0c\xd6\x96\x90\x8d\x03\x67\x2a\x4a\xf7\xf6\xca\x92\x79\xab\xbe\x39\x14\x6c\x3f\x5f\x7b\x41\xcb\xda\x7b\xa0\xd6\x98\x2c\x47\xed\xb1\x39\xda\xb9\xa7\x8d\x92\xfe\xa3\x0b\xe2\xd6\x27\xd6\xfe\xb2\x77\xde\xcc\x06\xd8\xd5\x0f\x5e\x3a\x68\xee\xc4\xbf\x04\x82\x07\xed\xed\xbf\x64\x80\xf7\x4b\x06\x30\xc3\x56\xd7\xc9\x00\x0d\xde\xdf\xbf\x64\x00\x03\x19\x40\x5e\x4c\x7f\x1d\xfb\x83\x1d\xfb\x6a\x73\x8d\x30\x9b\x4c\x52\x14\x58\x68\xaf\x1c\x0d\x9e\x0e\x9e\xa5\xd0\xcc\xbb\x5b\x4b\x52\xe0\xa1\xd1\xe0\x4d\x10\x86\x80\xa0\xe6\x03\x0f\x03\xbc\x81\x2c\x8d\x36\xcb\xa7\x31\xf0\xf8\x77\x49\x5a\x6d\x02\x3d\x0c\x7d\x45\x82\xc0\x68\xe7\xce\x55\xa6\x85\x5e\xb0\x1b\x06\xd9\xa3\xa6\xbd\xfa\x9e\x07\xda\xcf\x7b\xa6\x43\xea\xf3\x4c\xf5\xe4\xbe\xa0\x25\xe6\x1e\x18\xc6\xdd\xf3\xe7\x9a\x48\x95\xca\xb2\xb4\x64\xbf\xfc\x91\x06\x66\x25\xfe\x48\x13\xe9\x2f\x68\xcf\x04\x28\xba\xa6\x46\xfe\xfb\x5e\x45\xe1\x83\xf2\x4c\x35\xcc\x73\xed\x42\x7d\x3d\xef\x97\x17\xc0\xc2\x65\x2d\x23\x44\xda\xaa\x5a\xa0\x1e\xa7\x47\x89\x06\x9c\xd7\x00\x3e\x8a\x3b\xf3\x2e\xd1\x80\x8a\x36\x3c\xdd\x88\x40\x20\x45\x05\xce\x1b\x63\xfe\xa6\x93\x7c\x8c\x1b\x83\xfe\x3e\xcb\x9f\x68\xc5\x66\x3a\x48\xe8\x59\xe3\x84\x6a\x85\x75\xea\x8b\x4f\xdb\x11\x2c\x85\x7c\x38\x4b\x42\x18\xad\x89\xcb\x94\x42\x42\x95\xbf\x95\x04\xba\xa2\x2a\xc2\x51\x9b\xf8\x2b\x43\x16\x54\xa1\x46\x34\x29\x6a\x64\x06\xa9\x7b\x78\x2e\x73\x3d\x4a\xd4\x80\x21\x6b\xf3\xbd\x68\xc1\xb5\x5b\xc5\xc3\x87\xae\xf5\x2f\xf7\xbd\x0a\x5f\xeb\xdf\xcc\xa1\xb8\xb5\xf6\x19\xe0\x41\xf8\xc2\xfb\x57\x60\x18\x9a\xad\xfe\xe5\x0f\xc3\x1b\xbe\xcf\x4c\x1f\x22\x3f\xad\x7f\xf9\x8c\x63\x6a\xe8\x20\xca\x07\x55\x6d\x7a\x24\x11\x83\x87\x08\x1b\x3c\x9b\x99\x87\x0d\xce\x1b\xf3\x6a\xba\x07\x00\xcd\xdb\x53\x65\x9e\x5c\xe8\xcb\x59\x2d\x51\x46\x19\xfa\x72\xd6\x10\x79\x70\xc0\xd0\x17\xd0\x18\xfe\x02\xfe\x0a\x81\xf9\x93\x84\xc0\x00\x29\x0c\xe6\x6c\x58\xb2\xb1\xf7\xc4\xd1\xf5\x54\x09\xfd\x7b\x38\x69\x1e\x95\xd1\xff\xa1\x5d\x66\x03\x36\xb6\x22\xbb\x7e\x18\x23\xa8\x6b\xd4\xbe\x63\xf9\x14\x06\x4c\x16\xf5\x3f\x8c\xf1\xd2\xb4\xe9\x43\x18\x2e\x49\x31\xfa\x30\x46\x4b\xdd\xa4\x0f\x61\xb0\x24\x2d\xf2\xc3\x18\x2c\x75\x93\x3e\x84\xc1\x1a\x2c\x22\xe4\xe9\x0c\xd6\x61\xc2\x46\x9e\xc2\x60\x51\xfb\x44\xfa\x61\x0c\x53\xb5\x31\x1d\x06\xe8\xe9\x44\xe8\x9c\x19\x52\x6b\x4a\xd3\xa9\x88\xd0\x39\x53\xc7\x3f\x1e\x3e\x42\x07\x3c\xa5\x28\x1d\xf0\x57\xb4\xee\x13\x0e\xdb\x01\x52\xe8\xce\xa3\x73\x7d\xba\x71\x3d\x43\xf3\xcf\x01\x09\xaf\x27\xfe\x1c\x86\xf6\xd3\x14\xe6\xf4\x15\x4e\xa0\xb7\x2d\x38\x3f\x35\x8c\x9f\xdd\x59\x3e\x97\xcb\x65\xdc\x81\xe9\x73\x54\x65\xfa\xec\x8b\x76\x7b\x13\xa2\xf5\x6f\xbf\x67\x08\xc3\xaa\x8f\x3e\xa6\x78\xa3\x0b\x70\x99\xe2\x04\x45\x77\x0f\xd3\x30\xeb\xf2\x84\x15\x27\xc6\x83\xd5\x6a\x59\xc7\xdf\xb7\xba\x91\x87\x32\xcc\xc8\xce\xdc\xa1\xca\xd8\x29\x05\x6a\xbb\x02\xf6\x55\x39\x48\x1d\x39\x4f\x9b\xe1\x1d\x9a\x59\x4f\x95\xd8\x97\x85\xe7\x64\xd2\x02\xca\x32\x3c\xa0\xfc\xf9\x4c\x00\x80\xc6\x6e\xe4\x42\x0e\x00\x8d\x4b\x40\xf9\x8b\x37\x41\xe4\xa3\x37\xe3\x5f\x7f\xc8\x7f\x2f\xbd\x0b\x6c\x5b\x37\x08\xe1\x14\x27\x5e\x6c\x39\x17\xf9\x0b\xe1\x92\x5d\xbc\x41\x6f\x09\xda\x3c\xae\x43\xd0\x07\xec\xa2\x08\x41\x9f\x92\x6b\x1c\x82\x3e\x59\xa6\x2a\x98\x79\x1a\xb7\xa8\x87\x99\xc7\xed\x30\xf3\x12\x1a\x1f\xa0\x00\x3c\x16\xfb\xf2\x73\xba\x97\xeb\xb0\xe6\xd3\xfe\x58\xf3\x81\x11\xd6\x3c\xe9\x40\xb8\x14\xf8\x57\xef\x20\xb6\x2d\x86\x7c\x6c\x39\x17\xdd\x99\x59\x49\x8f\xd0\x00\x51\x13\xc0\x79\xb7\x40\x59\xde\x0b\x7a\x1e\xc9\xd0\xf3\xa8\x06\x3d\x2f\x74\x77\x1b\xea\x7c\x34\x4e\x31\x8a\xbf\x4f\x50\xec\xdd\x79\x74\x24\x58\x3f\x79\x76\x34\x66\x3c\x30\x57\x0c\x96\x3e\x5f\x2d\xb6\x45\x47\x37\x6f\xec\xd2\x7a\x9e\x40\x70\x8f\x32\x90\x66\xec\x8f\x37\x5e\x84\x01\x46\xc5\x4e\x6a\x1d\x41\x61\xa3\x03\x97\x70\xbb\xb2\x8e\xaa\x43\x40\xf6\x48\xb2\x21\xc2\xed\xea\x73\xeb\x22\x1c\xaf\x51\x74\x1b\x24\x5b\x1b\xbb\x62\xfd\x3f\x9a\x2c\x97\xcb\xe8\xd9\x33\x9b\x83\x20\x2e\x97\x4b\xf8\xb9\x37\xf6\x7e\xf5\xde\xda\xc2\x47\xb3\x24\xb4\x1d\xf7\x8f\x2d\xc4\x1b\xe4\x2f\xac\x2f\xbe\xfc\xee\xcb\xab\x2f\xad\x5d\x75\x38\x9d\x3f\xd8\x8a\x0b\xd1\x9a\xb4\x7d\x19\x14\x47\xa6\x6d\x9d\x58\xce\xce\x59\x78\xe3\x18\xa5\xb8\xfe\xf1\x7f\xbe\xfa\xf7\xcb\x71\x8a\x93\x20\xba\x0b\x6e\xef\xed\x3f\x3c\x46\x78\xb1\x73\x5a\x4b\x19\x27\x30\x44\x9e\x6f\x7f\x34\x71\x76\xf5\x91\x7f\xfe\xcf\xe7\xff\xbf\x36\xf2\xf2\x6c\x31\xa5\x03\x68\x06\xfd\xaf\x62\xfc\x4b\x33\x79\x71\x3e\x75\xd9\x36\xc3\x50\xff\xdd\x62\x2b\x5a\x4c\xeb\x7c\x00\xa7\x07\x01\xb8\xef\x4d\x3a\x78\x48\xa6\x19\x06\xd0\xa8\x8c\x37\x6a\x8e\x7c\xee\x11\x37\x69\xec\x4b\x57\x05\x45\x0d\x8e\xf7\xda\x1b\xa1\xfb\x20\xb5\xe9\x10\x51\xfb\x50\x5c\x36\xe6\x98\xdb\xea\x20\xdf\xa6\x10\xd1\xd1\x25\xde\x88\x1a\xa8\x10\x68\x3e\x6a\xa1\xf8\xac\xbe\x29\x05\x73\x6b\x08\x3f\xb5\x2f\x17\xb8\x93\xa3\x9d\xfb\x38\x40\x88\x4d\xeb\x53\xcf\x02\xfd\xa4\xe2\x4e\x87\x0f\x03\x6d\x56\x4d\xba\xa9\x25\x5a\x03\xe9\xe1\xa2\x86\xf6\x22\xf8\x3c\x5c\xb5\xba\x50\xf2\x3e\x68\xbd\xf6\x01\x78\x64\x46\xc9\x86\x58\xa9\x01\x03\x8a\x9a\x96\x64\xda\xb8\x24\xcb\x80\x96\xce\x4b\x32\x6d\x59\x92\xaa\x58\x99\x6e\x4b\xb2\xd1\x1e\xc8\xcc\x81\x69\x8b\x39\x50\x68\x20\xcf\xea\xb5\xea\x15\xda\x23\x2d\x79\xd4\x4c\x31\xfb\xd7\x28\x3f\xad\x51\xde\xd3\x9f\x35\x6a\xa1\x11\x7d\x6a\x38\x0b\x7d\x10\x16\x1a\x99\x4f\x4b\xf1\xbf\x2d\x19\xb4\x92\xfe\xf9\x12\x51\xd8\xf5\x14\x7c\x85\xb2\xc8\x17\x92\x32\xfb\x9b\xc1\xff\x54\x26\xeb\x3d\x58\x4c\x39\xe3\xe4\x20\x7c\xa3\xe7\xfa\xf4\x53\x83\x08\x5f\x23\x7e\xd4\x5e\x04\x9c\x32\xd7\xf1\xc3\x9a\x1e\xe7\x0d\x5c\x96\x55\xd3\x61\xa8\x60\xaf\x44\x12\x7b\x65\xa0\x60\xaf\x64\xdb\xb6\xe3\xa6\x4b\xa4\xb5\x2b\x76\xe4\xaf\x54\xdb\x08\x51\x7f\x1b\x61\xd8\x9f\x8f\x32\xd8\x8b\x8f\x92\xb6\xfe\xf0\x84\x94\x9e\x6c\x15\xf4\xaa\x56\x41\xc9\x68\x94\x1a\x71\x48\xb2\x81\x5d\x9c\x9e\xef\x61\x4f\x62\xc4\x92\xe7\x64\x32\x9e\x1d\x96\x08\x91\x21\xc3\xfb\x41\x1a\x87\xde\xfd\x02\x44\x28\x82\x17\x3d\xb8\xaa\x0d\x29\xe5\x35\x9c\x34\x16\x61\x8e\x2c\x18\x64\xe4\x20\x06\xd1\xef\x91\xd6\x53\x62\x04\xd9\xa4\xd5\x37\xd3\x1a\x8d\x30\x32\xde\x88\xbb\xa1\x27\x28\x45\x05\x85\x82\x2a\x91\xa7\xb3\x06\x4a\x3a\x6a\xd1\x68\x42\xa0\x5e\x15\x2c\x6a\x54\xea\xec\x71\x0d\x99\xba\x19\xed\xa2\x65\x44\xd9\xb9\x73\xff\xd8\xb9\x3f\xcf\x27\xbf\x38\x17\x7f\x3b\x39\xf9\x18\xa4\x28\x4b\xd6\xf0\x5f\x5e\x1c\x07\xd1\xdd\x4f\x3f\x7e\xb7\xcc\x27\xce\xf8\xd7\x74\xbc\xf5\xe2\xbf\xfd\x6f\x00\x00\x00\xff\xff\x7f\xdc\xe3\x12\x97\x84\x02\x00")
+var _mainJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x6b\x63\xdb\xc8\x91\x28\xfa\x57\x20\x4c\x62\x03\x23\x90\x22\x25\xd9\x33\x03\x0a\xd2\x71\x6c\x4f\xe2\xb3\x1e\xdb\xeb\x47\x76\xcf\x51\xb4\x0a\x44\x82\x22\x66\x20\x80\x01\x40\xdb\x8a\xc4\xfd\x1d\xf7\xc3\xfd\x75\xf7\x97\xdc\xaa\xea\x07\xba\x1b\x0d\x3e\xe4\x47\x32\xd9\x99\xdd\x58\x44\xa3\xd1\x8f\xea\xea\xea\x7a\xf7\xce\x74\x91\x8f\xeb\xb4\xc8\x9d\xc2\x4b\x83\x2a\x28\xfd\x1b\x59\x32\xf6\x92\x20\xf7\x6f\xd2\xa9\xb7\x53\x9d\x26\x67\xec\x57\x4a\xbf\xde\xc7\xa5\x53\x47\xae\xa8\xea\x46\x51\x7d\x3d\x4f\x8a\xa9\x53\x26\x7f\x5b\xa4\x65\x72\xef\x1e\xff\x31\xc2\x6f\xf2\x7b\xf7\x6a\xbf\x4c\xea\x45\x99\x3b\x35\x34\xba\x33\xf0\xb1\x7c\x26\xca\x66\xbc\x0c\x5b\x8d\xa3\x3c\xf9\xe0\x3c\x2d\xcb\xa2\xf4\xdc\xc7\x71\x9e\x17\xb5\x33\x4d\xf3\x89\x73\x55\x4c\x16\x59\xe2\xdc\x77\x77\x93\x5d\xf7\xbe\xeb\x8f\xea\x59\x59\x7c\x70\xe2\xfe\xb8\x98\x24\x91\xfb\xd3\xcb\x27\xef\x9e\x3f\x3d\x7f\xf1\xf2\xed\xf9\x8f\x2f\xdf\xbd\x78\xe2\x06\xf1\x12\xdb\xcb\x22\x1c\x7b\x74\x93\x7c\x9c\x17\x65\x5d\x85\x37\xcb\xe5\x08\xe7\x70\x3a\x38\xeb\x8f\xe3\x2c\xf3\xb2\x3e\x7f\x15\x88\xd9\x78\x30\x69\x3e\xb2\xb1\x47\x75\x87\x67\xa7\xf9\xd9\xed\x6d\xee\x2f\x83\x2c\x68\x3e\x28\x02\x06\xb2\x25\xaf\x8d\x3d\x89\x97\xcb\x29\x4c\x00\x07\x30\xdb\x04\x4c\x41\x1e\x0d\x46\xf9\x51\xd9\xcf\x92\xfc\xb2\x9e\x8d\xf2\xdd\x5d\x7f\xec\x95\xd0\xab\x3f\x12\x43\x59\x7a\x37\xc3\xf0\xb4\x19\x64\x90\x04\xb5\x7f\x93\x7b\x6e\xbf\xbf\x07\xff\xff\x73\xb5\x57\x97\x71\x5e\xa5\xf8\xb6\xff\x73\xe5\xfa\x81\xfa\x2e\xce\x92\xb2\x6e\x17\x5f\x2c\xea\xda\x56\x7d\x1c\x97\xc5\xa2\x4a\x32\xcb\x9b\x22\xcb\xe2\x79\x95\xb4\xdf\x4c\xca\x62\x3e\x29\x3e\x58\x5a\x83\xb5\x8b\x2d\x4d\xd5\x45\x91\xd5\xe9\xbc\xfd\x62\x5e\xcc\x8b\xf7\x49\xd9\x7e\x51\x8d\x4b\xe8\xbe\x9a\x5f\x5b\x1a\x8b\x2f\x2c\xb3\x9e\x4e\xd3\x8f\x54\xbc\x0c\x6e\x2c\xc5\xe1\x7e\x60\x01\x51\x78\x10\xd8\x20\x14\x1e\x06\x76\x00\x85\x0f\x02\x3b\x7c\xc2\x87\x81\x1d\x3c\xe1\x77\x81\x05\x3a\xe1\xf7\x81\x15\x06\xe1\x0f\x41\x07\x08\xc2\xe1\x20\x68\x81\x20\x1c\x0e\x03\x2b\x90\xc3\xa1\x3a\x5d\x1d\x5b\xc2\xe1\xc1\xf2\x2c\xd8\x6f\x23\xd8\x8e\x2c\x00\xda\xe0\xc2\x94\x9d\xaa\x2e\xd3\x71\xed\xd2\x76\x1d\x47\x6a\x7d\xff\xa6\x9e\xa5\x55\xbf\x98\x63\x41\x15\x95\xb0\x19\xea\x24\x9f\x78\x37\xcb\x60\xdc\x7f\xf2\xf4\xc7\x47\xef\x9e\xbf\x7d\x03\xd5\x02\xaa\xf6\xbb\x3a\x2e\x2f\x93\x3a\x2a\x3d\xf5\xab\x3e\x2b\xf5\xfb\xd0\xa4\xcb\x26\xdb\xbf\xa8\xfa\x6c\xc9\x26\x71\x1d\xf7\xe2\x79\xea\x06\x65\x7f\x5e\x16\x1f\xaf\xd9\xa7\xe3\x59\x32\xfe\xe5\x55\xc1\x66\x43\x8d\xfb\xec\xfb\x71\x96\x8e\x7f\xd9\xee\xf3\xff\x48\xeb\xd9\xd3\xf7\x49\x5e\x3f\x2f\x8a\x39\x6f\x8b\x8f\x37\xc9\x92\x2b\x78\x01\x03\xce\x79\x11\x35\x9b\x4c\xa2\x7c\x91\x65\xac\x64\x91\xcf\xd3\x5c\x79\x86\xa7\x3c\x99\xbc\x9c\x4e\x2b\x98\x69\x53\xac\x75\xe9\xf9\xcb\x91\xa4\xba\xb5\x17\x4b\xf2\x43\x55\x93\x78\x3c\xf3\x24\x94\x19\xed\xcd\x39\xd0\xfc\x20\x89\x72\x9a\x96\xe7\x8a\x59\xc2\x16\x00\xd2\x5c\x5c\xfc\x9c\xc0\x22\x49\x8a\x13\xdf\xbb\x17\x8f\x12\xa0\x61\x66\x6d\x6c\x01\x28\xee\x98\xda\x83\x05\xf7\x03\x17\x17\x38\xbf\xd4\xbe\x4d\x4e\xe3\x33\x18\xa6\xbf\x1c\xf7\xff\xfc\xf4\xf5\x9b\x67\x2f\x5f\x44\xee\x41\xff\xa0\xff\x9d\x0b\x2b\xfb\xfa\xe9\x9b\xa7\x6f\x23\x97\xda\x73\xe8\xdf\x5e\x5d\xcc\xf9\xaf\x8b\x02\xf6\xcf\x95\xab\x20\x40\x74\x53\x10\x38\xc2\x41\xc0\xd6\x3a\xfc\x00\x04\xbe\xf8\x80\x48\x02\xab\x52\x17\xd8\x6d\x1f\xca\xdf\xd4\x71\x9d\x44\x3a\x42\x06\x31\x83\x40\x16\xa9\x38\xd4\x67\x88\xf2\xb6\x98\x7b\x7e\x50\x44\xda\x72\xf5\x59\x6f\xf0\x22\xd5\xbf\x99\x25\xe9\xe5\x0c\xca\xf1\x2c\xc2\x95\xd9\x89\xea\x7b\xf7\x5c\x18\x39\xce\x5c\x59\x5d\x71\x4c\x65\x47\xe2\x3d\x7e\xe1\xf2\x89\xd9\xeb\xf2\xf6\x4e\x76\xbc\x6c\xb7\xc1\x8b\xa3\xa8\xe8\xc3\xf7\x3e\x34\xc3\xbf\x0e\xb1\x42\x7a\x14\xe5\xbd\x58\x29\xa5\xad\x55\x11\xba\xe8\xcd\x07\x65\x54\x9d\x64\x21\xb5\x32\xd2\xbb\xba\x77\x2f\x3b\x82\x0e\x69\x7c\x21\x2b\x83\x75\x83\x76\x8f\xa2\x72\xd7\xab\x4e\xd2\x30\x51\x7a\x68\xc1\xfa\x95\x8a\xa7\x0a\xb6\xc1\x4c\x5b\x78\xec\xab\xe8\xa9\xbe\x18\xe9\x80\x2f\x93\x2b\x20\x61\x8f\xb3\xb8\xaa\x3c\x8e\x25\x7e\x3f\x9e\x4c\x58\x89\xcb\xb1\x75\xc4\x10\xba\x73\x39\x93\x8e\xe5\x1c\x75\x8d\x22\x4a\x10\x3c\xbd\x5c\x9f\x63\xf7\x26\x57\xa7\x0b\x9f\xbf\x4d\xaf\x92\x62\x51\x7b\xeb\x28\x4c\x30\xf4\x57\xf4\x60\x83\xa1\x9c\x42\x0a\xb3\x0f\xdf\xa7\x55\x7a\x91\x25\xae\x2f\xb6\xb4\x5e\x47\x60\xa7\x98\xbf\x20\x8f\x6c\xfa\x01\x9f\x65\x10\xc3\x5f\xb6\xa6\x41\x16\xfd\x14\xd7\xb3\xfe\x55\xfc\xd1\x2b\xbd\x49\x31\x5e\x60\x3b\x7e\xd3\x50\x53\x08\x5f\x4c\xae\x9b\x37\xfe\x48\x90\x8b\x1d\xb1\xe5\x81\x35\xf1\xe2\x08\x3a\x01\x72\xd0\x66\x5f\x00\xd9\x3c\x3e\x00\x7d\x66\xbe\xb5\x7a\x4c\x8d\x89\x71\x9a\x5f\xd0\xfa\xf3\x3d\x2b\x36\xbd\x97\x05\x39\xed\xf5\x91\x80\x1d\xdf\x00\x3b\x51\x01\x3c\x0f\xc3\x78\xb9\xad\x80\xbd\xd4\x40\x37\x46\xec\xc2\x7d\x10\xb8\x1c\xbd\x52\x4e\x9d\xdc\x5d\xaf\x38\x71\x7b\xee\x6e\x11\xc2\xab\x00\x8f\x28\x42\x03\x2f\xdd\x75\xfb\x0d\x01\x1d\xb5\x56\x0c\x28\xe2\xe5\x65\x52\x7a\xb0\xec\x15\x2c\xdf\x93\x64\x1a\x2f\xb2\xfa\x55\x99\xe0\xd7\xc9\x04\x60\xc8\xb0\x71\xa4\x1d\x0b\x85\x7a\x26\x34\x04\xa3\x38\x11\x93\x55\x77\x9d\xe7\x87\xcd\xf9\xb0\xe1\x1e\x4a\x7d\x39\xb2\x14\xaa\xce\xb3\x78\x9c\x88\x8d\x15\xb8\x7c\x18\xae\xaf\x4d\x6e\xa9\x0c\xc4\x84\x1c\xdf\x5b\x37\x00\xbc\x30\xeb\x01\xf1\x00\x8a\xbf\xe4\x1b\xb4\xec\x4f\x73\xd6\xc6\xa8\xf9\x19\xd5\x41\xf3\xd0\x7f\x0c\x08\x5a\x97\x8b\x71\x5d\x94\xd1\x58\x7d\x91\x17\xf0\x6a\x0a\x87\xb1\x46\x5b\xf8\x06\x56\x5a\x63\x5b\x6b\x09\xa8\xca\x8e\x04\x76\x8a\x67\x45\x3c\x71\x03\xf5\x43\xef\xfe\x29\x9d\xe5\xc0\x06\x89\x95\x3d\xbb\xef\x6f\x7a\x54\xfa\xa3\x84\xcf\x34\x12\x3f\x6e\x6f\x81\x47\x61\x78\x25\x8a\xfe\x40\x50\x02\xd4\x15\x05\x1c\x7f\x8d\x0a\xbe\xf1\x19\x10\x2d\xf5\x1b\x80\xa4\xfa\x0a\xce\x66\x26\x76\x20\xb7\xb4\xc4\xff\xf3\x7e\xfe\xf7\x45\x52\x5e\x23\x83\x0a\x0c\xd8\xc1\x2a\x06\xac\xb0\x30\x60\x49\xc4\x41\x31\x49\xab\xab\xb4\xaa\x00\x1c\xc8\xc6\x02\x38\xe0\xb8\x53\x25\x9a\x02\xfe\x69\x78\x22\x17\xdb\x26\xc2\x96\x15\x15\x8c\x64\x94\xb6\xce\xf5\xb4\xff\xf6\xf5\xa3\x17\x6f\x9e\xbd\x85\xc2\xf3\x27\xef\x5e\x3f\xc2\x1f\xd1\xf0\xc1\x00\xde\x28\x44\x0f\xbf\xd7\x3a\x62\xa3\x2a\x38\xd0\x11\xc4\x71\x5d\x83\x30\x47\xa3\x64\xf4\x1d\xa5\xb7\xdb\x5b\x20\x21\x5e\xf3\x7a\x56\x26\x53\xa0\x85\x80\x91\x12\x91\xf7\xfa\xdf\x7a\x27\xd1\x37\xa7\xff\xf5\x97\xea\xec\xdb\xdf\xf9\x7b\xb8\x9d\x85\x94\x58\x78\xee\x37\x80\xc1\x70\xe2\x9d\x9e\x85\xb5\xdf\xf0\x4f\x19\xac\x7b\xdc\x9f\x24\x35\xe2\x42\xb3\x3d\x5c\x1a\xe8\x84\xf6\x01\x01\xc8\xe7\x5b\x0b\xb8\x1a\x20\x20\x39\x4c\x89\xf6\x32\xdf\xd9\x40\x2e\x63\x2e\x8c\xc1\x40\x91\x78\xd1\xf7\x55\x0d\xc2\x05\xff\x1e\x6b\x88\xd6\x73\x38\xd8\x19\x21\x61\xfd\x28\xdd\x00\x7e\x58\x09\x06\x36\xab\xed\x6e\x37\xcd\x81\x24\x15\xfd\x6a\x31\x47\x09\xb2\xdf\xf0\xe7\xc0\xba\xf5\x67\x71\xc5\xab\x4d\xe3\x09\x1c\x1a\x27\x31\x2c\x66\x82\x7c\xdc\x5b\x59\xef\x69\x0e\xdb\x24\x83\x6d\x70\xb5\xc8\x80\x88\x6a\x2f\x3c\xeb\x72\xfa\x21\x80\xcb\x17\x3b\xbc\xa0\x8d\x88\xc3\x1e\x35\x3f\x9b\xb5\xad\x37\xe1\x4b\x0b\x2b\x5f\xca\x40\x61\xf2\x9f\x54\xca\xf9\xcf\xd4\xe3\xac\x76\x8b\xfb\xac\x91\xfb\xac\xb9\xb4\x9e\xe3\x96\x09\x9a\xd1\x69\x24\x27\x55\x5f\xac\x24\x39\xca\xf4\x04\xc9\x29\x94\x23\x53\x17\x1d\xa8\xb9\x46\x74\x48\xda\xf8\x6f\xee\xe2\xc3\x6d\x77\x71\x66\x13\xa3\xa4\xbc\x51\x48\x79\x43\x48\x56\x85\x22\x59\x65\x6d\xc9\x2a\xad\x9e\x03\xc9\x04\x28\x46\x3b\xc3\xed\x45\x0b\xeb\x12\x32\x31\x78\x63\xd9\x82\x57\xe7\x8b\x9b\x29\xc2\x45\x5d\x5c\x5e\x02\xd7\x13\x45\xf1\x09\x32\x10\xf8\x00\x27\x1f\x8a\x18\xfd\x4a\x9c\xfe\x31\xae\x72\xd6\xa2\x48\x99\x22\x42\x64\x6c\x82\x6f\x01\x0e\xa1\xcb\x1f\xfa\xfd\xbe\x8b\xf0\x68\x56\xa7\x6a\x0b\x11\x82\x40\xb9\x40\x30\x63\xe0\xbf\x60\xcb\xd4\x3a\xeb\x05\x3c\x15\x63\xd1\xd2\x7c\xbe\x00\xb4\x3d\x71\xdf\xc7\x99\x1b\xba\xb3\xfa\x2a\x83\x41\x44\xb5\x38\x45\xf2\xdd\xc8\xc5\x01\xb8\x01\xe3\xd4\x33\xd8\xcf\x48\xe6\xa1\x08\xc9\x18\x03\x86\x2c\x82\x7e\x48\x88\x02\x0e\xa2\x61\x32\x0b\xce\x64\x2a\x8b\x40\xb5\x78\x83\xa7\xf9\xd9\x89\xba\xf0\xf0\x1c\x62\x21\xc0\x51\x01\x00\x00\x33\x3f\xf1\xcc\x95\x07\xe9\xaa\x61\x14\x12\x9f\x91\xd9\x04\x70\x04\xbb\x9c\x33\x95\x9b\x1f\xea\x5f\x21\x5f\x67\x22\x50\xa0\x33\x21\x89\x20\x9c\x8f\xa8\xbd\xa6\xb5\x21\x10\x12\xce\x19\x0f\x7c\x7d\x19\xd8\x32\x47\x2d\x4c\x83\x31\x9a\xdc\xbd\x20\xb3\xfc\x54\xe3\x5f\xba\x0c\x9f\x2a\x3c\xe5\x91\x39\x4b\x38\x6d\x16\x9a\x48\xbd\x0d\xd4\x17\xca\xd5\x1b\xb9\x25\xcc\xa4\x40\x6c\x65\x43\x75\x71\x48\xb0\xaa\x9e\x78\x26\xbe\x1d\xd9\x24\x98\x3d\x8c\x69\x08\x98\xcf\x9b\xe8\xc7\x30\xde\xf7\x89\x3c\x2b\x84\xe0\xc2\x4b\x0d\x56\x4d\x91\x6c\x78\x05\x3f\x64\x8d\x5f\x14\x1f\x5b\xfd\x23\xa8\xcd\x01\xec\x44\xa6\x10\x20\xa9\xbe\x68\x52\x8e\xd1\xe0\x4f\x09\x4e\x66\xf7\x81\xd9\x43\xb0\xae\x79\x38\xad\x10\x75\x9b\x53\x73\x16\xe7\x97\x50\xbe\x4c\x32\x20\x59\xc6\x7c\xe9\xdc\x8e\xcb\x34\xee\xc1\xe1\x59\x55\xd8\xfe\xce\xfa\x0e\x36\x18\xb8\x76\x20\xb1\xa5\x1f\x29\xbf\x81\xe9\x54\x9e\xb4\x23\x20\xd3\xde\xac\x3f\x03\x78\x83\x6b\x0f\x01\xde\x60\x73\x0a\x68\xf8\xf9\x5f\x02\x41\x91\xed\xb2\xf1\x42\xb9\xd4\x6a\x35\x6c\xc4\x45\x9d\x23\x1f\xc4\xce\xb5\x44\x12\x46\x60\x01\x94\xea\x40\x86\xee\x13\x22\x9f\x22\xd6\x44\x1c\x97\xcf\x02\x47\x2d\x94\x38\x06\xbb\x03\xd8\x0a\x0b\x2f\x93\x34\xf4\x2c\x10\x94\x1c\xc9\xaf\x58\xe6\x29\xcc\xba\x72\xfd\x30\x51\xf7\x8e\x90\x50\xf9\x17\x8d\xc0\x0a\x95\x4a\x98\x82\xdf\xfa\x1e\xc8\x36\x81\x8d\x1e\x2d\x60\x73\x2e\xb2\x45\xf9\x09\xe0\x5c\x01\x48\x1d\x91\xd8\x78\x82\xbd\xff\xa2\x1f\x5e\x9a\xfb\x27\xbf\xdb\xeb\xd7\x58\x1f\x5a\x00\xa0\xf9\x6d\xd6\xfb\xc1\xaa\x43\x7b\x61\x39\xb4\xe7\x2b\x0f\xed\x85\x3c\xb4\x7f\x07\x20\x4d\xc7\x31\xa0\x67\x65\x25\x54\x7d\xa1\x4b\xee\x35\x15\x5d\xe3\xc0\xe7\xdc\xfa\x3c\x86\x7a\xaa\xba\xb1\xca\x52\x22\xd3\x4d\x49\x0a\xec\x65\x09\x07\x96\x52\xf4\x3b\xb6\xaf\xd4\x92\xb4\x4e\xae\x2a\xa5\x40\x68\x17\x7e\x49\xae\x2f\x8a\xb8\x9c\xb4\x04\x43\x58\x55\x78\x47\xfa\x6b\x58\x3f\x31\x62\x37\x58\xa8\x5a\x12\x5e\x43\x68\x4d\xdd\x19\x6a\xb0\x85\x86\x4c\x74\x41\x73\xb8\x77\x6f\xc7\x73\x8b\xbc\x2e\x16\xe3\x59\x05\x4b\x5a\x03\xca\x39\x52\x3d\x21\x7e\x3c\xe5\x3a\x02\xdb\x68\xae\x70\x00\xc8\x4b\x97\x2b\x06\x44\x7d\xa9\x0a\x61\xfa\x2a\x4b\xe2\xf7\xc9\x8a\xaf\xc6\xd7\xe3\x4c\x7c\xa5\xb0\x4f\xa9\x97\x6d\xc2\x3e\x2d\x6c\xec\x93\xec\x09\x19\xa8\x85\xc2\xbd\xcd\x1b\xee\x4d\x48\xa7\x41\x8b\xc1\xca\xee\xdd\xcb\x40\xdc\x88\x5a\xbc\x71\x76\x92\x85\x35\x21\x41\x62\x30\x60\xcd\xd4\x18\x0b\x36\x57\x58\xb0\x7c\x71\x75\xc1\xd6\x45\xb4\x82\x47\x34\xcc\x2e\x04\x9e\x8c\x78\x14\x68\x54\xe0\x11\x32\x66\x04\x46\xd8\xef\x04\x18\x52\x03\xcf\x5b\xcc\xd9\xdc\x2a\x2e\x3e\x1c\x0c\x94\x29\x46\x37\xa2\xd5\xf0\x41\x72\x10\x50\xb3\x21\xc7\x92\xe0\x43\x19\xcf\x43\xe0\x08\x04\x0a\xc2\x6f\x04\x4f\xc3\x45\x70\xec\xd2\x78\x39\x34\x2f\xee\x11\xb5\xba\xad\x01\xa8\x71\x99\xc4\x7b\xa9\xdc\xe8\x4c\x99\x58\xc7\x97\x2f\xe2\x2b\xd8\xf2\x37\xd5\x87\xb4\x86\x25\xcb\xfb\x1f\x66\xe9\x18\x98\x87\x71\x0c\x5b\xfa\xe0\x3b\xc6\x04\x21\xd1\x04\xa6\xee\x02\x9a\xf8\x65\xc4\xde\xfc\xc0\xde\xe4\xd0\xb2\x7c\x33\x61\x44\x35\x64\x88\xb0\x6c\x13\xdb\xa5\x3e\x6a\x82\x99\x36\x66\xa1\xb7\x45\x09\x58\xd9\xd4\xf2\x50\x6f\x20\x0f\x5f\xc6\xe5\x33\xfe\xe8\x69\x2f\x75\xfa\xa0\x7c\xb3\xa3\xb4\x29\xd9\x39\x41\x13\x80\xf3\x94\xcd\x69\x28\x8f\x53\xe4\xcc\x9b\xb5\x5d\x7e\x6e\xeb\x53\x03\xe0\x3e\x03\x3a\xf2\x2c\x9f\x24\x1f\x6d\x33\xd4\x48\x0d\x0c\xa9\x44\x01\x19\xb0\x68\x96\x66\x13\xf8\x0d\x94\x0f\xdf\x49\x3e\x8a\x2a\x42\x8f\xd0\x9a\x07\xc0\x51\x49\x97\x6f\xed\xf8\xc7\xa2\x7c\x92\x96\xc9\x58\xd7\xb7\x12\x2d\x56\xd8\x42\x75\x94\xc0\xaf\x22\xfb\xe8\xb9\xb8\x68\xc8\x36\xdf\xbb\x37\x40\x25\xc2\xed\xad\x9b\x73\x46\x1a\x68\x8d\xe0\xc2\xd8\x80\x18\xa7\xd9\x1b\xfa\x02\xb6\x02\x36\x88\xb0\x42\x15\x9e\x70\xb5\x84\x57\xef\x36\x8d\x9f\xf4\x86\x21\x70\xc5\xbf\x6f\xb7\x36\x6a\x43\xa8\x9f\xfc\x0d\xe5\x1e\x6d\x9e\x75\x61\x11\x5c\xd8\x3e\xb6\xcc\x4d\xa3\xf5\xd6\x83\x06\x3b\x92\x3c\x2d\x41\x62\xc7\xcb\x8f\x6d\x93\x05\x5a\x72\x34\xf0\x35\x45\x3f\x3f\x6a\x4e\x4c\x52\x9c\x78\x2e\xbe\xd2\xe9\xa9\x42\x15\x89\xb6\xa0\xdc\x1e\xd6\x08\x93\x06\x3d\x1b\x82\x12\xca\x0e\x12\xaf\x3e\xca\x4f\xd8\x62\x84\x0c\x90\x81\x01\xa4\xdc\x37\xa0\x44\x8d\x6d\xb4\xc3\x06\x26\xf7\xc9\xe1\x42\xd8\xef\xd0\x4e\x06\x4e\x82\xc1\xe0\xde\xbd\x85\x55\x15\xd3\xa1\x18\xb6\x55\xee\x03\x8d\xe7\x3d\xb2\x89\xa2\xc4\x65\x9c\xd2\x6b\xb7\xb8\x3e\x57\x1c\xa9\x61\x5a\xd8\x51\x57\xa7\xb5\x62\xb0\x3a\x04\x4c\x13\x66\x30\xd5\x6d\xdb\x61\xe0\xd1\xdb\xa1\x57\xdd\x7b\x6f\x25\x02\xa2\xed\x82\x6f\x73\xcb\x86\x86\xb6\x6a\x3f\xe0\x66\x3e\x01\x92\xa0\x88\xe4\x46\x3d\x71\xb3\x64\x8a\x58\x52\xa2\x15\xc3\xe5\xd6\x3d\x44\xea\xd8\x26\x77\x58\x50\x19\x48\x2e\xb7\xb4\xc5\xa7\x83\xb3\xa0\x84\x83\x99\x2b\xf1\x68\x5a\x3a\x46\x03\x52\xa1\x52\x6d\xf2\x96\x99\x2c\xab\x60\x22\x46\x1a\x16\xcb\x15\x16\x83\xd2\x0f\x76\x4a\xbb\xc9\x40\x1a\x86\xe4\x78\x06\x41\xc6\x79\x1d\xbe\x43\x5a\x1c\xa4\x14\x7c\x5b\x2f\x36\x12\x59\xb9\xd1\x7e\xe1\xb5\x3e\x97\x54\xd9\x3f\x6d\x93\x96\xd8\x3f\xf3\x47\x63\x38\x90\x2c\x42\xee\x92\x79\xb9\x68\xb0\xdb\x0a\x74\x7c\x65\xec\xdb\xad\x4b\x9e\xa4\x15\x42\x29\x3e\x6e\x86\x04\x0c\x37\x2e\x24\xd7\xb6\xff\x47\x3a\xa9\x67\xaa\x0e\xa4\x40\x8d\xad\xfa\x54\x77\xa8\x50\x95\x7d\xa1\xab\x68\x4f\xf3\xa0\x38\xeb\xff\x5c\xa4\x70\x76\x39\x80\x53\x16\x68\x18\xda\x92\x53\xf1\x42\xff\x30\x48\x15\x1c\x54\xf5\x41\xea\x96\x6c\xe3\xd2\x0c\x76\xdf\x00\x85\x2c\xab\x86\xd7\xca\x81\xf9\x7e\xe8\xd5\x1d\xea\x8b\xd8\x3a\x7e\x7d\x83\x98\xe2\xba\x1c\x89\x2f\x51\x95\x13\x71\x46\xad\x84\xe8\xbe\x40\xe9\x5a\xa0\xc0\x48\x7b\x8a\xd2\x40\x7b\xd6\x04\xf8\xb9\xf1\x6e\xa5\x08\xaf\x37\xcb\x85\x78\x6e\x16\x69\x1d\x9c\xc8\x78\x73\xd6\x3c\xc6\x5f\x36\xb3\x04\x1c\x18\x70\x74\x9a\x16\x89\xc4\x6a\x91\xd8\x95\x16\x09\x83\xe4\x34\xbc\xbe\x70\x55\x50\x19\xfe\x58\x70\xf9\x42\x8d\x48\x0e\x0b\xea\x60\x08\xb5\x41\x14\x86\xdd\x5a\xc0\x79\x93\x35\x87\x05\xb2\x88\x29\x53\x18\xc4\x01\x9c\x0f\x05\x5a\x06\x2c\x32\x06\x9e\xb8\x05\x1a\x1e\xda\xbc\xe9\x68\xd1\xa5\xe2\x90\x20\x6f\xa4\x72\xf7\xb4\x19\xd0\x99\x8b\xaa\xc3\x6d\xbe\x80\x29\xd0\x47\xc1\x62\xb5\x35\x6f\x21\x94\x7d\x25\x9e\x25\x0d\xf8\xba\x2d\x7a\x7c\x1d\x47\xa9\xb0\xa5\x09\xd1\xc9\x66\x52\x7b\xb8\x4a\xae\x4f\x2d\x72\x7d\xb5\x52\xae\x4f\x5b\xca\xf8\x54\x59\xdd\xca\xe6\xe6\xc4\xf6\x0c\x7c\x69\x28\x35\x85\xab\x98\x7b\x76\x8a\xb8\x16\xb9\xdf\xdc\xdf\xcd\xfb\xe9\x64\xf7\xbe\x7b\x16\x74\x56\x55\x10\x56\xfb\xe2\x3e\xef\xaf\xa1\x9e\xba\x62\xa0\x91\xbf\x91\x0f\xe7\x6c\x1c\x7b\x90\xcc\xe4\x2b\xce\xa3\x33\x86\x0c\xa8\xc3\xa3\x32\x8d\x1f\xe5\x93\xc7\xbc\x7b\x4e\x2d\x74\x75\xb9\x36\x4b\x43\x7e\x60\xe3\xe7\x84\x42\x68\xfb\x97\xaa\xb5\x4e\xd9\x9e\x79\xe7\x96\xcc\xb7\xdb\x92\xe2\x48\x49\xbd\xda\x5f\xca\xbe\xca\xcd\xcc\x1f\xa9\x55\x7e\x17\xf0\x47\xf9\xbd\x63\xc1\xbb\xe5\x77\x34\x90\xf8\xa3\x9d\x84\x54\xab\x1c\x22\x7b\xd5\xac\xf8\x70\x3b\x03\x94\xe7\x8a\xa9\x98\xa9\x84\xf9\x8a\x93\x1a\x5a\x97\xe8\xc5\x10\xb8\x44\x5f\x6d\xe8\xb1\x55\xb5\x44\xf5\xca\x2a\xaa\x1f\x3c\x18\x28\xb3\x89\x6e\xd8\x40\x48\x0a\xaf\x14\x8e\x6f\x02\x27\x15\xe0\x97\xee\xe1\xa2\x89\x33\xed\xc3\xfa\x03\x1e\xc5\x68\x48\x61\x3f\x40\xe4\x27\xcf\x13\x57\x6f\x19\x01\x62\xe5\x49\x35\x8c\x16\x52\x98\xa5\x1b\x34\xa0\xf2\x55\x94\x76\x05\x86\xe0\x82\x8f\x60\x4f\xaa\x04\x3a\x8f\x73\xa2\x98\xaa\x50\x9a\x83\x2c\xc0\xa1\x8d\x50\xe5\x82\x52\x82\x58\x27\x64\x03\x2f\x8f\x12\x1b\x76\xf8\x68\x4a\xd6\xc6\xeb\x0b\x86\x38\x95\x0c\x12\xcc\xb3\xaf\x7d\xd5\xcd\x3d\x02\x0b\xbc\x53\x77\x70\x8f\xfa\x80\x4a\xa9\x48\x9e\x11\x67\x14\x80\xf4\x63\x1b\x22\xd9\xaa\xa4\x19\x9d\x7a\x95\x4b\xea\xf9\x2b\x3c\xb8\x1a\x1a\xa4\xf2\x3d\x2a\x9c\x10\xe3\x06\xbe\x6a\x17\x48\x3e\x02\x7c\x27\x64\x18\x18\x18\xe4\xd0\xde\xf6\xc4\x5d\xf7\xbd\x4e\xde\x86\xa6\xf9\xd4\x20\xd7\xd6\x5e\x68\xb0\xed\x39\x24\x0e\x22\x10\x4e\xc2\x75\xad\x9d\x0d\x3a\x98\x21\x5a\xd1\x5c\x5f\xd2\x25\x21\x4d\x6a\xe1\x69\xa5\x3b\x21\x5b\x30\x76\x92\x31\x1f\x28\x3c\xcf\xae\x92\xec\x71\x0c\xac\xff\x29\x77\x7e\x75\x83\x58\x70\x8e\x3d\x64\x36\x2c\xe2\x77\x8b\x81\x4d\xb9\x6a\x27\x13\xaa\x4f\x2b\xbf\x68\x25\x03\x34\x7f\xad\x0f\xe0\xa8\x4f\x8b\x33\x60\x1d\x96\xfa\x76\x9d\x69\x92\x5f\xf7\x76\xdd\x60\xb7\x36\x7b\x63\x26\xe4\xae\x0d\xf6\x06\x9c\xc3\x3b\x76\xdf\x0a\x55\x55\xd2\x8d\xdd\xa7\x89\x39\xd3\x04\xcd\xb3\x8d\x04\xf1\x27\xa2\x52\x9d\x56\x3e\x0d\x9b\xac\xd8\x4c\x08\xd5\x81\xcf\x43\x73\x3f\xb4\xf1\xb1\x7b\x33\x0c\x57\x6c\x86\xba\xb5\x19\x56\x63\xf1\x36\x3b\xc4\x55\x6c\x3e\xb0\x54\x93\x64\x6b\xac\xaf\x55\xac\x6f\x41\x7f\xe0\xaf\xc1\xe8\xfa\x0e\x18\x6d\x22\x6e\xdb\x1a\x8d\x6d\x9e\xae\xc2\xd3\x13\x46\x54\x43\xda\xe9\x2e\x9e\xab\x5a\x8b\x92\x73\xb2\x9c\x89\xa9\xee\xdd\xce\x4e\x20\x9f\x49\xeb\xdd\xfc\x20\x95\x73\xe6\xcc\xbd\xbf\x6b\x69\x82\xb1\x7c\x8c\x87\x11\xd0\xb1\xe9\x61\x52\xd4\x79\xae\x66\xe7\x32\x3c\x66\x6a\x61\xbd\xf7\x51\x6d\x65\xce\xb0\xeb\x5b\xab\xea\x27\x37\xa0\x37\xca\xed\x68\x5c\xa3\x61\x52\xb3\xdb\x35\x98\x0f\xc7\x5e\x07\xf2\xd7\xd2\x3c\x9c\x92\xf8\xc7\x3f\x19\x69\x4f\x51\x19\x68\xcf\x9a\x8c\x59\x19\xef\x56\xca\x98\x7a\xb3\xc2\x50\x9c\x76\x4a\x51\xa2\xd1\x0e\xdb\xa6\xba\xca\x36\x5b\x31\xe7\x3e\x47\x56\xaf\x39\xe4\x07\x4d\x91\x8e\xef\x79\x38\xf9\x7d\x72\x5c\xb1\x30\x25\x27\xc2\xb6\x1c\x26\xc2\x75\x85\x73\x0b\xe8\x62\xdb\x12\x98\xbe\xdb\x5e\x60\x32\xa6\x28\x42\x5d\x70\x8a\xb1\x6e\xaf\xd1\xbc\x10\x11\x60\xb2\x6e\xa0\xc8\x08\xaa\x88\x50\x36\xc0\xb1\x0a\x08\x68\xf8\x02\x11\xc1\x26\x25\xec\x7d\x73\xfa\xa8\xf7\x7f\xe3\xde\xdf\xcf\x38\x8f\x9d\x74\x8a\x0e\xba\x7f\x61\x1d\x01\x73\x45\x3b\x47\x90\x2d\x64\xdd\x19\xaf\x75\x52\x87\x8d\x31\xa3\x91\x2e\x0a\x94\x2e\x80\xef\x3e\x88\xa2\x28\x66\x06\x26\x18\x57\x0a\xfc\xa4\x98\x61\xef\x22\x1e\xff\x82\x0f\x8a\xef\x21\x20\x52\xd5\x25\xe5\x36\x82\x08\x0b\x38\x89\x0c\x3d\x1a\xd3\x76\x24\xca\x66\x2b\xe6\x49\x4e\x2e\x22\x30\x0e\xee\xe7\x89\x83\xc1\x1d\x0c\xc0\xb0\x9b\xc9\x62\xd3\x4c\x06\x13\x07\xdc\xc9\xeb\x38\xcd\x2b\x2f\x41\xc5\xa8\xa8\x42\xb2\x98\x3c\x00\xe2\xf6\xa9\xad\xac\x25\xb9\x47\x76\xf8\x3d\x76\x90\x03\x77\x1a\x67\x24\x61\x25\xfa\x99\xc4\x66\xa5\x38\x3a\x37\xbd\xf2\x03\x48\xeb\xd7\x47\x2d\x80\xbf\x8c\x5b\x62\x4f\xbc\xe2\x0c\x68\xed\x3f\x3c\xc7\x98\xd7\x44\x5f\x38\x8a\x05\x4e\x28\x7d\xc6\x24\x57\x5f\x8a\x7d\x67\xae\x02\xb6\x50\xc0\x02\xef\x00\x5a\x6c\x6c\xe9\x46\xe1\x46\xf1\x6c\xc8\xe3\xf7\x17\x71\xd9\x83\x3f\x8a\x59\xa2\x21\x3d\xfd\x31\xac\x63\x9d\xf0\x6f\x61\x5b\xa4\xef\x75\xa5\xa4\x0d\xf3\x60\x55\x93\xb2\x7e\x34\xad\x11\x94\x9e\x62\x1a\xe7\x7e\xc1\x85\xcf\x59\x6a\x2b\xb6\x21\x33\xa6\x78\xbd\xb6\x64\x9a\x66\x25\xb2\x4e\xcf\x57\xe1\x2a\xdf\xf6\x3f\xe9\xc0\x0b\xa0\xdb\x4c\xb3\xaa\x9d\x15\x5d\x68\x21\x99\x71\x6d\x2c\x3c\x46\x73\x67\xb8\x5c\x6a\x88\xd0\x61\x54\xde\xf3\x0e\xbe\xbf\x3d\x1c\xdc\xee\x7f\x77\x7b\xb0\xef\x4b\xd7\x11\x66\x37\x86\x55\xda\xdc\xe6\xdc\x42\x2b\x8b\x7b\x4e\xde\xaf\xea\x62\xfe\x0a\xc6\x1b\x5f\xc6\x8c\x06\x04\x9f\x8e\x7d\x3b\x40\x05\xf6\xbf\xdb\x89\x72\x41\x8d\xe8\x39\x12\xcf\x82\x23\x53\x8a\x90\xc8\x11\x63\x52\xb5\x7d\x7b\x02\x65\xb9\x18\xaa\x08\x44\x91\xd6\x1d\x89\x6e\x80\x8e\x0b\x27\x4b\xc3\xbc\xa8\x3d\x39\x01\x5f\x38\x10\x39\x31\x1b\x5f\xa6\x39\xf1\x15\x51\x26\x6c\xbf\x82\xdc\x8c\x0e\xbe\x57\x86\x36\x38\x2a\xee\xdd\x2b\x7a\xbd\xe0\x70\xa0\x94\x16\x47\x99\x34\x5a\xc2\xd3\xee\x6e\xf0\xdf\x05\x90\x98\x22\x02\x79\x31\x43\x73\x61\xd1\x9e\x0a\x8a\x32\x2a\x0b\x21\xc6\x3d\xd2\x9e\xb6\x74\x7c\xb6\xaa\x8d\x24\x0a\x9a\xbe\xcf\x0d\x6e\x32\x65\x4e\xbc\x8d\xfb\xb3\x36\x4c\x8d\xb3\x89\x8d\x77\xeb\x39\x1b\x39\xdb\xb5\x9c\x8d\x6c\xb4\xe1\x6c\x8a\xf5\x55\x1a\xac\x70\xa6\x45\x79\xe5\x6a\x2c\x8f\x05\xef\x97\xeb\x9b\xac\x2c\x94\xdc\x37\x3d\x94\xd6\x7e\xc7\xeb\x6e\xf2\xa1\x81\xd9\xae\xb5\x1d\x83\x89\xfa\x7e\x5b\x17\xf0\xd4\xa6\x75\x36\xdc\xbf\x7e\x87\x71\x5b\x51\x61\xc4\x71\x19\xd1\xa9\x8d\xb7\xf8\xef\x26\x69\x9c\x15\x97\x76\x53\x2c\x45\x1d\xf7\x58\x0d\xe9\x82\x21\xce\x09\xd5\x9f\xac\x7a\x33\x23\xfc\x68\x14\xc9\xb0\x9d\xd2\x3c\xce\xfe\x00\x9d\xbf\x8a\x35\x6f\x34\xd2\x58\xc0\x91\x45\xb6\x37\x21\x69\xa6\x97\x79\x51\x26\x7f\xe0\x4d\x3f\xc6\x95\x95\x86\x25\x21\xd3\xe0\x81\x5f\x27\xa6\xb2\x40\x1b\x2a\xf2\x24\x50\x88\x27\x61\x11\x4f\x3c\xcb\xe7\x81\xcd\x9b\xda\xae\xb1\xc1\x26\x58\x34\x08\x35\xee\x4a\xe1\x47\x61\x3c\x2b\x32\xb2\xdc\xd5\x61\x9e\xb7\x0b\x6c\x9b\xea\xac\x9f\x6e\xae\x2e\xd6\x09\x06\x6b\x4e\x0b\x96\xe8\x50\xfd\x32\x17\xae\x0c\x7d\xb8\xf0\x28\x24\xc7\x7a\xf8\x0b\x25\x40\x3b\x36\x0d\xf3\x39\x18\x60\x98\xcf\x1f\x1e\x3d\xfe\xb7\x27\xaf\x5f\xbe\x3a\xef\x8e\x04\x6a\xf4\xc5\x02\x77\x0c\x1f\xae\x00\x3b\x27\x2d\x72\xba\x9a\xff\x52\xe1\xcc\x91\x8e\x19\x29\x90\xbb\x94\x3e\x22\x38\x93\xdc\xd7\x1b\xd3\x15\xc7\x35\x5b\x16\xa6\xdb\x0c\x94\xc8\x1c\xc1\xa3\x70\x50\x9a\xfc\xcd\xd2\xd4\xad\x29\x7a\x26\x75\x50\xb8\x2c\x1d\x8c\xad\xb6\x61\x76\x06\x4a\xa8\xf7\x1b\xb1\x33\x84\x49\x1f\x23\x13\xcc\x32\xda\xde\x0a\xfb\xc6\xf0\x9e\x1d\xe8\xac\x46\x52\x8d\xe3\xb9\x74\x0b\x28\x93\x2a\xfd\x7b\xe3\x24\xa0\x7a\x47\x32\xfa\xc9\x63\xc2\x94\x49\x9b\xc1\x62\xac\x18\xc4\xb4\x42\xf5\x06\x43\x88\xeb\xa1\xef\x8c\x4e\x34\x2e\x94\x8c\x42\xb6\x9a\xd7\x2c\xe7\xba\x92\x92\x3e\x5b\xcc\x57\x7d\x64\x78\xf8\x02\xfb\xd3\x34\x42\x52\x8d\x9d\x94\x0c\xc8\xea\xc7\x06\x2a\x90\xd0\xb2\x49\x3b\x02\xab\x2c\x1a\x1f\x16\x61\x35\x52\xde\x49\xdf\x35\x11\x12\xa6\xbc\x8b\xe7\xb0\x40\x93\xb7\x05\x0e\x96\xd1\x63\xe5\x25\xe1\xab\xaf\xc4\x33\x0f\x98\xd9\xfd\xe7\x45\x55\x3f\x21\x98\x7a\xe4\x72\x1f\xab\xea\x56\xcd\x81\x21\xb6\xa9\x1e\x29\x54\x2c\xee\x27\x39\x9c\xa9\xe3\xe4\x47\x72\x72\xf6\xb9\xe1\xbb\x68\x31\xc2\xdd\x08\x9f\x9f\xc4\xca\xda\xae\xf5\x85\x68\xd3\x51\xc1\xb8\x8b\x82\xa4\xd3\x3b\xa1\x23\xfe\x6c\xd3\x46\x8d\x2d\xaf\x2b\x9f\x91\x95\xb0\xc6\xf0\x29\xbb\x5f\xc8\xa8\x92\x2e\x6f\xb2\xd7\x81\xd3\xb7\x6f\xf6\x26\x50\x86\x6f\xf6\xe1\xca\x0d\xaa\xc5\x15\x4c\xa7\x7c\x8a\x69\xde\x3d\xa0\x56\x64\x20\xfb\xae\x63\x5f\xf3\xb7\x9d\x7b\xcc\xdc\xc6\xb2\xb2\x7d\x1f\x77\x85\x21\x76\xa9\x48\x79\x48\xe2\x26\xa6\x89\x16\x9d\xf9\x09\x7b\x5c\xa9\xd5\xed\xc0\x1c\xbd\x05\xcf\xc4\x10\x75\x6f\xa8\xcc\xef\x06\x4b\xd1\xc4\x30\xa8\xc5\x6d\xee\x02\xd0\x4e\xb4\x15\xa1\x48\xc2\x08\x97\xf0\x5c\x6d\xf6\x73\xf7\x4b\x84\xa1\x42\xf1\x04\x75\xe9\x60\x5a\x84\xf8\x22\x58\x15\x7d\xbe\x84\x79\x2d\xdd\xbf\x44\x64\x8d\x5f\x12\xe7\x73\x6b\xc9\x1a\x56\xb8\x4d\xa7\x6d\xf3\xd7\x85\xc7\xe6\xb4\x16\x63\x0c\xd5\x41\x98\x13\x23\xf0\x77\xf6\x67\xcc\x8f\xed\xa5\xae\xf9\x9d\x14\x9a\x8f\x09\xab\x6c\x19\x3b\x1b\x62\x9c\x4f\xb2\xe4\xdd\x1c\x8e\x42\x71\xcc\x85\xca\xf7\x38\x2a\xb3\x01\x0b\xf9\x21\xb4\x6b\xc7\xb4\x61\x7b\x06\x23\xc1\x40\xd2\x7d\x40\xe5\xfc\xe4\xd7\xf6\xbc\x76\xf8\xe7\x2c\xb2\xf0\x11\x9d\x1b\xd8\x66\xe5\xc9\x42\x95\x8d\xc8\x2d\x58\xd3\xa8\xc9\xc4\x54\x5a\x90\xc5\x6e\xc5\xa1\xda\x36\xa5\x8a\x21\x8b\xfd\x2f\x9e\x1b\x25\xa6\x45\x78\xd0\xbb\xb8\x68\x35\x6e\x75\x67\xee\x26\x2d\xec\x6f\xe8\xba\xd2\x1c\x68\x47\x6c\xd1\x91\xe0\x01\x3b\xe8\x58\x63\x54\x94\x23\x2b\x36\x57\xab\xb1\xa5\x11\x5f\x3a\xee\x2e\x9a\x2a\x04\x17\xd0\x70\x72\xdb\xf0\x65\xb6\xbd\xd5\x25\x3c\x9d\xac\x90\xaa\x42\x41\x4c\x88\xe6\x8c\x17\x25\xf2\x2d\xec\xc0\x87\x23\x0b\x84\x05\x10\xb3\xc7\x66\x44\x8e\x98\xc9\x89\x49\xb8\xfa\x2c\x7c\x4a\xa1\xb5\x9e\x0c\xf2\x04\xfe\xc3\x44\x88\x96\xef\xa5\x8e\x2e\x06\xfb\xb2\x23\x6c\x82\xa3\xf8\xc4\xa8\x69\x3f\x3a\xf2\xce\xf3\x61\x95\x9c\xe2\x87\xa8\x55\xa0\xf0\x45\x69\xa1\xd6\x71\xe7\x77\x0d\xd2\x58\x11\x5c\xb5\x64\xb5\xbc\x0d\xcc\xed\xc3\xd8\x39\x4a\xe9\xf4\x09\x67\xe8\x1a\x48\x74\xc7\xf8\xaf\x86\x44\x26\x20\xc1\x87\xa8\xd3\x34\x85\x2a\xb6\x88\x80\xce\xb1\xea\x1f\xaa\xef\xec\xf4\x50\xc3\x29\xc6\x0b\x33\xdb\xfa\x71\x97\x22\xbc\x0f\x1b\x05\xfe\xb0\x5a\x06\x39\xc5\xf4\x2e\x37\x73\xc0\x26\x90\x7b\x9f\x27\xd3\x3a\x64\x8b\x8a\x5b\xee\x59\xf5\xf2\x7d\x52\x4e\xb3\xe2\x03\xb9\x1e\x70\x01\x52\x57\x4b\x00\x11\x09\xf8\xd7\xaf\xb1\xf5\xb0\xeb\xeb\x9d\xae\xcf\xdb\x04\x54\xa7\xcd\xdd\xde\x28\xad\xa1\x9b\x63\x69\x37\xae\x8b\x8f\x6d\xf8\xb2\x63\xab\x8f\x89\x64\xd8\x00\x59\xca\x45\x41\x5e\x3b\x01\x7c\x89\x09\x4c\x16\x39\x76\xfd\x98\x60\xfd\x3a\x19\xa3\x39\x11\x1d\x9b\xc8\xa3\xbe\x47\xd9\x84\xe2\x8b\x8a\xa2\xad\xa7\xb5\xbf\xb4\x03\x2a\xd2\xb4\x51\x7c\xe1\x68\x24\x47\xb9\x55\x2d\x44\x65\x57\x49\x5c\x2d\xca\x44\x39\xbc\x0c\x99\x5e\x39\xd8\xda\x93\x06\x79\xac\x4a\x9e\x01\x71\x56\xe4\x66\xca\xfa\xc3\x81\xd9\x63\x31\x01\x20\x93\x0f\x82\xe1\x80\x0b\xf6\xa6\xde\x4a\x1f\x77\x55\x5f\x67\x18\x4b\xd2\x2c\xc6\xed\xad\xcb\x8d\x92\x16\xe4\x58\xdd\x71\x90\xef\x5a\x66\x6e\x43\x1b\xeb\x24\xd7\x34\x6e\x9b\x8d\xd1\xb6\x09\xde\x36\x08\x57\x9e\x76\x23\x38\x3b\x90\x2c\xa1\x0d\x83\x2b\x09\x7a\x72\x2e\x3d\xde\xb8\xab\x69\x2d\xe8\xfc\x83\x63\x6b\x24\x4c\xb5\xca\x49\xd0\xcb\x55\xb4\xd0\x83\x9f\xf0\x6b\x24\x0b\x9c\xd6\xa2\x1b\x1e\x4a\x61\x89\x16\x03\x4e\x23\x18\x35\x3f\xa3\x2a\x68\x1e\x6c\x39\x40\xd8\x8b\xf5\xf1\xdf\xac\xb5\xb5\xe1\xdf\xac\xb9\x4e\x93\xbe\xd4\xa2\x6c\x92\x07\x87\x59\xa7\x03\x4c\x61\xd3\x61\xe4\xaf\xbb\x92\xe0\x48\x97\xf3\x20\x8b\xe2\x96\xaa\xb1\x31\xf3\x37\x1a\x47\xa6\x18\x0d\x77\xf6\xbe\xe1\x36\x2c\x0a\xa6\x55\xbc\xd0\x85\x47\x00\x7a\x1c\xa0\xbd\x29\x76\xfd\x8e\x6c\x38\x2c\xd2\x4b\xd7\xa1\xe9\xca\x7c\xbb\x56\x8c\x7f\x69\xb2\xa1\x7a\x88\x98\x9e\x11\x0d\x75\x96\x2d\xa9\x07\x75\x3c\x95\x74\x7e\x67\x4c\x48\xcb\x77\xe1\x87\x55\x6a\xf7\xcc\xa2\x76\x2f\x6c\x6a\xf7\x34\x4f\x61\x27\xf0\x0c\x9c\xb0\x99\x83\x84\x7b\x36\x65\x88\x33\x3c\xa3\xa6\xcf\x12\xd0\x2a\xe9\x6a\x5f\xb1\x0f\x44\x76\xd7\xca\x51\x13\x9c\x02\xf6\x9a\xaa\xd7\xa2\xd1\x9e\x66\x5a\x42\x97\xa6\x13\x15\xb7\x1b\xd5\xf1\x0d\xa1\x06\xe2\xa9\x8c\x7e\xe2\xe0\x0a\x85\x19\x97\x6b\xcb\xf1\x80\xa9\x93\xab\x39\xf2\x0a\xe1\xfd\x23\xd8\xdc\x0e\x6d\xec\x48\xce\xce\x81\x5d\x0d\x28\xcc\xfb\x73\x8f\xd5\x3a\x31\xcc\xeb\x03\x14\xed\x41\xd9\xf1\xd1\xec\xc0\xf8\xb6\x57\xa7\x35\x2c\x17\xbc\x9f\x1d\x1c\x5b\xda\x96\x2a\x7b\xd1\x02\xfd\x7b\x1f\xd6\xd1\x63\x89\x4f\x18\xb1\xda\x6c\xf2\xb2\x3a\xb0\xe2\x63\x65\xc3\x17\xbe\xee\x88\xc5\x51\xb0\xb2\xee\x78\x01\xc1\x65\x50\xe8\x67\xcd\x63\x36\xd2\x0e\xf6\x05\xc6\xd2\x24\xe2\xbb\xc4\x68\x9d\x9a\x45\xbc\xc8\x12\xfe\x3d\x9e\x9f\xc2\x6a\xa1\x03\x89\x47\x55\x09\x96\x1b\x73\xc9\x9c\xb0\x8c\x32\xa1\x8b\x56\x64\xf7\x0c\x4d\xb9\xad\x8f\x1b\xa3\x47\x13\x9f\xd5\x24\xb7\x22\x47\x2d\x5b\xc3\x2d\x23\xa2\xec\x8b\xd1\xe9\xa6\xd3\x9a\xc9\x91\x0a\x9f\x8b\x8c\xa8\x83\xf9\x3b\x59\xba\x33\x07\xcf\x7e\x87\xb0\x8c\xdc\x19\xdb\x63\xe4\x13\xa4\xae\x3d\xf2\x51\xea\xaa\xc0\x55\x03\x85\xc6\x75\x56\x16\xd8\xab\x27\x44\x03\xef\x26\x58\x50\x82\x5b\x6f\xec\x72\xdd\x42\xca\x98\x05\x3d\xa9\xa2\x4c\xa5\xa9\xd2\x63\x09\xfa\xdb\x5b\xcf\x92\xd4\x30\xe9\xf3\x0a\x27\xf2\x17\xb7\xd1\xc2\x69\x86\x99\x34\x78\xa1\x31\x44\xda\x52\x9d\xae\xf3\xec\xad\xf2\x9b\x4f\x99\x10\x50\x46\xf8\xd1\xae\x94\x5e\x70\xb4\x63\x38\xa8\x47\xea\x43\xd3\xcb\x46\x71\x0f\x99\xcd\x8a\x25\xa8\x44\x77\xde\x27\x8c\x6a\xd8\x9b\xc0\xd1\x52\x16\xd7\x7a\x38\x03\x7a\x0b\x69\x36\x2c\x49\x50\x99\x15\xab\xd8\x24\x80\x81\xb4\x15\xea\xac\xb4\xd3\xbe\xd0\x5f\xad\x3c\xef\x35\xd0\xf0\x13\xdf\x38\x3d\x86\x83\x6d\xac\xb6\x6a\x10\x4b\x13\x27\xb4\xca\x44\xcb\x58\xa8\xa7\x8a\xa1\x16\x0f\x3e\xbd\x6a\xa3\xc9\x0a\xb7\xce\xfb\x85\xa9\x2d\x08\x2e\xb6\x8c\xca\xc8\xce\xfa\xbb\xae\x83\x5e\x45\x4e\x96\x3a\xc7\x4e\x2c\x58\x49\xe2\xd4\xaa\xe8\xf4\x8c\xfb\xe0\x51\xfd\xe6\x99\xc5\x06\x32\x2d\x42\xcb\xda\xcb\x44\x34\xe9\x55\xac\x4d\xd1\xc8\xe1\x2c\x53\x57\x1b\xfa\x38\xf8\x39\x4e\xaa\x4a\xb3\x38\x01\x93\x04\x27\xe8\x4c\x28\x97\x78\x15\xd5\xe1\x2e\xf9\x84\x6c\x66\xcd\x48\x36\x4d\x68\xa6\x8c\xbd\x9d\xd3\x6c\x55\xf8\xcd\xea\x34\x66\x3c\x13\xf2\x70\xa0\x27\xcc\xba\x14\x82\x01\x87\x6e\x17\xbd\xd0\xa0\x6d\x0a\xd7\xb7\xb7\x32\x1f\xac\xce\x6a\xab\x95\x82\x4e\x01\x51\xad\x65\xe4\xf3\xe2\x8b\x63\x27\xb2\x98\xa7\x84\x4d\x0b\x93\xa5\x0d\x46\x1b\xa1\x98\x86\x4b\x82\xca\xab\x20\x40\x23\x0a\x6c\x96\xff\xa0\xad\xe1\xd9\x67\x4f\x39\x15\x91\xfb\x60\x1a\x11\x57\x44\x8f\x1b\x48\xa9\x24\x17\xd6\x0c\xaf\x44\x5e\xb5\x7d\xe4\x03\xf0\x6c\xa6\xc4\x16\x4a\xa9\x0e\xba\x1a\x97\x5f\x47\x7b\xff\xf5\x4d\x5f\xf1\x3e\x2d\xec\x4e\xa5\xf8\xcb\xe4\x81\x4f\x4f\x59\xc2\x38\xcc\x21\xba\x9b\x05\xc9\x19\x5e\x41\x80\xaa\x55\xbf\x5f\x15\x65\x6d\x78\x7b\x8b\x23\x0c\xe0\xd0\x43\xaf\xcd\x65\xdb\xad\x54\xc8\x64\xb0\x9d\x16\xb0\xb5\x70\x12\x08\xb5\x40\xe8\x0c\xd5\xf2\xe1\x19\xa7\xbc\x6a\xce\x00\xda\x83\xad\x55\x97\xb1\x56\x9d\x60\xd6\xfd\xd7\x65\x06\xe3\x8e\x75\x8e\x6d\x09\x8f\x77\xeb\x9e\xad\x13\x99\xdc\x98\x2f\x35\x9f\xa0\xc8\x01\xce\xe7\x25\x32\x7f\xab\x84\x4c\xaa\x80\x55\xd4\xa3\x3c\xda\x06\xfd\x89\x8f\xa2\x44\xf8\xca\xa5\x3b\x11\xe6\xfa\x3c\x2d\xa4\xcf\xd9\x99\x48\x4e\x44\x6d\x63\xf2\xc2\x9c\x9c\xdb\x52\x20\x01\x47\x19\x82\x57\xdd\xb0\x1d\x94\x94\x92\x40\x00\xef\x88\x77\x46\xe0\x81\x2e\x6e\x81\xe8\xf5\x46\x3e\x74\x59\x9c\xe6\x67\xd0\xdc\x31\xe5\x03\xbc\x77\xcf\x7b\x5f\xa4\x13\x07\xf3\x96\xc0\xf3\xee\x10\xb0\x02\x7b\xc2\x5f\xad\xb1\xe0\x97\xc6\x2a\x8a\x77\x9a\x2a\xde\x32\x38\x7d\x64\x4a\x3c\x8d\xd8\x1c\xbb\xf7\xf5\x50\xd4\xfb\xbb\x39\x05\xae\xf2\x68\x05\xa5\x1a\x0b\x6e\xe5\xef\xef\xa3\x47\x0c\xe5\x0d\x24\x9b\x3a\xe0\x7c\x96\xba\xb6\x48\xfa\x91\x34\xbb\x9b\xfe\x57\x8d\x2f\xac\x07\x48\xd4\xf8\xcb\x66\xa9\xe2\x70\x67\x4b\xc7\x17\x28\x66\x11\x01\x07\xfd\x60\x32\x80\x45\xd3\xd7\xad\x87\x06\x81\xe0\xb3\x78\x97\xd7\x69\x66\x3b\x75\x83\x75\x19\x19\x34\x3d\x87\x1c\xc8\x48\x7f\x8c\x92\x40\x2f\xb0\x25\xbd\x6b\x5e\xae\xd7\x7b\x34\x2d\x37\xba\x0f\x33\x48\x5b\x83\x8c\xa2\xfc\xd0\xc0\xa1\x26\x62\xe6\x01\x6b\xdd\x71\xdb\x85\x0c\x6e\xd8\x20\x6e\x7b\x68\xb9\xed\xa4\x61\xc6\xaa\x35\x2e\x74\x1c\xa7\x85\x53\x1c\x26\x87\x50\x5c\xbb\x92\xcd\x7c\x3a\x2b\x1b\xef\x50\xc7\x17\x2d\x77\x4e\x2c\xdb\x34\x91\xad\xb7\x8d\x1f\x96\x99\x6e\xd9\x8c\x91\x55\x64\x9b\x46\xb4\xc9\x9b\x2d\xb1\xc8\xb8\x13\xae\xba\x81\x7c\x3a\x98\xcc\xb3\x8b\x08\xa2\xc8\xca\xdc\x0a\xa5\x58\x93\x95\x19\x23\xf1\xc4\x6e\xa5\xfd\x6c\xc9\xc0\xc2\x4d\x72\x46\x72\xcd\x10\x6a\xd5\xe8\x12\x0c\x24\xbc\x32\x9d\x35\x08\xae\x86\xdf\x4a\x4e\x87\x1b\x50\xf8\xca\x74\xec\xb2\xd5\x8e\xa9\x36\x4b\xd1\x20\xf6\x7e\x46\x47\x1e\x7f\x28\x60\xec\x45\x87\x8f\xc7\x4e\xb6\x22\xbc\x30\x45\xf4\x10\xa1\x55\x92\xf8\xab\xd4\x08\x9d\xa6\xfd\x40\xaf\x90\xe2\x72\x72\x6f\x22\xdd\xb9\x46\x0c\xe8\x06\x17\x3a\x54\xf4\x65\x34\x2d\x2b\x0c\x72\xf3\x23\xe9\xf2\x63\xf9\x86\x41\x62\x49\xe1\x70\xe9\xea\x03\x81\x6d\x36\x7d\xb9\x8e\x9d\x26\x71\x4f\x86\xc7\x64\x65\x4f\x8f\x14\x4b\xe2\xdc\x4e\x5a\x7d\x7b\xbb\xb3\xa3\xb4\xc7\x0a\x85\x17\x78\xb3\x3b\x8b\x56\xe2\x15\x89\x44\xcd\xc7\xba\xb3\xb9\x32\xba\x8e\x0f\x49\x57\x62\x0d\xbb\x43\x58\x21\xcd\xea\x0c\xb5\xcc\x2d\x67\xc9\x1d\x5a\x42\x8f\xf4\x13\x52\x0f\x68\x56\xd2\xdc\x30\x8c\xfa\xa1\x45\x21\x43\x7a\x97\xb5\xc7\x61\xbe\xf9\x59\x78\x67\x78\x60\x1c\x36\x90\x03\x0f\x23\x6c\x44\xbf\x59\x67\x36\xf2\x62\x3b\x9f\x9e\x82\x34\xcd\x2d\xb3\xab\x38\x23\x2b\x52\x0e\xc6\x17\x23\xf1\x03\xce\x45\xf1\xd3\xb0\x02\xc8\xe2\x95\x67\xa1\x6c\x47\xcd\x1d\x53\x6b\xc7\x88\x35\x9b\x2a\x1d\x5f\xe2\x74\x60\x41\xa1\x30\xc8\xaa\xcb\x7c\x80\xe3\xe8\x34\x1e\x30\x70\x07\x5b\x7d\x33\x4f\xe9\x9c\xc5\x58\x48\xe3\xd0\x5c\x79\x83\xd3\xd4\x72\x68\x5e\xda\x14\xe0\xa4\x96\x6d\xa5\x0e\x51\x4a\x92\x9c\x62\x3a\x94\x92\x9a\x65\x30\x52\x4a\x28\xaf\x23\x4b\xbf\xdd\xbe\xd8\x42\xcb\x58\x6a\x56\x62\xfa\x77\xa1\x92\xe6\xfa\xf7\xcb\xd6\x91\x79\xd9\x79\x64\x5e\x2a\x22\x76\x9c\xa7\x57\x14\x5c\x80\xce\xc8\x8a\xe2\x9c\x2e\x08\x11\x8c\x5c\x88\x0e\x7c\x56\x3d\xb9\x18\xc5\x2a\x3d\x39\x2f\xec\xe9\xfa\x72\x4b\x05\xb2\xd2\x1a\xea\xf0\x46\x75\x4f\x00\x73\x78\xee\x5a\x52\x9a\xa2\xfa\x7e\x02\x54\xfc\x3a\x1c\x04\xa8\x5e\xc5\x61\xf2\xd8\xbc\x84\xc6\xfc\x3e\x4d\x3e\x20\x0d\x0e\x6f\xe4\x4c\x5c\x14\x66\xa5\x59\x39\x1c\x00\xb9\xbf\x54\xc8\x3d\xc2\xb6\x45\xea\x85\x2c\x24\x96\x55\xf8\x2e\x33\x3c\x30\x56\x6e\xea\x25\x86\x5a\x4a\x08\x72\x2f\xd9\x33\x85\x13\xd3\x27\x62\x78\xba\x44\x27\x4a\xef\xdd\x9b\x7a\x53\x38\x61\x7f\x94\xae\xdb\xb6\x5a\xfe\x89\xb5\xb8\x89\x23\xd7\x87\xc7\x9d\x55\x5a\xd5\x05\x7c\xb8\x46\xb5\xd5\x8b\x8e\x8b\x37\xb4\x0f\x11\xc2\xb4\x28\xf8\x83\xd6\x05\x7e\x2c\xf5\xfe\x80\x9c\xa7\x40\x78\xe2\x7c\x8c\x3c\x5e\x63\x55\x6d\xa8\x91\x99\x37\x52\x0a\x0f\x2d\x03\xd2\x5f\xc5\xab\xbf\x3a\xac\xb2\x73\xb5\x00\xe6\xe8\x02\x76\xec\x3c\x19\xa7\xd3\x34\x99\x38\x1f\x66\x09\xc8\xa1\xb0\x88\x69\x9c\xa5\x7f\x87\x15\x46\xff\x23\xb1\x56\xbb\xae\x83\x79\xfe\x67\x89\xc3\xdd\x02\xc4\x78\x1c\xa6\xe2\xda\x71\x99\x90\xa9\x24\xff\x90\xe2\x0a\x4f\x43\x50\xcd\x33\xdc\x7c\x8e\xcb\x8c\x8c\x5c\x14\xcd\x50\x14\xe5\x71\x5a\xf1\x69\x76\x46\x37\x60\x89\x08\xd3\xc2\xd7\xbd\x1c\x1a\x3a\xa6\x0c\x2d\xb0\xc2\x20\x98\xaa\x8a\x40\x46\xde\xb8\x1e\x70\x24\x7c\x76\xdc\xab\x38\x5f\xc4\x99\x4b\x77\xff\xf0\xdb\x7c\x44\x66\xe1\xe2\x44\x49\x04\xec\x86\xc2\x6b\x13\x36\x76\xbb\x0e\xa5\xfd\x15\x75\x80\x54\xb9\xad\x5c\x1a\x74\x0d\xd0\x96\x63\xa6\x9e\x75\x67\x79\xa5\xc1\x6a\xfb\x06\x69\x98\xc2\xc5\x73\x69\xfd\x80\xed\x88\x73\xb1\xfd\xa6\x8a\x56\x58\xad\x1f\xdc\x48\xd2\xc2\x41\xd8\xd0\x3b\x74\x34\x61\x3b\x65\x9a\x7e\xe4\xf6\x15\x9d\x50\xac\xb6\xa5\x5d\x2a\xb6\x34\xe3\xab\x97\x62\x58\xad\xe0\x0e\x2f\x6f\x8f\x55\xe9\xa6\x15\xc9\xc0\x6d\xd4\xb9\x8f\x36\x2f\xa0\x82\xf7\xee\xb5\x72\x17\xe7\xe2\x8d\xc7\x7f\x45\x37\x14\x70\xc2\x9f\x02\x94\x24\xc4\x03\x72\xcc\x96\x39\x66\xc9\x25\x6c\xf9\xd6\xa8\x45\x8c\x25\xe6\x6c\x8b\xda\x43\xd5\xbc\x18\xc4\x52\x00\x45\x63\xa2\xa4\x56\x1a\xe8\x27\x6c\x7c\x9a\x9f\xed\x60\x80\xb9\x57\xc3\xaf\x28\xa1\xe0\x05\x7d\x5c\x84\x54\x16\x67\x49\xdc\xf9\x92\xd6\x30\x05\x4d\x43\x67\x4e\xf2\x10\xa3\x4b\x35\xa7\x3f\xbf\x91\x51\x15\x34\x64\x77\x28\xb0\xcc\x5a\x40\x7d\xcc\x86\xcc\x46\x94\x85\xd2\x60\x85\xfa\xd3\x0d\x7b\x84\x79\x03\xf0\xd5\xd1\x4f\x99\xf4\x46\x77\x25\x71\xaa\x7b\x2a\xb7\x2f\x39\x4c\xc3\x67\x27\xdc\xfa\x2f\x92\x44\x9f\x51\x0e\xd7\x84\xdb\xc3\xf4\x2c\x13\xb7\xb7\x2e\x7d\x99\x28\x8c\x87\xaf\x3e\x44\xf8\x9e\xa8\x0a\x9e\x77\xa4\xda\x11\x69\x17\x13\xc1\xbe\x60\xe3\xc6\x17\x18\x89\x2b\x36\x20\xa1\x11\x48\x31\x46\x09\x89\xe5\x32\x15\x30\x0f\xfa\x18\xc9\x46\x23\x7b\x82\xc7\xf6\x70\x65\xac\x16\x6c\x45\x6b\x17\xe6\x59\x5e\x3d\x63\x90\x7b\x5b\x2e\x34\x9f\x3d\x41\xe3\x11\xe2\x8e\x7a\xb0\xf9\xd2\x7d\x96\x83\x3c\x17\x0a\xca\x9d\xc1\x48\x86\x48\x6b\x9d\x10\x3d\xfa\x9f\x8a\x8b\x78\x4c\xac\x40\xc6\x21\x8f\xd3\x56\x96\x81\xe7\x75\xdd\x0c\xbd\xb0\xf9\x4d\xf0\x0b\xa9\x58\x83\x5f\xcc\x8e\xbe\x1e\xbf\xd8\xe0\x4d\x04\x13\x56\x78\x6b\x1f\x06\x82\xd9\xd5\x4d\x53\x53\xf1\x62\x2e\x27\x63\xff\xa5\x65\xdf\x13\x7a\x69\xce\x5e\x76\x45\x65\x4a\xe7\xb1\x69\x93\x80\xc2\xe4\xb5\xfa\x20\xd0\x26\xe5\x93\x0e\xa3\x55\x8b\x35\xe3\x11\xf7\x76\xcf\xa4\x1d\x01\xd3\x51\x93\xb2\x58\xd0\x7a\xe6\xf2\x91\x49\xc2\xff\xee\xd9\x13\x4f\x99\x24\xd7\x02\x37\x8e\x08\x18\xd5\x45\xf2\x72\x4a\xfe\xb9\x66\xd2\xa8\x46\x94\x9e\x24\xd5\xb8\x4c\x2f\x92\xc9\xc5\x75\x53\x51\xac\x84\x94\x55\x50\x7b\xd2\x08\xed\x3c\x20\x8d\xb1\x5f\xb6\xfb\x18\xd5\x36\xa4\x88\x73\x62\x2f\x56\x18\x67\x4a\x7b\xdc\x02\x59\x68\xff\x2e\x48\xa3\xbd\xbf\x54\x27\xf1\xa2\x2e\x4e\xe0\xef\x1e\xc8\xd8\x11\x4f\x7b\x50\xf8\x23\x38\xfa\xbc\x22\x2a\xa4\x96\x30\x45\xb5\x20\x90\x64\x94\xb2\x10\x38\xd2\x67\x85\x5c\x60\xf1\x7e\xc1\x41\x80\x9e\x25\xf0\x67\x92\x02\xcf\x09\x22\x8e\x7b\x91\x15\xc0\x4f\x2e\x7d\x35\x49\xaf\x7d\x07\x33\xc1\x5b\x1b\xa7\x14\x8b\x4e\x62\xc3\x19\xbf\x55\x03\xa3\xce\xd4\x34\x18\xba\xfc\xd0\x95\xe4\x8d\x7d\xc1\x82\x86\x35\x84\xc7\x65\x29\x9b\x14\x9a\xf2\x52\xdf\x60\x1c\xb5\xd2\x11\xcf\xd4\x22\xee\xdd\x0c\x18\x5a\xb1\xad\xb5\x88\x8a\x60\xde\x6e\x49\x97\xa5\xfc\x51\x11\x69\x57\x38\x96\xfc\x62\xc2\xdd\xd9\xf1\x9c\xff\xe4\xd7\xc0\xf2\xcb\x6c\xa9\x0e\x5e\x89\x3a\x3b\x9a\xe3\xdf\x13\x79\xfb\x2c\xf7\x1e\xe3\x55\xe8\x61\x77\x0c\xad\x50\x9e\x46\x99\x60\x9b\xfe\xf0\x3a\xf8\xbb\x37\x86\x76\xf0\xc7\x09\xff\x3e\x2c\x0c\xad\xcd\x42\x5b\x43\xca\x10\x3d\x69\x7c\xa5\xe2\x6c\x4c\x2a\x21\x71\xf1\x65\x11\x94\xc1\x38\x98\x09\x5d\xea\x7c\x9e\x5d\xbf\x12\x58\xe7\x4d\x44\x46\x92\x2b\x8b\xde\x5b\x21\x6d\xa3\x7a\x55\x62\x3e\x58\x30\xb6\x5a\x41\xdd\xd2\x4b\x70\x3a\x99\x33\x6b\x2c\x1c\x76\x98\x35\x74\x34\x5d\xe1\xbd\x8f\xbe\x69\x1b\x5f\xc8\x77\xd5\xa1\x02\xb3\x6a\x2f\xfc\xf0\xca\x33\x89\xb0\x0e\x90\xee\x64\xeb\x8c\x64\x01\xfd\x32\x91\x2e\x53\x8b\xb8\xd9\xbf\x50\x7c\xa8\x99\x93\xf1\x15\x1c\x99\x69\xde\x63\x1b\x76\x38\xc0\xeb\x92\x3b\xaa\x10\x3e\x50\x9d\x51\x5a\xbd\x88\x5f\xc0\x12\xd3\xee\xc7\x6f\xe8\x39\xc5\xe7\x14\x9f\x73\x32\x5e\x03\x5a\xe7\x84\x30\xbb\x51\x0a\xc2\x0e\xbf\x1a\x13\xfe\xc7\x11\x00\x87\x17\x34\x92\x01\xb2\x80\x97\xa1\x66\xcb\x69\xc8\x06\xf9\x34\x94\xe8\xb9\xee\x51\xe3\x3e\x23\x23\x5a\x31\x73\x57\x47\x38\xe6\x78\x19\x59\x60\x86\xce\xf2\xac\x55\x2d\x40\x95\x6d\x40\x8d\xf8\x1e\x82\xa3\xb3\xdc\x89\x32\x92\x33\xf0\x4e\x4f\x36\xb1\xac\x57\x8a\x94\xea\x02\xbb\xff\xcc\xb7\x29\x8b\x07\x48\x26\xc0\xa7\x00\x01\x4b\x82\x3c\xa8\x02\xa8\x3c\x66\x1b\x47\x80\x83\x3d\x86\x1c\x4c\x63\xba\xd7\x99\xe5\x53\xdf\x83\x9f\xb7\x6c\x9f\x4a\xf7\x81\x60\x11\xcd\x4e\xf6\xbf\x65\x1f\xf5\xe2\xdd\x2a\xc4\x07\xdc\xd9\xd9\x6e\x09\x94\x03\xb6\xac\x32\x1d\xd8\xb9\xea\x4c\x40\xdc\x15\xf7\xbb\xe6\xd2\xc9\x86\x88\xf5\x23\x54\x5b\x79\x8b\x80\x52\x3d\xce\xcf\x82\x99\x21\x09\xaa\xd5\x5a\xaa\x23\xb6\x67\xa9\x05\x46\xdc\x6b\x49\x37\x48\xc3\xf6\x60\xf0\xad\x37\xec\xe5\x7b\x89\xbf\xeb\xfe\xde\x95\x55\x18\x7d\xa2\x8a\x78\x52\x18\x6c\xc7\x1d\x1d\x32\x1b\xe7\x4b\x5d\xe1\xb6\xa9\xf3\x65\xdb\x0f\x12\xd9\x67\x9b\x2b\xa4\x39\xe2\x56\x3c\xb2\x16\xdc\x26\x82\xcd\xd0\x6b\x18\x13\xa2\x99\x66\x2e\xed\x38\x51\xdc\xca\x98\x8c\xb0\x63\xb0\x70\xb5\x3c\x4c\x81\x87\x13\x34\x0f\x39\x3b\x23\x78\xf8\x91\x9d\xe9\xb0\xa6\x70\x74\x77\x13\x4e\x1f\x79\x68\x50\x67\xd6\xcd\x18\x53\x5a\xad\xca\xeb\xe4\x58\x82\x97\x83\x0e\x6a\x6a\x89\x7f\xda\x32\xe4\xa9\x83\x8a\x66\x42\x97\x60\x53\x42\xeb\x4b\x27\xf4\x1f\x6b\x5c\x46\x47\x32\x6d\x19\x77\x67\x05\xe6\x86\x5b\x78\x77\x1a\x65\x84\xe2\x3f\x2a\x02\x34\x84\xfb\x2b\xf9\xd5\x77\xbf\x0f\xda\xcd\xcb\x84\x58\xbc\x46\x6b\x9f\x6c\xe3\x3c\xdb\x52\x7c\xb4\x6f\x2a\x97\x68\xeb\xe5\x91\x19\xac\xeb\x13\xab\x18\xb9\x7f\x78\xf9\xe4\xff\x90\x50\xc1\x33\x5c\xd1\x05\xe4\x9d\xb1\x44\x74\x8b\x65\xcc\xd8\x09\xba\x04\x5c\x55\xff\xc4\xc1\x0d\xbd\x00\x6e\x8c\x05\x1c\xc5\x44\xd7\x02\xe6\xd3\x03\xa5\x6c\xd7\x41\x31\x6c\xc1\xa5\x2f\x82\xef\xb8\x66\xf3\xcd\x9f\xff\x28\x93\xa6\x25\xaa\x30\xd7\x7a\x8f\xce\x40\x27\x1a\xcb\xb9\x0c\xb3\x13\x1c\x5b\x28\x3c\xa2\x30\x11\x5f\x74\xc3\xfc\x17\xc2\xfa\x64\x8d\x4f\xdc\x5b\xa0\xcc\xb7\x46\xf4\x90\x78\x11\xe6\xaa\xe3\xd3\x12\x78\x64\xe8\x9b\x4d\x73\x2a\x3d\x2c\xe8\x19\xfa\xe4\x33\x6d\x5e\x08\x77\xa6\x25\x5d\x05\x2e\x54\x4c\x3a\xd0\x80\xef\x06\x96\xa8\xb5\x9c\x26\x57\x65\x10\xea\x40\x3a\x4b\x36\xbc\x63\xce\xa0\x42\xf7\xb8\xc3\xfe\x67\x9d\x33\x10\xb1\x90\x2f\x28\xa4\xa1\xee\xed\xf7\xea\xbd\xfd\xa5\xe0\x27\xd5\xef\x7a\xf1\xca\x0f\x38\xeb\x68\xed\x09\x2a\xc5\x7b\xfb\xea\xe7\xbd\x7a\x19\x6e\x54\x51\xf4\xb3\x6c\x81\xc1\x7a\xfc\x5a\x60\xc1\x73\xdc\x69\x58\x31\x92\x01\xa2\x0d\xb3\x2d\x9c\x87\xd5\x5b\xea\xdb\x76\x0c\xbb\xc9\x81\x9b\x60\x30\x08\x2d\x5d\xcf\xd5\x63\xd6\x39\xda\x07\xb7\x38\x1c\x91\x72\x8e\x3b\x1b\x54\x11\x83\x76\xd1\x4b\x39\x7e\x01\xb3\xc2\xa0\xd4\x14\xed\xc6\xa3\xea\x28\x25\x0e\x3f\x23\x26\x85\x7e\xf7\xaa\xb0\x3c\xa6\x5f\xbb\x29\x07\x28\xdd\x2a\x21\x2b\xc8\x62\x60\x66\x28\x3c\xf4\x86\x71\x34\x7c\x4d\x0a\x10\x5a\x38\xd4\x8b\xdd\x7a\x34\x86\x1e\x88\x85\xc9\xe8\x4f\x94\x72\x91\x20\x9c\x41\x27\xa5\x6c\x5d\x79\x07\xed\xd3\x62\xf5\x66\x22\x0b\x9f\x93\xb5\xd6\x6d\x13\x12\xbc\x89\xd7\xbe\x49\x75\xbb\x9c\xf7\xe9\xf5\x09\xff\xab\x3b\xee\x53\x51\x7b\x83\xbd\x7b\xf6\x44\x23\x95\xa8\x63\xc3\x2b\x80\xff\xfb\xbf\xbd\x61\xf2\xf0\x5b\xc6\x80\xc6\xb0\x8f\xaf\x50\x17\x25\xc9\x03\x7c\xc9\xa9\xc7\x1f\xae\x9f\x51\x80\x9c\x54\x1d\x1b\xca\x68\xe0\x0e\xac\xf9\xb4\x91\x6d\x90\xf7\x4e\x51\x25\xc3\xd1\x8c\xdb\x2d\x81\x23\xdf\x89\x1a\x01\x85\xbb\x55\xb4\xac\x4c\xaa\xb1\xe8\xaf\xe2\x5b\xdd\xe0\x84\xfa\xb7\x14\xfe\x22\xa4\x3e\xc6\xe3\x3a\xbb\x76\x86\x0e\xb1\x99\x70\xd8\x67\x0e\x5f\x8e\x1d\x57\x57\x82\x63\xaf\x86\xe4\xf2\xe9\xc1\x0e\xba\x69\xd5\x37\xd5\xe4\xa8\x4f\x6a\x45\x4e\x36\x56\x4c\xbd\x3a\xcf\x44\xd8\x5d\xdf\xd0\x7c\x32\x73\xd4\x53\xfe\xb6\xf3\x2b\xf5\xc9\xd6\x40\x07\x5f\x38\x42\x6f\x19\x0f\xde\x6e\xa8\x27\xf5\xbf\xb6\x8e\x14\x78\xc1\x93\x46\x21\xda\x1f\xb3\x14\x03\x46\x41\xd0\x52\x7f\x9e\x70\xf3\x05\xb0\xd4\x21\xd7\x1e\xc3\x4f\xb6\xb1\xda\x5a\xfb\x93\xa6\x4a\xd8\x7c\x68\xac\x1b\x8b\x2e\xe9\xc8\xb5\xa1\x69\x5a\x39\x02\x71\x65\x2b\xe3\x00\x51\xdf\xa9\x67\xdb\xd0\x12\x90\xc0\xb4\xf9\x9d\xb0\x9c\x6f\x7d\xd2\xc0\x23\x17\x4c\x31\xdf\x84\xec\x6f\xc3\x7f\xb3\x67\xc6\x59\xe6\x02\xa3\xc5\x93\x34\x7e\x8b\x02\xd5\xf5\x61\x29\x9d\x5a\xa6\x4a\xc4\xdb\x48\x7d\xd8\x32\x7e\x67\x6a\x75\x56\xe4\xee\x0a\x9f\x25\x7e\x47\x3a\x64\x30\x34\xbc\xdc\x38\x7e\x67\xda\x11\xd4\x17\x5d\xea\xaf\x56\xfa\xea\x68\xa0\xe9\x88\xdf\x39\x58\xe5\xfd\x12\x1b\xde\x2f\x31\x36\x68\x13\x2a\x2c\x1b\x16\xdd\x43\x18\xba\xf1\x4c\x9d\xeb\xd3\x65\x25\x48\x7f\x24\x85\xb4\xab\xe8\x01\xbe\xd0\x5e\x23\x91\xc5\x5d\xb7\x08\x92\x26\x83\xc9\x2e\xb1\xda\x80\xed\x83\xcd\x63\xbd\x2f\x8a\xa2\x06\x68\xc4\x88\x1f\x49\x74\xf3\x1f\xc9\xc5\x2f\x69\xdd\xcc\x28\x74\x3f\x18\x25\x34\xc7\x9f\x8a\xbf\xab\x75\x9a\x8e\x31\xb0\x30\x78\xa9\xbe\x2b\xb4\x4f\x9d\xc2\xa8\x5b\x77\x36\xb3\x94\x6e\x0a\x18\x75\xe8\x90\xdd\x8a\xb9\xbd\xef\xa0\x39\x86\x82\xf5\x4f\x6b\x61\xbb\xba\x81\x4f\x42\x7c\x5e\x36\x36\x2c\x52\xc9\x77\x78\x28\x92\xc8\xda\x27\x9f\x8a\x18\x23\x93\xf4\xd5\xbf\xb9\x80\xa3\xe7\x2d\xfa\x53\x76\x2d\x07\xfa\xe4\x10\x61\x5d\x5d\x8b\x65\xd4\xd0\x14\x59\xe8\x87\xaa\xa7\xb8\x63\x86\x7e\xc9\xca\xb0\x6f\x5e\x5e\xfc\xcc\x7f\x95\x4c\xfd\xc7\xf5\xf5\xe5\x25\xad\x63\xe5\x2f\x6d\x0e\xd3\x87\x6d\xec\xb7\x07\xac\xc5\xea\x25\x99\x48\xd5\xce\xcf\x93\xea\xa7\x62\xb2\x00\x96\x28\x0f\x6f\xc4\x15\xb7\xf9\x72\xa9\x28\x23\xd8\xe8\x8d\xea\x72\xe0\xdc\x72\x73\x43\x4c\x34\x12\xb8\x9d\x28\xf7\xb5\x35\xcc\xfd\x97\x44\x7f\x74\xf9\xf5\xe5\x87\x1c\xf3\xbf\x26\x65\x7d\x2d\x9c\xc2\x29\xd7\x1f\xae\x66\x94\xe3\x12\x8f\xa4\xdd\x8b\x8f\x0b\x36\x7f\xb2\xac\x95\x41\xc0\x46\xe3\xec\x39\x7c\x0e\x04\x7d\x8f\x81\xee\x22\x2e\xab\xbd\x8b\x98\xae\xe5\x01\x06\x3c\x6e\xbd\xab\xe2\x69\xd2\xe3\x94\x0b\xaa\x54\x96\x2a\xc9\xc7\x71\x42\x87\x27\x56\x28\x2d\xed\x2f\xea\x34\xc3\x9b\xcf\x83\xb1\xe5\x65\xb9\xc8\xf1\x2c\xc2\xd7\x33\x4b\xe3\x79\x81\x41\xa6\x44\xef\x30\xf1\xbc\x84\xf5\xc2\x93\x36\x56\x0c\x93\xec\xff\x49\x7e\xf2\x34\x7f\x9f\x96\x45\x4e\xfa\x08\x0e\x96\x52\x08\x87\x09\x48\x85\x70\x1a\xbf\x81\x49\xbd\xa1\x39\x01\xfb\xcd\x21\x06\xc5\x4f\xc5\x44\xa2\x4a\x29\x7d\x87\xa3\x8f\xca\x40\xa4\x0f\x7b\xfa\x71\x5e\x26\x15\xdd\xee\x54\xb6\x8a\xa0\xd6\x9f\x7f\x8a\xc6\x68\x7e\xe7\x5c\xa3\x2d\x7f\xe7\x58\xbe\x25\x05\xf5\x12\xd6\x8a\x5d\x96\x0e\xb3\x1a\xcd\x39\x05\x8a\x16\xc1\x4c\x0c\xc3\x9b\xfb\xc1\x5c\xae\xed\x1c\xef\xc5\x93\xbf\x61\x5c\x1f\x71\x67\x55\x91\x2c\x05\x5c\xb7\xac\x70\x38\x7c\x10\x74\xad\x5c\x38\xfc\x3e\xe8\x06\x7c\xb8\x6f\xbe\x15\xab\x16\xee\xff\x10\x74\x23\x4c\x78\x30\x08\x6c\xa8\x10\x1e\x0c\x71\x2f\x5a\x6e\x93\xff\xd4\xbd\x68\xa0\x3c\x00\xca\x8a\x18\xd1\x8c\x2b\x4a\x08\xdd\x38\x7e\x06\x85\xc0\x3f\x0d\xa3\x53\x56\x69\x96\x64\xb0\x03\xb1\x5a\xc5\x0a\x26\xc9\xb8\x28\xc5\x45\xf4\xa5\xf8\x34\x2b\xf0\x90\xa2\x4b\x88\x1a\x5f\xcc\xc3\xfe\xa0\x3f\x1c\xa2\x72\xf9\xf1\xcb\x9f\x5e\x3d\x7b\xfe\xf4\xf5\xf9\xeb\xa7\x7f\x7e\x46\x2f\xbf\x83\x52\xf1\x70\xfe\xf8\x4f\x8f\x5e\xfc\xf1\xe9\x9b\xe8\x66\x18\xba\x47\x91\x33\x84\xef\xca\x71\x7f\xdf\x0d\xf6\x43\xe0\x1b\xe8\x79\xd0\x83\x92\x03\x37\x38\xd0\x4b\x0e\xdd\xe0\x90\x97\x7c\xec\x7f\x74\x83\x07\xf4\xb0\x4f\xaf\xe3\x6c\x3e\x8b\xb1\xf0\x61\xe8\x1e\x8b\xc2\x0b\xe0\xcf\xfa\x43\x37\xf8\x8e\xca\x70\x84\x03\x77\xc9\xf5\xf4\xee\x29\x63\x83\x1c\x46\x8d\xce\x94\xa5\x98\x69\x3a\x6d\x0e\x14\xd4\x84\x09\x5f\xa5\x79\x5c\xa2\xdb\x5d\x85\xf7\xd6\x8a\xb2\x06\x54\x78\x8d\x34\xa5\xf8\x2d\x93\x4b\x90\x9e\x92\x92\xab\x48\xff\xc4\x1a\xe2\xec\x59\x65\xbe\x7e\x22\x1b\x60\x35\x96\x33\x25\x35\xc2\x8d\xc2\xe6\x87\xb3\x80\xad\x40\x58\xca\x2d\x0c\x05\xcd\x53\x1f\x9e\x02\xd1\x3a\xeb\x35\x54\x91\x90\x08\x38\x0a\xfe\x8c\x3e\x88\x94\xe2\x51\x14\x8d\xe9\x55\xa2\xc8\x8a\x85\xdc\x9e\xee\xa3\xf2\xd2\xc9\x8b\xda\xe1\xa7\x1c\xfa\x20\xa6\xf5\x0c\x24\x45\xe4\xc4\xb2\xc4\x91\xd8\x33\x92\x89\x1c\x54\x00\x02\xe7\xc2\x92\x4e\xa9\x85\xe4\xf8\xb4\x0c\x16\x79\xe7\x70\xfd\x1b\x3c\x64\xeb\xd6\x67\x4b\x39\xc3\x57\x6c\x35\x36\x9e\xa2\x3e\x3a\xb1\x96\x30\x3c\xe9\x13\x24\x03\xeb\xec\x90\xb8\xff\xa8\x46\xda\x56\xa3\xd3\x65\x5d\x38\x62\x1c\x4e\xec\xf0\xc6\x1c\xec\x0e\xe0\xc3\x63\xdd\x9c\xb8\x72\x16\x39\x7c\x9d\xe6\xc9\xe4\x3e\x37\x6f\x8a\x7e\x19\x04\x54\x10\xb4\xe7\xa3\xc3\x40\xf9\xb2\x01\x82\xc4\x9e\xaf\xb1\xd2\x2a\x59\x30\x16\xbb\x79\xa5\xaf\x77\x53\xde\x5a\x72\xdb\xd0\xf5\x19\x6b\x1f\xf3\x9c\xfd\x8b\x48\xc3\xf7\x11\xfd\x0b\xe7\x89\x60\x6e\x7f\x2c\x31\x67\x51\xa6\x3e\x05\x54\x07\xaf\xe0\x2c\xf5\x53\x44\x99\x4f\x38\x7c\x18\x68\xd4\x51\x9c\x1a\x1c\xb9\xc3\x21\x1d\x06\x9c\x04\x86\xfb\xdf\x05\x92\xb8\x72\x8a\x6f\xb9\x67\x54\xa3\xf8\x6d\xf2\xdd\x49\x08\xb4\x73\xb5\x59\x9f\x9c\xcb\x8c\x71\x90\x99\x64\x7a\x2f\xcd\x33\xc0\x32\x22\xf4\x5e\x1c\x65\x3e\x3a\x90\x28\x27\x49\xdc\x9c\x24\xf1\xd2\x9c\xbc\xf8\x38\x1c\x7e\x87\x13\xb1\xdc\xff\xb3\x6a\x22\xdc\x98\x8a\x03\x92\xc7\xcd\xa8\x39\xc4\xf5\x58\x8a\xd6\xda\xa3\x16\x80\x3a\x6f\x44\xa7\x2c\x28\x82\x34\x90\xa2\x97\x54\x83\x17\x72\x07\xe0\x05\x0f\x0d\x31\x06\x9a\x6b\xbf\x82\x3c\x95\x75\x46\xcd\x4f\xe0\x7f\x14\x0f\xd4\xa0\x69\x47\x5c\x89\xc8\xb2\x39\xc8\x2b\x44\x9b\x0f\xeb\x20\xc6\xb8\x33\x65\x1f\xf6\x81\x21\xc7\x10\x6a\xe0\x54\x41\x96\x04\x4e\x87\xdc\xaa\xec\x2c\x8b\x81\x2d\x96\xfb\x01\x36\x00\xf2\xa9\xcb\x2c\x79\x0c\x45\x03\x77\x9a\x66\x09\x5a\x61\xe0\x27\x02\xf1\x05\x73\x8e\x0d\xdc\x2b\xe0\xd8\xe2\x4b\x2c\xce\xd9\xdb\x5c\xbc\xa9\xea\x78\xfc\x8b\x7b\xa6\x5d\xaf\xd4\x40\x8c\x5d\x29\x59\x8c\x83\x38\x62\xb4\x10\x30\x8d\xfd\x18\xa1\x5a\x37\xdf\x8d\x5c\xa7\xe7\xb8\xbb\x1e\xde\x39\x42\xb7\xd9\xf4\xb1\x5f\x7f\xd7\x0d\xa1\x30\x93\x85\xe3\x22\x5b\x5c\xe5\x7e\xe3\x6c\x5e\x44\xa4\x7c\x54\x43\x6b\x95\xdc\x3e\x8d\x47\x52\x8e\x3c\xc9\x60\x94\x1e\x89\x8b\xce\x47\xe9\xee\x2e\xb9\x97\x9f\x56\xa7\x29\x00\xba\x60\x7f\x47\xac\x39\x60\x50\x6b\x4c\x73\x86\x93\x02\x21\x6e\x0c\xe3\xef\x78\xc1\x9a\x2f\x01\x33\xcb\xeb\x9b\x5a\xa8\x53\x1b\xa0\x45\x71\xc0\xc5\x13\x46\xaf\x85\x50\x72\x62\x2d\x65\xad\xb9\x6c\x9a\x6e\x00\xd0\xcb\x16\x49\x98\x05\x09\xc0\x39\x29\x51\x13\x88\x19\xef\xb9\x27\x15\xab\x05\x7b\x72\x39\x8e\xeb\xf1\x0c\x37\xc2\x72\xa9\x80\x22\x92\xba\x59\x85\xf7\x2d\x3b\x10\x09\x71\xc7\x92\xe4\xea\xf3\xf3\x96\x76\x26\x46\xdb\xce\x99\x42\x99\x02\x95\x4c\x05\xa9\xfa\x50\xa9\x0f\xa5\xfa\x30\x56\x1f\x66\x2d\x3a\x97\x49\x99\x89\x75\xbe\x47\x0e\x62\x3d\xf6\xd4\xc3\x0c\xaa\x5c\x72\x2b\xcc\x8a\xa8\x21\xd3\xa5\x3e\xfe\xa2\xfd\x6d\x65\x56\x49\xa7\x4c\xd6\x33\x8a\xe9\x9a\x0c\xf2\xe9\x32\xcb\x8b\x5f\x16\x73\x5d\xc4\xe3\xaf\xf0\xf0\x74\x31\x61\xea\xcd\xba\x39\x84\xfb\x83\xc0\x1c\x7e\xb8\x3f\x0c\xba\x47\x1e\xee\xef\x07\xfa\xa0\xc3\xfd\x83\xc0\x18\x6f\xb8\x7f\x18\xb4\x87\x1a\xee\x3f\x08\xcc\x51\x86\xfb\x0f\x01\xb3\xf6\x2d\xf9\x6f\xd6\x52\xa5\x74\x3d\xe9\x2f\xf0\x14\xd3\x39\x3d\x8f\x79\xfb\xb1\x87\x9f\xf8\xa4\x02\x1b\x15\x47\x85\xf3\x7b\x18\x28\x33\x35\x4f\x73\xb2\xd2\x21\x93\x26\xef\x94\x8c\x95\x3b\xbb\x86\xf8\x82\xe5\xc9\x50\x6a\xd4\x4d\x8d\xb4\x9f\x56\x8f\xca\x32\xbe\x46\xdb\x1a\x7f\x3d\x38\xca\xc5\xe5\x6e\xa8\xf0\x9e\x54\xcc\x13\x78\x02\x04\x37\xe9\x23\x05\x3d\x43\xfc\xe6\x00\x63\xea\x57\x1c\x20\xec\xef\xa6\x5d\x96\x48\x0f\xe9\x27\x7c\x27\xec\x8b\xa9\xca\x86\xf0\x2a\xc8\x39\x51\x6e\xa8\x8f\xf5\xab\xb8\x9e\x41\x1d\xe6\xae\xf8\xb8\x29\xe3\x35\xd5\x6a\x01\x1b\x08\xe9\xe9\xf0\x5d\x98\x2d\x97\x72\xfa\x24\x68\x6f\x7c\xf6\xec\x5b\x02\xeb\xd7\xae\x72\x1c\xcc\xf5\x75\xe6\x3c\x88\x26\x50\x06\x93\xf5\xcc\xc7\x5a\xd6\x40\xe0\x07\x6d\x82\x06\x23\xe2\x80\x29\xa0\x76\x72\x85\x77\x9d\x34\xbc\xeb\x4f\x68\xb8\x9a\xc7\x55\xe5\xa4\xd0\x08\xb2\x16\xc8\xa3\x7f\xc3\x28\x81\x90\x88\xe9\x94\x86\x3f\x02\xa3\x30\x6f\x51\x11\xb9\x2e\x10\x0a\x7e\xde\x55\xe2\xbc\xd3\x0f\x48\x0a\x92\x43\xa7\x36\x4c\x9d\x1e\xe5\x40\xe0\xe8\xae\xaa\x28\xc1\x0b\x97\xd2\xb2\x82\x86\x50\x6c\x80\x27\x0c\x67\x8f\x76\x76\xea\xa0\xa2\xea\xea\x4a\x57\xbb\x39\x12\xab\x5d\x60\x31\x30\x06\x24\x60\x0b\x99\x06\xb4\x13\x80\xfb\x8f\xaf\xaa\x70\xde\x57\x9e\xbc\x53\xaa\x07\xff\x7f\x0a\xdf\xd2\xcd\xcd\x94\x96\x85\x7c\x9a\x19\xba\xe5\x1c\x5d\xab\x68\x6e\xc1\xa3\xbc\x8d\x47\xf4\x01\x5a\x3a\x31\x32\x09\x35\x2f\x4a\xfc\x1d\x5d\x41\x1e\x47\xb1\x72\x47\xab\x1f\x88\x9e\xbc\x34\x9a\x6b\xe8\xcc\x5e\xb0\x44\xd0\x6d\xdb\x02\x6a\x6c\xe7\x72\xaf\x41\x35\xc1\x0a\x8c\x9b\xa8\xb2\xfa\x68\x3c\xaa\xf1\x7c\x47\xfd\x20\xb4\x52\xe2\xfd\xae\x41\x4d\x37\x28\x8a\x04\x2b\x5c\x3a\x63\x4e\x71\x62\x6d\x78\x53\x0b\xfa\xce\x8f\x0d\x45\xa2\xb7\xf0\x65\x96\x94\x9d\x28\x9a\x61\xc3\xb3\xa0\x86\xb6\x80\x46\x83\xa4\x00\x3d\x8e\x2c\x6f\x31\xb6\x59\xec\x29\x5c\xcd\x9a\x9c\x1a\x13\x01\x87\x62\xf5\x0e\x33\x25\x07\x63\xcf\x59\xe2\x72\x37\xd8\x73\xb6\x3d\xb6\x01\x83\xbf\xf1\x1e\x9b\x75\x90\x5f\xda\x69\x43\x80\x8e\xd4\x38\x0b\x73\xb2\x55\x2e\xe6\x0d\x70\x4d\x40\x88\xf2\xaf\xfc\xf0\xd4\x6c\xa2\x37\x3c\x23\x52\x06\x02\xf2\xfd\x35\x54\x4b\x83\x29\x42\xd1\x62\xdf\x59\x0f\xc5\xf5\xe7\x13\xaa\x7c\x5a\xb0\x81\x23\xd5\x38\x8f\x04\xbd\x55\xb7\x4c\xee\xd3\x65\xf0\xb9\xb6\x65\x76\x48\xb5\x3d\x03\x1a\x31\xce\x16\x93\xe4\xff\x26\x65\x81\xe9\xa4\x31\x17\x6a\x5a\x3d\xbd\x9a\xd7\x78\xf8\x9c\xc8\x83\x8d\x7d\x16\xe2\xe1\xc6\x55\x40\x7e\xd0\x1e\xcf\x22\xcf\x80\xab\xef\x18\x53\x2d\x4f\xa7\x74\xaa\xb2\xd3\xc1\xcd\x34\x0f\x9b\x13\x94\xff\xa5\xae\x02\x1c\x62\xc8\x46\xba\xdc\xe6\xf8\x58\x67\x66\x68\xf3\x92\x2d\x80\x67\xc8\x3c\x9a\x13\x44\x8e\x45\x45\x41\x19\xc4\x14\x9d\xb2\xdd\x7a\x06\xb4\x7b\x25\x5e\x21\x3d\x07\xa2\xd2\x7e\x43\x54\x26\x67\xe9\xad\x9a\x16\xd0\xb8\xc0\x30\x64\x38\x62\x26\x0b\xbe\x6e\xe4\xe6\x70\x12\x6b\x8f\xa1\x38\xe0\x45\x4d\xa2\xac\xf4\x8a\x88\xa6\x5a\x00\x44\x13\xaf\xfd\x80\xfd\x8b\x8a\x07\x6e\xc1\xc9\x82\x7c\x05\x94\x11\xb0\xeb\x74\xc6\x1b\x00\xd6\xba\xcb\x39\xdf\x67\xc7\x1c\x94\x0b\x4e\x93\xb3\xd5\x23\xdb\x52\xb7\xa1\x28\xa0\x37\x57\x09\x88\xd1\x12\x3f\x6a\x8c\x35\x5b\xb7\xe5\xc4\xad\xbd\x9c\x3f\xcc\x94\x7d\xd6\xc4\x4d\x69\xdb\x6d\x24\xd2\x9b\xe0\xb2\x29\x36\xa6\x86\x89\x43\xf7\x8c\x58\xd7\x24\x09\x16\xce\xd7\xce\xf5\x6c\x53\x0e\x8e\x9f\xbc\xb0\xbb\x61\x5a\xec\xf4\x8f\xb5\xd3\x3f\xd3\x4f\x7f\x3c\xf8\xd1\x48\xaf\x36\x73\xe6\x6f\xb5\x59\xef\xa2\xcc\x89\x4d\xd3\xc1\xcd\x55\x52\xcf\x8a\xc9\x4f\xf1\x3c\x44\x0d\xc4\xc5\x02\x36\xaa\x9b\xe6\xd3\x02\xfe\x7c\x88\x4b\xd4\x44\x24\x28\xbf\xba\x67\x01\xdb\x2b\xfc\x25\x43\xbc\xe7\x54\x64\x58\x41\x5b\x1e\x03\x52\xdf\x13\x33\xd6\xea\xe5\xd4\x2b\xfa\xb2\x5f\x0a\x24\x78\x5e\x7c\x48\xca\xc7\x71\x85\x31\x76\xa3\x3c\x1a\x1c\x45\xc9\x49\x12\xca\x20\x85\x1c\x23\x12\x96\x8d\x6f\x15\xea\xc6\x8d\x5e\x31\xcd\x96\x32\x28\x14\x3b\x5d\xa9\xa1\x6d\xfc\x78\x51\x37\x51\x64\xc9\xbd\x7b\x7a\xed\x82\xef\xee\xa3\xa8\x49\xef\xdd\x0c\x11\x56\x6b\xc4\x3f\x84\xfd\x44\xce\x3a\x44\xd2\x1a\x25\x48\xdd\x3a\x4e\x41\xa4\x61\xfc\xd1\xf0\xa8\x3e\x01\x16\x24\xc4\x1c\x31\x40\x8d\xb2\xa3\x7a\x94\x01\xc9\x8a\x4f\x33\x20\x6b\x0a\xd1\xcb\xd4\x3e\x38\x61\xe1\x05\x78\x95\xf7\x52\x3d\xfa\x8b\x6e\xf3\x99\x86\x22\x16\x55\x54\xe3\x62\x60\x3a\x4d\x6c\x46\x83\x18\x70\x24\x8f\x15\x03\x7b\xc2\x5c\x5c\x29\x0f\x7a\x63\xb6\x1a\xad\xb9\xa2\x54\xad\x1b\x51\x88\x8e\xa7\x15\x61\xf6\xe2\xa5\x7d\x2f\xf8\xca\x31\x68\x5b\xe3\xcb\xac\xb8\x88\xb3\x13\xf6\x27\xb4\xd5\xa8\x92\x6c\x7a\x82\xff\x58\xdf\xb2\xf9\x9c\xb0\x3f\xe1\xcd\x92\x1b\xe1\xf7\xd7\x29\x67\xda\x00\xa4\x1b\x1e\x5e\x27\x98\xf9\xd1\xea\x8d\x4d\x14\x7a\x00\x18\x85\x3e\x28\xf3\xb6\x99\x8d\xa4\x4f\x80\x32\xcb\xe3\x91\x1c\xc9\xd4\x4d\xf3\x96\xf1\x0d\x8e\x3c\xc0\x2f\x4b\x79\x72\x36\x6a\x38\xbb\x45\x23\x55\xbd\xe5\xb6\x5c\xe7\x43\x5c\x39\xf3\x32\x19\x17\x57\xf3\x34\x13\x86\x81\x38\x77\x8a\x6c\x92\x94\x0e\xd2\x55\x94\x95\x00\x2e\xcd\xea\x38\xf5\x2c\x66\xc9\x28\xb8\xc3\x99\xc3\x2d\xab\x7d\xe7\x55\x96\xc0\x36\x76\x16\x74\xd9\x88\x73\x5d\x2c\x4a\xa5\x71\x12\xdc\x62\x1c\x89\xd2\xb2\xe7\xee\xc6\xbb\xae\xef\x80\x58\x87\x39\x8d\x2e\x4b\x8c\xce\xa0\x0f\x79\xa3\xf4\x91\x39\x1e\xf8\x2a\x83\xaf\x40\xd0\x59\xde\x65\x7a\xc6\x18\x3e\x61\x76\xea\x20\xdb\x33\xcb\x4f\x87\x67\x7c\x98\x4b\xcc\x51\xd8\x32\xaf\xa3\xe6\x92\x84\x5f\x35\x69\x88\x32\x8f\x17\x85\x93\x34\x16\x60\x12\x83\x61\x12\xd0\x99\x68\x8a\xdf\x08\x5d\xdd\xde\xee\x54\xfd\xab\x38\xcd\xed\xed\xbc\xcb\x7f\xc9\xf1\xaa\x5e\xf1\x19\x4f\x1b\x12\x62\x8e\x11\xbe\x29\xfc\x11\x6b\xa0\x31\xce\x44\xac\xe0\x7c\x12\x94\xfd\x3f\xff\xa4\xa3\xb3\x87\xea\x4f\xb6\xaa\x3e\xd7\x16\xdd\xb0\x9d\xd0\xb6\x57\xed\x78\x09\xf3\x13\xb1\x8e\x0d\xa4\x85\x5d\x94\x19\xc8\x40\xc5\x77\x23\x56\xbf\x0f\x42\xb5\x74\xa8\x45\x46\x86\x9f\x3a\x46\xfb\x0d\x0d\xce\x1b\xda\x3b\x18\xc5\x40\x68\x63\x20\xb4\x78\x36\x9c\xc6\x67\x82\xbb\xc3\xdf\xd0\x96\xf4\x6e\x61\x8f\xd0\x74\x7c\x75\x31\x89\x43\x1b\x23\x65\xf1\x32\xce\x4f\x38\x8f\x92\xf8\x21\x9c\x47\xa6\x3f\x45\x38\x6b\xbb\x58\x00\x8b\x52\xfc\x92\xd8\x2c\x9b\x64\x97\x26\x7e\x14\x89\x60\x34\x53\xac\x18\x68\xe0\xc2\x17\x28\x35\x30\xbe\xa5\xe6\xac\x06\xa6\x4b\xc0\xa3\x92\x16\x06\xfa\x28\xb2\xf7\xa2\x71\x4d\x4a\xc0\xd6\xf9\xd1\x4f\x55\xb5\x61\xb4\x6b\xf2\xb8\x0e\x8c\x07\xe5\xab\x4b\x7d\x4a\xc3\x48\xcd\x94\x66\x91\x7c\x0d\x13\x68\x10\x81\x7b\xa3\x02\x4a\xd3\xd5\xe5\xe6\x57\x1e\x76\xe1\x8f\x6e\xa4\x1f\x51\xcc\xf2\x13\x11\x5b\x90\xd7\xcd\x52\x62\x7a\x1a\x9e\xad\xe6\x2f\x4c\x30\x46\x3f\x7b\x99\x3d\xb5\x38\x42\x85\x4d\x76\x5a\x00\xe5\x2c\x76\x51\x98\x4d\xfd\x51\x01\x6b\x8d\x45\x91\x68\x6e\x17\x9f\x46\xc8\xe6\xfd\x5c\xa4\x39\x6b\x49\x2a\xd6\x3a\x88\x06\xec\x79\x61\xf0\xc5\xb8\x52\x12\x66\x5d\x60\x1a\x16\xd9\x84\xb0\xf3\x02\x68\x82\x24\x24\x98\xae\x07\x76\x7f\x8e\xf2\x71\x9a\x0b\x42\xd0\x2b\xf2\xec\xda\xb9\x2a\x30\xe6\x09\xf6\x3c\x08\x69\x6d\xba\x8f\x46\x4f\xd5\xf9\x49\x6e\xb7\xd3\x7c\xd7\x3d\x9f\x00\xb3\x95\x2c\x83\x79\x59\x5c\x12\xeb\x78\x7a\x26\x7e\x1b\x68\x13\xd0\x2d\xc6\x4a\xf8\x82\xf8\x04\xd9\x4b\x1e\x97\x30\xc5\x6e\x65\x5f\xb7\xb7\x19\x88\xaa\xb7\xb7\xf5\x49\x11\x4d\xc4\xc2\xa7\xb2\xad\x90\x5d\xb0\x6e\xb6\xa5\x54\x25\x6d\x49\x40\xfc\x6d\x7b\x17\xa2\xdf\x73\x82\xe9\x84\x90\x85\x3f\x67\x39\xed\x14\x8f\xf8\xab\xa4\xbc\x4c\x8c\xcf\xf8\xc6\xbd\xbd\x4d\x46\x8d\xd4\x02\x38\x97\xef\x44\x2c\x8f\x8a\xb1\x1d\x50\xcd\x06\x9c\x0e\xa2\x0f\xe7\xc9\x43\x6e\xc6\xa9\x92\x38\x83\x3a\xc0\x36\x14\xc5\x3c\x24\x5c\xc7\x5f\x81\xc0\xcd\x67\xc0\xb7\x86\x0d\xaa\x2a\x29\x43\xc7\xcd\xc2\x98\x5c\xdc\x51\x34\xbc\xbd\x95\xbe\x03\x0d\xb3\x36\x3c\x3b\xb9\x59\x86\xea\x33\xb1\x3f\x24\x70\x8c\xfb\xe7\x15\x4c\x65\x8e\x81\x87\x3b\x89\xd8\x04\x98\x55\x11\x98\x85\x27\x4c\xe1\x66\xc6\xfa\xc2\x54\xdd\xb2\x28\x6a\x37\xa5\x45\x42\xe7\xf1\xe4\x44\xd7\xc9\x01\xa5\x81\xd9\xf5\xb1\x56\xa4\xac\xe8\x92\xdc\xea\xc4\x16\x97\xa6\x3d\xea\xec\x0f\x8d\xc4\x71\x72\x7a\x16\x9a\xea\xcf\xa2\xb1\x21\xb9\xee\x2e\x23\xf5\x5e\x8a\xab\x2c\x1d\x3c\x1a\x1b\x29\x60\x48\x86\x7c\xa8\x4c\xaf\x87\x93\x49\xe6\xf5\xac\x12\x32\x32\x3d\x9c\xe4\x3b\xf2\x37\x50\xa9\x13\xc0\x1e\x14\x71\xc6\x31\xa6\xca\x60\xc5\xa8\x0e\x61\xbf\x42\xcc\x51\x12\x00\xc2\x5d\x79\xac\x77\x32\x0f\x8b\xb7\xb7\xb7\x98\x42\x1c\xb0\xd2\x67\x5a\x70\xe9\x85\x96\x56\x6f\x8b\x39\x32\x58\x02\xd2\x66\x56\x3f\x36\xe4\x13\x2f\x6d\x3c\x7d\x2c\x33\x8a\xf2\x66\x72\xa9\xea\xec\xa3\x1c\x80\x30\x58\xa5\x95\xb4\x4f\x28\xec\x35\xad\x95\xe2\x17\xda\xc2\x00\x22\xaf\xc4\x62\x7b\x4a\x3f\xcd\x67\xb2\xbf\xb2\xb1\x4d\x03\x00\xd4\x4f\x6f\x6f\x39\x68\xe5\x08\xa8\x31\x65\x78\x4d\x73\x8a\x67\x46\xa9\x8e\x19\x0d\x53\x00\x1b\xba\x57\xc4\x12\x20\x85\x59\x09\x0c\xf4\xc0\x44\x66\x76\xbe\xe1\x4a\x2a\xdf\x49\x7e\x45\xf2\x08\xf5\x19\xd3\xa1\x21\xc1\x4e\xbc\xae\x01\x46\x10\x1c\xb6\xba\x4d\x84\xc9\x84\x70\x8e\x68\x56\x12\x0c\x68\x88\x30\x7c\xe4\x96\x3e\x94\xf1\xfc\x15\x23\x43\xd1\x84\xf4\x66\xea\x31\xd7\x8a\x57\x16\x87\x32\x90\x37\xa2\xdc\x98\xf1\x96\x7e\x44\x79\x90\xab\xc7\x11\x60\x5d\x98\x47\xee\xff\xe2\x05\x3d\x96\x9d\x02\xf5\xc2\x54\xfd\x84\xa5\xa7\x3a\x75\xf5\xf7\x67\x61\xfb\x44\x53\xa8\x5b\xad\x1f\xad\xfa\xe8\x64\x58\x5a\xcc\xd5\x10\xb1\xbd\x8b\x51\x2c\xba\x40\xfc\x8e\xf9\x99\x1f\xb7\x34\x0e\x51\xcc\xd9\x00\x54\x3c\x9a\x2f\x45\x3a\x11\xbe\xdf\xd1\x19\x1e\x3b\x9c\x22\x4d\x85\x83\x53\xf3\x31\xc7\x6f\x0d\xe5\x7f\xcc\x6d\x59\x5c\xae\x45\xbd\x4b\x11\x75\x8c\xd7\x22\xd3\xdc\x9d\x84\xea\xaa\x1a\x63\x54\x5c\x3d\xc3\x6f\xb5\x6a\x0f\x24\x23\xcd\x0b\x7a\xa5\x36\xab\x44\xa0\x93\xdb\x50\x3d\x48\x62\x85\xae\x29\x5b\x71\xe9\x09\xf3\x01\x13\x49\x0b\x52\x47\x15\x8c\x65\x69\x5e\xd8\x11\x5d\x67\x1e\x62\x2b\xf3\x30\xc5\x58\x5a\xb6\x79\xb4\x34\x45\x42\x03\x26\xd9\x52\x8f\x09\xfd\x30\x3a\x3c\xc4\xa2\x94\x5b\x0d\x66\x91\xa9\xf6\xf8\x27\x71\xda\xf6\x54\xbd\x92\xb0\x20\x6a\xc6\x8d\xc5\x7a\xe3\x06\x37\x44\x72\xcf\xee\xe6\xa0\x9a\xa0\x89\x30\x40\xe7\xa1\x04\x63\x64\x81\xd9\x10\x6f\xea\xcf\x74\x74\x8b\x88\x43\x14\xa3\xf2\x28\x62\x3b\x2b\x27\x6b\x95\xc2\x6a\x30\x19\x22\x12\xef\xe1\x14\x57\x8e\x37\xa4\xe4\x19\x0d\x34\x96\xc7\x83\x82\x66\x0c\x6f\x81\x5f\x0d\x60\x0b\x9e\x26\xaa\x36\x50\x36\x91\xfa\x98\xf6\x95\x27\xd4\xab\xe1\x54\xcc\x88\x2f\xab\xf0\x58\xf4\x7d\xc1\x91\x61\x84\x0c\x3b\x22\xa3\xea\x44\xcc\x36\x44\x75\x83\xd2\x26\xfa\xb1\x42\x51\xe3\xe5\x9f\x7a\xcd\x59\xdf\x94\x5e\x35\x8c\x64\x50\x30\x84\x6a\x0e\x12\x91\x92\x11\x90\x28\x51\x0f\x45\x0f\x57\xa1\x46\xdb\x20\x65\x19\xc2\x75\x89\xd1\xc9\x43\x7a\xab\xa7\xf2\xb0\x4e\xb8\x47\x9a\xea\xc9\xdd\x72\xc4\x53\x55\x56\x07\xeb\xfc\x14\x74\x0f\x18\x96\xac\x89\xf9\xc4\xe7\x2d\x57\x97\x58\x0b\xd8\xe3\xae\xf3\x7a\xe1\x9f\xde\xfe\xf4\xbc\xad\x9d\x72\x79\xbc\x1c\x6b\x79\xa9\xa8\xc2\xe2\x6e\x55\xfa\xc1\x96\xc6\x77\x68\x95\x41\x2c\xaa\x82\x5a\x68\x46\xa3\x2e\xd9\x16\x66\x23\xe5\x5b\x34\x84\x90\xdd\x83\x64\xdb\xfa\x8c\xdc\x5a\x85\xb9\x88\x63\x72\x0f\xb3\x99\xb6\x7d\xff\x3b\x74\xb5\x3b\x8a\xae\x56\x50\x15\x06\x9c\x26\x7e\x85\x3d\x7b\xbe\xa0\x28\x8d\x13\x06\xbb\xbb\x79\xa7\xa1\x5e\xbb\x50\x02\x07\xac\xbb\x9b\xa3\x41\x7b\xa7\x90\x11\xcf\x92\x3c\xc9\x56\x45\x56\xa9\x0c\xb1\x06\xe1\xc0\xb4\xfb\x96\xf8\x83\x1d\x18\x15\xc5\x08\xdd\xde\xee\x78\x3b\xe8\x6c\x05\x28\x4e\x31\x43\xdc\x80\xb9\x34\x3c\x45\x2d\x72\x17\xd2\x7e\x85\x79\x16\x42\x0a\x91\x30\x63\x03\xd9\x4d\x2a\xb0\xa1\x61\xdf\x25\x01\x1d\xf8\x2d\xdb\x80\xf5\x23\x0f\xf8\x12\xb4\xc8\x87\x78\x49\x13\xbf\xfc\x32\x8e\x6e\xdc\x7b\x50\x72\x2f\xbe\x9a\x8f\xdc\xc0\x3d\xc2\xdf\x59\x8d\x3f\x8f\xf1\xe7\x25\xfe\xbc\xef\xde\x87\x9f\x7f\x5b\x14\x54\x7e\x1f\xcb\xbf\xf9\xb8\xff\x1d\x3e\xfc\x95\x3d\x3c\x1c\xe0\x43\xc4\x1e\x0e\x9e\x8c\xdc\x25\x50\xde\xbd\xd3\x7b\x47\xc7\xee\xfd\xbf\x46\x67\x7b\x97\x20\x39\x2b\x8f\x23\x85\x1a\x34\xee\x63\xe8\x88\xd0\x50\x84\x4a\x04\x40\x33\x88\x0d\x47\x49\xcb\xe8\x36\x4a\x00\xf5\xb4\xb3\xa4\xa1\xa6\xc9\xd9\x66\xc7\x8a\xfa\x05\x3b\x61\x08\x91\xd5\x62\xf5\xb0\xc9\x97\x2c\x15\x57\xab\x6d\xb1\xad\x61\x73\xc9\x1d\x5e\xf2\x78\x80\x36\x0a\xd9\x34\x39\xcb\xd1\xd8\xdb\xfb\xb8\xe7\x33\xdd\x8a\xb4\x45\x45\x9b\x7e\x0f\x92\x9b\x88\x3b\x10\xdf\x9e\x21\x2f\x59\x0a\xd7\x6c\x32\xf9\xaa\x0d\xf3\x34\x40\x64\x1e\x10\x6e\x14\xb7\xb7\x16\x84\xf7\xd0\xb0\x2c\xfc\x2f\x94\x2d\xaa\x74\x49\x1f\x1b\xfd\x8d\x6a\xd1\x6a\x34\xe3\xa4\xc9\xe2\xa3\xd0\xd0\x31\xe6\x70\x9c\x56\xf5\xde\xf8\xe7\x4a\x09\x7b\xe9\xcb\x20\x27\xc3\xb7\x7a\x45\xd5\x70\x78\x88\xfd\x59\xac\xf9\x5a\x7f\xb6\x48\x2a\xb5\x13\x5b\xcc\xce\xc1\x3e\xb6\x6c\x31\x51\x4b\xc3\x16\xde\xad\x7d\x51\xe4\x89\x70\x61\x42\xdd\x7e\x59\xc1\xa9\xc5\xbc\xa7\x81\x5f\x03\x7e\x10\xe8\x6f\xd2\x5c\x4b\xc9\xfb\xac\xc2\xd6\x19\x70\xc3\xe3\x60\x42\xfe\x37\xf8\xe3\xeb\x47\xaf\xfe\xf4\xec\xed\xd3\xf3\x77\xaf\x9f\x87\xea\x03\x7b\x73\xfe\xf4\xc5\xa3\x3f\x3c\x7f\xfa\x24\xd4\x9e\x82\x37\x6f\x1f\xbd\x7d\xf3\xe4\xfc\xd9\x8b\xb7\x4f\x5f\xff\xf9\xd1\xf3\xd0\x78\x16\xef\x1f\xbf\x7c\x87\x25\xe7\x3f\xbe\x7c\xfd\xd3\xa3\xb7\xa1\xb5\x54\xd4\xfd\xe3\xa3\x77\x7f\x7c\x6a\xd4\x54\xcb\x44\xbd\x57\xaf\x9f\xfe\xf8\xec\x3f\x43\xed\x29\x78\xf1\xe6\xdf\x9f\xbf\x7c\xf9\x6f\xef\x5e\x3d\x09\x9b\x9f\x01\xf0\x15\xf3\xd9\x79\x8a\x31\xda\xef\xd1\x66\xb2\x3f\x73\x83\x67\x6f\xce\x1f\x3d\xf9\xe9\xd9\x8b\x50\xfc\x08\xfe\xf0\xe8\xcd\xd3\xf3\x57\x8f\xde\xfe\x29\x94\xbf\xf0\xc6\x09\x91\xb1\x3a\x51\x81\xc8\xf2\x18\x60\xba\x68\x58\xcb\xcb\x24\xd4\x7b\x68\x59\x81\x0b\x40\xde\x37\xc0\x5b\xc4\x97\x94\x23\xea\x59\x9d\x5c\x79\xae\xf9\x0d\xba\xc2\xc9\xab\x11\xd9\x06\xf0\x32\xd2\xe8\xc4\xf4\x0b\xaf\xf4\xe2\x39\x62\xb0\x41\x8a\xd6\xac\x92\xb8\x1c\xcf\xfa\x55\x96\xc2\x21\x33\xf4\x85\x5a\xf1\x9e\xeb\x07\x96\x1d\xb7\x43\x27\x1f\xaf\x13\xe1\xd5\xb4\xbe\x9a\x8c\xca\x73\xb5\xc5\xc5\x8b\x79\xf7\x79\x32\x2e\x97\xee\x5d\xa9\x6f\x6f\xb5\xa9\x5c\x76\x4c\xc5\x47\x85\x97\xc8\x34\xd9\x9e\x28\xde\xca\x86\x2c\x13\x9e\x29\x61\x7b\x98\x9e\xe7\xee\x91\xb8\x2a\x87\x25\xd7\x03\x87\xe4\x86\xd6\x17\xfe\x6e\xee\x37\x57\xbd\xfc\x65\xef\x77\x7b\x3c\x91\xe3\x1e\x1c\x1b\xf1\x3c\xed\xe8\x8c\xc5\x6b\x88\xd1\x40\xcf\x78\x6b\x01\x34\xb5\xc4\xbc\x3d\x1c\xda\x8f\xe6\x73\x96\x88\x49\x35\x4c\x16\xb0\x91\xc5\x8e\xe4\xba\xa7\xa0\xd9\x91\xbc\x04\xb7\xb4\xc5\x39\xa2\x73\x4b\xf7\xfb\xd0\xff\xfc\xbc\xaa\xc9\xf2\x81\x9b\x9a\xca\x50\xdd\x9a\x61\xf4\x1f\xdd\xa7\x91\x02\x93\xf7\xb8\xc8\x30\x1f\x36\xa2\x80\xd8\xeb\x54\x29\x2c\x48\x23\x18\xb3\xf0\x14\xca\xe9\xa9\x06\x62\x69\xc2\xb1\xd2\x86\xdd\xbb\xdd\x1e\xc0\x1b\x2c\xca\xac\x4d\x4f\x1c\xf4\x25\x48\x39\x0c\x71\xa0\x78\xf3\x71\x40\x26\x6e\x1b\xd4\xd1\x7c\x0a\x75\x00\xca\xa3\x06\xa4\x29\xf7\x03\x68\x40\x10\x1e\x1c\x06\x26\x00\xc2\xc3\xfd\xc0\x00\x3c\x82\xd9\xe2\xe9\x21\xc1\xac\x51\xca\xcc\x00\x7c\x61\x01\x7c\xaa\x03\xbe\x2e\xe6\xe9\x98\x82\x0c\xb3\x6e\xc8\xa7\x9b\x42\x3e\xfb\xcc\x90\x2f\x14\xc8\xd3\x48\x3b\x40\xcf\x8d\xb2\x68\xf8\x8f\x99\xb4\x76\x23\xc2\xce\x9d\x98\xa8\x0b\x65\xf3\x83\xef\x2d\xb4\xe3\x06\xe5\xfd\x30\x0f\xc4\xc7\x61\x42\x91\x4d\xbe\xbe\x82\xd5\xba\x15\x64\x90\x0c\x0f\x0f\xcc\x25\xb4\xef\x1d\x8b\x13\x88\x5c\xd4\x9f\xff\x86\x21\xe4\xb6\xc3\x70\x14\xf7\xe3\x9f\xe3\x8f\xaf\xca\x64\x9a\x66\x98\x97\x43\x57\xa0\xce\x92\x18\xeb\x46\x32\x0a\xa0\xf2\x64\x21\x05\x42\xde\xb8\xff\xd9\x7b\x57\x25\xe5\xa3\x4b\x80\xbb\x1b\xbe\x7b\x03\x67\xd4\xa3\x3f\x3e\x7d\xf1\x36\x78\x34\x46\xe1\x8e\x2e\x23\x06\x8a\x4b\x24\x78\xef\x7d\x3e\xe9\xe7\xd5\xdf\x46\xc2\x2e\x1a\x0d\x31\x68\x93\x32\x48\xf2\x2c\xc7\xfb\xc9\x21\x3c\xf1\x6b\x75\x31\xf2\x3e\xd2\x1a\xf8\xb9\x02\x8e\x8b\xcc\xf0\x6c\x4a\x2b\x21\x62\xf1\x79\x90\x61\x4c\x12\x22\xf3\x16\xd2\x13\xc4\x66\x17\xd5\xf4\xba\xe1\x48\x82\x89\x05\xf3\xaf\xac\x01\x49\xc2\x7c\xe1\x8e\x8b\x05\x5d\x58\x80\x7a\xa2\x93\x3a\x9a\x30\x32\x6c\x3d\xcf\x5d\x85\x1c\xff\xbe\x02\x66\x3d\xf1\x43\xf7\x32\x5e\x5c\x26\x2e\xf7\x88\x30\xbf\x57\x4f\x79\xcb\xd7\x64\x1a\x99\x5a\xf8\xd6\x1b\x52\x17\x88\xc6\xe1\xc4\x3e\x9f\x66\x98\xcf\xe9\x9c\x46\x2b\xcb\x61\xb5\x93\xb2\x4c\x26\x46\x31\x5e\x7a\x9e\x2c\x12\x51\x2a\x66\x18\xf0\xd5\x6b\x95\xf3\x10\xa5\x56\xf9\x98\x12\xc8\x55\xb2\x5d\xf7\xdb\xf3\x8b\x6b\x90\x0c\xdd\xa6\xe4\x72\x0c\x12\x19\xc8\xcc\xe7\xdf\x36\x85\x50\x06\x2b\x52\x29\x0d\x01\x2a\xce\xcf\xd9\xf9\xdf\xb4\x96\xec\x27\xe7\xfc\x1e\x4b\x10\x02\xce\xd1\xc6\x9d\x8f\xaf\xc5\xeb\x25\x0b\xad\x34\xd6\x0e\x25\x60\xb6\xe1\x58\x6c\x01\x31\x2b\x79\x92\xd1\x23\x7b\x4d\x90\xc3\xe7\x04\x5e\xeb\x00\x72\x15\xb9\xdb\x85\x52\x77\x29\xa2\x4d\x5d\xa2\xc3\x4a\x20\x03\xbe\x06\x26\x2b\x49\xf2\xe0\x22\x5b\x24\xc1\x7c\x51\xce\xb3\xc4\x65\x77\x6d\x28\x18\x23\xaa\x53\x55\x77\x29\xd2\xba\xc1\x27\x70\x46\xab\x12\x09\xe9\x6c\x12\x69\xfe\xa3\xd4\x71\x94\x6a\x17\xef\x5d\x5d\x44\x68\xc4\x83\xf1\x7e\x8b\xee\xef\x3a\x0a\x31\x36\x90\x48\x75\x93\xd2\x79\xef\xf4\x2f\xfd\x10\xc5\x45\xf7\x1c\xde\x80\xac\x0a\x62\xb5\x97\x46\xa9\x81\x61\x95\x0f\xfc\xd3\xe2\x82\xe9\x0c\xbc\x54\x58\xdb\x87\x64\xa2\xd8\x8d\xc8\xeb\x3c\x15\x32\x9b\x02\xd8\x13\xaf\x8c\xae\xbc\x29\xde\xce\xb6\xd8\x65\xe5\x7d\x72\xfa\x80\x7f\x33\xd4\x1c\x91\xf7\xa6\x5b\x2d\xae\xde\x24\x65\x9a\x54\x9e\xbb\x5b\xee\xba\x3e\x5e\x98\xa5\xad\x48\x77\x3b\xbc\x16\x26\xbb\xdc\xa8\x55\xb9\x3e\x27\xde\x38\x82\xb6\xae\x92\x2b\xfc\x8a\x10\x10\x91\x0d\xdf\xa1\x15\x67\x1c\xb9\x57\xc5\x7b\x98\xed\x23\xa0\x5d\x80\xd3\xd0\xc6\x78\xd7\x0d\x0e\x1f\xe0\x45\x7b\xbc\x07\x3e\xa2\xb1\x8f\x0d\x03\x16\xb2\x76\x67\xd1\x9c\x4e\x8c\xb8\x0f\x52\xef\x38\xc1\x4b\x24\x13\xfd\xd8\xa0\xd3\x46\xaa\x22\x22\x17\x81\x1e\x8b\x99\x9c\xa8\x13\x64\x87\x8e\x36\x4d\x59\x91\x4d\x77\x17\x16\x6e\x77\x38\x18\x7c\x9b\xf7\xff\xb6\x88\xa9\xb3\xd0\xda\x82\xbd\x2e\x6c\x45\x97\xbb\xc5\x23\xe9\x65\x73\x95\x50\x4b\x08\x6a\x81\x5b\x01\x63\x8b\x10\xe0\x13\xc6\xd8\xc0\x60\xd0\x1f\xe0\x7f\x43\x1f\x2f\x1d\xd1\x50\x19\xda\x6a\xaf\xd6\xb7\x72\x0a\xdf\xae\x5d\xa5\x60\xa6\xd8\x68\x33\x95\xd8\x52\x42\xb6\x79\xf1\xc1\x1b\x0e\x94\x78\x4e\x35\x4f\xf0\xb7\xb5\xbf\x57\x2f\xe3\x96\x07\x2a\x3f\xcf\xba\x1c\x66\x81\x2b\x5f\xb2\x6b\xca\x4c\x87\xf1\xe4\x6f\xfa\x37\x41\x73\xcb\x24\xaa\x89\xd1\x66\x24\x5c\xbc\xc3\x5a\x77\x47\xb5\xb6\xc8\x5c\xbe\x57\xb5\xba\x73\x87\x56\xd3\xe9\x65\xbd\xa2\xcd\xe4\x08\xcf\xa4\x6d\x9b\xcc\x56\x35\x99\x1f\x6d\x3f\x4a\x46\x36\x5c\x0b\x0f\xe5\x08\x5d\x9e\xfd\x3b\x74\x12\x1d\xa3\x26\xd9\xfe\xa9\xe6\x46\x6a\x87\xfa\x7c\xbe\xa6\x85\x77\x58\x63\x45\x0b\xd3\xa2\x34\x61\x81\xc6\xbd\xc6\x71\xc5\x75\x91\x5f\x1e\x15\x00\x97\x51\xb1\x1b\xd5\x7e\xb6\x4b\x36\x34\xcc\xa4\x2f\x53\x24\xda\x5a\x8e\x8d\x24\x53\xbc\xf2\xbc\x8f\x5a\x9d\x79\x9f\x4b\xd9\x8d\xe2\xcc\xb7\x0f\x50\x1b\x9f\xda\x4a\x7e\xbd\x45\x2b\xe6\x8a\xeb\xc8\x6e\xfd\x24\xef\xfe\x66\xa7\xeb\x1b\x60\xd7\xe1\x90\x46\x5a\x6c\x5b\x0f\xf6\xea\xdd\xeb\x67\x8f\x41\x6c\xc0\xe4\x51\x35\xd3\xa6\x59\x56\x25\x2b\xe2\xfa\x6d\xf1\x8a\x51\x5a\x6b\x63\x44\x1d\xa0\x1e\x2c\x14\xd1\x3e\x7b\x43\x88\x1e\x6f\x16\xd3\x69\xfa\xd1\x6d\xd3\xea\xa8\xd5\xc6\xef\x87\xe2\x6a\x15\x67\x48\x54\xc0\xad\x30\xed\x0c\xfb\x09\xeb\x19\x1e\xb0\x9f\xe5\x04\xd3\x51\xcf\x5c\x6b\x9f\x20\x14\x5d\xc5\xd3\x6b\xdb\xa8\x59\xb6\xde\x81\x2f\x75\x9b\x9e\x2a\xc1\xff\xc1\x3b\x89\xbc\xbf\x4c\x6e\x0e\x96\xfe\xae\x77\xb2\xf3\x97\x89\xef\xe3\x11\x1e\xb8\xf6\xb9\xe5\x71\x0e\x92\xd4\x6c\x71\x15\xe7\x96\xc9\x61\x16\x39\x57\x4c\xe6\xe0\x61\x32\x1c\x1e\x31\xa7\x5c\x75\xd6\xf9\x1e\xbd\x01\xd6\xfd\xf7\x11\xfd\x42\xaf\x97\x5d\x77\x06\xa7\x03\x3c\x0d\xac\x5f\xe0\x0b\xfa\x00\x7f\x04\xf5\x2e\x7e\x70\x85\x39\xe6\x93\x1f\x88\x1c\xed\x62\x4e\x62\x20\xef\x7b\x50\x10\xec\xfb\x70\xa8\x60\x4a\x86\xe4\xa1\xf9\xf2\x21\x7b\x79\x45\x6f\x0f\xcc\xb7\x07\xec\x2d\xde\xe1\x52\x47\xf9\xae\x9b\xe3\x3d\x7b\x56\x30\x54\x20\xf7\xfd\x62\xe4\x25\x68\x79\x53\xdd\x8c\x8b\xac\x28\x9f\xc3\x87\x21\x06\x09\x66\x32\xa1\xaf\xbb\x3f\x70\x03\x96\xf4\xd7\x1d\xe2\x6f\xcc\xab\xf7\xc7\x32\x9d\x60\x9a\x33\xe0\xd2\xe8\xf9\x79\x72\x89\x69\xcb\x94\x92\x47\x1f\x93\x4a\x3c\x5f\x5c\x52\xe3\xa1\x3b\x9d\xd2\xd9\x09\x8d\x4c\x45\xd1\x45\x86\x31\xfc\x01\xcb\xc6\x1f\xba\xf0\xea\xfa\x27\xfe\x03\x87\x8c\xba\x4b\x3c\x65\xe1\x04\x1d\xd7\x78\x67\xe7\xa4\x8c\x3f\xbc\x58\x64\xd9\xa3\x0a\x23\x91\xa0\xc9\x38\x23\xe2\x56\x16\x57\xa1\xdb\x73\x77\x39\xef\x67\x6a\x9f\x02\x94\x70\x32\xa8\x31\xbc\x4a\x81\xcd\x6c\x31\x89\x42\x3b\xe9\xe2\x6a\x24\x63\x89\x17\x05\x4f\x6f\xc6\x79\x9b\xb1\x02\x38\x0b\xf6\xe2\xa1\x0e\x33\x49\xff\x8e\x4c\x43\xbe\x7b\x3f\x70\xef\xef\xa6\xbb\xf7\x01\x41\xe3\xf7\x97\xae\x7f\x1f\x96\x67\xa2\x68\xd5\xb8\x36\x15\xfb\xdc\x2b\x13\x94\xcd\x4e\xd0\x2b\x89\x1c\x4e\xf0\xaa\x09\xeb\x91\x80\xc3\x39\xa7\xe9\xdd\x6d\x39\x0f\x1f\x34\xeb\xf9\x3d\x2e\xc5\xaa\xd5\xf9\x81\xfe\xfb\x95\xae\x89\xca\x9c\x21\x53\x46\xae\x0d\x92\xa7\x83\x2d\x13\x00\x57\xb8\xd7\xdd\xa7\x2f\x3d\x76\xbe\xc2\xba\x96\x28\x6b\x5b\xce\x59\xe1\x03\x26\x27\xe9\x6a\xc2\xa6\xeb\xb3\xdb\x93\x9b\x06\xb9\x37\x8e\xc7\xa3\x58\x02\x26\xcf\x63\xfe\xce\x6a\x8f\x8a\xfa\x20\xf3\x23\xb3\x69\xf9\x04\xe3\x5f\x28\x78\x52\xfd\x88\x17\xae\xf8\x4c\x28\x80\xf4\xef\x44\x69\xfb\x43\x19\x35\xcf\x55\xac\xd6\xe3\x6b\xd2\x68\x60\x59\xac\x59\x87\x16\xc9\x9c\x59\xf8\x60\x18\xd8\x07\x1f\x3e\x7c\x10\x18\x0a\x8f\xf0\xe0\x20\x58\xa3\x61\x09\x3a\x66\x14\x3e\xc4\x2c\x03\x07\x96\x10\x89\x4d\x75\x8c\x31\xde\x70\x9c\x27\x5e\xc6\xae\x50\xa8\xa4\x72\xf9\x15\x48\x9c\x8b\x8b\x3b\xa9\x96\x0f\x2d\xde\x04\x1d\xea\xb1\x96\xbe\x73\x0f\x44\x09\xdc\x2e\x32\x95\x1a\x9b\x35\x80\x9c\x32\x24\xfd\x2e\x8a\x03\x96\x3a\x2d\xbd\xd8\x43\x65\x1a\x73\x45\xc4\xdb\x9c\x45\x69\x63\xc4\x3a\x6f\x12\x69\xd1\x45\xf2\x41\xc1\xb2\xa9\x78\x3c\x83\x84\xd1\x46\x78\x40\x39\x88\x3a\xda\x08\x0f\xc8\x6b\x82\x0f\x2f\x3c\xa4\x44\x10\xcd\xe0\xc2\xc3\x07\x2d\xd5\xa1\xb6\xa8\x08\x17\x8b\xab\xc2\x8a\x75\xb2\x29\xdd\x15\x78\xa5\x51\x61\x58\xd5\xd2\x09\xde\x50\x91\x5e\xc0\x08\x43\x9e\xa7\xa6\x43\xd5\x2b\xbe\xfc\xcc\xfa\x75\xa6\xa5\xdd\x03\xd9\xb5\xcd\x43\x36\xc6\x11\xae\xb5\x46\xc2\xb4\xa6\x26\xcd\x81\xfc\x2a\xd7\x29\xec\x23\xa1\x28\xa6\x27\xf2\x61\xb5\xa9\x98\xf1\xad\xb0\x33\x85\x94\xc3\x37\x01\x89\x96\xdd\xf2\x93\x70\x87\xf5\x90\xc1\xbf\x3f\x2b\xaa\x1a\x07\xd0\x38\x4d\xe0\xe5\x47\xe7\x17\x65\x11\x4f\x40\x98\xa9\xcf\xe3\xc9\x04\xfd\x3b\xa2\x4c\x17\x81\x48\x9f\xa0\x95\x04\x4d\x63\xe7\x94\xbf\x38\xa3\xf4\x3e\x75\x90\x93\x3a\x96\x2b\xe8\xe4\x14\xf8\x73\xe7\x24\xd8\xfb\xf3\x74\x42\xb6\x2f\xd1\x32\xb9\xfe\xf3\xa9\xf5\x89\x22\x8f\xf8\x88\x65\x7d\xca\x6f\xbf\x72\xa4\x18\xa8\x69\x56\xa9\xf5\x12\x99\xf9\x01\x73\x3b\xd7\x89\x80\x82\x02\x55\x06\xd1\x06\x6c\xfa\xdc\x6b\x9a\x7b\xc6\xe6\xbe\x89\x9d\x65\x23\x8a\x63\xb1\xb4\xab\xa1\xea\xea\x3e\x6a\xd3\x9d\xec\xce\xfb\x28\xbb\xe3\x3e\x7a\x5d\x14\xb5\x65\x2f\xc5\x36\x5b\x55\x5d\x5c\x5d\x54\x35\x8c\xf5\x2d\xee\x9b\x4e\x53\x21\xcf\x38\xed\xb1\x28\xd8\xff\xfd\xe6\xe5\x0b\xee\x47\x95\x4e\xaf\xe9\xa2\x27\xf8\x16\x61\x8e\xaf\x29\xcb\x2e\xbb\xa8\xc7\xb4\x94\x14\x9b\xac\x01\x42\xdc\xe2\x6b\xf0\x3f\x90\x96\xfd\x8f\xa0\x50\x9f\x6d\x97\x6e\xe3\x45\x62\xb3\x38\xd3\xd9\x3c\x27\xc6\x84\xdb\x9b\x5f\xd3\x71\xdc\x60\x8d\xd5\x29\x82\xa7\x73\x6f\x2f\x4d\xa6\xb2\x77\x8a\x8e\x7d\x88\xde\x3c\x74\x9f\x17\x36\x8f\x4e\xb6\x7b\xa8\x89\x15\x46\xcc\xc0\x78\xc7\x51\xc5\x0b\xe9\x87\xef\xed\x85\x5c\xdd\xea\xcb\xaf\xda\x1f\x89\x34\x57\x81\x2b\x7f\x19\x35\x08\x51\xa0\x31\xfc\x4b\x2d\x71\x92\x60\xd6\x13\x12\x86\x1f\x48\x61\x03\xef\xc9\x41\x4e\x47\x37\xac\xc3\x67\xb0\x25\xae\x39\x13\x74\x83\x2a\x60\xf2\x1f\xa0\xd4\x6a\x48\x68\x70\x1a\xfa\xd6\x92\x37\x5b\xf1\x97\x88\x60\xae\xa8\x6b\x04\x15\x71\x2f\xdd\xe4\xc4\xfc\x8c\x7d\x15\xe4\x7e\xa8\xbc\xe1\x20\x12\xef\xc8\x87\xdc\x0c\x34\xd4\x46\xc0\x5f\x8a\x11\x10\x30\xb4\xfd\xc6\xfb\xcf\xd5\xfe\x59\x2d\xf6\x4d\x68\x94\xcb\x61\x2d\x03\x0e\xb7\x8e\x9e\xc5\x5b\xde\xb5\xb6\x1b\x88\xb1\x64\xec\xa4\x29\x16\xa8\xd8\x1a\x1e\xfc\x60\x23\xa2\x1d\x3e\x18\x85\xca\x28\x0b\x86\x9c\xa5\x26\x8f\x8a\x51\xae\xe5\x99\x6f\x52\x43\x60\xe1\x45\xf1\x51\x71\x13\x50\x77\x90\x38\x0a\xb5\x2d\x24\xb2\x4c\x4a\xa6\xbb\x54\x5d\xc1\x83\xb1\xc8\x66\x8c\x36\x68\x34\xbd\xb0\x67\xb9\x0f\x16\xca\xb3\x30\xef\xf6\xf7\x84\xed\x48\x18\x72\x1b\x54\xbf\x62\xcf\x02\x8f\xa7\xcd\x23\x3c\x5d\xf2\xaf\x25\x36\xbf\xb7\x7a\x99\x5c\xd8\x5d\x20\xce\xf5\xe2\x66\x0c\xd7\x51\x43\x1e\x30\x91\xc2\x37\xf2\xd6\x52\x37\xa0\xb4\xf5\x55\x08\x42\x3a\xde\xbc\xe1\x60\xf6\xc2\x5f\xd0\xaf\x28\x7f\x0e\x3f\x1e\x63\x19\x48\xd7\xfc\x9d\x3c\x86\x7b\xb2\xd6\x5b\x51\xc4\xaa\x76\xf9\x64\xa9\x49\x1a\x9b\x1a\xf6\x93\x29\xe0\x59\x14\x41\x42\x85\xe6\xbd\x38\xd0\x36\x1e\x7b\x8b\x3f\x89\x17\xe8\xac\x6e\xad\x6d\xa9\xac\xef\x41\x59\xfd\x31\xa7\x5e\xed\x0f\xd4\x3d\xd8\xd4\x7f\x4e\xa5\x96\xea\xca\xee\x6b\x6a\xbf\xc0\xc2\x8e\xca\xb6\xba\xb6\x71\xab\x1b\x52\x19\x37\x2b\xb6\x7c\x80\x72\x5a\x58\x02\x1a\x5f\x6b\x6a\xfa\x02\x50\x86\x74\x1e\xbe\x71\x05\x88\x62\xf5\x2a\x30\xa0\x30\x8e\xd2\x0e\x0d\x4d\x80\x77\x5e\x91\x26\x28\x14\xb7\x12\xb3\x47\xc4\x6f\xa1\x7b\xda\xff\x36\x66\xda\x23\xa9\x6d\x22\xfb\x29\x95\x4c\x8b\xf2\x2a\xae\x43\x97\x79\x5b\x8c\x0a\x72\x11\xf1\x6e\x90\x41\x49\x57\xaa\x73\x5c\x16\x0f\x9a\x29\x1c\x1d\xb6\x31\x77\x03\xfa\x23\x9e\x96\x7e\x7f\x82\x02\x7e\xdb\x06\x5a\xd3\xbd\x8d\xdc\xa9\x3c\xc2\xab\x70\x7c\x16\x80\xc1\xe9\x38\xde\xdc\x89\x6d\xcf\x81\xe9\xa8\x59\xec\x45\xbb\x0c\xfe\xff\x68\x70\xe2\xbe\xd8\x7b\xe4\x86\x9e\xf5\xf5\x5e\x8c\x8a\xf4\x1f\xd3\x8f\xc9\xc4\xdb\x47\xc3\x47\x7f\x1a\xa7\x99\x7a\xe7\x06\x1f\x88\xfb\xf4\xf5\xeb\x97\xaf\x5d\x96\x8e\x45\x5e\xa8\x89\x53\x45\x81\x7d\x0e\xfc\xc9\x6b\x7a\x6a\xf9\x3b\x32\x87\xf0\x37\x8b\x0b\x5c\x66\x0f\x29\xf2\x18\x0f\x40\xc0\x08\xbc\xbc\x4c\x3b\x23\x38\x2d\x15\x37\xca\x79\x03\xba\xcd\x94\x92\x8a\xb2\xa4\x03\xf8\x05\xbf\x7e\x4c\x29\xe1\x17\xd9\x78\xed\xba\x51\x2e\x0a\xf5\x51\x98\xf5\xf8\x80\xde\xb6\xce\x58\x89\xbd\x58\xcb\x6b\xf3\xa4\x38\x1d\x4c\x27\xd5\x7c\xde\x7c\x9d\xac\xf8\x9c\x31\x3d\xf8\xf5\x85\xc7\x9c\xa4\x92\x20\xad\x1e\x4d\xae\xd2\x5c\x20\x96\xf0\x30\x45\x90\x8f\x94\xee\x16\xc2\x75\x2c\x67\x99\x71\x14\x9a\xa0\xf4\x2d\xf3\xba\xaf\xee\xfd\x5c\x08\x1f\x49\x40\xa3\xa8\x37\x1d\xc5\xdc\x32\x8a\xe7\x2d\x06\x61\x13\xf0\x21\x63\xd8\xdd\xa7\x68\xfb\x85\xce\x50\x6c\xd6\xf4\x95\xfa\xf5\xb6\x0b\xf3\x5e\x2c\x8c\x3e\xf1\xa9\x0d\xfc\x6d\x06\x65\x93\xe1\x5d\xe2\xf7\xca\x71\xa6\xf3\x4b\xfd\x71\x5d\x66\xff\x96\x5c\xe3\x9d\x44\xa8\x56\xa4\x9f\x18\x2f\xcb\xae\x20\xe5\x89\x6a\x49\x3a\x80\x63\x0e\x63\x42\xe7\xf1\x65\xcc\x7a\x09\xaa\x7e\x1e\xbf\x4f\xf1\xce\x16\xaf\x68\x5f\x81\xc5\x28\xe1\xac\x4c\xa6\x40\x07\x6f\x38\x27\x45\x7c\x26\x0d\x48\x3f\x39\x8d\x51\x6d\xd2\x3d\x0f\xb4\x2b\x94\xab\x5f\xd8\xad\x4a\x9c\x4b\xa8\x2d\xaf\x04\xab\x10\x47\xee\xa3\x92\xd2\x88\x38\xd5\x82\xff\xf8\x10\xe7\x35\xa6\xf7\x38\x42\x21\x3a\xbf\x3c\x96\xa7\xfd\xd1\x1e\x2f\x71\x8e\x92\xab\x63\xf2\xca\x38\xda\x83\x5f\x27\x2e\xcb\x3a\x3a\x4d\xcb\x2b\x38\x61\xd4\x19\xec\xf0\x30\x46\x63\x89\xfb\xba\x20\x8f\xb7\x14\xe9\x84\x59\x52\x27\xe9\x45\x5d\x26\x19\x88\x72\x1e\xa6\x51\xe4\x54\x93\xe5\xee\x27\xed\xe4\xa3\xff\xfd\xe8\x3f\x59\x6e\x66\xbc\x5e\x87\xa7\xed\x5a\x6a\x0f\x1a\xa7\x7a\xdd\xa9\xbd\x36\xf8\x54\x0b\x1b\x15\x1e\x0e\xad\xfe\xae\x56\x0f\x4a\x85\xa1\xe4\x6a\x52\x16\x87\x76\x48\x09\xe1\x9b\x26\x49\x9f\x2a\xd8\xbd\xf0\x01\x4f\xd8\x4b\xac\x66\xf8\xe0\x20\x68\x18\xc7\xf0\x01\x45\xb0\xb1\x5e\x1f\x7c\x17\x48\x16\x32\x7c\x40\x39\xe4\x79\xd7\x0f\xf7\x83\x86\x39\x0d\x1f\x82\x4c\xca\x18\x62\x21\x92\x4a\xa6\x39\x1c\xb6\x55\xb3\xab\xdc\x74\xbb\x3d\x3a\x2d\x8a\x8c\x36\xe7\xcd\x43\x3d\x14\xbb\x07\xb0\xd9\x45\x9f\xce\x15\xc1\x99\x5a\x75\x1b\x86\x86\x87\x5f\x4f\xc8\xef\xcf\xe0\x0d\x6c\xa5\xf5\x58\x11\x33\x50\xb1\x73\xab\x42\x47\x36\xe5\xe4\xe5\x97\xf8\x89\xf4\x35\xea\x67\x40\x98\x7e\x49\xe1\xcc\x9b\xb4\x4f\x65\xbc\x4d\x41\x2f\x63\x6e\x7d\x4a\xc3\x2f\x73\xcc\x2d\xae\xf5\xe4\xab\x93\xd5\xda\xe6\x47\x2c\x97\xc9\xe9\x28\xe6\xe7\x6c\x25\x49\x81\xd0\xc9\xb0\x2f\x1e\xd7\x1f\xe9\x52\x6e\x7e\xbb\xb5\xb8\x40\x27\xd1\x2f\x58\x64\x4d\x4d\x30\xa6\x74\xc6\xae\xc5\xec\x27\x94\xe4\x8e\x1f\xe9\x58\xc0\x8e\x75\x4f\x5c\x7e\xd7\xb0\x21\x1e\x79\x7e\xea\xa0\x1a\x88\xeb\x90\x95\x71\x58\x7c\x9d\x6f\x98\x15\x92\x5f\x71\xa8\xf1\x79\x4d\x90\x83\x19\x1d\x92\x76\x18\x30\x59\x41\x0c\x5d\xbc\x4f\x3a\x9a\xc2\x74\xb2\xd3\x29\x7a\xba\x75\x35\x92\x57\x7f\x63\x9b\x4d\x8e\xa6\x09\x53\x41\x51\x8c\xb9\x10\x8b\x77\x3c\x36\x07\x4e\x4f\x0d\x9c\x44\x09\x4e\xf0\xae\x08\x19\x93\xda\x94\x03\xf9\x43\xa5\xa4\xe7\x37\x49\xdc\xb9\xaf\x39\x39\x2f\x28\xdf\xdc\x34\xaf\xcc\xaa\xb2\x91\xa5\x4f\x17\x6b\xeb\x1f\x62\x96\x92\xa4\x8b\x53\xc4\x60\x0b\x85\x41\xb3\xa6\x1c\x72\xda\x8c\x1c\x4b\x1a\x88\xca\x09\xbd\xd8\xf8\x5e\xdb\x43\xe4\x5c\xa7\xec\x41\xf9\x02\xb3\xf1\x7b\xc9\x09\xc3\x2d\x4c\x2d\x22\xb0\xcc\x17\x68\x96\x93\x92\xd0\xaa\x45\x14\xac\x70\x5f\xdc\x95\xc6\x0c\x76\x50\x90\x64\x74\xac\xea\x1b\x43\x9d\x3b\x65\x89\xd1\x87\xc8\x63\x0a\x7d\x63\xe0\xa8\x69\x94\xcc\xae\x68\xb2\x45\x2c\xf8\xbe\x11\xf8\xde\xda\x92\x6d\xe2\xc4\x6a\x74\xd1\x25\xd2\x9d\xd2\x59\xf6\x13\xb3\x2b\x5b\xf6\x0c\x93\x0e\x42\x07\x8f\x41\x96\x8a\x8b\x1b\xff\xf1\x27\xa0\x6f\x8c\xfc\x1d\xb9\xf6\x1e\xe2\xf1\xdb\x27\x69\x8f\xd4\x5b\x3e\xde\x50\x90\x44\xa4\x12\xa7\x9e\x3c\x7c\x5b\xcd\x81\x5e\x26\x6f\x01\x79\xfc\x3e\xb7\x66\x37\xd7\x09\xd8\xba\xc3\x40\xec\x49\xe2\x60\x33\x74\x65\x43\x2e\x3f\x93\x51\xd7\x81\x71\x32\x6b\xd3\x00\x0e\xe4\x1b\x6e\x09\x0e\x9c\x6f\x98\x61\xdc\x67\x97\x69\x02\x4f\x02\x2f\xa9\xc8\xe9\xc7\x59\x52\xd6\x78\xa9\x3c\x0c\x4d\xde\xac\xa3\x83\x07\x63\x7a\x9b\x4f\xa0\x2e\xb2\x81\xb8\x5e\xac\x7f\x84\x7d\xbb\x7f\xfb\x4a\x49\x3a\x47\x22\x58\x05\xac\x27\x5f\x81\xce\x9e\x97\x26\x63\x51\x76\x30\x16\x86\x3d\x7c\xa5\x75\xd4\xae\x2f\x5e\x15\x66\x61\x04\x0d\x28\xe3\x89\x1b\x7a\x7f\x33\xb4\xe6\x6e\x62\x0b\x76\xff\x68\x92\xbe\x77\xc6\x78\x75\x6a\xe4\xe2\x65\xb8\xc7\x7f\xc9\x1d\xf8\x4f\x2d\x06\xd2\xd3\xbb\x9a\xf4\x1e\x8a\x77\xe6\x7b\x5a\x2c\x87\xfe\xed\x09\xc7\x05\xa5\x2a\x55\x9f\x1d\x1e\xbf\x28\xea\x74\x0c\xbc\x24\xfc\x74\x5e\x14\x0e\xec\x8b\xc9\x62\x0c\xf3\x75\x92\x8f\x78\x2d\xf0\x14\x73\xab\x03\xbc\x1d\x62\x5e\x04\x8b\xd4\x37\xda\x99\x1f\xbf\x49\x12\xe7\x28\x16\x7d\x93\x1e\xca\x41\x1e\x3b\xc2\xfb\x91\x5a\x51\xe7\x9e\x57\x4b\xfd\x36\x88\xee\x68\xdb\x6b\x1e\x45\x7e\x63\x9e\x69\x9a\x27\x77\xe4\xa9\x78\x4f\x92\x30\x57\x33\x30\x10\xc3\x21\x55\xd6\x01\x63\x69\x15\xcf\x09\x4a\x7c\x7c\xc3\x13\x54\x21\x31\xda\xbd\xef\x1e\x33\x59\xed\x68\x2f\x3e\xa6\xf9\x5d\xc1\xd2\x3a\x98\xc5\x14\xd5\x1d\x48\xca\x15\x80\xee\x01\x44\x05\xf0\xf9\x6f\xf1\xf7\xfe\x32\x38\xb0\xac\x21\xe5\x66\x60\x11\x26\xa8\xb3\x5c\x31\xec\x71\x64\x4c\x15\xb5\x98\x2d\x58\x8d\x84\xe7\x1f\xb5\xe4\x61\x96\x01\x91\xf7\xb9\x0c\x64\xf3\x7d\x21\x46\x26\x02\x06\x98\xd3\x5a\xcc\x7e\x9a\x87\xb9\xc8\x16\xe1\x1d\xc2\x18\x07\x34\x4a\x99\x23\x3a\x67\x99\xb1\x24\x90\x4e\x2a\x8a\x4d\xbf\x0f\x93\xdd\x18\x11\x87\xfb\xf2\x25\xe0\x12\x17\xca\x09\xaf\x78\x69\x8d\x2c\x85\xf8\x88\x3d\xd0\xbf\xbd\x8b\xa2\x24\x26\x85\x3f\x02\xf1\x9c\x24\x40\x00\x27\x1a\x66\xd7\xa5\x89\xbc\xf5\xec\xf8\x5e\x7e\x51\xcd\x47\x47\x7b\xf0\xb3\xf5\x12\x88\x70\x56\xcd\xe3\x3c\x72\x0f\x5d\xd9\x2b\x80\xbe\x37\xa6\x9b\x87\xdd\x63\x4e\x37\x9c\x7f\xc7\x58\x96\x6a\x5d\x23\xf7\x77\xd7\xad\x80\xc6\xed\xac\x5f\x86\x1f\x6c\xcb\x20\x5e\x0e\x87\xcd\xdb\xd6\xaa\xd8\xa7\x83\x07\x0a\xec\x58\x90\x30\xf8\x54\x56\x8f\x18\x0a\x8d\x1f\x38\x87\x8e\x98\x99\x04\xfa\x56\xc3\x16\xc2\xca\x6f\x12\x8d\x54\x6b\xa7\x3a\x3c\xd8\x18\xe5\xdc\x66\xe7\x69\x2b\x6e\x5f\x7f\xe0\x04\x27\xce\x9f\x80\xa9\xb2\xaf\xde\x31\xa5\xa2\xea\x78\xf7\x13\x9c\x37\xe5\xb5\xb3\xeb\x3c\x49\xab\x5f\x3a\xea\x3c\xcb\x7b\x3f\x52\x5c\x54\x67\xfb\x2c\xfc\xa7\xe3\xf5\x6b\x16\x27\xd5\xf5\x1a\x2f\x09\x9e\x38\x2f\x17\x5d\xad\x73\x04\xb5\xa1\xa6\xfb\xb9\xb1\x71\xf8\x60\xf3\x25\x6a\x8f\xf4\x31\xe3\x77\x50\x06\xe4\x83\xd5\x07\x88\x2a\xec\x0e\xf4\xdb\x0a\xeb\xc4\x34\xd8\x7d\x21\xf6\x89\x7c\x77\x67\x5c\x5b\x37\x66\x1c\x2a\xb3\x1b\x24\x9b\x0c\xc4\xba\xc1\xed\x74\x56\xc1\x72\xb1\xb7\x29\xab\x76\x0b\x29\x26\xc7\x6f\x8b\x1a\x24\x2e\x18\xf1\xc4\xf2\xf2\xfe\xee\x0c\x8f\x56\xee\x40\xce\x4f\x56\xf9\x34\xf6\xdb\xf3\x61\x71\x80\x72\x3e\xd2\xf5\xbc\x7d\x6e\xba\x1d\x7d\xba\x5b\xf7\x79\x45\x3b\xef\x7c\x8b\xae\x61\x97\x6e\xdf\x0d\x72\x75\x20\x98\x6c\xd3\xcf\x67\x9b\xa2\x19\x50\xf9\x75\x7b\x37\xc2\x36\xbf\x6e\xe7\x7a\x70\xe8\xd7\xed\x5b\x0f\x40\xfd\xda\x58\xad\x06\xb9\x6e\xd3\xf7\x67\x27\xe6\xfb\x0f\xb7\xa7\x81\x77\x9f\x38\x77\xe2\xfb\xdc\xf3\xfe\x9a\x0c\xca\xfe\xf7\x9f\xe9\xd0\xf8\x0c\x8b\x77\xb0\x39\x7f\xee\xf2\x71\x20\xdb\xdc\x21\xa4\xb8\xcb\xe0\xb0\x3b\x49\xf0\xc8\x2e\x6e\x3a\x5c\xcc\xeb\xc5\xec\x4c\x5f\xc1\xf5\xef\x6b\x4c\xfa\xc5\xa2\xae\x31\x7f\x2e\xab\x72\x51\xe7\x0e\xfc\xaf\x07\x5c\x4e\xba\xb8\xa2\x9f\x42\x10\x75\x70\x2a\xbc\xf9\xc8\x25\xcd\xa6\x7b\x4c\x79\xbe\x18\x3b\x7e\xb4\xc7\x9a\x32\x66\xf5\x79\x06\x31\xc1\xfc\x33\xa5\x31\x06\x76\x17\xad\x0b\x2c\x1d\xdd\x49\x2b\xe5\x97\xad\xc7\xd1\xb0\xdd\x85\x82\x10\xab\xe4\xc0\x06\x55\x28\xd4\x7d\xb2\x01\x92\x10\xb7\x66\x13\x1c\xbe\x63\x2f\x1a\x4c\x29\x54\x4c\x69\xe1\xc6\x83\x55\x4a\x88\xcd\x21\x5a\x2d\xc6\xb8\x23\x0d\x90\x2e\x72\x9a\x8f\x7b\xfc\x2e\x7f\x85\x3f\x2c\x40\x05\x19\xfa\xbb\xcf\x33\x84\x79\x99\x5e\xc5\xe5\xb5\x31\x04\x3e\x80\x15\xdd\xff\xb0\xa2\x7b\xf7\x01\x80\x68\xb8\x4a\x51\x03\xb2\x25\xd4\xb0\xa9\x01\x8c\x1d\xa6\x93\x59\x4d\xae\xb4\xa8\x47\xf2\x3e\xcb\x3e\xdf\x60\x92\xf1\x63\x35\x29\x2c\x34\x52\x58\x60\x01\x27\x85\x05\x26\x85\x40\x15\xc8\xd3\xfd\xa7\xce\x2b\xf9\xb1\xf3\x9c\x7d\x2c\x24\xc7\x25\x5e\x6a\xbf\x62\xd6\x24\xdc\xc0\x27\x54\x1f\x21\x60\x5f\x44\xee\x30\xb6\x02\xf5\xd3\x96\x0a\xa4\xea\x56\x81\x68\x67\x15\x07\x24\x9c\x57\x15\x9e\x57\x7a\xc0\x27\x3f\xb6\xcc\xc2\x94\x9f\x5e\x85\xb2\xe5\x64\x74\xbc\xdc\x74\x66\xec\xa8\xed\x00\xab\x16\x73\xd1\x73\x13\x21\xca\x7b\x55\x0b\x36\xeb\x51\x0d\x32\xb5\x1e\x97\xd8\x9d\x10\xac\x96\x78\x29\xec\x57\x53\x3b\x35\xc7\x9d\x45\x00\xdf\x8c\x79\xb1\x7b\xfe\x6e\xc2\xc6\x0c\x56\xa9\x48\xf6\xf7\xbb\x55\x24\x2d\x4c\x59\x3f\xca\x8d\x49\xef\xfe\x16\xe7\xb3\x01\xb0\xcf\xc7\xdf\xff\x26\xb4\xfd\x26\xb4\xfd\x26\xb4\x7d\x0e\xac\xfe\x32\x42\x5b\x8a\x59\xa5\xf1\x1f\x9d\xfb\xc7\x92\xdf\xa4\xb7\x5f\x8d\xf4\xf6\x29\xab\x78\x30\xdc\x74\x50\x4b\xbc\x8b\xbb\x93\x7d\x42\xbf\x9d\x15\x87\x79\xd5\x3a\xcc\xcb\xa8\x49\x4a\x8b\x6e\xf2\x1b\x73\x53\xee\xee\xd8\x63\x19\x65\x55\x7e\xb3\xd6\xc3\x6f\xd0\x0b\x4f\x2e\xa7\xf6\x06\x56\x11\xa5\x8d\xca\xc7\xd4\xb3\xc0\x71\x12\x24\x53\x01\x27\xad\x6a\x1b\xad\x80\x49\xdd\xbd\xef\x78\x5d\x16\xc4\xf1\x4a\x83\x61\xe5\x8b\xce\x44\x6c\xda\x26\x76\x40\x0c\xf1\xb4\x4f\x18\xdb\xd0\xe6\x49\xfe\xdf\x2b\xa7\x47\x0e\x60\xf6\x59\xb9\xc7\xf7\xbf\x46\x3f\x2e\x9a\x33\x7d\xe2\x0f\xf7\xf7\x3f\x2b\x3a\xb5\x31\x08\xd0\xaa\xc1\x31\x9b\x84\x43\x3b\xac\x63\x2d\xcb\x2f\xb4\x96\xe5\xdd\x60\x3c\xde\x76\x2d\xed\xfd\xdc\x61\x93\xb4\x7b\xde\x60\x93\xd0\x32\xb3\x55\xb6\xeb\x75\xc4\x6a\x1c\xa1\x78\x29\x57\x20\xbe\x48\x32\x87\xfe\x95\x82\xf2\x31\xe3\x78\x41\xb8\x80\x8a\xc7\x20\xf2\xed\x3f\xdc\x44\x12\x57\x4e\x1b\x29\x8d\x17\xd9\xc4\xa1\x60\x05\x87\x67\x9e\xe8\x34\xfa\x63\x2d\xbe\xe6\xec\xe7\x1d\x8c\xfd\xcc\xa7\x32\x30\x97\x54\xe5\x28\xb0\xc6\x39\xf7\x2c\x77\x25\x06\xb1\x1c\x12\x56\x8f\x00\x7e\xa6\x21\x14\xbe\x5f\x2b\xcc\x3b\xca\xba\x37\x56\xa2\xf5\x1a\x9e\xbb\x49\xef\x1b\x98\x9a\xf6\x7f\x30\xd5\x41\xc6\x39\x83\x4a\xa0\x25\xde\x57\xf9\x15\xa4\x74\x1b\xa6\x18\xbc\x9a\x7c\x43\x38\x5a\xa7\x75\x96\x20\xca\xfc\x03\x04\xf9\xd0\xb9\x4a\x73\x27\x72\xb8\x2c\xaf\x64\x44\xe2\x3d\x6b\x25\xb6\x6e\x35\x9f\x0b\x2d\xa3\x92\xa5\xbb\xc0\xb9\x8a\x3f\x7e\x5a\x77\xf1\xc7\x8d\xbb\x23\x72\x75\xd7\x8e\x78\xce\xbf\xcd\xe7\xc6\x09\x49\x7b\xa5\x05\xbf\xb8\xc4\x0b\x31\xbe\xec\xc1\x64\xa2\xa0\x62\x4f\x25\x0e\xae\xa7\xb8\xb0\xa8\xe8\xd9\x21\x4f\xc0\x11\xa6\x9d\x5a\x4a\x72\x21\x0e\x46\xad\x44\x39\xbb\x84\x2b\xf9\xc6\x54\x4a\x61\xc8\xd9\xa7\x92\x7c\xb1\x7c\xa3\x62\x15\xb4\xfc\x46\x76\x52\x96\x5e\x5d\x3a\x94\xc0\x28\xa2\x84\x54\x0e\xcb\x6c\x14\x61\xa2\x2a\xc7\xa9\xca\xb1\x9c\x8e\x4c\x7c\xc5\x27\xa3\x3c\x7f\x8d\xa9\x28\x79\xb7\x3a\x68\x72\xdc\xb9\x30\xff\xc4\xeb\x65\x68\x11\xfe\x35\x56\xae\x6b\x52\x9f\xb2\x86\xff\x0c\x8b\x65\xe4\xef\xfd\x97\x58\xab\x8e\x39\xfd\xda\x97\x4a\x53\x51\xfd\x6b\xac\x94\x7d\x4a\xbf\xf6\x85\xd2\xf4\x79\xff\x1a\x0b\x65\x9f\xd2\xaf\x7d\xa1\xf4\x4c\x79\xff\x12\x0b\x65\x9f\xd2\xbf\x28\xa7\xc1\xb3\x71\xfd\x6b\xac\x9c\x39\x99\x2d\xd6\xcc\xee\x71\x91\x9a\x52\xf7\x67\x30\x9e\xaf\x55\xfa\xee\x6f\x20\x88\x5b\xb4\xd0\x4b\xbc\x90\x6b\xa5\x68\xd4\xd6\xc1\x55\x2b\xe5\xa5\xd2\x94\x97\xec\x8e\x08\x13\xd5\x11\x21\xfd\x0a\x7e\x07\x16\xf1\xb0\xd9\x4a\xe9\x06\x5b\xa9\xe4\xd8\x57\x05\x94\x5a\x7f\x15\xe6\x75\x0d\x14\x55\x42\xae\xfd\xe5\xe7\xda\x4b\x7c\x2b\xa5\x6b\xb6\xd2\x57\x9a\xcc\x26\x7b\xc9\x6a\xaa\xc6\x10\x97\x15\xee\x63\xbf\xc9\xec\xbf\xc9\xec\xff\x12\x27\xe9\x6f\x32\xfb\xaf\x88\x6d\xfd\x4d\x66\xff\xd5\x2c\xd5\x6f\x32\xfb\xaf\x64\xa1\x7e\x93\xd9\x7f\x25\x0b\xf5\x9b\xcc\xfe\xeb\xe6\x34\x7e\x93\xd9\xff\xe9\x64\xf6\x56\x2c\xc5\xc6\x32\xbb\xdd\x47\xfe\x37\x99\xfd\xd7\x29\xb3\xff\x2b\x09\xed\x1b\x05\xd5\xd0\x37\xeb\x32\x86\x18\x39\x42\x5e\x14\x0e\xdf\xf5\x8e\xbc\xe1\x06\x53\xd0\x50\x8e\x90\xb1\x08\xb0\x69\x12\x64\xac\x70\x39\x69\x0b\xfe\x9f\x92\x20\x82\xb5\x60\xc6\x28\xb0\xd2\xd9\xf1\x63\x1a\x73\x57\xaa\x00\x51\x0b\x6f\xb0\xed\xd1\x15\xb6\x2b\x2a\xc9\xe4\xef\xb6\xb8\x7c\x51\x69\x55\x62\x02\x51\x67\x55\x72\x01\x51\xe7\x35\xa6\x0a\x72\x28\xc5\xe2\x8a\x5a\x3f\xa6\x79\x5a\xcd\xac\x79\x06\x9a\x86\x3a\x53\x11\x88\x2a\x2b\xd2\x0d\x48\x30\x8a\x25\xb7\x65\x2c\x60\x74\x51\x27\xec\xdb\xb8\x47\x35\xde\xc8\x9b\x05\xd8\x1f\x0e\xb6\x26\xdc\x3c\x50\x12\x83\x22\x57\xba\xdc\x6e\x4b\xa8\x35\x87\xc8\x55\xe1\x34\x06\x29\xb7\xa3\xeb\xa4\x71\x89\x9a\x09\x67\x43\xa1\x69\xf3\xd0\x17\x4b\xbf\xdf\x42\xf3\x36\xd4\x5f\x91\xbb\x61\x1a\x96\xcc\xdd\x30\x15\x74\x4b\xf8\xc3\x69\x75\x2d\xfe\x86\x29\xf7\x21\xb2\x8f\x62\x1b\x97\xc7\xce\x31\xac\x73\x79\x84\x21\xd8\x18\x85\xca\x8c\x2f\x92\x37\x89\x6c\x92\x00\x67\xb8\x19\xe2\x58\xf8\x48\xbe\x40\xc7\xee\xca\x51\x69\x47\xef\x02\x88\xca\x79\x8c\x44\x85\x1d\xb7\x1b\x33\x26\x87\x07\x9f\x3c\x4c\xd5\xc1\xbd\x13\x7c\xf1\xd5\x3c\x4b\xce\xd1\x39\x72\x13\xd8\x6d\xc6\x2d\xad\xeb\xb4\xce\x36\x09\x00\x3b\xfc\xee\xb3\x74\x36\x49\xa6\xd9\x66\xb3\x7b\xb0\x19\x49\x59\x0b\xd2\x3c\x9e\xcf\xaf\x37\xe9\x6f\x33\x7b\xd1\xba\xfe\xe2\x45\x3d\xdb\x2c\x6c\xf8\x70\xa3\xfe\xda\x2e\x9c\xdd\x28\xd6\xe5\xe6\x3d\x5b\xe9\xe6\xad\xf0\x50\x5b\xba\x79\xdb\x69\x51\xb7\x9b\x77\x27\xe5\xe9\x70\xf3\x5e\x4d\xf3\x3e\x6b\x3f\x6e\x87\x0c\xdb\x10\x99\x55\xc1\x3c\x0d\x08\x9b\xd1\x7c\xae\x08\xb6\xbb\x8f\x80\x72\x1b\xfe\xc3\x7a\x9f\x12\x23\xf4\x0f\x9c\xfc\xe7\x88\xa2\xbb\x7b\xff\x77\x8f\x66\x5b\xd1\xff\x1a\xdf\x61\xdb\x38\xa4\x64\xb0\x8d\xf7\x70\x5b\x67\x23\x05\xed\xc3\x15\x7e\xc3\x32\x9a\x09\xaf\x9e\x6c\xc7\x1c\x34\x6c\x59\x14\xd9\xe2\x36\x24\xdf\xa0\xed\x6a\x85\x9b\x20\xa2\x68\x46\x29\x88\xc8\x8d\x55\x4c\xa2\x00\xbe\x68\xaa\x23\x9e\xc3\xc7\xf9\xad\x4f\x20\xe0\x1e\x55\x57\xd0\xe3\xf1\x9d\x26\xd9\xb0\x20\xda\x2c\x35\xce\xe4\x13\xa7\xd9\xb4\xd5\x19\xb7\xc2\x27\xb0\xc4\xbb\x65\xb6\xca\x97\xa0\x21\x45\x57\x58\x0b\xcb\x97\xf5\x86\x98\x99\x89\x63\x8d\x3f\x59\x07\x26\x85\x13\xd2\xe0\xa4\x71\x48\x9f\x0a\x28\xa5\xb1\x0e\x48\xfd\x5e\x7a\xd2\x23\xac\x56\x64\x56\xb8\x33\xac\x64\x16\x96\x4f\xc9\x55\x02\x2c\xdc\xb9\x48\xbe\xbc\x01\x2b\xf7\xfd\x66\xcc\xec\xf1\xdb\xe7\x6f\xb4\xf9\xaf\x08\xc7\xf9\x62\xa1\x91\xf7\xa5\xfc\x65\x0f\xdc\x53\xa6\xae\x21\x8a\x06\x92\x55\x61\x7c\x4a\xc5\x0e\x24\xe8\x8c\xc6\xc4\x4f\xc7\xe9\x7c\x06\xdb\xad\x5a\xa4\x06\xa6\x9a\x2f\xd7\x8f\x42\xad\x7d\x97\xa1\xe4\xc9\x65\x51\xa7\x80\xcc\x93\x73\x4a\xa9\x3c\x2e\xb2\xd6\x88\x2c\x75\xd6\x0f\xcc\xf2\x51\xd7\xf8\xae\x16\xf5\x22\xce\xc2\x2d\x87\x79\x9e\x56\xe7\xec\xcb\x4d\x06\xdc\xd4\xbe\xd3\xd0\x9b\xcf\xbb\x22\x0b\xef\x2f\x83\x07\x76\x9d\xc4\x5d\x77\xf9\x84\xdd\x52\x81\x29\x8d\x48\xfe\x91\xdb\x0a\xbb\xb2\xbb\x9b\xdd\xb5\x2b\x19\x53\xf8\x86\x24\x1f\xad\xa7\x3b\xfb\x0f\xdd\x99\xbc\x89\x6c\x44\x1d\x51\x67\xf8\xdf\x6a\xf3\x83\x90\xa9\xe0\xd4\x46\xc5\x78\x7d\x7d\x8e\x77\x1e\x6d\x20\x5e\x6d\x26\x1c\xdf\xef\x1e\x97\x36\xa7\xcb\xec\x7a\x3e\x4b\x81\x99\x72\xe4\xaf\x1e\x9e\xb3\xce\x87\x59\x4a\xc1\x96\x8c\x4a\x3d\x22\xe9\x6f\xcb\x19\x6d\x32\x9b\xcd\xa4\x6f\x52\x45\x77\x84\x7e\x7d\x61\x70\x6f\x12\xf6\xd8\xca\xc2\xa2\x81\x5b\x39\x69\x1e\x6c\xc0\x95\x28\x76\x8f\x3b\xf0\x17\xad\x39\x6a\x84\xc7\x02\x81\x4f\xe5\x35\x5a\x4d\x76\x87\x35\xc3\xfc\xd7\x73\x1a\xae\xf3\x4c\xa0\xcf\x9d\xf8\x50\x6d\x3c\xdd\x93\xff\xcc\x13\xb7\x4e\x1a\xe6\xbb\x32\xf3\x17\x8a\xe6\x2e\xde\xbe\x78\x35\x4f\xb3\xa4\x0c\x4f\xbf\x0b\xdc\xe3\xc8\x39\xec\x0f\xfa\x03\xf7\x2c\xb8\x8a\xd3\x7c\x5d\x12\x26\xe4\x30\x56\x0c\x75\xd6\x62\x4f\x16\x36\x93\xe1\xbc\x1d\x6a\xdf\xa4\xac\xc8\x41\xe2\x7f\x5f\xfc\x92\x88\xab\xe6\xe3\xbe\x48\xe3\x2f\x77\x8f\xbc\xb3\x9e\x5f\xc9\xc6\x2f\x0d\x0f\x6b\xbc\xda\x00\x3f\xaa\xc2\x38\xc0\x0b\x04\x80\x23\x2d\xa0\x3c\xef\x37\x0f\x4d\xde\x8c\x55\x5d\x52\x2e\xfd\xa6\x43\x96\xf8\xff\xd3\xba\xc3\xc4\xe3\x45\x26\xe3\xcb\x51\xa9\x30\x2e\x17\x57\x17\x8c\xa4\x1f\x65\x69\xa7\xda\x69\xb1\x52\xed\x34\xe3\xc2\xea\x38\x70\xf7\x36\x4d\x16\xff\xa6\x86\xee\xaf\x2a\x61\x6e\xfb\x3c\x9d\xf3\xab\x80\x36\x55\x7a\x51\xc3\xb0\x7f\xd9\x85\xbf\xbc\x65\xe5\xb9\x69\xda\xb0\xe1\x37\x54\x54\xd6\xee\x8c\x14\x5e\x08\xee\x49\xa4\x0f\xf3\x4a\xba\x61\x1b\x9a\xd1\x39\x24\xd6\x30\x2c\x56\x19\xce\x90\x0b\x9a\x9f\x94\xa2\x7b\xc1\x05\xb1\x10\xfd\xd6\xbe\x2b\xa1\x2b\x05\x90\xd2\xf0\x48\x79\x55\xba\xc7\x80\x8d\xea\x5a\x37\x72\x40\x58\x35\x02\xfc\xb7\x73\x00\xb0\x84\x98\x05\xb1\xc8\xe0\xcf\x36\x29\xee\xf5\xbb\x16\x2e\xb2\x62\xfc\xcb\xdf\x16\x45\x9d\x98\x8a\x8b\xf9\x31\xbb\x0d\x4f\xde\xd9\xf5\xb5\x80\xeb\xca\x3b\xc1\x5a\x23\x12\xb7\xe4\xc9\x31\xb9\x5f\x03\xd8\xea\x80\xdc\x95\x54\x04\x6f\x79\xc3\x2f\x1b\x42\x22\x4a\x24\x2d\x49\xd1\xec\x5b\x87\x5a\xe2\x1a\xf7\x8e\x14\x46\xb1\x0a\xb6\xd6\xd1\xcc\x93\x69\xa6\x09\x5a\xe4\x19\x5e\x2b\xaf\xed\x38\x2d\xcd\x11\x4f\x12\x6e\x4b\x62\xc4\xbe\xed\x4a\x19\xbe\x2a\xdf\xdd\x41\x77\xba\x3b\x24\x97\x78\xe7\x02\x33\x72\x6b\xc9\xd8\xe9\xfa\x85\xed\xaf\x71\xb8\xbf\xdd\x84\xa5\xd9\x76\xeb\x29\x1f\x7c\xb7\x72\xce\x3f\xac\xc9\xf1\x67\x49\x69\xba\xa8\x04\x56\x85\x3b\x03\x7c\x7a\x82\x1f\xf2\x9f\xe8\xcc\x5e\xf1\x4b\xa5\x6f\x8c\x8b\x5a\xc2\x83\x03\xbc\xd9\xe5\x7b\xe5\x66\x97\x3a\x90\xd7\xad\xc6\x51\xdd\x79\xf1\x71\x3c\xaa\x6d\x17\x1f\xd7\xea\xc5\xc7\x05\x3e\x9a\x97\x1c\xa7\xbc\x50\xbf\x93\xad\x56\xee\x39\x2e\xa3\xaa\xb9\x89\x0d\x17\xea\x05\xd3\x2a\xb2\xdd\xec\xc8\x1b\x83\x7b\xd3\x6c\x81\x3a\x46\x79\x1d\x1a\x35\x52\xcd\x53\xa8\x26\xae\x76\x33\xef\x14\x36\xb2\xfc\x3a\x2c\x2d\xaa\x2b\x5b\x7f\xc4\xb8\x8e\xae\xcb\xd9\xaa\xbb\x5f\x1e\xcc\xdd\xd0\x2e\x93\xd0\xb8\xee\x4b\xbd\xb5\x4c\xbb\x3e\x8d\x5d\xd4\x45\x57\x7c\xf1\x9b\x4e\x00\xcc\xca\x9b\x69\x82\xf7\x23\x59\x2e\x94\xd5\x6e\x59\x63\xa0\x15\x97\xd8\x30\xa8\xa8\x97\xb9\xca\x2b\x86\xe4\x0d\x4a\xf2\x4a\xd0\x64\xa9\xdf\x60\xd8\xba\xf4\x50\x5e\x6d\xa4\xd5\x8a\xb3\x0f\xf1\x75\xe5\xc9\x2b\xbb\xd9\xcb\x42\xbb\xf7\x17\x6f\xc8\xd2\x40\x7e\xf7\x5b\x27\xe3\xf6\x7d\x97\xb8\x6f\x3c\x97\xad\x31\x5d\x3f\xb9\xfe\x8a\x49\x7e\x97\xa4\x7e\xbd\xa4\xb9\x0c\xfc\xce\x4a\xe2\x4f\xcc\x77\x74\x20\xf8\xb6\xfb\x28\x6b\xeb\x7d\x94\x9e\x48\xbf\x4c\x97\xb4\xc7\xec\xa2\x61\xa5\x51\x60\x5e\x60\xda\xb0\x3a\xf5\xac\x98\x84\xee\x93\xa7\xcf\x9f\xbe\x7d\xda\xbe\x3f\xb8\x75\x4d\x65\x94\x4a\xfe\xcb\x13\x4c\x17\x0c\x97\x31\x42\xef\x5e\x3f\x7b\x0c\xec\x3d\xb4\x90\xd7\x5e\xc7\xec\xfc\x8d\xaf\xb7\x0c\x63\xba\x80\xaf\x3d\x6a\xba\xdc\x0b\x40\x09\x4c\x78\x3a\xbd\xf6\x6e\xd8\x4a\x20\x3e\x7d\x89\x5b\x36\xdb\xd7\x6c\xe6\x6b\x6f\xc3\xb2\x5c\xb3\x69\xbb\x17\x93\x5d\x97\x75\x48\x97\x5c\xaa\x84\x25\x7c\x38\xd8\xea\x3e\xcb\x1f\xbe\xec\xd5\x59\x74\x2c\xa0\x9f\x5f\x3a\x01\xd1\x21\xbd\xe4\x0e\x81\xd2\xd5\xef\xc1\xa0\x89\xcf\xb3\x27\xa9\x5a\xe3\xcd\x78\x87\x94\x55\xe2\x2a\xd1\xc0\xfd\xd6\x25\xdf\x3f\xf7\xce\x9e\xdc\x7b\x4c\xad\xb6\xa5\x60\xda\x32\x26\xd5\xd7\xe4\x09\x75\x51\x4c\xae\x9d\x1b\xc6\x0c\x60\xea\xd5\xcb\x12\x46\x33\xe9\x01\x2f\x50\x94\xe1\x37\x83\xc1\x60\xf4\x97\x7c\xf9\x97\x7c\xef\x5b\xa8\xf2\xad\x03\x13\xbb\xba\xc0\xbb\xc1\x42\xe7\xed\x2c\x69\x0e\x1f\xba\x45\x8b\xbf\xc4\x8a\x7b\xc8\x72\x7c\x23\x2a\xf3\xe6\xe9\xb2\x22\x38\x22\x2e\xf3\x90\x5d\x59\x34\x62\xe5\x57\x30\xe3\x34\xef\xc1\x86\x0b\x87\x83\xc1\xfc\x23\xeb\xf1\x2f\x79\x5f\xfb\x7e\x0a\x7d\xf5\xa6\xf1\x55\x9a\x5d\x87\xce\xfd\x1f\xcb\x18\xb6\x70\x5a\x39\x2f\xf3\xe4\x7e\xe0\x54\x71\x5e\xf5\xaa\xa4\x4c\xa7\x23\x59\xb7\xc2\xd3\xca\xf9\x8e\x35\xe8\x38\x6c\x46\x4c\x73\x46\x05\xf4\xab\x57\xcd\xe3\x31\x54\xcb\x8b\x0f\x00\x71\x2a\x87\x4d\x9c\xd2\x06\x75\x60\xf3\xc5\x28\x97\x50\xf1\x24\x2d\xf9\xbd\x8f\x4e\x56\xb3\xa1\x03\xb3\x52\xa7\xb0\xf6\x7c\x52\xce\x55\x3a\x81\x5d\x49\xaf\x18\xae\xc9\xee\x69\x3e\x26\x14\xad\x40\x44\x77\x37\xc7\x00\xa4\xa8\x4f\x70\x20\xe7\xd5\xd0\x79\x20\xe6\x65\xf4\xb4\x62\xfc\x15\x6c\x1e\x80\x5d\x9a\xa3\x4f\x69\x8f\x98\x5e\x7a\xc3\xe0\x1f\x3a\xfb\xbc\x01\xe6\x6c\xd9\x2b\xe3\x49\xba\xa8\xa0\x27\x59\xfc\xb1\x57\xcd\x62\x20\x4f\xa1\xf3\xcd\x0f\x3f\xfc\xe0\x0c\x9c\xde\x70\xfe\xd1\x19\xb0\xff\x05\xce\x37\x87\x87\x87\x50\xa8\x94\xb5\x66\xfe\x3c\xad\xeb\x2c\x61\xa0\x77\xaa\x7a\x31\x9d\x56\x4e\x3d\x8b\x6b\x07\xc5\x68\xf8\x95\xe0\x2d\x73\x0e\x10\x37\xfa\x7d\x51\x00\x3b\x72\x65\x82\x21\xbc\x48\xa6\x78\x5f\xdb\x0d\x5b\x55\x40\x24\x10\x09\x9c\xfb\xf7\xf5\x59\x36\xd3\xe3\x00\x3b\x30\xe0\xf5\x50\x4c\x4b\x22\xbd\x8a\x1c\x0d\x10\xe3\x8b\xaa\xc8\x16\xbc\x38\x4b\xa6\xf0\xe9\x80\x7e\x23\xbe\x3a\x07\x02\xea\x7f\xef\xa1\x70\xf2\x11\xa0\xd8\x02\x56\x9a\x57\x49\xed\x94\x97\x17\xde\xf0\x60\x10\x38\xe2\x1f\x1f\x60\x35\x20\x48\x0d\x0d\xc0\xb3\x01\x22\x1c\xa1\x6b\x20\x9a\x17\x59\xcc\xe7\xc2\x6b\x50\xd7\x2b\xde\x33\xc0\xd9\xaa\xa8\x1b\x2b\x8c\xa7\xb5\xc0\xaa\xcf\x0b\x47\x9c\xeb\xfe\x00\xa6\x29\xfe\xf1\x57\x01\x95\xcf\xf7\x0b\x43\x95\x2d\xdd\x17\x00\xaa\xe3\x70\xb0\x72\x1c\xc7\x3d\x3d\x8f\xe1\xb8\xac\x5a\x1b\x18\x85\xfb\x40\x6e\xff\x3e\x6b\x91\x2d\x80\x05\xe0\x02\xc2\x07\x62\x07\x9a\x5b\x5f\x21\xa8\x8e\x42\x51\x0b\xa0\x4b\xd3\x0c\x61\x34\x03\x82\x94\xe4\xb6\x4d\x7d\x60\x59\xb5\xde\x87\xe4\xe2\x97\xb4\xee\x21\x79\x88\xcb\xde\x25\xd6\x45\xce\xe8\x87\xc1\x24\xb9\x0c\x08\xd4\x08\x64\xf6\x3f\x9f\x15\xfc\x00\x0f\xec\x7f\xbe\xdf\x6e\xb0\xf8\x7c\x6d\x99\x0d\xdd\xad\x1d\x7e\x28\x20\x04\x35\xd2\xac\x2e\x10\x5b\x8f\xde\x55\xf1\xf7\x1e\xe1\x5b\xfa\x77\x38\xe3\xc3\x06\x0b\x18\xe0\x04\xb0\x56\x55\x29\x56\xbd\x5d\xf1\x8a\xe8\x73\x8b\xae\x37\x68\x4a\xb8\xac\xd1\x67\xc7\xa8\x40\x5b\xaa\x4d\xc1\x1b\x38\x34\x5b\xb1\x21\xd5\x5b\x92\x0f\x1d\x7f\xb5\x31\x5b\xdb\x5b\x3d\x6a\x5e\x67\xcb\x81\x1f\xf0\x66\xd4\xfd\xf7\x0a\xf7\x1e\x9c\x21\x29\xbb\xcb\xb3\xd9\x85\x7b\x6c\x6f\xe6\xc9\x07\x3a\x65\x68\x8f\xd2\x2b\x75\xf5\xc3\xbc\x9e\xf5\x40\xcc\xae\x7b\xc5\xb4\x87\x92\xad\x77\xe8\xb3\x19\x76\x50\x2f\x49\xa2\x06\x23\x39\x0e\xea\x07\x73\xa2\x6e\xd3\xcf\x01\xef\x47\x8e\xbc\x37\x59\x94\x31\x9f\x6b\x7f\xbf\x1a\xe9\x2f\x89\x5b\xc4\x0e\x8c\xf2\x69\x9a\x65\x3d\x14\x3f\x42\xe4\x27\x3e\xc4\xe5\xc4\xfc\x12\x38\x6b\xc0\xba\x9e\xe0\x11\x43\xe0\x39\xaa\x04\x66\x41\x94\x4c\x99\xd0\x70\x84\xb3\xe1\x5b\x01\x78\xf9\xbc\xc2\x0b\x52\x7b\x05\xac\x11\xb2\x0b\x70\xd2\x0f\x06\xbf\xd7\x36\xc3\xea\x4a\xc5\xea\xf7\x2b\x5e\x12\x58\xff\xd7\x2f\xc9\xf5\xb4\x84\x59\xd3\x55\xb4\x9c\x29\x2c\x05\xfe\x99\xc3\x0c\x1d\xe0\xeb\xb3\xe4\xff\x78\x43\x9f\xb3\x99\xad\x41\x5a\xaa\x14\xab\xde\x76\xbe\x82\xe1\x39\xec\xec\x5a\x33\x96\xc1\xfa\xb1\x0c\x56\x8e\x65\xd0\x3d\x96\x81\x1c\xcb\xd2\x40\x76\xbe\x4d\x2d\x78\xc8\x37\x7a\x0b\x15\xf7\x37\x44\x79\x81\x21\x5b\xa1\x2c\xeb\xd4\x78\x05\xd2\x32\x1e\x7e\x96\x8f\xee\x8a\xcf\xc5\xa2\xb6\xe2\xaf\x09\xb0\x4e\xec\x1e\x58\x10\x4f\xa5\x78\x1b\xe0\xde\x60\x4b\xfc\x18\x5a\xd7\x10\x09\x49\xf7\x1a\x5a\x69\xc9\x90\x2f\xa0\x5c\xa9\xde\x50\x52\x6f\x1e\x71\x96\x4c\xc4\x60\x48\x5a\xe2\x02\xdf\xc5\xc5\x85\x26\x95\x85\x92\xd9\x50\xa5\xaa\xfd\xc3\x79\xad\x16\x72\xb1\xec\x4f\x49\xf6\x3e\x41\x89\x88\xf5\x25\x34\x68\xe8\xe9\xb6\x51\x5f\xfb\x0f\x6c\x7d\x0d\x36\xe9\x0b\x75\x55\x4c\xa0\xd5\x23\x1e\xbe\x82\x7d\xd4\xf4\xd0\xff\xc2\xf6\x51\xe9\x41\x42\xaa\x7b\x54\x71\x70\x31\x19\xb5\xf6\xaa\x3a\x5f\x2d\x16\x4a\xf2\xb9\x78\x29\x91\x40\xde\x82\x5c\x89\xdb\xa0\x30\xb6\x6b\xae\x55\x56\xd6\x11\x5d\x3d\xe6\x9f\x78\xc7\xd9\xb6\x17\xd3\x6e\x1a\x04\xb3\xd6\x00\xd0\xad\xf3\x7f\x30\xb0\xab\xa4\x32\x54\x49\x35\xd7\xbf\x93\x72\x3e\x6f\xac\x00\x01\xa9\xac\x0c\xf5\x7d\x41\x65\x42\x7d\x9f\x46\x85\x55\x7d\xcf\xf4\x41\x2b\xd4\xf7\xd4\x08\xaf\xc6\x15\xd5\x76\x2d\x7c\x71\x77\x2d\x7c\xbc\x91\x16\x9e\x3d\xc0\xf4\xca\xda\x03\x08\x62\xd0\xd8\x7b\x6d\x04\xe3\x0c\x58\xf3\xb7\x2c\xdb\x02\xd3\x4f\xce\x8b\x2c\xc3\x2f\xdb\x6f\x18\xd9\x2e\xe0\x9d\x3a\x6e\xd6\xa6\x7d\xcc\xcb\x80\xba\x56\x3b\x54\xfa\x20\x1b\x54\xa0\x35\xad\x14\xc1\xb1\x52\xc7\xd1\x80\x4f\x1b\xf0\x06\x36\x7a\xb4\x33\x64\xcf\x2c\x55\xfd\x2b\x68\xe6\x19\x9f\x77\x34\x4c\x0e\xd9\x3b\xae\x42\x7f\xb1\xb8\x8a\x7a\xbc\x7a\x2a\x2b\x0d\x78\x83\x04\xb4\x77\x65\xa6\x74\xb8\x98\x03\x62\x26\x78\x13\x77\xe5\x89\x91\x3f\x87\x7e\xc3\x96\x45\x42\x36\x97\x07\xea\x74\x40\xa8\xd5\xe0\xa5\x34\xa8\xe8\x75\x83\x1c\x1a\x57\x5e\xa9\xc0\x49\xa7\x5e\x45\x2a\xec\x18\xe0\x99\x72\xcd\xb7\xd0\x3f\xb6\x54\xce\x39\x7d\xb0\xc3\xd7\x1b\x57\x61\xc2\x50\x3f\x89\x32\x28\x98\x2c\xc6\x89\x87\xd6\x06\xe8\x24\x50\x77\x88\xd0\xb6\x3a\xf9\xae\x11\x76\xb0\x84\xdd\x3a\x82\x36\x7b\xc3\x28\x8a\x0c\x60\xfa\x26\x70\x13\x36\xf9\x0f\x65\x5a\x27\x14\xe4\x5a\x79\xe6\x27\x23\x90\xa4\x13\x07\x1a\x4c\x8e\xd9\x32\xc2\x41\x87\xe5\x34\xca\x3a\x4a\x7a\x6a\xe9\x48\x59\xf6\x7a\xcf\xd3\x20\xbd\x37\x4c\x0e\xfc\xbd\x07\x03\x1d\x5b\x6e\x6f\xd9\x27\x4c\xfe\xfe\x11\xcf\x79\x58\x39\x73\x9c\x6a\x1f\x81\xfa\x10\x25\x23\x6e\x1c\x54\xbb\x1a\xc5\x47\x1d\x28\x76\xef\x9e\x67\xd4\xdd\xc5\x61\x29\x7b\x0c\xd1\xc5\x8b\x81\xd2\x78\xee\x37\xe2\xb0\x72\xbe\x61\x87\x86\xdf\x07\x91\x1e\xc6\x17\xb8\xee\x4e\x14\xc9\x13\x16\x1a\x55\x6a\x3b\x7d\x8a\xe0\x86\xca\x48\x7c\x3d\x59\x4b\x6b\x12\xde\x62\xd8\xa4\x87\x26\x82\xb6\x55\xab\x85\xad\x1c\x35\x6e\x6f\xbd\x55\x5b\xba\x6b\x1b\x36\xf3\x82\x0d\xc6\xab\x19\x26\x0c\xe8\x49\xb7\x5c\xd0\x60\x1b\xed\xbd\xcf\xad\xca\x7c\x3c\x62\xeb\xc1\x80\xf4\xad\x68\x7e\x15\xd7\x75\xe9\xb9\x55\x89\xa6\x1c\x5a\xa9\x3c\xfa\x09\xb6\x04\x5e\x28\x00\x9d\x0e\x93\x87\xdf\xd2\x23\xb0\x62\x93\xe2\x0a\xa0\x31\xea\x6e\x40\xdf\xf4\xbb\xee\xbd\xf3\x45\x9e\xfe\x2d\x72\x77\x61\x33\x2e\x03\x15\x81\x5a\xb4\xaa\xc1\xa4\xdd\xa8\xc1\xd0\xf5\xb8\x6f\x40\xd4\x24\x0e\x6a\x9f\x2a\x75\x00\x8c\xda\x7b\x38\x00\x12\xa1\xb4\xad\x51\x20\xe0\x3a\x3d\xb6\x7d\x80\xe9\xa8\x12\x40\x4e\xd8\xd3\xc3\x01\x60\x4c\xf1\x86\x0c\x55\x80\x62\x09\xc2\x52\xe8\xe0\x5d\xff\x74\x70\x06\xc7\x9d\x52\x24\xf4\x58\x70\x30\x65\xd1\x60\x94\x1d\xd5\x7c\x89\x46\xd9\xee\xae\xb8\x54\xa2\x46\x1b\x73\xf9\xa8\xf6\x32\xa2\x09\x31\xaf\x72\xcc\xed\x11\x29\x34\x18\x9f\x66\x67\xf0\x92\xa3\x63\x90\x62\x0c\xd7\x04\xba\x01\xc6\x56\xa0\x70\xa1\x14\x33\x4e\xb8\x79\xb3\x24\xf2\x50\x79\x89\x8f\xc7\x07\x9e\xb3\xf7\x35\x1f\x5a\x3e\xc8\x63\xad\x10\xdb\x3e\xbe\xbf\x5b\xa0\x2f\x10\x39\x80\x6a\x6f\x79\x17\x54\x41\xdc\x6a\xd0\x38\x8a\xfa\x4b\x2b\x10\x28\xd2\xdd\xd3\x49\x64\x7e\x1c\x09\xa0\xdc\xbb\x47\x43\x64\xdb\x77\x49\x76\xb9\xc6\xb4\x95\x76\xd8\xe5\x74\x23\x9c\xc2\x0b\x84\x87\x3f\xe8\x66\xb5\xa0\x61\x52\x1a\x43\xdb\x83\xe1\x97\x35\xb4\x11\xcb\x1d\xb9\x52\x75\x99\xc3\xc9\x32\x72\xef\x6c\x9b\x5a\x17\x75\xdf\x71\x57\xb1\x4b\x4c\x30\xa3\x8f\x86\x9f\xb1\xea\xb6\x62\x09\x93\x53\x7c\xe3\xd6\x3a\xe5\xac\xf7\x82\x5e\xe7\x51\x23\xfd\x8d\x3a\x12\x6e\xf0\x4b\x89\x0d\xc7\xad\x4d\xfd\x8c\xb5\x20\x55\x3e\x3f\xcd\x89\x4b\xce\x99\x42\x55\x5b\xae\xb5\x66\xd0\x2a\xaf\xde\x11\xb7\xaa\xcc\x46\xbd\x10\xd9\xea\x8b\xb3\x9e\xf9\xde\xff\x42\x68\xba\x15\x56\x39\xc6\x7f\x8a\x77\xe2\xa4\x2c\xe6\x93\xe2\x43\xde\x15\x4f\x20\x9d\xc2\xbf\x71\xcd\x4f\x7a\x75\x71\x79\x99\x25\xfc\x52\x62\xf6\xa0\x34\xe8\x94\x05\x3e\x73\xbf\x1b\x27\x2e\xd3\xb8\x07\xf3\x83\xc3\x28\x01\x9c\x9e\x82\x30\x98\x18\x94\xcb\x16\x12\x30\x4f\xc7\x35\x7a\x72\xb0\xa8\x00\x41\xaa\xee\x86\x3a\xba\x20\xa0\x61\x90\xfe\x6a\x53\x44\x32\x25\x0b\x6b\xbc\xb5\x1e\xf6\x30\x8e\x61\x4d\xe4\x3c\xda\x69\x6e\x24\xdc\x17\x59\x0b\xde\x20\x33\x2c\xba\xe3\x3e\x2c\x8b\xda\x9b\x25\xf1\x04\xf7\xdd\x1f\x71\xa0\x0e\x9e\xaf\xa4\xec\xe1\x4e\xa2\x5d\xd9\x4c\xaa\x96\x40\x2b\xa6\xb8\x59\xee\x92\x2d\xf3\x44\x6b\x93\xd8\x5b\x64\xd6\x5b\x76\x68\xc0\x78\xcd\xd3\x9d\x42\x7b\x98\x5b\x33\x43\xe4\x9f\xe3\xf7\x71\x35\x2e\xd3\x79\x1d\x8e\xf0\x1c\x5c\x75\x43\x75\x42\x59\xa2\x78\x28\xbb\x18\xc2\x97\x39\x01\xac\x79\x57\xba\x83\xf8\x8e\xf2\xb8\xd1\x86\xc4\xef\x2f\x90\xfb\xa3\x3f\x3d\x0e\x76\xf1\x88\x67\x6e\x3a\xee\x11\x57\x60\xa3\xe2\xba\x8c\xde\x41\xcb\x79\x5b\x02\x9d\xf4\x05\x12\xd7\x97\xe3\x96\x6b\xf6\xbb\xfe\x25\xa3\x0e\xe8\xa8\x90\xc5\x73\x54\xcd\xe8\x44\x43\x94\x8b\x62\x7e\xd1\xda\x37\xec\x6b\x6b\x92\x2e\x75\x53\x55\x65\xaf\xc8\xb3\x6b\xf7\xf8\x2d\xeb\x06\x3e\x4b\x99\xef\x58\xf7\xbd\x4d\xea\xf7\x44\x69\xa8\xa3\x7f\x48\x7d\xe5\xce\x76\xad\x38\x36\x80\x78\x81\x9c\xfc\xa6\xf7\x46\xaa\x49\x28\x36\x8d\x06\x20\xcf\x22\xee\x3d\xb4\x79\xeb\x0c\xc3\xf6\xf2\xea\x6f\xe7\x17\xd9\x22\xe9\xcf\x51\xe1\xb8\x51\x87\x22\x5b\xd9\x81\x92\xac\x0c\x7e\x63\x82\x29\x9d\x32\xaa\x27\x71\x1b\x85\x19\xee\x08\x84\x6f\x70\x89\x14\x88\x56\x0c\x52\xa8\x2b\xee\x23\xfe\x25\xfc\xb1\xa2\xda\x8a\xa0\x88\xcf\xbf\x06\x4a\x44\x06\x27\x39\x5f\x66\x3c\x9b\x27\x26\x81\x41\xbd\xc0\xca\x5f\x7c\x48\xd2\x91\x6c\xb3\x41\x3d\x66\xd5\xbf\xf8\xb0\x40\x9a\xfe\x65\x31\xdf\x74\x54\xcf\xa9\xb6\x32\x28\xbb\x3a\xb9\x7d\xc6\x26\x39\x26\xf0\xda\x24\xd1\xcd\x86\x4c\xbb\x4e\x62\x5a\xe7\x6a\xe7\x1e\x10\x3f\xc9\x5e\xbd\x62\x43\x30\x48\xce\xea\x7a\x1e\xee\xe1\xee\xef\xa7\xc5\x9e\x7b\xfc\xa4\x18\x93\x72\x93\x93\xdf\x75\x6b\xd3\x34\x52\x41\x2b\x97\x69\x3d\x5b\x5c\x60\x1e\x10\x6c\x10\xda\x83\x7f\x81\x7b\x49\xeb\x3f\x2d\x2e\x56\xb7\x25\xa6\xc2\xdc\x42\x7a\x1f\x2b\xa0\x66\x73\xf3\x10\x82\xa3\xd6\x60\x39\xad\x91\xb5\xef\x11\x3b\xd6\x31\x93\xb6\x10\x79\x35\x3c\x1e\x53\xe6\xb4\x18\xc6\xee\xb0\xf8\xd4\x57\xc4\xf1\x79\xe7\x44\x2d\xcb\xa8\x17\xd9\x65\x16\x00\xc0\x16\x32\xcb\xc1\x0a\x99\x45\x33\x18\x64\x9a\xc1\xa0\xb0\x18\x0c\x52\xcd\x60\x50\x45\xa9\xcd\x60\xc0\xd9\x0a\xc3\x3e\xc0\x4a\x3b\xbc\xfb\x35\x9e\xd8\xc9\x52\x37\x74\x8b\x9c\xb8\x5c\xa1\x8c\x7c\x8c\x15\x3b\xdd\xfb\xd3\xbb\x1b\x16\x8a\x8d\x0c\x0b\xcb\x00\x38\x98\xd7\xf4\xfb\x71\xfd\x51\xed\x9a\xeb\x96\x63\x01\x09\x75\x28\xea\x37\x1d\x83\xb9\x31\x39\xf2\x53\x77\x08\xb4\xc8\xdd\xc7\x7f\x86\xf4\xef\xfe\x21\xfe\x7b\xf8\x3d\x95\x3c\xa4\x3f\xc5\x74\x0a\x1c\xaa\x21\xe5\x14\xcc\x1b\xdc\x98\x06\x6a\x70\x82\x22\x7f\x9d\x54\x49\xdb\x30\xc1\x43\x09\x38\x4c\x7e\x07\xeb\x64\x4a\x83\xbe\x2c\xf1\xa8\x9d\xf6\xa2\x18\x01\x00\x2d\x6f\xff\xa0\xe8\x57\x96\x71\x05\x19\xf0\xe5\x35\xf7\xfb\x27\x05\x99\x6f\xa8\x9a\xaa\x4d\x54\x4d\x0a\x5a\x85\x0f\xf6\x37\xd1\x34\x1d\x7e\x59\x4d\xd3\xda\x24\xa5\x7c\x33\xeb\xa9\x4a\x1d\xa0\x8c\x31\xc6\x6e\x38\x69\xe5\xe4\x45\xed\x50\x0c\xc2\xe5\x02\x13\x89\x7e\x00\x2a\x8a\xef\xd9\xc1\x35\x71\x30\x0d\x61\xd5\xa8\x2e\x30\x73\xe9\xba\xf0\xde\xbb\xe8\xaa\xba\x95\x44\x87\x9a\x5c\xf1\x05\x33\xa1\x2a\x93\xee\xc8\x4f\xda\x4a\xe4\x59\x69\xa2\x6f\xa9\x26\xbc\x95\x8d\x6d\x96\xb1\xd3\x16\xeb\x65\x9c\xcc\x95\x7e\x32\x37\x49\x3b\x75\x72\x2d\x22\xf3\xb6\x84\xb0\x3e\x29\xc1\x69\x94\x66\xd8\xec\x26\xc9\x09\x1f\xae\x0a\x5c\x1b\x0e\xb6\x0c\x5c\x33\x63\x0c\x6d\x43\x93\xa1\x48\xeb\xd9\x9f\xfd\x4d\x21\xbd\x0c\x56\x5d\x23\xee\xea\xd8\x53\x1e\xb3\xbc\x60\x1b\xe9\x03\x58\x22\x76\x9e\xc1\xcb\x76\xb5\xb8\xb2\x9b\x5a\xa9\x80\x3b\x36\x3c\x4b\xf2\xa4\xa3\xeb\x1f\x92\xac\xf8\x80\x5b\x3c\x76\x40\x2e\x48\x9c\x62\xea\xbc\x65\x81\x3e\x3c\xe4\x95\xfb\x98\xc7\x25\xec\x28\x66\x5b\xc9\xae\x9d\x34\x67\x1e\x08\x0e\x1c\x2e\x49\x9f\xc8\x03\x00\x0f\xed\x9d\x13\x07\x04\xf5\x38\xbf\x46\xf2\x30\x81\x7a\xe4\x8b\x3e\xce\x16\x15\x1a\xec\x41\x0a\xa5\x86\x70\xde\x18\x6b\xc9\x5f\xb3\x4d\x40\x92\xb9\xbf\x42\x24\x23\x36\xa4\x6b\x5f\x6d\x98\x75\xc9\x40\xcf\x15\xbb\xcd\x1a\x59\x69\xdd\x6d\x4a\x30\x2c\x0d\x11\x16\xcc\x96\x0c\xe2\x53\xb3\x1b\x28\xc9\x97\xe6\x9b\xa6\xc8\xb5\xf3\x78\x5c\x9d\x22\x0c\x36\x75\xde\xa3\x08\x02\x24\x8c\x2c\x90\xac\x47\x70\xea\x31\x79\x86\xeb\x51\xa0\x00\xa5\x9a\xf9\xdd\xa2\x9e\x17\xdb\x84\x98\xbb\x0e\x37\x8e\xcc\xe3\xc9\x84\x7c\x7c\x07\xe4\x29\xcf\xdd\x5d\xd1\x5b\xd4\x3d\xfe\xff\xfe\xdf\xff\x47\xaa\x35\x9c\x2e\x19\x6c\xfe\xa5\x52\x0a\x7c\x15\x30\x1c\x7f\x15\x70\xbb\x76\x1d\x31\xdb\x6e\x6e\xc7\x76\x53\x53\x25\xf0\x00\xb7\xcd\xb6\xd5\xf7\xdb\x6f\x2b\x63\x7b\xe9\x45\x42\x67\x6a\x4b\x74\xa6\xec\xb8\x48\x50\xd8\x8d\x2e\x51\x5b\xbf\x87\xb6\xd8\x4b\x22\x4e\xd8\xb6\x9b\x4a\xaf\xe2\xf0\x4d\x4f\x87\x67\x27\xf8\x0f\x5b\x4b\xfc\xc5\x2f\x10\x60\x9f\xf0\x56\xc4\x47\x89\x78\xf9\xb9\x76\x4a\xb9\x72\xa7\xdc\x21\x68\x6f\xdb\x1d\xb5\x1e\x14\xa2\x96\x98\xfb\xb1\x0a\x8a\x0e\x2c\xd6\x91\x64\xb8\x51\x8a\xb0\x3b\x24\xf5\x97\xe7\x21\x3b\x3b\x15\x46\x78\xb8\xd2\x9e\x70\x57\x0e\x17\x1d\x5f\x1b\x85\x44\x52\xc6\xe5\x78\x76\xdd\xe2\x62\xb3\xe4\x12\xa4\xa9\xe3\xc7\x65\x82\x4e\xa4\x34\x34\x71\xaa\x03\x4c\xd8\x4b\xe3\x93\x2d\x38\x07\xaa\x3f\x3f\x7e\x8b\x57\x17\xc0\xee\x7e\x9f\x4e\x12\xe4\x22\x3e\xc4\xd7\xe8\xab\x0b\x02\x16\x1c\xe9\x31\xe0\x27\x6a\x1b\x1d\x39\xc8\x76\x1b\x3c\x22\xad\x02\x21\x0c\x20\x5a\x11\x83\x30\x49\xe6\x59\x71\xcd\xee\x46\x60\x8c\x05\x05\xf4\x5a\x07\xf0\x6c\x4a\x21\xd6\x7c\x62\xce\x0b\x32\x3f\x41\x01\x32\x35\x20\x20\xd5\xd7\x81\xf3\x33\xb0\x1f\x22\x40\x2e\x1d\xe3\x8b\x31\xc1\x64\xd2\x37\xc9\x89\xce\x72\x98\x20\x21\x27\x67\x8c\xa5\x98\x5b\x81\x91\xe6\x73\x60\x70\x98\x95\x82\x54\x41\x0e\x22\x70\xc4\xa3\xa1\x1d\x90\xd5\xc6\xc9\xac\xc8\x60\x63\x46\x2e\xad\x06\x0d\x76\xab\xa6\xc4\xf5\x33\x7a\x63\xea\xdc\x5b\x58\x60\x9b\x53\x8b\x6a\x39\x48\xb9\x44\xca\x3b\xde\x6f\xb5\xb8\xb8\x4a\x6b\x97\xe3\x8f\xc5\x86\x70\xb4\x87\x00\xe9\x10\x2e\xb6\xf7\x64\x68\x73\xb4\xff\x33\x52\x35\x6d\x99\x5c\x44\x42\x7f\xb6\x2f\x75\xc1\xf0\xb3\x4b\xc4\xeb\xce\x45\xb2\x9e\x65\xd6\xd3\xb2\x28\xd2\xea\xd7\xca\xcd\xf2\xc9\xf9\x48\x1e\x3c\x58\x91\x8f\xc4\x50\x35\xd6\x9a\xaa\xb1\x9d\x5a\x24\xb5\xe5\x20\x31\xf3\x8d\xb0\x2a\x94\xc1\xa0\xda\xe3\x69\x10\x80\xd9\xd7\x8a\xc5\x26\xf6\x81\xcf\xb7\xe6\x27\xe1\x02\xd1\x27\xa5\x27\x91\x14\xb7\x49\x4c\x52\xe4\x6c\x2b\x13\xed\x79\x2c\x2e\xb2\x92\x1a\xcf\x16\xeb\x8f\x5f\x3c\xa1\xd2\xb7\x2c\x69\x92\x51\x55\xe3\x6c\x9a\xca\xa2\xe5\xcf\x91\xf9\x44\x24\xe6\x10\x05\xa7\x83\x33\x21\xc8\x8f\x32\x96\xe8\xa2\x50\x3c\x75\x19\xcf\x71\x22\x8e\xe3\xa8\x2e\x17\x89\xd5\x6d\xd7\x92\xd7\x84\x01\xdd\x96\xd6\x84\x4b\x8d\x71\xff\x2a\x9e\xa3\x8a\x90\x1e\xad\x0e\xbd\x37\xfc\x6e\x2f\xc9\x12\xe7\xcb\xa5\x1f\x7c\xa9\xb4\x28\xa9\x9e\x16\x25\x6d\xa5\x45\xb1\x2d\xf8\xdd\xb3\xa3\x34\xea\xd1\x3e\xd2\xfd\x3e\x2c\x36\xad\x09\x03\x88\xdf\x7f\x1f\x63\xc2\x8e\xba\xbb\x1e\x87\x0a\xaf\x39\x72\x29\x5f\xc9\xbd\x7b\xf4\x17\xc8\x4e\xc6\x52\x80\xb4\xd7\xd3\x6d\xa7\x01\x11\xe9\xd9\x78\x93\x61\xfd\x65\xf2\x81\x20\x08\x95\x1d\x70\x07\xd8\x05\x18\x44\x06\x38\x44\x88\xa1\x2a\x98\x59\x62\x19\x4e\x21\x30\x17\x4b\x82\x09\x63\x60\x6a\xf8\xe2\x2d\xec\x8d\x90\x1d\xfc\xeb\xd3\xb4\x7c\xd2\xbc\xee\x8e\x15\x34\xb3\xb1\x58\x8a\xce\xa9\x05\x1d\x33\x97\x44\xf0\xab\xcf\x5d\xcb\xea\x32\xdb\x3c\xab\x4b\x8b\x7e\x87\x87\xc3\xa0\x45\xed\xc3\xc3\x03\xd3\x22\xa0\x50\x96\xf0\xc1\xa1\x2d\x01\xcc\x06\x46\x82\x87\x5f\xd2\xcf\x6f\xa5\x32\x2a\x6d\x29\xa3\xaa\x55\x7e\x43\x36\x76\xc6\xc2\xcc\x7c\xef\xf0\x1f\xc5\x74\x0a\xc2\x42\x4f\xf2\x36\x36\xf5\xfd\xd5\xa2\x56\xb5\xf4\xba\x7e\xfe\xc8\xb8\xfb\x40\x49\x33\x5b\x6d\x90\x90\x26\xe5\xd2\x6c\x11\xf0\xcb\x46\xf4\x4c\x74\xfc\xba\x39\x4c\x3e\x73\x7e\x71\x5d\x27\x5b\x5f\x3e\x29\x5c\x74\xae\x2e\x7b\xf3\x02\xbe\x81\x15\x95\x7e\x29\xfb\x4a\x36\x9d\x7f\xec\x58\xf7\x5a\xae\x82\x47\xb3\x07\x42\xbb\x60\xc9\x95\xe0\x1e\xff\xf1\xb1\xf3\x0a\xd7\x7e\x51\xa2\x18\xfc\x40\x93\x0b\xb4\x15\xf9\x5a\xeb\x73\x39\x3e\x9f\xc7\xc0\x16\x9e\x7f\xfb\xab\x58\xa2\xbb\x0c\xf7\x6e\xab\x84\xbd\x38\xaf\x9a\x7b\x2a\xff\x69\x96\x0b\x28\xd6\xaf\x63\x3b\x6d\x3d\xd6\x3b\x2d\xd4\xeb\x45\xfe\x4f\xb2\x36\xb3\x24\x9e\x9f\x17\x17\x3f\x27\xe3\xed\x2f\xdb\xfd\x87\x2c\xd0\xdd\x06\xbc\xfd\x2a\xfd\x09\xfa\x71\x5e\xb2\x7e\x9c\x67\x79\xef\x5d\xb5\x92\xf8\x71\x13\x1e\xff\x29\x4d\xb1\x16\x5d\x89\xfd\x0e\x9b\x6d\x35\x93\x86\x9a\xc7\xb0\xe8\xbf\x28\x98\x02\xac\x72\x92\x8f\x69\x55\xa3\xa5\x8e\x2e\x1e\x45\x50\xf6\xd5\x19\xc8\x51\xd9\x92\xba\x1b\x66\xfc\x76\xce\xed\x2d\x4d\x5b\xaa\xf2\xbd\xdb\x74\x6f\xb5\xd5\xb7\xed\xf4\xda\xfd\xbe\xfb\xe8\x8b\x25\x52\x08\x97\xad\xab\x7e\x85\x72\xa3\xd1\x37\x73\x41\x0f\x7f\x6b\x1a\x0e\xd2\x37\x37\x19\x1d\x85\x92\xd7\x76\x4f\x76\xd3\xfb\x77\x6e\xe7\x55\xda\xca\xa0\xba\xaf\xa8\xb2\x67\xa5\xae\xe3\xec\x9c\x4b\x91\xd5\xa6\x77\x54\x89\x71\x2b\x57\x94\xae\x1a\x96\x7b\xc7\x61\xb5\x6e\x1f\xdd\x6c\x54\x2c\xef\xae\x39\x28\x61\xfc\xde\xc0\xfe\xb4\x85\x51\xd7\xea\x75\xd0\x6d\x7d\xd2\xee\x3c\x5d\x63\x84\x67\x36\xdd\xf6\x6e\x98\xad\xdc\x0d\x8b\x16\x6f\x6d\xc9\x58\xaf\xdc\xf1\xdb\xc6\xf7\x59\x83\x71\x07\x2e\xcb\x9c\x6d\xf3\x4a\x81\x12\x52\x97\x75\xbc\xfb\x29\xb9\x2a\xca\x6b\x67\xd7\x79\x92\x56\xbf\xd8\xeb\x34\xfd\x1c\x12\x66\xdb\x9b\xe9\xbc\x04\x97\x2e\xc0\xe5\x6a\x11\xe3\xb5\x42\x25\xf9\x2c\xe5\x21\x62\xb1\x3b\x68\x9b\xec\xc0\xaa\x3d\x5f\x61\x94\xab\x8b\xab\x0b\x10\x65\xf3\x44\xb5\xc7\x21\x01\x64\x2e\xbb\x06\x8d\x58\x67\x95\x93\x86\xbc\x71\x77\x82\xf2\xf3\x96\xb5\x56\xbd\x1f\x9e\x6c\xb6\x0b\x2d\x31\xf8\x4c\x4b\x55\x7e\xfe\x59\x0d\xe5\xff\xa0\x91\x1a\x97\x88\x74\x10\xa0\xf6\x4a\xda\xbd\x7a\x66\x81\xb9\x3a\x5a\x56\x0c\x5a\xa5\x75\x3e\x3e\x5b\xb8\x77\xd8\x86\x35\x5e\x41\x21\x17\xbe\x3e\x4c\x76\x89\x2a\xec\xbd\xcd\x28\xe3\x46\xa0\xda\x7e\x04\x57\xb4\xc3\xcf\xb7\x18\x08\x50\x83\xed\xbb\xc1\x6c\x63\x49\x3e\xd9\xa2\x9f\xfb\xeb\x0e\xd2\xc3\xce\x83\xb4\xbd\x32\x76\x0f\xb5\xcf\x81\x30\x3f\x7c\x5d\x84\xb9\xc3\x65\x94\x5f\x00\x71\xf4\x34\xf5\xd2\xcf\xc3\x66\xfe\xd9\xe2\x9a\x4c\xeb\xd9\xae\x5a\xa6\xee\xd0\xfd\x6a\xeb\xd3\x4a\xf3\xd3\x70\x95\xfd\x69\xc5\xb5\x3e\x9f\x53\x69\x76\x67\x39\x8e\x5f\x67\xd1\x92\x8b\x4c\xdf\x20\x92\x92\x68\x53\x6e\x2b\xcf\x71\xd1\x6d\xb8\x6f\x88\x6e\xb0\x39\xcb\x5f\x30\x73\x21\x1f\xa1\xf2\xbc\xd5\xf8\xd4\x33\xc6\x32\x4a\xd9\x6c\xd7\x18\x41\x82\x03\x49\xc5\x7e\x1d\xd1\xaf\x69\x9d\x5a\x70\xb8\x63\x8a\xeb\x7f\xd0\xaa\xd9\x47\xbb\xe1\xea\x0d\xd7\xe6\x24\x67\xf4\x6b\xa5\xb8\x37\x1c\xb6\x99\xc1\x6d\x7d\x79\x50\x46\x16\x84\x86\x4b\xc9\x98\x58\x9a\xc4\x64\x9a\x72\xdf\xea\x4f\x61\xa5\x6f\x38\xaf\x35\x7e\xf0\x23\x91\x07\xe8\xee\x2e\xad\x5b\x79\xdf\x0d\x37\xf5\x21\x87\xa1\xdb\x3c\x9b\x3f\xff\x0d\x5d\x9b\x89\x38\x42\x7d\xf4\xa0\x4b\xf2\xd0\x84\x7e\xe9\xe3\xf4\x25\xc4\xa0\xe3\x67\x79\xef\x47\xba\x25\xbc\xb3\xfd\x69\x52\x96\x98\x08\xce\xfa\xfa\x35\xbb\xe5\xba\xeb\x35\x86\xd0\x4f\x9c\x97\x8b\xae\xd6\xd7\x49\x58\xea\xed\x35\x1b\x0a\x59\x5d\xda\x23\xe6\x82\xbe\x92\x35\xdb\xb7\x89\x5f\x78\x5d\x93\x25\xb8\x4d\x93\x37\x38\xd6\xb6\x25\x0e\xf5\x85\xf4\x68\x6d\x79\xb3\xaa\xb5\x36\x92\x38\xf0\x3f\xbb\x30\xa1\x6a\x12\x48\x1f\xbf\x51\x80\xe4\x83\xbb\x72\x83\x9f\x28\xfa\x8c\x25\x27\x7b\x80\x9c\xec\x41\x8b\x93\x3d\x58\xcf\xc9\x0e\x3f\x51\xf6\x59\x6c\xa9\x1d\xfa\xfc\xb2\xcf\xb6\x23\xb8\xa3\xec\xb3\x6d\x37\xdb\xcb\x3e\xd6\x8b\xdb\xef\x34\xc5\x34\x3f\x9f\x12\x55\xfa\xc4\xeb\xeb\xef\xd6\xfb\x84\xd3\xbc\x7f\x48\xe7\x25\xa3\xa8\xff\x90\xbe\x6b\x96\x51\xec\x1f\xd2\xf7\x1d\x44\xc4\x7f\x06\xea\xf3\x89\x82\xf4\x36\x50\xda\xfc\x96\xb5\x3b\x13\xa5\xb5\xb2\xec\x67\xbe\xf1\x6d\x7f\xa5\x28\xbb\xbf\x4a\x94\x1d\xda\xaf\xe8\x15\xa2\xcd\xda\xdb\xa9\xd9\xe9\xc8\x63\xc8\x91\xb9\xfd\x67\x97\x8d\x85\x9f\xce\xc6\x72\x4c\x17\x1b\xf2\x19\x24\x67\x91\x7d\xe4\x6e\xf2\xd8\x17\x9b\xc9\xa6\xf2\xd9\x3f\xbb\x78\xfd\x19\x01\xc4\xf7\xe6\xbf\xc4\x62\x9b\x73\xd9\x70\xb9\xf7\xbf\x94\x38\x7e\xa7\xe0\x1a\x3e\x09\x74\x87\x46\xd9\x86\x45\x85\x90\x44\x2e\xae\x56\xdb\x4a\x26\xdf\xb7\x9b\xda\xf5\x9b\xba\x57\x4c\x71\xd6\x65\xfc\xe2\x17\x88\x76\xc5\x85\x43\xc9\xbb\x2a\x29\x7b\x8f\x2e\xa1\x56\x47\x85\x8e\xe2\x47\x75\x5d\xa6\x17\x8b\xba\x53\xec\x5b\x27\x93\xbe\x46\x97\x60\x87\xf2\xd9\xdc\x4d\x2c\xfd\x31\xcd\xd3\x6a\xd6\xf9\x7a\x33\xa9\xb4\xf5\x79\xeb\xf0\x2c\xee\xa2\x00\x31\xcd\xce\xab\x12\xc7\x1d\x6e\x92\xd9\x06\x70\xc4\x1e\xd6\x7d\xf7\x3c\x6b\x4a\xf0\xee\xec\x8e\x5a\x90\x4e\x69\xbc\x4e\xeb\x8c\xcc\x97\x33\xcf\x96\x47\x06\xb3\x0f\xd7\xc9\x79\x3c\x99\x60\x67\x9a\xb0\xad\xbf\x92\x59\x65\xa2\x28\x1a\xb7\x32\xcb\xe8\x75\x3b\x52\x11\x62\x4c\x9e\x7d\x14\x98\x22\xa2\x25\xec\x8b\xc2\xd5\x3d\x8b\x5a\xf6\x3e\xd7\xe5\x3e\xc2\xcc\xb8\xdc\x35\xe1\x3c\xdd\x44\xb6\xdf\x7f\xb0\x69\xce\xfc\x0e\x3e\x7e\xd5\x88\x3c\xe5\xb8\xec\x03\x3b\x55\x9e\xc7\x48\x0e\x90\x11\x2c\x04\x23\x58\xac\x1f\xe2\x77\x9f\x34\xc4\x4e\x29\x60\xf5\xd8\x09\x9a\xf1\xd5\x3c\x4b\xce\xf1\x36\x83\x4d\x40\xf9\xc3\x46\xe3\x5c\xd7\x69\x9d\x6d\x92\x51\xe2\x60\xb3\xac\x55\xeb\x3a\x03\x51\x36\xdb\x6c\x76\x07\x1b\x11\x93\xff\xbf\xbd\x6b\x6d\x6e\x1b\xb7\xa2\xdf\xf7\x57\x70\xd9\x69\x24\xb7\x8a\xe3\x87\xe4\x66\x95\x28\x3b\xd9\x36\xed\x76\x27\xc9\x26\x6b\x6f\xa7\x33\x9d\x8e\x87\xb6\x68\x9b\x59\x49\x54\x48\xaa\xb1\x67\x27\xff\xbd\x00\x08\x90\x00\x89\x8b\x17\x41\xd9\x71\x9c\x2f\xb1\x24\x90\x78\x5d\x00\x17\xf7\x71\x8e\x7e\x48\x57\xd1\x7a\x7d\x63\x52\xdf\x91\x97\xfa\xa2\x4d\x71\x65\x64\xe4\x3a\x7c\x6a\x0f\xb3\x09\x2e\x8a\x2b\xc5\xb5\xad\xc6\x42\xeb\xcb\x9e\x61\x5b\x3b\x49\xa1\xb9\xa5\x9a\xfd\x19\x33\x6c\xeb\xbe\x20\x87\xfc\xad\x54\xed\xcf\x96\x41\xeb\x5e\x45\xab\xb4\x48\xaf\x36\xcb\x68\x45\xeb\x17\xbe\x91\xb5\xa1\xd2\x2f\xeb\xfa\xb9\x67\x6c\x1c\xdb\x48\x81\x90\xdf\xb5\x45\x25\x73\x28\xc5\x82\x91\x98\xce\xd9\xa1\x41\xd6\x74\x75\x96\x89\x76\xf3\xfa\x84\x23\x2b\xb3\x05\x2d\x9c\xea\x75\x2a\x36\xe8\xec\x55\x92\x03\x37\x45\x9d\xde\xc1\xfd\x53\xdc\xfd\x6b\x9a\xdc\x25\xaa\x51\x0e\x78\xa3\xeb\x64\x7d\x34\x0a\xbd\x14\x4e\xcc\x8e\xdd\xac\xdf\x05\xf4\xf3\xf9\x13\xda\x01\xd4\x5d\xc5\xfd\x57\x09\x1e\x01\x19\x55\xca\xc0\xb6\x63\x72\x9a\xce\x8d\xc1\xa6\x85\x21\xe2\x8e\x62\x61\x8c\x84\x23\xba\xeb\x20\x71\x2f\x03\x46\xe9\x8f\x35\xb8\xff\xe7\xd1\xa1\x22\xc7\xc8\x69\x9c\xd8\xb5\x30\xe8\xc2\x99\x84\xf4\x87\xd3\x0a\x39\x51\x7f\xec\x19\xa2\x4b\xbf\x38\x79\x7d\x2c\xf4\x5d\x8e\xf4\x40\xef\x0c\xca\x1b\x43\xde\xba\x31\x64\xfc\x8d\x41\x05\xd3\x5c\x29\xff\x55\x5c\x9f\x20\x24\x5c\xd7\xc5\xc0\x3e\x7e\x48\x48\x9f\x72\xac\x7c\x67\x4c\x34\x92\x2a\xb2\xaf\x2e\x08\x08\x00\x09\x6e\x82\xea\x3e\x4f\xd6\x57\x68\x99\xe5\x9b\xa4\x21\xa5\xcd\x1f\xf5\xad\xe0\x4b\xbb\x34\x65\x15\x5f\xa6\x45\x82\x51\x1f\x4e\x49\xfe\xf1\x79\xba\x68\xb5\x48\x52\x46\xdf\x30\xc9\x43\x50\xfb\x96\x9b\x62\x13\x2d\xa6\x96\xcd\x3c\x4d\xf2\xd3\xf2\x49\x93\x06\xd7\xa5\x9d\x9a\x5e\x3f\x2e\xef\xc4\x00\x73\x54\x1c\xaa\x60\xcf\xec\x56\x38\x03\x9d\xc0\x7e\xf3\x05\xc1\x99\xa0\x4b\x0a\x57\x23\x8b\xb9\x76\xab\xa6\xb2\x62\x1f\x13\x75\x5b\xa8\x45\x86\x1d\x64\xb4\x70\x9d\xb6\xb4\x0a\x05\xb6\x6d\x1c\xc3\xff\xe4\x1b\x5d\xd2\x50\xe0\xd1\xe9\x8c\x93\xb9\x8a\x9b\xd3\x4d\xb6\x30\xd9\xd4\xcc\x6e\x62\x03\x79\x9b\xb4\x24\x0a\xf8\x2c\xa5\x0c\x0a\xcc\x1c\xf1\x92\x5c\x33\x2c\x7b\x63\xd0\x93\xb1\x21\x38\xb1\x02\x3a\xbd\xbf\x61\x1e\x1f\x9a\x9d\x1d\xc0\x30\xf3\xa7\x89\x81\xc6\xc1\x99\xcb\x1d\xf4\x87\x56\xff\x84\xcd\x45\xd2\xfb\xae\xba\x44\xeb\x95\xd0\x06\x83\x4d\xd0\x63\xbd\x26\x11\x06\xff\x64\x62\xe3\xa4\x63\x0a\xed\x81\x3b\xef\xb9\xe3\xd2\x4e\xa3\xfe\xaa\x12\xc0\x08\xb8\x95\x3d\x31\x44\x6f\xd1\x5a\x5f\x07\x2e\x4f\x5a\xc1\x97\x9f\x61\x7b\xc0\x79\xb6\x59\x9e\x55\x49\xea\x0a\xe0\xf7\x8d\x31\xe8\xa0\x1b\x44\x7e\xd5\x82\xca\x8f\x42\xdc\xef\xd8\xf6\xaa\x0f\xbc\x52\x43\x07\xda\xc1\x06\x12\x58\xb5\xe7\x4f\xd2\xc5\x0b\x18\x81\x95\x0f\x58\x70\xe1\x2e\x35\xc3\xbb\xb5\x47\x51\x82\x61\x91\xa4\x0e\x7b\x96\x63\x66\xed\xaf\x3f\x54\xb9\xeb\x27\xa0\xb7\xde\x88\x92\xd5\x14\x03\xe9\x2f\x1c\x74\x44\x3c\x5a\xd5\xd0\x11\x71\x1b\xcf\x68\x41\xbf\x14\x49\x59\x63\x3d\x29\x2b\x96\x65\x05\x62\x51\xdc\x46\x2c\xf2\xce\xc8\xba\xb0\x60\x64\x25\x98\x1d\xbb\x17\x71\x71\x7e\x35\xd4\xe2\x03\x91\xc6\x93\x2c\x59\x09\x3a\x50\x0b\xdd\xa7\x13\xa6\x4f\x24\x62\xfa\x44\x4d\x4c\x1f\x01\xbc\x04\xa2\xbe\x93\x80\x97\x08\x80\x24\x55\x57\xa6\x93\x23\x09\x1c\x09\x96\x99\xa7\xbd\x62\x92\xb7\xb1\x3d\x8b\x2b\x0a\x66\x36\x0f\xb0\xb7\x30\xdf\xa5\xae\xc2\xd0\x00\x4b\xbc\x37\x30\xdd\x26\x80\xb3\x41\x08\x29\x0e\x06\x4b\x2f\x4e\xe7\x66\x2e\x04\x73\x48\x6f\xa9\x2f\x9d\x98\x3b\xe5\x10\xb1\x7a\x7f\x1b\x08\x15\xab\xf0\xb7\x65\xf2\x74\x2d\xd6\x98\xee\x30\xbc\xe6\x27\x22\x8c\xc2\x7b\x96\xa5\xe8\xb4\x8e\xf2\x42\xea\xf3\x6c\xfd\xaa\x1e\x8c\x56\x71\xe0\x34\x9c\x82\x13\x51\x14\xeb\x53\xbc\x3a\xc4\x99\x60\xdf\x6a\xa6\x82\x15\xb3\x86\x08\xbe\x95\x41\x28\x19\x54\x40\xf1\x80\x86\xa8\x38\x97\x8c\x10\xfb\x52\xdd\x32\x56\x0a\x6c\x90\x75\x63\xfa\x9c\x2f\x97\xf6\xe8\x68\x60\xc0\xb6\xc0\x56\x3b\xae\x25\xea\x0d\x4d\x02\x91\xc0\xa1\x40\xb6\x60\x12\x9c\x30\xff\x0d\xb1\x9f\x6d\x5a\xab\x53\xd8\x60\x8d\xd3\x5c\xe5\x94\xa0\x53\xb7\xdd\x51\x95\x3b\x48\x69\x2e\x6b\xa4\x61\x0c\x0c\xa0\x06\x9c\x38\x39\xa5\xa2\x47\x7e\x89\x6a\x15\x79\xb5\x7a\x4c\x7c\x70\x41\x03\x14\x96\xfc\x7d\x9d\x0b\x09\xb6\x43\xa6\x38\xc7\x1f\xe9\xa6\x5e\x7f\x94\x23\x46\x67\x6d\xc1\x3a\xc0\x82\x75\xd0\x10\xac\x03\x51\xb0\x24\xf3\x2b\x86\xa3\xc4\x4a\xd5\x3c\xfe\xd8\x39\x09\x7c\xa0\x23\x72\xb4\x6f\x22\x05\x15\x01\x51\xab\xf1\xcd\x45\x4e\x12\x0c\x3c\x81\xff\x99\xd0\x8e\xb4\xdb\x65\x10\x39\xd5\x29\x6a\xbb\x5c\x0c\xed\x18\x3d\x6e\xdd\x00\xa4\x09\x95\xc2\x88\xc5\x8f\xad\x10\x28\xdb\x93\x25\xd3\x19\xf0\x6b\x9c\x65\x4f\xb0\x41\x05\xc8\x3b\xb4\xf3\x57\x9d\x45\xf3\xcb\xd8\x50\x3e\x52\xe9\x16\xc5\x85\xfe\x50\x2c\x73\x66\x08\xec\x14\x19\x67\x01\x8c\xb2\x6f\xe4\xf4\x02\xe1\xcc\x7d\x12\x90\x22\x7d\x1d\x46\x40\x69\x6c\x5b\xf6\x5c\x7b\x96\xa8\xf4\xe7\xd2\x60\x36\xf2\x92\x36\x50\x06\x1f\xc6\x76\xd5\x0a\x63\xa3\x39\xb5\xf2\xb8\x39\x5e\x6f\x2e\xfd\x04\x80\x85\x5a\x88\x8b\x62\x58\x26\x46\x29\x6b\x2d\xd3\xb4\x98\xcc\xb6\x23\x31\xa1\x7b\x6f\x83\x61\xdc\xda\x8b\x6d\x0d\x3c\x65\x0d\x08\x81\x4c\xdd\x6a\xf7\x11\x9d\xd1\x9a\x54\x8a\x50\xf4\x38\x81\x89\x12\xa2\x07\xb7\x4e\x31\x26\xf8\xf4\x15\xd5\x4e\x3d\xc0\x02\x5e\x97\x03\x53\xbc\xad\x06\xf1\x75\x58\x79\x3b\xa0\xaf\xbf\x3d\x7e\x3f\x0f\x88\x59\x36\x18\x5a\x6b\x07\x98\x45\xb6\xcc\xd4\x95\xe9\x05\x3b\x4a\x4c\x77\xe7\x46\xeb\xe1\xd5\x1a\x4c\x69\xe6\xcc\x68\x3f\x52\xab\x81\x24\x04\x9c\x15\xf9\x81\x5d\x23\x83\x97\xa5\xee\xa1\x28\x7b\xf2\xd7\x77\xc1\x3b\x74\x91\x52\x14\xf9\xf1\xe4\x44\x5b\xe6\x5f\xe5\x25\x88\x96\x50\xf3\x97\x59\xa1\xdd\x7b\xb0\x5a\x4b\x8d\x60\x15\xb6\x1c\xc0\x2b\x07\x01\xa2\x65\x23\xb9\x68\x19\x9c\xfd\x52\x13\xb5\x8e\xe9\x4a\xc5\x2b\xe7\xc1\x64\xfd\x9d\xc2\x64\x5d\x81\xf4\x2f\x64\x06\xec\x54\x62\xc0\x4e\x04\x03\x76\x4e\x4b\xd4\xc3\x94\x53\xbb\x13\x06\xf0\x97\x12\x88\x92\x9f\xed\xac\xdb\x4d\x3c\xfe\xfa\x6a\x55\x22\xf1\xe3\x6f\xb1\xa5\x93\x24\x65\xdc\x1e\x8d\x68\xf9\xa1\x1e\x8a\x19\x46\xf5\xce\x9b\xdf\x5a\x1b\xca\xf3\xfe\x2d\xe5\x0b\xd1\x52\xbe\x90\xa1\xdf\x0b\x83\xac\xc3\x38\x8f\x38\x8c\xf2\x15\xa1\xff\xdc\x2d\x29\x47\x87\x0d\xb3\x7b\x06\x9a\xdd\xdb\x22\x35\x3d\x9c\x18\x1a\xe4\x73\x6a\x91\x7f\xaa\x05\x08\x47\x0b\xe4\x68\xaf\xb3\x7d\xde\x52\x7b\x90\x72\x16\x9d\x6d\xce\xce\x90\xca\x7a\xf9\x8f\xea\x08\x22\x17\x23\xcc\x8b\x5e\xfd\x74\xba\xdf\x08\xc5\x90\x95\x39\x30\x28\x73\xc8\x95\xe1\x30\x4a\x4d\x18\x87\x8f\xf6\xb7\x47\xb1\xaa\x3e\x90\x8f\x44\x25\x62\x5c\x6e\xf8\x0c\x9a\x33\x78\x8f\x83\xbf\x49\xe2\x1b\x57\xaa\x33\xea\x2b\xe5\x66\xcc\x00\x48\x9b\xc6\xf3\xeb\x17\xc7\x71\x1c\xa8\x83\x4b\x7c\x93\x85\xb9\x52\xa0\x93\xae\x2c\x31\x7f\x14\x8e\xa8\xcd\x96\x51\x83\x27\x4a\xce\x56\xed\x87\xa6\x16\xd3\xba\x34\xee\xb3\x8a\xdc\x2e\x7f\xf4\xa4\x16\x3c\xb0\x6e\xaa\xa2\x5e\x2c\xdd\x28\x76\x0d\xd5\x2f\x41\x0f\x8b\x0f\x62\x1c\x9a\x88\x23\xe6\xd0\x00\x9f\xe2\xb5\xb8\x3a\xbf\x29\x75\xb2\x75\x0d\x69\x3e\xcd\xad\x94\x34\x0b\x6b\x56\xad\x9c\xc9\x52\x41\xc9\x15\x40\x91\xf1\xd9\x15\x6c\x48\x91\x58\xa9\x67\xbc\xb5\x8d\xb7\xb0\xf0\x46\x4a\x72\x3c\x45\x6c\x57\x9d\x9e\xea\x36\xc9\x26\x96\x2c\x07\x2f\x83\x85\x6e\x4d\x4e\x6a\x33\x93\x9a\x39\xd5\x25\x9f\xf7\xa9\x81\xbe\x45\x0b\xb3\x40\x4a\x35\x90\xfc\x32\x50\x26\xde\x9c\xef\xb4\xfb\xe3\x03\xab\xc6\xb6\x4e\x47\x38\x1e\xdb\x6a\x7c\xc2\xf1\xd8\x77\xd1\x47\x3e\x93\xf7\x25\x7e\x68\x71\xd7\xeb\x3a\x0c\x7e\xb1\x43\xd5\x43\xb1\xcd\xb3\xe3\xc0\xdc\x6b\xa4\xde\x60\x7c\xcc\xa7\x05\x0e\x19\x6d\x87\xfa\xde\xae\xc8\x7b\x07\x08\x7e\x4a\x1d\xf2\x71\x54\xde\x7a\x14\xfa\x85\x68\x89\x92\x33\x50\x2e\xe3\x79\xb2\x59\x06\xbc\xb3\xa7\x04\xda\x2e\x5f\x3f\x0b\x09\xa1\x67\xf8\xe2\x15\xfe\x8f\x69\x25\x02\x2f\xa5\xa0\xf0\x75\x6e\xc4\x1c\xdf\x9f\xb3\x46\x1b\x4a\xf6\x3b\x9c\xb0\x80\xff\x0f\x28\xe4\xba\x75\x2b\xba\x64\x34\x19\x03\xd5\xb5\x72\xd9\x05\xd7\x67\xdb\xe6\x1f\x02\x72\xa1\x84\x0e\x32\x1f\x4f\x96\xfb\x20\x0e\xe8\x66\x45\xfa\x13\xbe\xf8\x75\x55\x72\xd5\x34\x87\x74\xa0\x76\x0e\x5a\x34\x80\x19\xe5\xc5\x06\xd0\xea\xc1\xca\x2d\xb2\xff\x34\x10\xfd\x5d\x26\xdd\x56\x9d\xdb\x03\x1d\x3e\x07\x90\xc3\x47\x8b\xae\x69\xe5\xe4\x14\xbc\x9d\xf0\x66\x9c\x0a\x9b\x71\xda\x72\x87\xa2\x66\xbd\x3a\x78\x15\xbc\xab\x1e\x0e\x5e\x97\x0f\xcb\xd1\x44\x06\x1a\x9e\xe7\xf0\x28\xd4\xd0\x32\x87\x13\x08\xff\xb4\x0e\xc8\xc7\x90\x29\x24\x5b\x09\xfd\x81\x4b\xcb\x33\x95\x3c\x03\x24\x49\x2d\xc6\x61\x89\x2d\x74\xb1\x48\xa3\xe2\x24\xa5\x34\x4f\xf4\x70\x6e\x7e\x59\xa3\x0c\xd5\x13\xf3\x71\x13\x91\xa1\xaf\x45\x4a\x7c\x4a\x7e\x2a\xe7\x9b\x35\xab\x19\xcf\xde\xf1\xe6\xe2\x22\xb9\xa6\xb5\xf2\x5f\x98\xd5\x58\x3f\x01\x91\x85\xa0\xea\xea\x88\xd0\x7d\x0d\xdb\xfb\x16\xb1\x69\xa5\xe1\x5c\xae\xfc\x13\x06\xe1\xfb\xa8\xa4\x18\xbe\x4f\x90\xa1\xc0\xf0\x7d\x42\x9a\x04\x70\x47\x88\x0c\x16\x1b\x0b\xee\x0b\x4b\xd6\x09\xe8\xe6\xcf\xc7\xcd\x12\x98\x16\x49\x58\xa2\x23\x9e\xa0\x0c\x2e\x4f\xeb\x7f\xf1\x08\x10\x7b\x60\xa5\x68\xeb\x2b\x5e\x26\xe8\xb5\xa2\x47\x07\x3e\xf3\xcd\x95\x42\x35\xc8\x23\x9b\x7f\x66\x9a\x32\xe2\x49\x98\x38\xa8\xc7\x74\xf7\xf6\x85\x45\xba\x7d\xf4\xd5\xaf\x00\x60\xd6\xfd\x46\xab\xc3\x12\x75\xe7\xe4\x38\xb0\xbc\xfe\xb8\x8f\xc0\x36\x2e\xb3\xad\xba\xbe\xc4\xcb\xac\x8f\x69\x3d\xdc\x33\x6d\x94\x1a\x99\xb2\x37\xd4\x05\xc9\xc9\x21\x4f\xe8\x67\xa9\x14\x92\x80\x72\xfe\x17\x75\xa2\xbe\x50\x14\xc8\x99\x0d\x86\x50\xee\x85\x3a\x34\x2e\xdf\x61\x95\xd9\xe6\x5e\xc8\x3b\x0c\xeb\x27\x60\xf7\x00\x1d\x85\x66\x02\x6f\xa3\x1e\x12\x05\xb6\x53\xe2\xf6\x28\x02\x42\x1d\xc4\x49\xca\x8e\xa8\x8f\x6e\x84\xe6\x32\xeb\x69\x2e\x33\xb7\x31\x3e\xb7\x9d\x4b\x79\x3d\x0e\x8b\xa4\x5d\xb3\xc1\x22\xa9\x83\xfd\x00\x08\x50\x36\x1b\xf6\x50\xc8\x00\xdc\x13\x1c\x89\x5f\xdd\x0d\xd2\xc5\x3c\x20\xe8\x3a\x41\x19\x7a\x00\xfb\x5b\x4b\xa0\x1f\x32\xe7\xe5\x9f\x0e\x7e\x56\x7b\x5e\x17\x86\xfe\x28\x05\x00\x62\x26\x83\x79\x79\xed\x36\x00\x85\x0a\xb8\x79\xb7\x09\x67\x76\x33\x25\x98\x20\x82\x9a\x80\xdc\xa1\xae\x29\x30\x42\x3c\x02\x20\x4b\x24\x05\x4a\x0f\x20\x32\x5a\xa1\xff\xdc\x82\x09\x60\x1a\xa0\x3b\x41\x30\x0b\xa8\x15\x40\x03\xb8\x26\xab\x56\x70\x77\xeb\xa0\xd6\x46\xc1\x32\xba\xee\x56\x5d\x74\x6d\x5c\x1d\xd9\xae\x5c\x2b\x8a\x90\x28\xe1\x38\x27\x0b\x18\x39\x00\xa9\xa4\x4e\x8e\x38\x94\x9b\xb4\xfc\x1d\x4c\x32\x11\xe4\xdc\x91\x44\x8b\x7b\xcc\x45\x10\x34\xc5\x54\x9d\x16\x2a\x9c\x60\x1a\x58\x6f\xee\x1c\xeb\xcc\x1e\xe6\xce\x96\x95\x69\x00\xbb\x7d\xb6\xd2\x04\x96\x5b\x35\xbe\x77\x64\xe8\x7d\x13\x96\xf5\x3b\x05\xee\x84\x65\x8e\x53\x41\xcf\x49\x25\xf0\x90\x4f\xdb\xb9\x27\xc0\xdc\xa6\x95\x26\xd3\x43\xfd\xe1\x0d\xa9\xad\xf9\x5a\x26\x07\x29\xce\x44\xde\x1f\x91\x6c\xc1\xfd\x00\xa6\x4d\xb2\xb5\x95\x18\xac\x2d\x2e\x0b\x09\x35\x46\x29\xab\x50\x63\x89\xdc\xca\x7f\xf4\x45\x57\xc0\xd6\x5e\xa2\x59\x7b\x5b\xea\x8d\xc9\x72\x04\x8f\x4d\x1c\xe0\xa7\xd4\xf4\x6f\x5d\x11\x0f\xff\x14\x76\xd7\xbd\x71\x37\x15\x70\xb3\xf7\x5e\x3b\x50\x0f\xe2\x83\x42\xb0\xd5\xd1\x7e\xd0\x01\xbe\x2c\x1d\xc0\x0c\x53\x1e\xd2\x01\x14\x5e\xef\x07\x1d\xc0\x40\x07\x10\x17\xd3\xc3\xb1\xef\xed\xd8\x97\x9b\x6b\x38\x69\x32\x49\xcd\xa0\x21\xcd\x62\x14\x7c\xee\x3d\x3b\x43\xcd\x37\xdc\x4a\xce\x60\x21\xe1\x68\x2e\x17\x8b\x80\xb0\x05\x04\x51\x81\x1e\x89\x69\xfa\x30\x4e\x64\x59\x04\x11\x7b\x2f\x49\x27\xce\x62\x0c\xf2\x2b\x49\x8c\xc0\x50\x9b\x32\xd3\x82\x13\xdc\x88\x41\xd6\xac\xe9\xa8\x7e\xe1\x09\x06\x63\xc7\x34\x50\x38\xbf\x16\x26\x35\x66\x25\xa0\x5c\x03\xf6\xbb\x2e\xdf\x80\x95\x53\x91\x49\xd5\x75\x81\x24\xc7\xac\x88\x82\x51\x8a\x15\x51\x91\x1d\xd7\xad\x06\x33\x20\xaa\xa1\x69\x91\x1e\x7f\x51\xd9\x07\xa4\x1b\xe5\x99\x6a\x98\xdf\x6b\x43\xf9\x3d\x76\xcb\x87\xa0\x61\xc2\xa1\x11\x12\x6f\xf3\x5a\x20\x9f\xa7\x5b\x89\x82\x1c\xb7\x80\x4d\xaa\x5f\xc6\x36\x51\x90\x92\x3e\xdc\xdd\x48\x48\x4e\xa2\xf0\xfe\xae\x8c\x75\xdc\xdf\xc3\x73\xac\x0c\x76\xfc\x0e\x97\xd0\x62\x52\xf5\x12\x72\xa7\x14\x28\x58\x27\xeb\x88\xcb\x6b\x09\x12\x43\x5e\xbc\xc9\xd0\x54\x9d\x13\x97\x69\x09\x85\x55\x7f\x96\x12\x07\xf3\x57\x11\x86\x56\xc5\x1e\xf1\x59\x51\x83\x12\xd2\xa4\xaa\x81\x19\x94\x70\xff\x1c\xee\x30\x3a\x96\xc7\x50\xbd\xb1\x45\x08\x8c\x54\xe7\x94\x6f\x15\xdb\x0f\x5d\x73\xaf\xf7\x8b\x0a\x5f\x73\xef\xa6\x2f\x4e\xb1\x2e\x13\xec\x85\x27\xdd\xbd\x01\x7e\xe8\xc5\xdc\xeb\xf7\xc3\x97\xde\x45\xd2\x7d\xe4\xe5\xb9\xd7\x4f\xb9\xb5\x7c\x07\x51\x6e\xf5\x6a\xe3\x90\x3c\x4d\x77\xca\x7e\xc3\x06\x27\x07\xe6\x61\x83\x63\x65\x3e\x91\x7d\x00\xd0\x58\x9f\x22\x74\xe7\x42\x5f\x26\xad\x04\x21\x69\xe8\xcb\x44\x11\x79\xd0\x63\xe8\x0b\x5b\x63\x30\xd6\xe5\x43\x08\xcc\xd7\x10\x02\x43\xb7\x0e\x1a\x06\x33\xf1\x4b\xb2\xf6\x85\x38\xba\x3c\x32\xc2\x77\xf6\x86\xc9\xac\xc6\x16\x4e\x9a\xde\xba\x72\x17\x5d\x66\x1e\x3b\xdb\xd0\x5d\xef\xc7\x0c\x42\x9d\xea\x3a\x97\x77\x61\xc2\x44\x55\xff\x7e\xcc\x17\xd0\xa7\xfb\x30\x5d\xc2\xc5\xe8\x7e\xcc\x96\xbc\x4b\xf7\x61\xb2\x84\x5b\xe4\xfd\x98\x2c\x79\x97\xee\xc3\x64\x79\x8b\x08\xb9\x3b\x93\xd5\x4f\xd8\xc8\x5d\x98\xac\xd2\x3e\x91\xdf\x8f\x69\x6a\x76\xc6\x62\x82\xee\x4e\x84\xce\xc4\x90\x52\x54\x10\xa7\x2a\x42\x67\x22\x8f\x7f\xec\x3f\x42\x87\x4a\xf5\xdd\x88\xd2\x21\x8d\x79\x88\xd6\xbd\xa3\x61\x3b\x54\x62\x69\xe8\xce\x44\x13\xca\xda\x5f\x4c\x19\x64\xf4\x23\x48\x7c\xb5\x9d\x09\x16\xe3\x58\x04\x6a\xc0\xf2\x49\x50\xea\x6b\xf1\xac\x7e\x0d\x8a\x34\xb0\x7c\x55\x19\x96\xcc\x28\x42\x82\xd0\xee\x69\x82\x53\xcc\x1e\x9e\x92\xa7\x61\xcb\x75\x2d\x2e\xfa\x17\xd3\xb3\x30\x3f\x5d\xa6\xff\x8b\xe7\xf5\x86\xa6\xf4\x1e\x91\xb2\x23\x8f\xad\xc8\x62\x0c\x5b\x8c\xc1\x48\xcc\x1a\x50\x95\xe7\x39\x97\x27\xfa\xfc\xc5\xb6\xd4\xc8\x21\xa7\xae\x73\x81\x3c\xa9\x6c\xad\x14\xf3\xc5\x81\xb8\x58\x95\x98\xdb\x81\xa6\x58\x99\xbc\xfb\xa6\xec\x81\x88\x8b\x75\xeb\x7c\xc4\xa3\x75\x3b\x9b\xfa\xeb\xa0\xad\x80\xc9\x89\xfd\x50\x13\x9b\x42\x31\x1f\x17\xa8\xfa\x65\xc5\x4b\x0c\xb0\x12\xdb\x33\x11\xcf\x66\xb3\xb5\x05\x1b\xf1\xa0\xc9\x46\xec\x8a\xc8\x7d\xb6\x48\xcf\x7f\xfb\xb8\x49\x8b\xb8\x71\x52\x3d\x5f\x97\x98\xc8\x53\x74\x50\x14\x59\xba\xba\xdc\x4e\xc7\xb0\x61\xbc\xac\x4e\x74\x9d\xb5\x05\x6d\x19\x17\x11\x7e\xb2\x96\x35\xf6\x4d\x25\x6e\x09\x8e\x95\x2c\xa6\x82\xae\x18\x3a\x0a\x21\x17\x2c\xd6\x1a\xb3\x36\x63\x89\xe8\xf5\xd3\xd2\x2d\x53\x94\x5d\x6b\xb6\x65\x69\xd4\xa5\x84\xe7\x42\x02\x94\x2d\x15\x19\x4b\x96\x68\x35\x30\x8c\x9a\x27\x5a\x8a\x16\x5c\xf9\x5c\xf7\xe0\xb6\x43\x90\x8d\x1d\x29\x38\x8e\x0e\x38\xc8\xfc\x62\x84\x36\x71\x06\x99\x5f\xd4\x14\x1c\xcf\x3e\x21\x91\x4a\x3f\xed\x7e\x78\x8f\x3f\xcf\xa2\x67\xe8\xb7\xb3\x34\x2d\x90\xc8\x46\x6b\xf4\x33\x7e\x60\x31\xa3\x5f\x9e\xa5\xd7\x84\x9f\xa3\x68\x93\x76\x24\xf4\x4b\x9e\xb4\x23\x27\xdf\x31\xd2\x8e\x6c\x96\xcb\x88\x39\xca\x88\x67\x98\x98\xa3\xd0\x13\x73\x08\xf8\xa5\x41\x79\xbc\x85\xf4\xcd\x2f\x29\x16\x0c\x2b\x2b\x9c\xe3\x68\x81\x95\x1f\x69\x29\x88\xc3\x23\x77\xe7\xf0\x48\x8c\x38\x3c\xc8\x30\xc7\x33\x8e\xd7\x1a\x29\x8a\xc3\x90\xc2\x76\xa1\x69\xb0\x67\xbc\x26\xe3\x56\x06\xa0\x9b\x10\x79\x8c\x2a\xf4\xfa\x4e\x94\x1e\xa9\x48\xe9\x91\xb6\x28\x3d\xb8\x49\xd1\xb1\x79\xac\x90\xca\x9b\xae\xdf\x65\xe9\x3a\xba\x8c\xca\x99\xa0\xe3\x84\x79\x3e\x28\xbf\xd6\x09\xa5\xfb\xc0\x6b\x6a\x18\x96\x32\x80\x3b\x3b\x0b\x5f\x66\x71\x70\x93\x6e\x82\x7c\x43\xff\xf8\x14\xad\x0a\xac\xb5\xb3\xdd\x3f\xfc\x73\xcc\x6d\xce\xc1\xf3\x78\x89\xbe\x6a\x4e\x01\xd9\xd7\xc9\x26\x8e\x7e\xfe\x3e\x7c\xb6\xc0\x34\x30\x17\x49\xb6\x44\x2b\x8a\x6f\xff\xb7\x7b\xe8\x58\x58\x3d\x7a\x34\x64\xe0\xb2\xe8\x63\xfc\x7d\xb4\x1b\x7d\x88\xae\x87\xdc\x4b\x37\xd9\x02\x75\x0d\x8d\x7f\x71\x95\xce\xa7\xe1\xdf\x5e\xbd\x7e\x75\xf2\x2a\xfc\xdc\x9c\xce\x9d\xdf\xe9\xba\x44\x1b\x33\xe9\xfb\x2c\xa9\x8e\xf9\x21\x3a\xdb\x77\xd0\xd9\x12\xed\xae\xd3\xbc\x68\xbf\xfc\xa7\xe3\x9f\xdf\xa2\xa1\xcb\x90\x56\x94\x5c\xdc\x0c\x7f\x8f\x28\x91\x10\xda\x70\x74\xb5\x20\xf9\x58\xa4\xd1\x7c\xf8\xed\x1e\xaa\xa0\x35\xf3\x2f\x7f\x7a\xf9\xef\xd6\xcc\x8b\xd2\xf2\x79\x24\xac\xa8\x1e\xa6\x98\xe8\xb8\x64\x82\xdf\xa0\x2b\x08\x49\x9a\xc0\x51\x69\x8b\xf4\x32\x48\x2f\x4c\x26\x31\xb8\xc8\xd2\x25\x24\x04\x48\x3c\x86\xab\xfc\xe3\x3c\x20\xd4\x54\x14\xc3\x70\x67\x8a\xe7\x1d\x6d\x01\xcb\x75\xd1\x98\x76\x34\xe3\x96\xd3\xc0\xb6\x1c\x74\x85\x2f\x2f\xee\xf4\x56\xb8\xea\x67\x7a\xc4\xd9\x31\x24\xc1\x51\x53\xdd\x34\x99\x6d\x84\x7d\x66\x7a\xb4\x3f\xa2\x47\x05\xe5\xba\x19\x55\xc7\xc9\x74\xbf\xcd\x82\x73\xd8\x0b\xad\x8b\x33\xd5\x6e\x9f\xfc\x6a\x14\x96\x18\x4c\x82\xf8\xf9\x13\x1a\x55\xe0\x77\x75\x5e\x8c\x43\x54\xbd\x71\xa4\x95\x2c\x64\xd6\x3b\x0a\xba\x33\x6f\x45\x2f\xad\xb1\xc8\xb7\xd8\x16\xc3\x9b\x39\x13\x85\x3c\x05\x44\x95\x40\x30\xc0\x59\x11\x9c\x7d\x92\x4b\x43\x1a\x68\x88\xaf\x9b\x4f\x0a\xa9\x3e\x00\x0d\x36\xf8\x70\x85\xc6\x8c\x1e\xbc\x1d\x78\x60\xd5\xfa\x9d\x7b\xb8\x92\x6f\x21\x2b\xc1\x7f\x92\x80\xfa\x32\x6c\x77\x11\x56\xba\xcf\xc2\x36\x82\xb1\x70\x37\x63\x97\xe1\xf2\x6e\x96\xe2\xdd\x92\x92\x6d\x42\x11\xbe\xfd\x45\xaa\x76\x22\xd3\xee\xaf\x59\xfb\x16\x49\x8b\x5b\x6d\x97\x3b\x6b\x49\xe5\x08\x53\xc4\xe7\x7a\x0c\x62\x55\x2d\xf4\x5c\xb9\xd0\xeb\x20\x4a\xeb\x85\x9e\x6b\x16\xba\x2c\x3e\xd3\x6e\xa1\x2b\x7d\x50\xd4\x05\x95\x6b\x5c\x50\x5c\x07\x19\x92\x44\xd8\x6e\x50\x07\x28\x8c\x81\x9a\xce\xfd\x61\x96\xef\xd6\x2c\x77\x8c\xa1\x18\x68\x28\xbb\xef\x1a\xb6\x4f\xdd\x73\x73\x54\x1f\x25\xcb\x78\x7d\xe9\xd0\x01\x10\x34\x20\x07\xde\xa6\x25\xc5\x49\x1e\xfc\x1d\x0d\xfd\x9c\x03\x02\x70\x77\xe7\x7c\x55\xae\x97\x0e\x8c\xe1\x8c\xdd\xd9\x0b\xb7\xf7\x11\x0c\x79\xa0\x92\x4f\x99\xdd\x1a\xe2\x22\xd7\x9e\xcc\x2a\xa3\xf5\xfe\x64\xfb\x46\xeb\xb1\x82\x37\xba\x69\x74\x5e\x48\x98\xa2\x53\x81\x29\x3a\x91\x30\x45\xd3\x6d\x1b\xdb\xa7\x53\xd0\x22\x6d\xc9\x15\x2d\xb7\x1b\xa7\xee\x76\xe3\x85\x3b\xf7\x73\xd2\x89\xfb\xb9\xec\x7d\xff\xe4\xcf\x91\x68\x29\x8e\x9a\x96\x62\xc1\x54\x95\x1b\xf1\x35\xd3\x89\x9d\x1e\x1e\x75\xb0\x62\x51\x12\xe7\x23\x22\x8c\x93\x7e\x49\x87\x29\x0b\xcb\x3c\xc9\x51\xe9\x9b\x69\xb0\x42\xf3\xf3\x2c\xb4\xdf\xc7\xab\xe0\x1a\x75\x24\x0d\xc0\xff\x16\x12\x96\xe6\x8a\xad\x4d\x0c\x9c\xe3\x3d\x66\xf9\x88\xbf\x8c\x95\xc2\x10\x9b\x7b\xf5\xb4\x11\x70\x03\xe3\x8d\xd8\x0e\xb1\xc7\x34\x44\x83\x65\x1f\x0d\xb1\x32\x49\x3b\x28\xdc\x7c\xab\x4e\xa3\x26\x27\xed\x40\x8d\x84\x0d\x53\xe5\xbd\x25\xc5\x25\xb7\xe1\x44\xb8\xe7\xa8\x28\x8e\x43\x43\x7a\xec\xa3\x9e\x24\xd5\xdc\x86\x1a\x18\x21\xf7\xc0\x17\x79\x23\x8b\xa5\x3b\x17\x8f\x41\x4b\xe6\x48\x48\xb2\x64\x4d\x4e\x51\x83\x2c\xda\xde\xdb\x83\xc6\x15\x87\x4a\x1a\xb5\x46\x87\x02\x07\xb4\x46\x03\xac\x23\xb6\x27\x2a\xd0\x96\x7d\xb6\x29\x0c\x91\x74\x74\xb8\xec\xdd\x07\xa8\x88\x2e\x73\x13\x1d\x47\x97\x26\x5b\xb7\x04\xb2\x94\xf2\x11\x64\xe1\xf3\x75\x69\xab\x9f\x72\x5e\x24\x0b\x28\x99\x66\x3f\x52\xba\x04\x52\x66\xcb\x62\x2f\x35\x21\xf3\xc4\x8d\xe9\x58\x3b\x2f\xf6\xa4\x0d\x90\xdd\xb6\x59\xef\x2f\x95\x7c\xfa\x1a\x88\x5a\xe2\x25\x83\xa1\x04\xf0\x07\x89\x5e\xc8\xf8\x20\x7d\xea\xd1\xa3\xc5\xee\x6f\xf1\x0d\x89\x9d\xac\x5b\x9b\xd6\x8d\x8b\xdb\x35\x02\x2c\x84\xe6\x7b\x62\x59\xbb\xf3\x82\x13\xe5\x5b\x05\x5a\xa5\x3b\x60\x19\xd7\x8e\x52\x1d\x01\xe3\x67\x69\x10\x24\xb6\x17\xab\xa6\xb7\x1e\x41\x1c\x90\x19\x84\x8e\x7a\x4c\xde\x48\xb7\xb7\xbb\x16\x89\xa3\x68\x76\xff\xd1\x0c\x9c\xd1\x11\x8c\xbe\xfe\x3c\xfa\xcf\x78\xef\xbf\x3b\xcf\xbe\x79\xf2\xe4\x0f\x41\x9e\x6e\xb2\xf3\xf8\x0d\xd2\x58\x91\x6e\xf0\xeb\x2f\xaf\x67\xb8\xcf\xbb\x1f\xf2\xdd\x65\xb4\xfe\xe6\xff\xea\x57\xb1\xd7\x8b\x94\x02\x00")
 
 func mainJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -275,7 +275,7 @@ func mainJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "main.js", size: 165015, mode: os.FileMode(420), modTime: time.Unix(1549664195, 0)}
+	info := bindataFileInfo{name: "main.js", size: 169099, mode: os.FileMode(420), modTime: time.Unix(1792386647, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
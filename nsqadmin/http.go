@@ -73,6 +73,7 @@ func NewHTTPServer(ctx *Context) *httpServer {
 		ci:       clusterinfo.New(ctx.nsqadmin.logf, client),
 		basePath: ctx.nsqadmin.getOpts().BasePath,
 	}
+	s.ci.SetLookupdAuth(ctx.nsqadmin.getOpts().NSQLookupdTLS, ctx.nsqadmin.getOpts().NSQLookupdAuthSecret)
 
 	bp := func(p string) string {
 		return path.Join(s.basePath, p)
@@ -238,10 +239,33 @@ func (s *httpServer) topicsHandler(w http.ResponseWriter, req *http.Request, ps
 		}{topicChannelMap, maybeWarnMsg(messages)}, nil
 	}
 
+	var metadata map[string]*clusterinfo.Metadata
+	if len(s.ctx.nsqadmin.getOpts().NSQLookupdHTTPAddresses) != 0 {
+		metadata, messages = s.getMetadata(messages, "", s.ctx.nsqadmin.getOpts().NSQLookupdHTTPAddresses)
+	}
+
 	return struct {
-		Topics  []string `json:"topics"`
-		Message string   `json:"message"`
-	}{topics, maybeWarnMsg(messages)}, nil
+		Topics   []string                         `json:"topics"`
+		Metadata map[string]*clusterinfo.Metadata `json:"metadata,omitempty"`
+		Message  string                           `json:"message"`
+	}{topics, metadata, maybeWarnMsg(messages)}, nil
+}
+
+// getMetadata returns the metadata of the topics, or of the channels of topic,
+// the errors are only warned about
+func (s *httpServer) getMetadata(messages []string, topic string, lookupdHTTPAddrs []string) (map[string]*clusterinfo.Metadata, []string) {
+	var metadata map[string]*clusterinfo.Metadata
+	var err error
+	if topic == "" {
+		metadata, err = s.ci.GetLookupdTopicMetadata(lookupdHTTPAddrs)
+	} else {
+		metadata, err = s.ci.GetLookupdChannelMetadata(topic, lookupdHTTPAddrs)
+	}
+	if err != nil {
+		s.ctx.nsqadmin.logf(LOG_WARN, "failed to get metadata - %s", err)
+		messages = append(messages, err.Error())
+	}
+	return metadata, messages
 }
 
 func (s *httpServer) topicHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
@@ -277,10 +301,16 @@ func (s *httpServer) topicHandler(w http.ResponseWriter, req *http.Request, ps h
 		allNodesTopicStats.Add(t)
 	}
 
+	var metadata map[string]*clusterinfo.Metadata
+	if len(s.ctx.nsqadmin.getOpts().NSQLookupdHTTPAddresses) != 0 {
+		metadata, messages = s.getMetadata(messages, "", s.ctx.nsqadmin.getOpts().NSQLookupdHTTPAddresses)
+	}
+
 	return struct {
 		*clusterinfo.TopicStats
-		Message string `json:"message"`
-	}{allNodesTopicStats, maybeWarnMsg(messages)}, nil
+		Metadata *clusterinfo.Metadata `json:"metadata,omitempty"`
+		Message  string                `json:"message"`
+	}{allNodesTopicStats, metadata[topicName], maybeWarnMsg(messages)}, nil
 }
 
 func (s *httpServer) channelHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
@@ -312,10 +342,16 @@ func (s *httpServer) channelHandler(w http.ResponseWriter, req *http.Request, ps
 		messages = append(messages, pe.Error())
 	}
 
+	var metadata map[string]*clusterinfo.Metadata
+	if len(s.ctx.nsqadmin.getOpts().NSQLookupdHTTPAddresses) != 0 {
+		metadata, messages = s.getMetadata(messages, topicName, s.ctx.nsqadmin.getOpts().NSQLookupdHTTPAddresses)
+	}
+
 	return struct {
 		*clusterinfo.ChannelStats
-		Message string `json:"message"`
-	}{channelStats[channelName], maybeWarnMsg(messages)}, nil
+		Metadata *clusterinfo.Metadata `json:"metadata,omitempty"`
+		Message  string                `json:"message"`
+	}{channelStats[channelName], metadata[channelName], maybeWarnMsg(messages)}, nil
 }
 
 func (s *httpServer) nodesHandler(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
@@ -547,6 +583,8 @@ func (s *httpServer) topicChannelAction(req *http.Request, topicName string, cha
 
 	var body struct {
 		Action string `json:"action"`
+		Node   string `json:"node"`
+		Target string `json:"target"`
 	}
 
 	if !s.isAuthorizedAdminRequest(req) {
@@ -601,6 +639,36 @@ func (s *httpServer) topicChannelAction(req *http.Request, topicName string, cha
 
 			s.notifyAdminAction("empty_topic", topicName, "", "", req)
 		}
+	case "migrate":
+		if channelName != "" || body.Node == "" || body.Target == "" {
+			return nil, http_api.Err{400, "INVALID_ACTION"}
+		}
+		producers, err := s.ci.GetTopicProducers(topicName,
+			s.ctx.nsqadmin.getOpts().NSQLookupdHTTPAddresses,
+			s.ctx.nsqadmin.getOpts().NSQDHTTPAddresses)
+		if err != nil {
+			pe, ok := err.(clusterinfo.PartialErr)
+			if !ok {
+				s.ctx.nsqadmin.logf(LOG_ERROR, "failed to get topic producers - %s", err)
+				return nil, http_api.Err{502, fmt.Sprintf("UPSTREAM_ERROR: %s", err)}
+			}
+			s.ctx.nsqadmin.logf(LOG_WARN, "%s", err)
+			messages = append(messages, pe.Error())
+		}
+		// only migrate from a node the topic is known to be on
+		known := false
+		for _, p := range producers {
+			if p.HTTPAddress() == body.Node {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, http_api.Err{400, "INVALID_NODE"}
+		}
+		err = s.ci.MigrateTopic(topicName, body.Node, body.Target)
+
+		s.notifyAdminAction("migrate_topic", topicName, "", body.Node, req)
 	default:
 		return nil, http_api.Err{400, "INVALID_ACTION"}
 	}
@@ -211,6 +211,54 @@ func TestHTTPTopicGET(t *testing.T) {
 	test.Equal(t, false, ts.Paused)
 }
 
+func TestHTTPTopicMetadataGET(t *testing.T) {
+	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupds[0].Exit()
+	defer nsqadmin1.Exit()
+
+	topicName := "test_topic_metadata" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqds[0].GetTopic(topicName)
+	topic.GetChannel("ch")
+	time.Sleep(100 * time.Millisecond)
+
+	url := fmt.Sprintf("http://%s/topic/metadata?topic=%s", nsqlookupds[0].RealHTTPAddr(), topicName)
+	resp, err := http.Post(url, "application/json", bytes.NewBufferString(`{"owner":"payments"}`))
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	resp.Body.Close()
+	url = fmt.Sprintf("http://%s/channel/metadata?topic=%s&channel=ch", nsqlookupds[0].RealHTTPAddr(), topicName)
+	resp, err = http.Post(url, "application/json", bytes.NewBufferString(`{"owner":"billing"}`))
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	resp.Body.Close()
+
+	var topics struct {
+		Metadata map[string]*clusterinfo.Metadata `json:"metadata"`
+	}
+	resp, err = http.Get(fmt.Sprintf("http://%s/api/topics", nsqadmin1.RealHTTPAddr()))
+	test.Nil(t, err)
+	test.Nil(t, json.NewDecoder(resp.Body).Decode(&topics))
+	resp.Body.Close()
+	test.Equal(t, "payments", topics.Metadata[topicName].Owner)
+
+	var doc struct {
+		Metadata *clusterinfo.Metadata `json:"metadata"`
+	}
+	resp, err = http.Get(fmt.Sprintf("http://%s/api/topics/%s", nsqadmin1.RealHTTPAddr(), topicName))
+	test.Nil(t, err)
+	test.Nil(t, json.NewDecoder(resp.Body).Decode(&doc))
+	resp.Body.Close()
+	test.Equal(t, "payments", doc.Metadata.Owner)
+
+	resp, err = http.Get(fmt.Sprintf("http://%s/api/topics/%s/ch", nsqadmin1.RealHTTPAddr(), topicName))
+	test.Nil(t, err)
+	test.Nil(t, json.NewDecoder(resp.Body).Decode(&doc))
+	resp.Body.Close()
+	test.Equal(t, "billing", doc.Metadata.Owner)
+}
+
 func TestHTTPNodesGET(t *testing.T) {
 	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
 	defer os.RemoveAll(dataPath)
@@ -529,6 +577,64 @@ func TestHTTPEmptyTopicPOST(t *testing.T) {
 	test.Equal(t, int64(0), topic.Depth())
 }
 
+func TestHTTPMigrateTopicPOST(t *testing.T) {
+	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupds[0].Exit()
+	defer nsqadmin1.Exit()
+
+	topicName := "test_migrate_topic_post" + strconv.Itoa(int(time.Now().Unix()))
+	nsqds[0].GetTopic(topicName)
+	time.Sleep(100 * time.Millisecond)
+
+	client := http.Client{}
+	url := fmt.Sprintf("http://%s/api/topics/%s", nsqadmin1.RealHTTPAddr(), topicName)
+	body, _ := json.Marshal(map[string]interface{}{
+		"action": "migrate",
+		"node":   nsqds[0].RealHTTPAddr().String(),
+	})
+	req, _ := http.NewRequest("POST", url, bytes.NewBuffer(body))
+	resp, err := client.Do(req)
+	test.Nil(t, err)
+	test.Equal(t, 400, resp.StatusCode)
+	resp.Body.Close()
+
+	// only the topic's producers can be migrated from
+	body, _ = json.Marshal(map[string]interface{}{
+		"action": "migrate",
+		"node":   "127.0.0.1:1",
+		"target": nsqds[0].RealHTTPAddr().String(),
+	})
+	req, _ = http.NewRequest("POST", url, bytes.NewBuffer(body))
+	resp, err = client.Do(req)
+	test.Nil(t, err)
+	test.Equal(t, 400, resp.StatusCode)
+	resp.Body.Close()
+
+	// migrating a topic to the node it's on is refused by nsqd
+	body, _ = json.Marshal(map[string]interface{}{
+		"action": "migrate",
+		"node":   nsqds[0].RealHTTPAddr().String(),
+		"target": nsqds[0].RealHTTPAddr().String(),
+	})
+	req, _ = http.NewRequest("POST", url, bytes.NewBuffer(body))
+	resp, err = client.Do(req)
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	resp.Body.Close()
+
+	var stats nsqd.MigrationStats
+	for i := 0; i < 20; i++ {
+		stats, _ = nsqds[0].GetMigrationStats(topicName)
+		if stats.State != nsqd.MigrationRunning {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	test.Equal(t, nsqd.MigrationFailed, stats.State)
+}
+
 func TestHTTPEmptyChannelPOST(t *testing.T) {
 	dataPath, nsqds, nsqlookupds, nsqadmin1 := bootstrapNSQCluster(t)
 	defer os.RemoveAll(dataPath)
@@ -17,6 +17,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/nsqio/nsq/internal/discovery"
 	"github.com/nsqio/nsq/internal/http_api"
 	"github.com/nsqio/nsq/internal/util"
 	"github.com/nsqio/nsq/internal/version"
@@ -82,7 +83,13 @@ func New(opts *Options) (*NSQAdmin, error) {
 		n.httpClientTLSConfig.RootCAs = tlsCertPool
 	}
 
+	if err := discovery.Validate(opts.NSQLookupdHTTPAddresses); err != nil {
+		return nil, fmt.Errorf("invalid --lookupd-http-address - %s", err)
+	}
 	for _, address := range opts.NSQLookupdHTTPAddresses {
+		if discovery.IsName(address) {
+			continue
+		}
 		_, err := net.ResolveTCPAddr("tcp", address)
 		if err != nil {
 			return nil, fmt.Errorf("failed to resolve --lookupd-http-address (%s) - %s", address, err)
@@ -25,6 +25,8 @@ type Options struct {
 
 	NSQLookupdHTTPAddresses []string `flag:"lookupd-http-address" cfg:"nsqlookupd_http_addresses"`
 	NSQDHTTPAddresses       []string `flag:"nsqd-http-address" cfg:"nsqd_http_addresses"`
+	NSQLookupdTLS           bool     `flag:"lookupd-tls"`         //用https请求nsqlookupd
+	NSQLookupdAuthSecret    string   `flag:"lookupd-auth-secret"` //nsqlookupd的--auth-secret
 
 	HTTPClientConnectTimeout time.Duration `flag:"http-client-connect-timeout"`
 	HTTPClientRequestTimeout time.Duration `flag:"http-client-request-timeout"`
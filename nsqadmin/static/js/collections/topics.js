@@ -19,8 +19,9 @@ var Topics = Backbone.Collection.extend({
     },
 
     parse: function(resp) {
+        var metadata = resp['metadata'] || {};
         var topics = _.map(resp['topics'], function(name) {
-            return {'name': name};
+            return {'name': name, 'metadata': metadata[name]};
         });
         return topics;
     }
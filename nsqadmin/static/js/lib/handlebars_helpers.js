@@ -282,6 +282,7 @@ Handlebars.registerHelper('rate', function(typ, node, ns1, ns2) {
 
 Handlebars.registerPartial('error', require('../views/error.hbs'));
 Handlebars.registerPartial('warning', require('../views/warning.hbs'));
+Handlebars.registerPartial('metadata', require('../views/metadata.hbs'));
 
 Handlebars.registerHelper('basePath', function(p) {
     return AppState.basePath(p);
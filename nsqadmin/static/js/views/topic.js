@@ -15,7 +15,8 @@ var TopicView = BaseView.extend({
     template: require('./spinner.hbs'),
 
     events: {
-        'click .topic-actions button': 'topicAction'
+        'click .topic-actions button': 'topicAction',
+        'click .migrate-link': 'migrateAction'
     },
 
     initialize: function() {
@@ -50,6 +51,26 @@ var TopicView = BaseView.extend({
                     .fail(this.handleAJAXError.bind(this));
             }
         }.bind(this));
+    },
+
+    migrateAction: function(e) {
+        e.preventDefault();
+        e.stopPropagation();
+        var node = $(e.currentTarget).data('node');
+        var txt = 'Move the backlog of <em>' + this.model.get('name') +
+            '</em> from <strong>' + node + '</strong> to (nsqd HTTP address):';
+        bootbox.prompt(txt, function(target) {
+            if (!target) {
+                return;
+            }
+            $.post(this.model.url(), JSON.stringify({
+                'action': 'migrate',
+                'node': node,
+                'target': target
+            }))
+                .done(function() { window.location.reload(true); })
+                .fail(this.handleAJAXError.bind(this));
+        }.bind(this));
     }
 });
 
@@ -5,6 +5,7 @@ package nsqd
 //消息(存储)队列的接口定义
 type BackendQueue interface {
 	Put([]byte) error	//推入消息
+	PutSync([]byte) error	//推入消息并同步到磁盘
 	ReadChan() chan []byte // this is expected to be an *unbuffered* channel //读取消息的channel
 	Close() error	//关闭队列
 	Delete() error	//删除队列
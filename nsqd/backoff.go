@@ -0,0 +1,98 @@
+package nsqd
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// requeue backoff policies
+const (
+	backoffNone        = "none"        // requeue immediately
+	backoffFixed       = "fixed"       // always wait Delay
+	backoffExponential = "exponential" // Delay doubled with every attempt up to MaxDelay, with jitter
+)
+
+// RequeueBackoff is how long a channel holds back a message that timed out or
+// was requeued with a timeout of -1 before it is delivered again
+type RequeueBackoff struct {
+	Policy   string        `json:"policy"`
+	Delay    time.Duration `json:"delay"`
+	MaxDelay time.Duration `json:"max_delay,omitempty"` //0表示--max-req-timeout
+}
+
+func (b *RequeueBackoff) validate(maxReqTimeout time.Duration) error {
+	switch b.Policy {
+	case backoffNone, backoffFixed, backoffExponential:
+	default:
+		return fmt.Errorf("invalid requeue backoff policy %q", b.Policy)
+	}
+	if b.Delay < 0 || b.Delay > maxReqTimeout {
+		return fmt.Errorf("requeue backoff delay (%s) is out of range 0-%s", b.Delay, maxReqTimeout)
+	}
+	if b.MaxDelay != 0 && (b.MaxDelay < b.Delay || b.MaxDelay > maxReqTimeout) {
+		return fmt.Errorf("requeue backoff max delay (%s) is out of range %s-%s", b.MaxDelay, b.Delay, maxReqTimeout)
+	}
+	return nil
+}
+
+// delay returns how long to hold back a message after attempts deliveries
+func (b *RequeueBackoff) delay(attempts uint16, maxReqTimeout time.Duration) time.Duration {
+	maxDelay := b.MaxDelay
+	if maxDelay == 0 {
+		maxDelay = maxReqTimeout
+	}
+
+	switch b.Policy {
+	case backoffFixed:
+		return b.Delay
+	case backoffExponential:
+		d := b.Delay
+		for i := uint16(1); i < attempts && d < maxDelay; i++ {
+			d *= 2
+		}
+		if d > maxDelay {
+			d = maxDelay
+		}
+		// somewhere in the upper half so that messages that failed together
+		// are not all retried at once
+		if d > 1 {
+			d = d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+		}
+		return d
+	}
+	return 0
+}
+
+// RequeueBackoff returns the channel's requeue backoff, nil if messages are
+// requeued immediately
+func (c *Channel) RequeueBackoff() *RequeueBackoff {
+	b, _ := c.backoff.Load().(*RequeueBackoff)
+	return b
+}
+
+// SetRequeueBackoff changes the channel's requeue backoff, nil or the "none"
+// policy requeue messages immediately
+func (c *Channel) SetRequeueBackoff(b *RequeueBackoff) error {
+	if b == nil {
+		b = &RequeueBackoff{Policy: backoffNone}
+	}
+	err := b.validate(c.ctx.nsqd.getOpts().MaxReqTimeout)
+	if err != nil {
+		return err
+	}
+	if b.Policy == backoffNone {
+		b = nil
+	}
+	c.backoff.Store(b)
+	return nil
+}
+
+// requeueDelay returns how long to hold back msg before it is delivered again
+func (c *Channel) requeueDelay(msg *Message) time.Duration {
+	b := c.RequeueBackoff()
+	if b == nil {
+		return 0
+	}
+	return b.delay(msg.Attempts, c.ctx.nsqd.getOpts().MaxReqTimeout)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/nsqio/nsq/internal/lg"
 	"github.com/nsqio/nsq/internal/pqueue"
 	"github.com/nsqio/nsq/internal/quantile"
+	"github.com/nsqio/nsq/internal/util"
 )
 
 type Consumer interface {
@@ -22,7 +23,7 @@ type Consumer interface {
 	Close() error
 	TimedOutMessage()
 	Stats() ClientStats
-	Empty()
+	Empty(channel *Channel)
 }
 
 // Channel represents the concrete type for a NSQ channel (and also
@@ -41,6 +42,8 @@ type Channel struct {
 	messageCount uint64
 	//超时数
 	timeoutCount uint64
+	//#broadcast频道因消费者跟不上而丢弃的副本数
+	broadcastDropCount uint64
 
 	sync.RWMutex
 
@@ -54,14 +57,16 @@ type Channel struct {
 
 	backend BackendQueue
 	//消息队列
-	memoryMsgChan chan *Message
-	exitFlag      int32
-	exitMutex     sync.RWMutex
+	memoryMsgChan  chan *Message
+	memoryMsgMutex sync.RWMutex //memoryMsgChan可以在运行时改变长度
+	exitFlag       int32
+	exitMutex      sync.RWMutex
 
 	// state tracking
 	clients        map[int64]Consumer
 	paused         int32
 	ephemeral      bool
+	broadcast      bool //#broadcast频道，每条消息投递给所有消费者
 	deleteCallback func(*Channel)
 	deleter        sync.Once
 
@@ -75,10 +80,32 @@ type Channel struct {
 	inFlightMessages map[MessageID]*Message
 	inFlightPQ       inFlightPqueue
 	inFlightMutex    sync.Mutex
-}
 
-// NewChannel creates a new instance of the Channel type and returns a pointer
-func NewChannel(topicName string, channelName string, ctx *context,
+	// messages that change hands while a snapshot is being taken
+	recording      int32
+	recordMutex    sync.Mutex
+	recordMessages []*Message
+
+	// distribution of messages among the consumers, see dispatch.go
+	dispatch           int32 //分发策略，-1表示使用--channel-dispatch-policy
+	dispatchMutex      sync.Mutex
+	dispatchChans      map[int64]chan *Message //每个消费者接收分发消息的chan
+	dispatchUpdateChan chan int
+	dispatchReadyChan  chan int
+	dispatchExitChan   chan int
+	dispatchLast       int64 //上一个收到消息的消费者
+	dispatcherOnce     sync.Once
+	dispatchWaitGroup  util.WaitGroupWrapper
+	idFactory          *guidFactory //广播给每个消费者的消息副本的id
+
+	backoff     atomic.Value //*RequeueBackoff，重新投递消息前的等待时间
+	msgTimeout  int64        //ChannelConfig.MsgTimeout
+	maxAttempts int32        //ChannelConfig.MaxAttempts
+}
+
+// NewChannel creates a new instance of the Channel type and returns a pointer,
+// its backend accepts messages of up to maxMsgSize bytes
+func NewChannel(topicName string, channelName string, maxMsgSize int64, ctx *context,
 	deleteCallback func(*Channel)) *Channel {
 
 	c := &Channel{
@@ -88,6 +115,12 @@ func NewChannel(topicName string, channelName string, ctx *context,
 		clients:        make(map[int64]Consumer),
 		deleteCallback: deleteCallback,
 		ctx:            ctx,
+
+		dispatch:           -1,
+		dispatchChans:      make(map[int64]chan *Message),
+		dispatchUpdateChan: make(chan int),
+		dispatchReadyChan:  make(chan int, 1),
+		dispatchExitChan:   make(chan int),
 	}
 	if len(ctx.nsqd.getOpts().E2EProcessingLatencyPercentiles) > 0 {
 		c.e2eProcessingLatencyStream = quantile.New(
@@ -98,6 +131,11 @@ func NewChannel(topicName string, channelName string, ctx *context,
 
 	c.initPQ()
 
+	if strings.HasSuffix(channelName, "#broadcast") {
+		c.broadcast = true
+		c.idFactory = NewGUIDFactory(ctx.nsqd.getOpts().ID)
+	}
+
 	if strings.HasSuffix(channelName, "#ephemeral") {
 		c.ephemeral = true
 		c.backend = newDummyBackendQueue()
@@ -112,14 +150,17 @@ func NewChannel(topicName string, channelName string, ctx *context,
 			backendName,
 			ctx.nsqd.getOpts().DataPath,
 			ctx.nsqd.getOpts().MaxBytesPerFile,
-			int32(minValidMsgLength),
-			int32(ctx.nsqd.getOpts().MaxMsgSize)+minValidMsgLength,
+			int32(MinValidMsgLength),
+			BackendMaxMsgSize(maxMsgSize),
 			ctx.nsqd.getOpts().SyncEvery,
 			ctx.nsqd.getOpts().SyncTimeout,
 			dqLogf,
+			ctx.nsqd.diskQueueOptions()...,
 		)
 	}
 
+	c.startDispatcher()
+
 	c.ctx.nsqd.Notify(c)
 
 	return c
@@ -179,6 +220,10 @@ func (c *Channel) exit(deleted bool) error {
 	}
 	c.RUnlock()
 
+	// the dispatcher gives back the message it holds
+	close(c.dispatchExitChan)
+	c.dispatchWaitGroup.Wait()
+
 	if deleted {
 		// empty the queue (deletes the backend files, too)
 		c.Empty()
@@ -196,12 +241,14 @@ func (c *Channel) Empty() error {
 
 	c.initPQ()
 	for _, client := range c.clients {
-		client.Empty()
+		client.Empty(c)
 	}
+	c.emptyDispatchChans()
 
+	memoryMsgChan := c.getMemoryMsgChan()
 	for {
 		select {
-		case <-c.memoryMsgChan:
+		case <-memoryMsgChan:
 		default:
 			goto finish
 		}
@@ -216,14 +263,15 @@ finish:
 func (c *Channel) flush() error {
 	var msgBuf bytes.Buffer
 
-	if len(c.memoryMsgChan) > 0 || len(c.inFlightMessages) > 0 || len(c.deferredMessages) > 0 {
+	memoryMsgChan := c.getMemoryMsgChan()
+	if len(memoryMsgChan) > 0 || len(c.inFlightMessages) > 0 || len(c.deferredMessages) > 0 {
 		c.ctx.nsqd.logf(LOG_INFO, "CHANNEL(%s): flushing %d memory %d in-flight %d deferred messages to backend",
-			c.name, len(c.memoryMsgChan), len(c.inFlightMessages), len(c.deferredMessages))
+			c.name, len(memoryMsgChan), len(c.inFlightMessages), len(c.deferredMessages))
 	}
 
 	for {
 		select {
-		case msg := <-c.memoryMsgChan:
+		case msg := <-memoryMsgChan:
 			err := writeMessageToBackend(&msgBuf, msg, c.backend)
 			if err != nil {
 				c.ctx.nsqd.logf(LOG_ERROR, "failed to write message to backend - %s", err)
@@ -236,6 +284,10 @@ func (c *Channel) flush() error {
 finish:
 	c.inFlightMutex.Lock()
 	for _, msg := range c.inFlightMessages {
+		if c.isBroadcastCopy(msg) {
+			// the message itself was delivered to every consumer
+			continue
+		}
 		err := writeMessageToBackend(&msgBuf, msg, c.backend)
 		if err != nil {
 			c.ctx.nsqd.logf(LOG_ERROR, "failed to write message to backend - %s", err)
@@ -246,6 +298,9 @@ finish:
 	c.deferredMutex.Lock()
 	for _, item := range c.deferredMessages {
 		msg := item.Value.(*Message)
+		if c.isBroadcastCopy(msg) {
+			continue
+		}
 		err := writeMessageToBackend(&msgBuf, msg, c.backend)
 		if err != nil {
 			c.ctx.nsqd.logf(LOG_ERROR, "failed to write message to backend - %s", err)
@@ -257,7 +312,41 @@ finish:
 }
 
 func (c *Channel) Depth() int64 {
-	return int64(len(c.memoryMsgChan)) + c.backend.Depth()
+	return int64(len(c.getMemoryMsgChan())) + c.backend.Depth() + c.dispatchDepth()
+}
+
+// getMemoryMsgChan returns the in-memory queue, it is replaced when the queue
+// is resized
+func (c *Channel) getMemoryMsgChan() chan *Message {
+	c.memoryMsgMutex.RLock()
+	defer c.memoryMsgMutex.RUnlock()
+	return c.memoryMsgChan
+}
+
+// resizeMemoryMsgChan replaces the in-memory queue with one of size messages,
+// the queued messages are moved to it or to the backend when it is full
+func (c *Channel) resizeMemoryMsgChan(size int64) {
+	c.memoryMsgMutex.Lock()
+	old := c.memoryMsgChan
+	if int64(cap(old)) == size {
+		c.memoryMsgMutex.Unlock()
+		return
+	}
+	c.memoryMsgChan = make(chan *Message, size)
+	c.memoryMsgMutex.Unlock()
+
+	for {
+		select {
+		case msg := <-old:
+			c.put(msg)
+			continue
+		default:
+		}
+		break
+	}
+
+	// the consumers and the dispatcher select on the new queue from now on
+	c.dispatchUpdated()
 }
 
 func (c *Channel) Pause() error {
@@ -307,18 +396,38 @@ func (c *Channel) PutMessage(m *Message) error {
 }
 
 func (c *Channel) put(m *Message) error {
-	select {
-	case c.memoryMsgChan <- m:
-	default:
-		b := bufferPoolGet()
-		err := writeMessageToBackend(b, m, c.backend)
-		bufferPoolPut(b)
-		c.ctx.nsqd.SetHealth(err)
-		if err != nil {
-			c.ctx.nsqd.logf(LOG_ERROR, "CHANNEL(%s): failed to write message to backend - %s",
-				c.name, err)
-			return err
+	if c.isBroadcastCopy(m) {
+		// a requeued or timed out copy only goes back to its consumer
+		c.redeliver(m)
+		return nil
+	}
+	c.record(m)
+	// durable writes go straight to disk
+	durable := c.ctx.nsqd.getOpts().DurableWrites && !c.ephemeral
+	if !durable {
+		c.memoryMsgMutex.RLock()
+		select {
+		case c.memoryMsgChan <- m:
+			c.memoryMsgMutex.RUnlock()
+			return nil
+		default:
 		}
+		c.memoryMsgMutex.RUnlock()
+	}
+
+	var err error
+	b := bufferPoolGet()
+	if durable {
+		err = writeMessageToBackendSync(b, m, c.backend)
+	} else {
+		err = writeMessageToBackend(b, m, c.backend)
+	}
+	bufferPoolPut(b)
+	c.ctx.nsqd.SetHealth(err)
+	if err != nil {
+		c.ctx.nsqd.logf(LOG_ERROR, "CHANNEL(%s): failed to write message to backend - %s",
+			c.name, err)
+		return err
 	}
 	return nil
 }
@@ -370,6 +479,7 @@ func (c *Channel) FinishMessage(clientID int64, id MessageID) error {
 // `timeoutMs` == 0 - requeue a message immediately
 // `timeoutMs`  > 0 - asynchronously wait for the specified timeout
 //     and requeue a message (aka "deferred requeue")
+// `timeoutMs`  < 0 - wait as long as the channel's requeue backoff says
 //
 func (c *Channel) RequeueMessage(clientID int64, id MessageID, timeout time.Duration) error {
 	// remove from inflight first
@@ -380,6 +490,14 @@ func (c *Channel) RequeueMessage(clientID int64, id MessageID, timeout time.Dura
 	c.removeFromInFlightPQ(msg)
 	atomic.AddUint64(&c.requeueCount, 1)
 
+	if c.exhausted(msg) {
+		return nil
+	}
+
+	if timeout < 0 {
+		timeout = c.requeueDelay(msg)
+	}
+
 	if timeout == 0 {
 		c.exitMutex.RLock()
 		if c.Exiting() {
@@ -411,6 +529,10 @@ func (c *Channel) AddClient(clientID int64, client Consumer) error {
 	}
 
 	c.clients[clientID] = client
+	c.dispatchMutex.Lock()
+	c.dispatchChans[clientID] = make(chan *Message, c.dispatchChanSize())
+	c.dispatchMutex.Unlock()
+	c.dispatchUpdated()
 	return nil
 }
 
@@ -424,6 +546,10 @@ func (c *Channel) RemoveClient(clientID int64) {
 		return
 	}
 	delete(c.clients, clientID)
+	c.dispatchMutex.Lock()
+	delete(c.dispatchChans, clientID)
+	c.dispatchMutex.Unlock()
+	c.dispatchUpdated()
 
 	if len(c.clients) == 0 && c.ephemeral == true {
 		go c.deleter.Do(func() { c.deleteCallback(c) })
@@ -456,6 +582,7 @@ func (c *Channel) StartDeferredTimeout(msg *Message, timeout time.Duration) erro
 
 // pushInFlightMessage atomically adds a message to the in-flight dictionary
 func (c *Channel) pushInFlightMessage(msg *Message) error {
+	c.record(msg)
 	c.inFlightMutex.Lock()
 	_, ok := c.inFlightMessages[msg.ID]
 	if ok {
@@ -484,6 +611,14 @@ func (c *Channel) popInFlightMessage(clientID int64, id MessageID) (*Message, er
 	return msg, nil
 }
 
+// isInFlight reports whether the message is in flight to the client
+func (c *Channel) isInFlight(clientID int64, id MessageID) bool {
+	c.inFlightMutex.Lock()
+	msg, ok := c.inFlightMessages[id]
+	c.inFlightMutex.Unlock()
+	return ok && msg.clientID == clientID
+}
+
 func (c *Channel) addToInFlightPQ(msg *Message) {
 	c.inFlightMutex.Lock()
 	c.inFlightPQ.Push(msg)
@@ -502,6 +637,7 @@ func (c *Channel) removeFromInFlightPQ(msg *Message) {
 }
 
 func (c *Channel) pushDeferredMessage(item *pqueue.Item) error {
+	c.record(item.Value.(*Message))
 	c.deferredMutex.Lock()
 	// TODO: these map lookups are costly
 	id := item.Value.(*Message).ID
@@ -595,6 +731,13 @@ func (c *Channel) processInFlightQueue(t int64) bool {
 		if ok {
 			client.TimedOutMessage()
 		}
+		if c.exhausted(msg) {
+			continue
+		}
+		if delay := c.requeueDelay(msg); delay > 0 {
+			c.StartDeferredTimeout(msg, delay)
+			continue
+		}
 		c.put(msg)
 	}
 
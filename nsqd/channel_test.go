@@ -248,3 +248,85 @@ func TestChannelHealth(t *testing.T) {
 	resp.Body.Close()
 	test.Equal(t, "OK", string(body))
 }
+
+func TestRequeueBackoff(t *testing.T) {
+	b := &RequeueBackoff{Policy: backoffExponential, Delay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for _, tc := range []struct {
+		attempts uint16
+		max      time.Duration
+	}{{1, 100 * time.Millisecond}, {3, 400 * time.Millisecond}, {10, time.Second}} {
+		d := b.delay(tc.attempts, time.Hour)
+		test.Equal(t, true, d >= tc.max/2 && d <= tc.max)
+	}
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.MsgTimeout = 100 * time.Millisecond
+	opts.QueueScanRefreshInterval = 100 * time.Millisecond
+	_, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "test_requeue_backoff" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	channel := topic.GetChannel("channel")
+
+	test.NotNil(t, channel.SetRequeueBackoff(&RequeueBackoff{Policy: "linear"}))
+	test.NotNil(t, channel.SetRequeueBackoff(&RequeueBackoff{Policy: backoffFixed, Delay: 2 * opts.MaxReqTimeout}))
+
+	url := fmt.Sprintf("http://%s/channel/backoff?topic=%s&channel=channel&policy=fixed&delay=1m", httpAddr, topicName)
+	resp, err := http.Post(url, "application/octet-stream", nil)
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 200, resp.StatusCode)
+	test.Equal(t, time.Minute, channel.RequeueBackoff().Delay)
+
+	m, err := getMetadata(nsqd)
+	test.Nil(t, err)
+	test.Equal(t, backoffFixed, m.Topics[0].Channels[0].Config.RequeueBackoff.Policy)
+
+	// REQ with a timeout of -1 and timeouts are held back
+	minTs := time.Now().Add(time.Minute).UnixNano()
+	msg := NewMessage(topic.GenerateID(), []byte("test"))
+	channel.StartInFlightTimeout(msg, 0, time.Minute)
+	err = channel.RequeueMessage(0, msg.ID, -1)
+	test.Nil(t, err)
+	channel.StartInFlightTimeout(NewMessage(topic.GenerateID(), []byte("test")), 0, opts.MsgTimeout)
+	time.Sleep(4 * opts.MsgTimeout)
+
+	channel.deferredMutex.Lock()
+	test.Equal(t, 2, len(channel.deferredMessages))
+	test.Equal(t, true, channel.deferredMessages[msg.ID].Priority >= minTs)
+	channel.deferredMutex.Unlock()
+	test.Equal(t, int64(0), channel.Depth())
+
+	test.Nil(t, channel.SetRequeueBackoff(nil))
+	test.Nil(t, channel.RequeueBackoff())
+}
+
+func TestChannelMaxAttempts(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	_, _, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "test_channel_max_attempts" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	channel := topic.GetChannel("channel")
+	test.Nil(t, channel.SetConfig(ChannelConfig{MaxAttempts: 2}))
+
+	msg := NewMessage(topic.GenerateID(), []byte("test"))
+	msg.Attempts = 1
+	channel.StartInFlightTimeout(msg, 0, opts.MsgTimeout)
+	test.Nil(t, channel.RequeueMessage(0, msg.ID, 0))
+	test.Equal(t, int64(1), channel.Depth())
+	<-channel.memoryMsgChan
+
+	// the second attempt was the last one
+	msg.Attempts = 2
+	channel.StartInFlightTimeout(msg, 0, opts.MsgTimeout)
+	test.Nil(t, channel.RequeueMessage(0, msg.ID, 0))
+	test.Equal(t, int64(0), channel.Depth())
+	test.Equal(t, 0, len(channel.inFlightMessages))
+}
@@ -0,0 +1,70 @@
+package nsqd
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// kafkaClient tracks a Kafka producer connection for /stats
+type kafkaClient struct {
+	ID          int64
+	conn        net.Conn
+	connectTime time.Time
+
+	metaLock  sync.RWMutex
+	clientID  string
+	pubCounts map[string]uint64
+}
+
+func newKafkaClient(id int64, conn net.Conn) *kafkaClient {
+	return &kafkaClient{
+		ID:          id,
+		conn:        conn,
+		connectTime: time.Now(),
+		pubCounts:   make(map[string]uint64),
+	}
+}
+
+func (c *kafkaClient) String() string {
+	return c.conn.RemoteAddr().String()
+}
+
+func (c *kafkaClient) SetClientID(clientID string) {
+	c.metaLock.Lock()
+	c.clientID = clientID
+	c.metaLock.Unlock()
+}
+
+func (c *kafkaClient) PublishedMessage(topic string, count uint64) {
+	c.metaLock.Lock()
+	c.pubCounts[topic] += count
+	c.metaLock.Unlock()
+}
+
+func (c *kafkaClient) Stats() ClientStats {
+	c.metaLock.RLock()
+	clientID := c.clientID
+	pubCounts := make([]PubCount, 0, len(c.pubCounts))
+	for topic, count := range c.pubCounts {
+		pubCounts = append(pubCounts, PubCount{
+			Topic: topic,
+			Count: count,
+		})
+	}
+	c.metaLock.RUnlock()
+	hostname, _, _ := net.SplitHostPort(c.String())
+	return ClientStats{
+		Version:       "KAFKA",
+		RemoteAddress: c.String(),
+		ClientID:      clientID,
+		Hostname:      hostname,
+		State:         stateConnected,
+		ConnectTime:   c.connectTime.Unix(),
+		PubCounts:     pubCounts,
+	}
+}
+
+func (c *kafkaClient) IsProducer() bool {
+	return true
+}
@@ -0,0 +1,288 @@
+package nsqd
+
+import (
+	"bufio"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nsqio/nsq/internal/auth"
+)
+
+// the maximum number of un-acknowledged QoS 1 deliveries per MQTT connection,
+// this plays the role of the RDY count for V2 clients
+const mqttMaxInFlight = 64
+
+type mqttSubscription struct {
+	filter  string
+	qos     byte
+	channel *Channel
+
+	readyStateChan chan int
+	exitChan       chan int
+}
+
+// mqttPending tracks a QoS 1 delivery awaiting PUBACK
+type mqttPending struct {
+	channel *Channel
+	id      MessageID
+}
+
+type mqttClient struct {
+	// 64bit atomic vars need to be first for proper alignment on 32bit platforms
+	InFlightCount int64
+	MessageCount  uint64
+	FinishCount   uint64
+
+	pubCounts map[string]uint64
+
+	writeLock sync.Mutex
+	metaLock  sync.RWMutex
+
+	ID  int64
+	ctx *context
+
+	net.Conn
+	Reader *bufio.Reader
+	Writer *bufio.Writer
+
+	State        int32
+	ConnectTime  time.Time
+	ClientID     string
+	CleanSession bool
+	KeepAlive    time.Duration
+
+	subscriptions map[string]*mqttSubscription
+	pending       map[uint16]mqttPending
+	nextPacketID  uint16
+
+	AuthSecret string
+	AuthState  *auth.State
+}
+
+func newMQTTClient(id int64, conn net.Conn, ctx *context) *mqttClient {
+	return &mqttClient{
+		ID:  id,
+		ctx: ctx,
+
+		Conn:   conn,
+		Reader: bufio.NewReaderSize(conn, defaultBufferSize),
+		Writer: bufio.NewWriterSize(conn, defaultBufferSize),
+
+		State:       stateInit,
+		ConnectTime: time.Now(),
+
+		subscriptions: make(map[string]*mqttSubscription),
+		pending:       make(map[uint16]mqttPending),
+		pubCounts:     make(map[string]uint64),
+	}
+}
+
+func (c *mqttClient) String() string {
+	return c.RemoteAddr().String()
+}
+
+func (c *mqttClient) Stats() ClientStats {
+	c.metaLock.RLock()
+	clientID := c.ClientID
+	var identity string
+	var identityURL string
+	if c.AuthState != nil {
+		identity = c.AuthState.Identity
+		identityURL = c.AuthState.IdentityURL
+	}
+	pubCounts := make([]PubCount, 0, len(c.pubCounts))
+	for topic, count := range c.pubCounts {
+		pubCounts = append(pubCounts, PubCount{
+			Topic: topic,
+			Count: count,
+		})
+	}
+	c.metaLock.RUnlock()
+	hostname, _, _ := net.SplitHostPort(c.RemoteAddr().String())
+	return ClientStats{
+		Version:         "MQTT",
+		RemoteAddress:   c.RemoteAddr().String(),
+		ClientID:        clientID,
+		Hostname:        hostname,
+		UserAgent:       "mqtt/3.1.1",
+		State:           atomic.LoadInt32(&c.State),
+		ReadyCount:      mqttMaxInFlight,
+		InFlightCount:   atomic.LoadInt64(&c.InFlightCount),
+		MessageCount:    atomic.LoadUint64(&c.MessageCount),
+		FinishCount:     atomic.LoadUint64(&c.FinishCount),
+		ConnectTime:     c.ConnectTime.Unix(),
+		Authed:          c.HasAuthorizations(),
+		AuthIdentity:    identity,
+		AuthIdentityURL: identityURL,
+		PubCounts:       pubCounts,
+	}
+}
+
+func (c *mqttClient) IsProducer() bool {
+	c.metaLock.RLock()
+	retval := len(c.pubCounts) > 0 && len(c.subscriptions) == 0
+	c.metaLock.RUnlock()
+	return retval
+}
+
+func (c *mqttClient) IsReadyForMessages() bool {
+	return atomic.LoadInt64(&c.InFlightCount) < mqttMaxInFlight
+}
+
+func (c *mqttClient) readyForDispatch() bool {
+	return c.IsReadyForMessages()
+}
+
+func (c *mqttClient) inFlight() int64 {
+	return atomic.LoadInt64(&c.InFlightCount)
+}
+
+func (c *mqttClient) capacity() int64 {
+	return 0
+}
+
+func (c *mqttClient) tryUpdateReadyState() {
+	c.metaLock.RLock()
+	for _, sub := range c.subscriptions {
+		select {
+		case sub.readyStateChan <- 1:
+		default:
+		}
+	}
+	c.metaLock.RUnlock()
+}
+
+func (c *mqttClient) SendingMessage() {
+	atomic.AddInt64(&c.InFlightCount, 1)
+	atomic.AddUint64(&c.MessageCount, 1)
+}
+
+func (c *mqttClient) FinishedMessage() {
+	atomic.AddUint64(&c.FinishCount, 1)
+	atomic.AddInt64(&c.InFlightCount, -1)
+	c.tryUpdateReadyState()
+}
+
+func (c *mqttClient) PublishedMessage(topic string, count uint64) {
+	c.metaLock.Lock()
+	c.pubCounts[topic] += count
+	c.metaLock.Unlock()
+}
+
+// Consumer interface
+
+func (c *mqttClient) TimedOutMessage() {
+	atomic.AddInt64(&c.InFlightCount, -1)
+	c.prunePending()
+	c.tryUpdateReadyState()
+}
+
+// Empty forgets the deliveries of the emptied channel, the client's
+// subscriptions to other channels keep their messages in flight
+func (c *mqttClient) Empty(channel *Channel) {
+	c.metaLock.Lock()
+	for packetID, p := range c.pending {
+		if p.channel == channel {
+			delete(c.pending, packetID)
+			atomic.AddInt64(&c.InFlightCount, -1)
+		}
+	}
+	c.metaLock.Unlock()
+	c.tryUpdateReadyState()
+}
+
+func (c *mqttClient) Pause() {
+	c.tryUpdateReadyState()
+}
+
+func (c *mqttClient) UnPause() {
+	c.tryUpdateReadyState()
+}
+
+// allocates a packet identifier for an outgoing QoS 1 PUBLISH,
+// packet identifiers must be non-zero
+func (c *mqttClient) addPending(channel *Channel, id MessageID) uint16 {
+	c.metaLock.Lock()
+	c.nextPacketID++
+	if c.nextPacketID == 0 {
+		c.nextPacketID = 1
+	}
+	packetID := c.nextPacketID
+	c.pending[packetID] = mqttPending{channel, id}
+	c.metaLock.Unlock()
+	return packetID
+}
+
+func (c *mqttClient) popPending(packetID uint16) (mqttPending, bool) {
+	c.metaLock.Lock()
+	p, ok := c.pending[packetID]
+	delete(c.pending, packetID)
+	c.metaLock.Unlock()
+	return p, ok
+}
+
+// prunePending forgets the deliveries that are no longer in flight to this
+// client, the PUBACK of a timed out message would not be accepted anyway
+func (c *mqttClient) prunePending() {
+	c.metaLock.Lock()
+	for packetID, p := range c.pending {
+		if !p.channel.isInFlight(c.ID, p.id) {
+			delete(c.pending, packetID)
+		}
+	}
+	c.metaLock.Unlock()
+}
+
+func (c *mqttClient) QueryAuthd() error {
+	remoteIP, _, err := net.SplitHostPort(c.String())
+	if err != nil {
+		return err
+	}
+
+	authState, err := auth.QueryAnyAuthd(c.ctx.nsqd.getOpts().AuthHTTPAddresses,
+		remoteIP, false, "", c.AuthSecret,
+		c.ctx.nsqd.getOpts().HTTPClientConnectTimeout,
+		c.ctx.nsqd.getOpts().HTTPClientRequestTimeout)
+	if err != nil {
+		return err
+	}
+	c.metaLock.Lock()
+	c.AuthState = authState
+	c.metaLock.Unlock()
+	return nil
+}
+
+func (c *mqttClient) Auth(secret string) error {
+	c.AuthSecret = secret
+	return c.QueryAuthd()
+}
+
+func (c *mqttClient) IsAuthorized(topic, channel string) (bool, error) {
+	c.metaLock.RLock()
+	authState := c.AuthState
+	c.metaLock.RUnlock()
+	if authState == nil {
+		return false, nil
+	}
+	if authState.IsExpired() {
+		err := c.QueryAuthd()
+		if err != nil {
+			return false, err
+		}
+		c.metaLock.RLock()
+		authState = c.AuthState
+		c.metaLock.RUnlock()
+	}
+	return authState.IsAllowed(topic, channel), nil
+}
+
+func (c *mqttClient) HasAuthorizations() bool {
+	c.metaLock.RLock()
+	defer c.metaLock.RUnlock()
+	if c.AuthState != nil {
+		return len(c.AuthState.Authorizations) != 0
+	}
+	return false
+}
@@ -38,13 +38,13 @@ type identifyDataV2 struct {
 	SampleRate          int32  `json:"sample_rate"`
 	UserAgent           string `json:"user_agent"`
 	MsgTimeout          int    `json:"msg_timeout"`
+	Capacity            int64  `json:"capacity"`
 }
 
 type identifyEvent struct {
 	OutputBufferTimeout time.Duration
 	HeartbeatInterval   time.Duration
 	SampleRate          int32
-	MsgTimeout          time.Duration
 }
 
 type clientV2 struct {
@@ -54,6 +54,7 @@ type clientV2 struct {
 	MessageCount  uint64
 	FinishCount   uint64
 	RequeueCount  uint64
+	Capacity      int64 //IDENTIFY声明的处理能力，用于按权重分发
 
 	pubCounts map[string]uint64
 
@@ -80,7 +81,8 @@ type clientV2 struct {
 
 	HeartbeatInterval time.Duration
 
-	MsgTimeout time.Duration
+	MsgTimeout    time.Duration
+	msgTimeoutSet bool //IDENTIFY指定了msg_timeout，否则使用channel的
 
 	State          int32
 	ConnectTime    time.Time
@@ -183,11 +185,15 @@ func (c *clientV2) Identify(data identifyDataV2) error {
 		return err
 	}
 
+	err = c.SetCapacity(data.Capacity)
+	if err != nil {
+		return err
+	}
+
 	ie := identifyEvent{
 		OutputBufferTimeout: c.OutputBufferTimeout,
 		HeartbeatInterval:   c.HeartbeatInterval,
 		SampleRate:          c.SampleRate,
-		MsgTimeout:          c.MsgTimeout,
 	}
 
 	// update the client's message pump
@@ -227,6 +233,7 @@ func (c *clientV2) Stats() ClientStats {
 		State:           atomic.LoadInt32(&c.State),
 		ReadyCount:      atomic.LoadInt64(&c.ReadyCount),
 		InFlightCount:   atomic.LoadInt64(&c.InFlightCount),
+		Capacity:        atomic.LoadInt64(&c.Capacity),
 		MessageCount:    atomic.LoadUint64(&c.MessageCount),
 		FinishCount:     atomic.LoadUint64(&c.FinishCount),
 		RequeueCount:    atomic.LoadUint64(&c.RequeueCount),
@@ -347,7 +354,7 @@ func (c *clientV2) FinishedMessage() {
 	c.tryUpdateReadyState()
 }
 
-func (c *clientV2) Empty() {
+func (c *clientV2) Empty(channel *Channel) {
 	atomic.StoreInt64(&c.InFlightCount, 0)
 	c.tryUpdateReadyState()
 }
@@ -468,6 +475,7 @@ func (c *clientV2) SetMsgTimeout(msgTimeout int) error {
 	case msgTimeout >= 1000 &&
 		msgTimeout <= int(c.ctx.nsqd.getOpts().MaxMsgTimeout/time.Millisecond):
 		c.MsgTimeout = time.Duration(msgTimeout) * time.Millisecond
+		c.msgTimeoutSet = true
 	default:
 		return fmt.Errorf("msg timeout (%d) is invalid", msgTimeout)
 	}
@@ -475,6 +483,38 @@ func (c *clientV2) SetMsgTimeout(msgTimeout int) error {
 	return nil
 }
 
+// SetCapacity sets the relative share of messages the client gets from channels
+// that distribute messages by weight
+func (c *clientV2) SetCapacity(capacity int64) error {
+	if capacity < 0 {
+		return fmt.Errorf("capacity (%d) is invalid", capacity)
+	}
+	atomic.StoreInt64(&c.Capacity, capacity)
+	return nil
+}
+
+// msgTimeout returns the timeout of the messages sent to the client, the one it
+// asked for in IDENTIFY or else its channel's
+func (c *clientV2) msgTimeout() time.Duration {
+	if c.msgTimeoutSet || c.Channel == nil {
+		return c.MsgTimeout
+	}
+	return c.Channel.getMsgTimeout()
+}
+
+// readyForDispatch is IsReadyForMessages for a client that may not have finished subscribing
+func (c *clientV2) readyForDispatch() bool {
+	return atomic.LoadInt32(&c.State) == stateSubscribed && c.IsReadyForMessages()
+}
+
+func (c *clientV2) inFlight() int64 {
+	return atomic.LoadInt64(&c.InFlightCount)
+}
+
+func (c *clientV2) capacity() int64 {
+	return atomic.LoadInt64(&c.Capacity)
+}
+
 func (c *clientV2) UpgradeTLS() error {
 	c.writeLock.Lock()
 	defer c.writeLock.Unlock()
@@ -0,0 +1,225 @@
+package nsqd
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/nsqio/go-diskqueue"
+)
+
+// TopicConfig is the configuration of a topic that is kept in the metadata with
+// it, zero values leave nsqd's options in effect
+type TopicConfig struct {
+	Compression  string `json:"compression,omitempty"`    //磁盘队列的压缩方式，--disk-compression
+	MemQueueSize *int64 `json:"mem_queue_size,omitempty"` //topic及其channel内存队列的长度，--mem-queue-size
+	MaxMsgSize   int64  `json:"max_msg_size,omitempty"`   //发布的消息的最大长度，--max-msg-size
+}
+
+// ChannelConfig is the configuration of a channel that is kept in the metadata
+// with it, zero values leave nsqd's options in effect
+type ChannelConfig struct {
+	MsgTimeout     time.Duration   `json:"msg_timeout,omitempty"`     //未在IDENTIFY指定msg_timeout的消费者使用，--msg-timeout
+	MaxAttempts    uint16          `json:"max_attempts,omitempty"`    //投递次数达到后超时或重新投递的消息被丢弃，0表示不限
+	DispatchPolicy string          `json:"dispatch_policy,omitempty"` //分发策略，--channel-dispatch-policy
+	RequeueBackoff *RequeueBackoff `json:"requeue_backoff,omitempty"` //重新投递的等待策略
+}
+
+// Config returns the topic's configuration
+func (t *Topic) Config() TopicConfig {
+	var cfg TopicConfig
+	if t.hasCompression() {
+		cfg.Compression = t.Compression().String()
+	}
+	if size := atomic.LoadInt64(&t.memQueueSize); size >= 0 {
+		cfg.MemQueueSize = &size
+	}
+	cfg.MaxMsgSize = atomic.LoadInt64(&t.maxMsgSize)
+	return cfg
+}
+
+// SetConfig replaces the topic's configuration, it is validated as a whole
+// before any of it is applied
+func (t *Topic) SetConfig(cfg TopicConfig) error {
+	compression := diskqueue.Compression(-1)
+	if cfg.Compression != "" {
+		c, err := diskqueue.ParseCompression(cfg.Compression)
+		if err != nil {
+			return err
+		}
+		compression = c
+	}
+	memQueueSize := int64(-1)
+	if cfg.MemQueueSize != nil {
+		if *cfg.MemQueueSize < 0 {
+			return fmt.Errorf("mem queue size (%d) is negative", *cfg.MemQueueSize)
+		}
+		memQueueSize = *cfg.MemQueueSize
+	}
+	err := checkMaxMsgSize(cfg.MaxMsgSize, t.ctx.nsqd.getOpts().MaxBodySize)
+	if err != nil {
+		return err
+	}
+
+	if compression < 0 {
+		atomic.StoreInt32(&t.compression, -1)
+		compression = t.ctx.nsqd.diskCompression()
+		setBackendCompression(t.backend, compression)
+		t.RLock()
+		for _, channel := range t.channelMap {
+			setBackendCompression(channel.backend, compression)
+		}
+		t.RUnlock()
+	} else {
+		t.SetCompression(compression)
+	}
+	t.setMaxMsgSize(cfg.MaxMsgSize)
+	t.setMemQueueSize(memQueueSize)
+	return nil
+}
+
+// MemQueueSize returns how many messages the topic and each of its channels
+// keep in memory
+func (t *Topic) MemQueueSize() int64 {
+	if size := atomic.LoadInt64(&t.memQueueSize); size >= 0 {
+		return size
+	}
+	return t.ctx.nsqd.getOpts().MemQueueSize
+}
+
+// setMemQueueSize resizes the in-memory queues of the topic and its channels,
+// -1 goes back to --mem-queue-size
+func (t *Topic) setMemQueueSize(size int64) {
+	atomic.StoreInt64(&t.memQueueSize, size)
+	size = t.MemQueueSize()
+
+	t.RLock()
+	channels := make([]*Channel, 0, len(t.channelMap))
+	for _, channel := range t.channelMap {
+		channels = append(channels, channel)
+	}
+	t.RUnlock()
+
+	for _, channel := range channels {
+		channel.resizeMemoryMsgChan(size)
+	}
+	t.resizeMemoryMsgChan(size)
+}
+
+// checkMaxMsgSize validates the max msg size of a topic's configuration
+func checkMaxMsgSize(size int64, maxBodySize int64) error {
+	if size < 0 {
+		return fmt.Errorf("max msg size (%d) is negative", size)
+	}
+	if size > maxBodySize {
+		return fmt.Errorf("max msg size (%d) is greater than --max-body-size (%d)", size, maxBodySize)
+	}
+	if size > maxValidMsgSize {
+		return fmt.Errorf("max msg size (%d) is greater than %d", size, maxValidMsgSize)
+	}
+	return nil
+}
+
+// MaxMsgSize returns the size limit of the messages published to the topic
+func (t *Topic) MaxMsgSize() int64 {
+	if size := atomic.LoadInt64(&t.maxMsgSize); size > 0 {
+		return size
+	}
+	return t.ctx.nsqd.getOpts().MaxMsgSize
+}
+
+// backendMsgSize returns the size limit the backends of the topic and its
+// channels are created with, they never go below --max-msg-size
+func (t *Topic) backendMsgSize() int64 {
+	size := t.MaxMsgSize()
+	if optsSize := t.ctx.nsqd.getOpts().MaxMsgSize; optsSize > size {
+		size = optsSize
+	}
+	return size
+}
+
+// setMaxMsgSize changes the size limit of the messages published to the topic,
+// 0 goes back to --max-msg-size. The backends only ever raise their limit so
+// that the messages already written can still be read
+func (t *Topic) setMaxMsgSize(size int64) {
+	atomic.StoreInt64(&t.maxMsgSize, size)
+	size = t.MaxMsgSize()
+
+	setBackendMaxMsgSize(t.backend, size)
+	t.RLock()
+	for _, channel := range t.channelMap {
+		setBackendMaxMsgSize(channel.backend, size)
+	}
+	t.RUnlock()
+}
+
+// Config returns the channel's configuration
+func (c *Channel) Config() ChannelConfig {
+	cfg := ChannelConfig{
+		MsgTimeout:  time.Duration(atomic.LoadInt64(&c.msgTimeout)),
+		MaxAttempts: uint16(atomic.LoadInt32(&c.maxAttempts)),
+	}
+	if c.hasDispatchPolicy() {
+		cfg.DispatchPolicy = c.DispatchPolicy()
+	}
+	if b := c.RequeueBackoff(); b != nil {
+		// a copy, the config may be changed and set again
+		backoff := *b
+		cfg.RequeueBackoff = &backoff
+	}
+	return cfg
+}
+
+// SetConfig replaces the channel's configuration, it is validated as a whole
+// before any of it is applied
+func (c *Channel) SetConfig(cfg ChannelConfig) error {
+	opts := c.ctx.nsqd.getOpts()
+	if cfg.MsgTimeout != 0 && (cfg.MsgTimeout < time.Second || cfg.MsgTimeout > opts.MaxMsgTimeout) {
+		return fmt.Errorf("msg timeout (%s) is out of range 1s-%s", cfg.MsgTimeout, opts.MaxMsgTimeout)
+	}
+	dispatch := int32(-1)
+	if cfg.DispatchPolicy != "" {
+		if c.broadcast {
+			return errBroadcastDispatch
+		}
+		policy, err := parseDispatchPolicy(cfg.DispatchPolicy)
+		if err != nil {
+			return err
+		}
+		dispatch = policy
+	}
+	if cfg.RequeueBackoff != nil {
+		if err := cfg.RequeueBackoff.validate(opts.MaxReqTimeout); err != nil {
+			return err
+		}
+	}
+
+	atomic.StoreInt64(&c.msgTimeout, int64(cfg.MsgTimeout))
+	atomic.StoreInt32(&c.maxAttempts, int32(cfg.MaxAttempts))
+	c.SetRequeueBackoff(cfg.RequeueBackoff)
+	if !c.broadcast {
+		c.setDispatchPolicy(dispatch)
+	}
+	return nil
+}
+
+// getMsgTimeout returns the timeout of messages sent to consumers that did not
+// IDENTIFY one
+func (c *Channel) getMsgTimeout() time.Duration {
+	if t := atomic.LoadInt64(&c.msgTimeout); t > 0 {
+		return time.Duration(t)
+	}
+	return c.ctx.nsqd.getOpts().MsgTimeout
+}
+
+// exhausted reports whether msg was delivered as often as the channel allows,
+// it is dropped instead of being requeued
+func (c *Channel) exhausted(msg *Message) bool {
+	maxAttempts := atomic.LoadInt32(&c.maxAttempts)
+	if maxAttempts <= 0 || int32(msg.Attempts) < maxAttempts {
+		return false
+	}
+	c.ctx.nsqd.logf(LOG_WARN, "CHANNEL(%s): dropping message %s after %d attempts",
+		c.name, msg.ID, msg.Attempts)
+	return true
+}
@@ -0,0 +1,427 @@
+package nsqd
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// channel distribution policies
+//
+// with dispatchAny the consumers of a channel read its queues directly and each
+// message goes to whichever consumer reads it first, the other policies have a
+// dispatcher goroutine read the queues and choose the consumer of each message
+const (
+	dispatchAny           = iota // first consumer to read
+	dispatchRoundRobin           // consumers in turn
+	dispatchLeastInFlight        // consumer with the fewest messages in flight
+	dispatchWeighted             // least messages in flight relative to the capacity from IDENTIFY
+	dispatchBroadcast            // every consumer, the policy of #broadcast channels
+)
+
+var dispatchPolicyNames = []string{"any", "round-robin", "least-in-flight", "weighted", "broadcast"}
+
+var errBroadcastDispatch = errors.New("broadcast channels deliver every message to every consumer")
+
+func parseDispatchPolicy(s string) (int32, error) {
+	if s == "" {
+		return dispatchAny, nil
+	}
+	for i, name := range dispatchPolicyNames {
+		if s == name && i != dispatchBroadcast {
+			return int32(i), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid dispatch policy %q", s)
+}
+
+func dispatchPolicyName(policy int32) string {
+	return dispatchPolicyNames[policy]
+}
+
+// dispatchConsumer is implemented by consumers that can be handed messages by
+// a channel's dispatcher
+type dispatchConsumer interface {
+	readyForDispatch() bool
+	inFlight() int64
+	capacity() int64
+}
+
+// how long a dispatched message waits for its consumers before they are looked
+// up again (consumers may have come and gone)
+const dispatchRetryInterval = 100 * time.Millisecond
+
+// DispatchPolicy returns the name of the channel's distribution policy
+func (c *Channel) DispatchPolicy() string {
+	return dispatchPolicyName(c.dispatchPolicy())
+}
+
+func (c *Channel) dispatchPolicy() int32 {
+	if c.broadcast {
+		return dispatchBroadcast
+	}
+	policy := atomic.LoadInt32(&c.dispatch)
+	if policy < 0 {
+		policy, _ = parseDispatchPolicy(c.ctx.nsqd.getOpts().ChannelDispatchPolicy)
+	}
+	return policy
+}
+
+// hasDispatchPolicy reports whether the policy was set for this channel
+func (c *Channel) hasDispatchPolicy() bool {
+	return atomic.LoadInt32(&c.dispatch) >= 0
+}
+
+// isDispatching reports whether messages are handed to consumers by the dispatcher
+func (c *Channel) isDispatching() bool {
+	return c.dispatchPolicy() != dispatchAny
+}
+
+// SetDispatchPolicy changes how messages are distributed among the channel's consumers
+func (c *Channel) SetDispatchPolicy(name string) error {
+	if c.broadcast {
+		return errBroadcastDispatch
+	}
+	policy, err := parseDispatchPolicy(name)
+	if err != nil {
+		return err
+	}
+	c.setDispatchPolicy(policy)
+	return nil
+}
+
+// setDispatchPolicy changes the policy, -1 for --channel-dispatch-policy
+func (c *Channel) setDispatchPolicy(policy int32) {
+	atomic.StoreInt32(&c.dispatch, policy)
+	c.startDispatcher()
+	c.dispatchUpdated()
+}
+
+// startDispatcher starts the dispatcher the first time a policy needs it
+func (c *Channel) startDispatcher() {
+	if !c.isDispatching() {
+		return
+	}
+	c.exitMutex.RLock()
+	defer c.exitMutex.RUnlock()
+	if c.Exiting() {
+		return
+	}
+	c.dispatcherOnce.Do(func() {
+		c.dispatchWaitGroup.Wrap(c.dispatchLoop)
+	})
+}
+
+// dispatchUpdated wakes up the consumers and the dispatcher to pick up a new
+// policy or set of consumers
+func (c *Channel) dispatchUpdated() {
+	c.dispatchMutex.Lock()
+	close(c.dispatchUpdateChan)
+	c.dispatchUpdateChan = make(chan int)
+	c.dispatchMutex.Unlock()
+}
+
+// dispatchUpdate returns a channel that is closed on the next dispatchUpdated
+func (c *Channel) dispatchUpdate() chan int {
+	c.dispatchMutex.Lock()
+	defer c.dispatchMutex.Unlock()
+	return c.dispatchUpdateChan
+}
+
+// dispatchChan returns the channel a consumer receives dispatched messages on
+func (c *Channel) dispatchChan(clientID int64) chan *Message {
+	c.dispatchMutex.Lock()
+	defer c.dispatchMutex.Unlock()
+	return c.dispatchChans[clientID]
+}
+
+// dispatchChanSize is the number of messages a consumer's dispatch chan buffers,
+// a broadcast channel queues the copies for each of its consumers there
+func (c *Channel) dispatchChanSize() int64 {
+	if c.broadcast {
+		return c.ctx.nsqd.getOpts().MemQueueSize
+	}
+	return 0
+}
+
+// dispatchReady tells the dispatcher that a consumer is waiting for a message
+func (c *Channel) dispatchReady() {
+	select {
+	case c.dispatchReadyChan <- 1:
+	default:
+	}
+}
+
+type dispatchTarget struct {
+	id     int64
+	client dispatchConsumer
+	ch     chan *Message
+}
+
+// dispatchTargets returns the consumers that are ready for a message, in the
+// order the policy prefers them
+func (c *Channel) dispatchTargets(policy int32) []dispatchTarget {
+	c.RLock()
+	c.dispatchMutex.Lock()
+	targets := make([]dispatchTarget, 0, len(c.clients))
+	for id, client := range c.clients {
+		dc, ok := client.(dispatchConsumer)
+		if !ok || !dc.readyForDispatch() {
+			continue
+		}
+		targets = append(targets, dispatchTarget{id, dc, c.dispatchChans[id]})
+	}
+	c.dispatchMutex.Unlock()
+	c.RUnlock()
+
+	// round-robin order starting after the last consumer, it breaks ties of the
+	// other policies
+	last := c.dispatchLast
+	sort.Slice(targets, func(i, j int) bool {
+		a, b := targets[i].id, targets[j].id
+		if (a > last) != (b > last) {
+			return a > last
+		}
+		return a < b
+	})
+	switch policy {
+	case dispatchLeastInFlight:
+		sort.SliceStable(targets, func(i, j int) bool {
+			return targets[i].client.inFlight() < targets[j].client.inFlight()
+		})
+	case dispatchWeighted:
+		// compare inFlight(i)/capacity(i) < inFlight(j)/capacity(j)
+		sort.SliceStable(targets, func(i, j int) bool {
+			a, b := targets[i].client, targets[j].client
+			return a.inFlight()*weight(b) < b.inFlight()*weight(a)
+		})
+	}
+	return targets
+}
+
+// hasDispatchTarget reports whether a consumer is ready for a message
+func (c *Channel) hasDispatchTarget() bool {
+	c.RLock()
+	defer c.RUnlock()
+	if c.broadcast {
+		// copies are queued for the consumers that are not ready
+		return len(c.clients) > 0
+	}
+	for _, client := range c.clients {
+		if dc, ok := client.(dispatchConsumer); ok && dc.readyForDispatch() {
+			return true
+		}
+	}
+	return false
+}
+
+// weight is the capacity of a consumer, consumers that did not declare one count as 1
+func weight(client dispatchConsumer) int64 {
+	if client.capacity() > 0 {
+		return client.capacity()
+	}
+	return 1
+}
+
+// dispatchLoop hands the messages of the channel to its consumers one at a
+// time, a message is only read once a consumer is waiting for one
+func (c *Channel) dispatchLoop() {
+	var memoryMsgChan chan *Message
+	var backendMsgChan chan []byte
+	var readyChan chan int
+
+	for {
+		update := c.dispatchUpdate()
+		if !c.isDispatching() {
+			memoryMsgChan = nil
+			backendMsgChan = nil
+			readyChan = nil
+		} else if c.IsPaused() || !c.hasDispatchTarget() {
+			// wait for a consumer to become ready
+			memoryMsgChan = nil
+			backendMsgChan = nil
+			readyChan = c.dispatchReadyChan
+		} else {
+			memoryMsgChan = c.getMemoryMsgChan()
+			backendMsgChan = c.backend.ReadChan()
+			readyChan = nil
+		}
+
+		var msg *Message
+		select {
+		case <-readyChan:
+			continue
+		case msg = <-memoryMsgChan:
+		case b := <-backendMsgChan:
+			var err error
+			msg, err = decodeMessage(b)
+			if err != nil {
+				c.ctx.nsqd.logf(LOG_ERROR, "failed to decode message - %s", err)
+				continue
+			}
+		case <-update:
+			continue
+		case <-c.dispatchExitChan:
+			return
+		}
+
+		if !c.dispatchMessage(msg) {
+			// exiting or no longer dispatching, give it back to the queues
+			c.put(msg)
+			if c.Exiting() {
+				return
+			}
+		}
+	}
+}
+
+// dispatchMessage sends msg to the consumer the policy prefers, it returns
+// false when the channel exits or stops dispatching first
+func (c *Channel) dispatchMessage(msg *Message) bool {
+	for {
+		policy := c.dispatchPolicy()
+		if policy == dispatchAny {
+			return false
+		}
+		if policy == dispatchBroadcast {
+			return c.broadcastMessage(msg)
+		}
+
+		// waiting for the preferred consumer rather than handing msg to whichever
+		// one is waiting first keeps the distribution independent of how the
+		// consumers' goroutines happen to be scheduled
+		var ch chan *Message
+		var readyChan chan int
+		targets := c.dispatchTargets(policy)
+		if len(targets) > 0 {
+			ch = targets[0].ch
+		} else {
+			readyChan = c.dispatchReadyChan
+		}
+
+		select {
+		case ch <- msg:
+			c.dispatchLast = targets[0].id
+			return true
+		case <-readyChan:
+		case <-c.dispatchUpdate():
+		case <-time.After(dispatchRetryInterval):
+		case <-c.dispatchExitChan:
+			return false
+		}
+	}
+}
+
+// broadcastMessage queues a copy of msg for every consumer of the channel. A
+// consumer whose queue is full does not hold up the others, its copy is
+// dropped. It returns false when the channel exits before there is a consumer.
+func (c *Channel) broadcastMessage(msg *Message) bool {
+	for {
+		update := c.dispatchUpdate()
+		var targets []dispatchTarget
+		c.RLock()
+		c.dispatchMutex.Lock()
+		for id := range c.clients {
+			targets = append(targets, dispatchTarget{id: id, ch: c.dispatchChans[id]})
+		}
+		c.dispatchMutex.Unlock()
+		c.RUnlock()
+
+		if len(targets) > 0 {
+			for _, t := range targets {
+				select {
+				case t.ch <- c.broadcastCopy(msg):
+				default:
+					atomic.AddUint64(&c.broadcastDropCount, 1)
+					c.ctx.nsqd.logf(LOG_DEBUG, "CHANNEL(%s): client(%d) is not keeping up, dropped a copy of %s",
+						c.name, t.id, msg.ID)
+				}
+			}
+			return true
+		}
+
+		select {
+		case <-c.dispatchReadyChan:
+		case <-update:
+		case <-time.After(dispatchRetryInterval):
+		case <-c.dispatchExitChan:
+			return false
+		}
+	}
+}
+
+// dispatchDepth returns the number of copies queued for the consumers of a
+// broadcast channel
+func (c *Channel) dispatchDepth() int64 {
+	if !c.broadcast {
+		return 0
+	}
+	c.dispatchMutex.Lock()
+	defer c.dispatchMutex.Unlock()
+	var depth int64
+	for _, ch := range c.dispatchChans {
+		depth += int64(len(ch))
+	}
+	return depth
+}
+
+// emptyDispatchChans drops the copies queued for the consumers of a broadcast
+// channel
+func (c *Channel) emptyDispatchChans() {
+	if !c.broadcast {
+		return
+	}
+	c.dispatchMutex.Lock()
+	defer c.dispatchMutex.Unlock()
+	for _, ch := range c.dispatchChans {
+	drain:
+		for {
+			select {
+			case <-ch:
+			default:
+				break drain
+			}
+		}
+	}
+}
+
+// broadcastCopy returns the copy of msg a consumer of a broadcast channel gets,
+// each copy has its own ID so that its consumer can FIN, REQ or TOUCH it
+func (c *Channel) broadcastCopy(msg *Message) *Message {
+	var id guid
+	var err error
+	for {
+		id, err = c.idFactory.NewGUID()
+		if err == nil {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	m := NewMessage(id.Hex(), msg.Body)
+	m.Timestamp = msg.Timestamp
+	m.Attempts = msg.Attempts
+	return m
+}
+
+// isBroadcastCopy reports whether msg is a copy of a message of a broadcast
+// channel, the messages of a broadcast channel only go in flight as copies
+func (c *Channel) isBroadcastCopy(msg *Message) bool {
+	return c.broadcast && msg.clientID != 0
+}
+
+// redeliver queues a copy again for the consumer it was sent to, it is dropped
+// if the consumer is gone
+func (c *Channel) redeliver(msg *Message) {
+	ch := c.dispatchChan(msg.clientID)
+	if ch == nil {
+		return
+	}
+	select {
+	case ch <- msg:
+	default:
+		// the consumer's queue is full, try again later
+		c.StartDeferredTimeout(msg, dispatchRetryInterval)
+	}
+}
@@ -0,0 +1,91 @@
+package nsqd
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Drain puts nsqd into the draining state: it unregisters from nsqlookupd, rejects
+// new publishes with a retryable error and keeps serving consumers until every
+// channel is empty or timeout passes, at which point Main returns.
+//
+// Calling Drain on an nsqd that is already draining has no effect.
+func (n *NSQD) Drain(timeout time.Duration) {
+	if !atomic.CompareAndSwapInt32(&n.draining, 0, 1) {
+		return
+	}
+	n.drainDeadline.Store(time.Now().Add(timeout))
+	n.logf(LOG_INFO, "NSQ: draining, exiting within %s", timeout)
+	close(n.drainChan)
+}
+
+func (n *NSQD) IsDraining() bool {
+	return atomic.LoadInt32(&n.draining) == 1
+}
+
+// GetDrainDeadline returns the time at which a drain gives up waiting on consumers,
+// the zero time when not draining
+func (n *NSQD) GetDrainDeadline() time.Time {
+	deadline, _ := n.drainDeadline.Load().(time.Time)
+	return deadline
+}
+
+// isDrained reports whether every channel has been fully consumed. Messages on
+// topics without channels have nowhere to go and are left on disk.
+func (n *NSQD) isDrained() bool {
+	n.RLock()
+	defer n.RUnlock()
+	for _, t := range n.topicMap {
+		t.RLock()
+		numChannels := len(t.channelMap)
+		for _, c := range t.channelMap {
+			if c.Depth() > 0 {
+				t.RUnlock()
+				return false
+			}
+			c.inFlightMutex.Lock()
+			inFlight := len(c.inFlightMessages)
+			c.inFlightMutex.Unlock()
+			c.deferredMutex.Lock()
+			deferred := len(c.deferredMessages)
+			c.deferredMutex.Unlock()
+			if inFlight > 0 || deferred > 0 {
+				t.RUnlock()
+				return false
+			}
+		}
+		t.RUnlock()
+		if numChannels > 0 && t.Depth() > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// drainLoop waits for a drain to start and then for it to finish, it returns
+// true when nsqd should exit
+func (n *NSQD) drainLoop() bool {
+	select {
+	case <-n.drainChan:
+	case <-n.exitChan:
+		return false
+	}
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if n.isDrained() {
+				n.logf(LOG_INFO, "NSQ: drained")
+				return true
+			}
+			if time.Now().After(n.GetDrainDeadline()) {
+				n.logf(LOG_WARN, "NSQ: drain deadline passed, exiting with messages remaining")
+				return true
+			}
+		case <-n.exitChan:
+			return false
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package nsqd
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nsqio/go-nsq"
+	"github.com/nsqio/nsq/internal/http_api"
+	"github.com/nsqio/nsq/internal/test"
+	"github.com/nsqio/nsq/nsqlookupd"
+)
+
+func TestDrain(t *testing.T) {
+	lopts := nsqlookupd.NewOptions()
+	lopts.Logger = test.NewTestLogger(t)
+	lopts.BroadcastAddress = "127.0.0.1"
+	_, _, lookupd := mustStartNSQLookupd(lopts)
+	defer lookupd.Exit()
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.NSQLookupdTCPAddresses = []string{lookupd.RealTCPAddr().String()}
+	opts.BroadcastAddress = "127.0.0.1"
+	tcpAddr, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "test_drain" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	channel := topic.GetChannel("ch")
+	channel.PutMessage(NewMessage(topic.GenerateID(), []byte("test")))
+
+	// allow some time for nsqd to push info to nsqlookupd
+	time.Sleep(350 * time.Millisecond)
+
+	var lr struct {
+		Producers []struct{} `json:"producers"`
+	}
+	endpoint := fmt.Sprintf("http://%s/lookup?topic=%s", lookupd.RealHTTPAddr(), topicName)
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+	err := client.GETV1(endpoint, &lr)
+	test.Nil(t, err)
+	test.Equal(t, 1, len(lr.Producers))
+
+	err = client.POSTV1(fmt.Sprintf("http://%s/drain?timeout=10s", httpAddr))
+	test.Nil(t, err)
+	test.Equal(t, true, nsqd.IsDraining())
+
+	var info struct {
+		Draining bool `json:"draining"`
+	}
+	err = client.GETV1(fmt.Sprintf("http://%s/info", httpAddr), &info)
+	test.Nil(t, err)
+	test.Equal(t, true, info.Draining)
+
+	resp, err := http.Post(fmt.Sprintf("http://%s/pub?topic=%s", httpAddr, topicName),
+		"application/octet-stream", bytes.NewBufferString("test"))
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 503, resp.StatusCode)
+
+	// the error is not fatal, the connection remains usable
+	conn, err := mustConnectNSQD(tcpAddr)
+	test.Nil(t, err)
+	defer conn.Close()
+	_, err = nsq.Publish(topicName, []byte("test")).WriteTo(conn)
+	test.Nil(t, err)
+	readValidate(t, conn, frameTypeError, "E_DRAINING PUB failed, nsqd is draining")
+	_, err = nsq.Nop().WriteTo(conn)
+	test.Nil(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+	err = client.GETV1(endpoint, &lr)
+	test.Nil(t, err)
+	test.Equal(t, 0, len(lr.Producers))
+
+	done := make(chan bool)
+	go func() {
+		done <- nsqd.drainLoop()
+	}()
+	select {
+	case <-done:
+		t.Fatal("drain finished with messages remaining")
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	// consumers are still served
+	sub(t, conn, topicName, "ch")
+	_, err = nsq.Ready(1).WriteTo(conn)
+	test.Nil(t, err)
+	frame, err := nsq.ReadResponse(conn)
+	test.Nil(t, err)
+	frameType, data, err := nsq.UnpackResponse(frame)
+	test.Nil(t, err)
+	test.Equal(t, frameTypeMessage, frameType)
+	msg, err := decodeMessage(data)
+	test.Nil(t, err)
+	_, err = nsq.Finish(nsq.MessageID(msg.ID)).WriteTo(conn)
+	test.Nil(t, err)
+
+	select {
+	case exit := <-done:
+		test.Equal(t, true, exit)
+	case <-time.After(time.Second):
+		t.Fatal("drain did not finish")
+	}
+}
+
+func TestDrainDeadline(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	_, _, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topic := nsqd.GetTopic("test_drain_deadline")
+	channel := topic.GetChannel("ch")
+	channel.PutMessage(NewMessage(topic.GenerateID(), []byte("test")))
+
+	nsqd.Drain(200 * time.Millisecond)
+	start := time.Now()
+	test.Equal(t, true, nsqd.drainLoop())
+	test.Equal(t, true, time.Since(start) >= 100*time.Millisecond)
+	test.Equal(t, int64(1), channel.Depth())
+}
@@ -12,6 +12,10 @@ func (d *dummyBackendQueue) Put([]byte) error {
 	return nil
 }
 
+func (d *dummyBackendQueue) PutSync([]byte) error {
+	return nil
+}
+
 func (d *dummyBackendQueue) ReadChan() chan []byte {
 	return d.readChan
 }
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/julienschmidt/httprouter"
+	"github.com/nsqio/go-diskqueue"
 	"github.com/nsqio/nsq/internal/http_api"
 	"github.com/nsqio/nsq/internal/lg"
 	"github.com/nsqio/nsq/internal/protocol"
@@ -40,6 +41,8 @@ type httpServer struct {
 
 func newHTTPServer(ctx *context, tlsEnabled bool, tlsRequired bool) *httpServer {
 	log := http_api.Log(ctx.nsqd.logf)
+	rateLimit := ctx.nsqd.adminRateLimit
+	audited := ctx.nsqd.audit.Audited
 
 	router := httprouter.New()
 	router.HandleMethodNotAllowed = true
@@ -55,6 +58,9 @@ func newHTTPServer(ctx *context, tlsEnabled bool, tlsRequired bool) *httpServer
 
 	router.Handle("GET", "/ping", http_api.Decorate(s.pingHandler, log, http_api.PlainText))
 	router.Handle("GET", "/info", http_api.Decorate(s.doInfo, log, http_api.V1))
+	router.Handle("POST", "/drain", http_api.Decorate(s.doDrain, rateLimit, audited, log, http_api.V1))
+	router.Handle("GET", "/snapshot", http_api.Decorate(s.doSnapshot, log))
+	router.Handle("GET", "/audit", http_api.Decorate(ctx.nsqd.audit.DoQuery, log, http_api.V1))
 
 	// v1 negotiate
 	router.Handle("POST", "/pub", http_api.Decorate(s.doPUB, http_api.V1))
@@ -62,18 +68,29 @@ func newHTTPServer(ctx *context, tlsEnabled bool, tlsRequired bool) *httpServer
 	router.Handle("GET", "/stats", http_api.Decorate(s.doStats, log, http_api.V1))
 
 	// only v1
-	router.Handle("POST", "/topic/create", http_api.Decorate(s.doCreateTopic, log, http_api.V1))
-	router.Handle("POST", "/topic/delete", http_api.Decorate(s.doDeleteTopic, log, http_api.V1))
-	router.Handle("POST", "/topic/empty", http_api.Decorate(s.doEmptyTopic, log, http_api.V1))
-	router.Handle("POST", "/topic/pause", http_api.Decorate(s.doPauseTopic, log, http_api.V1))
-	router.Handle("POST", "/topic/unpause", http_api.Decorate(s.doPauseTopic, log, http_api.V1))
-	router.Handle("POST", "/channel/create", http_api.Decorate(s.doCreateChannel, log, http_api.V1))
-	router.Handle("POST", "/channel/delete", http_api.Decorate(s.doDeleteChannel, log, http_api.V1))
-	router.Handle("POST", "/channel/empty", http_api.Decorate(s.doEmptyChannel, log, http_api.V1))
-	router.Handle("POST", "/channel/pause", http_api.Decorate(s.doPauseChannel, log, http_api.V1))
-	router.Handle("POST", "/channel/unpause", http_api.Decorate(s.doPauseChannel, log, http_api.V1))
+	router.Handle("POST", "/topic/create", http_api.Decorate(s.doCreateTopic, rateLimit, audited, log, http_api.V1))
+	router.Handle("POST", "/topic/delete", http_api.Decorate(s.doDeleteTopic, rateLimit, audited, log, http_api.V1))
+	router.Handle("POST", "/topic/empty", http_api.Decorate(s.doEmptyTopic, rateLimit, audited, log, http_api.V1))
+	router.Handle("POST", "/topic/pause", http_api.Decorate(s.doPauseTopic, rateLimit, audited, log, http_api.V1))
+	router.Handle("POST", "/topic/unpause", http_api.Decorate(s.doPauseTopic, rateLimit, audited, log, http_api.V1))
+	router.Handle("POST", "/topic/migrate", http_api.Decorate(s.doMigrateTopic, rateLimit, audited, log, http_api.V1))
+	router.Handle("GET", "/topic/migrate", http_api.Decorate(s.doMigrateTopic, log, http_api.V1))
+	router.Handle("GET", "/topic/config", http_api.Decorate(s.doTopicConfig, log, http_api.V1))
+	router.Handle("POST", "/topic/config", http_api.Decorate(s.doTopicConfig, rateLimit, audited, log, http_api.V1))
+	router.Handle("POST", "/topic/compression", http_api.Decorate(s.doTopicCompression, rateLimit, audited, log, http_api.V1))
+	router.Handle("POST", "/topic/import", http_api.Decorate(s.doImportTopic, rateLimit, audited, log, http_api.V1))
+	router.Handle("POST", "/channel/create", http_api.Decorate(s.doCreateChannel, rateLimit, audited, log, http_api.V1))
+	router.Handle("POST", "/channel/delete", http_api.Decorate(s.doDeleteChannel, rateLimit, audited, log, http_api.V1))
+	router.Handle("POST", "/channel/empty", http_api.Decorate(s.doEmptyChannel, rateLimit, audited, log, http_api.V1))
+	router.Handle("POST", "/channel/pause", http_api.Decorate(s.doPauseChannel, rateLimit, audited, log, http_api.V1))
+	router.Handle("POST", "/channel/unpause", http_api.Decorate(s.doPauseChannel, rateLimit, audited, log, http_api.V1))
+	router.Handle("POST", "/channel/dispatch", http_api.Decorate(s.doChannelDispatch, rateLimit, audited, log, http_api.V1))
+	router.Handle("POST", "/channel/backoff", http_api.Decorate(s.doChannelBackoff, rateLimit, audited, log, http_api.V1))
+	router.Handle("GET", "/channel/config", http_api.Decorate(s.doChannelConfig, log, http_api.V1))
+	router.Handle("POST", "/channel/config", http_api.Decorate(s.doChannelConfig, rateLimit, audited, log, http_api.V1))
+	router.Handle("POST", "/channel/import", http_api.Decorate(s.doImportChannel, rateLimit, audited, log, http_api.V1))
 	router.Handle("GET", "/config/:opt", http_api.Decorate(s.doConfig, log, http_api.V1))
-	router.Handle("PUT", "/config/:opt", http_api.Decorate(s.doConfig, log, http_api.V1))
+	router.Handle("PUT", "/config/:opt", http_api.Decorate(s.doConfig, rateLimit, audited, log, http_api.V1))
 
 	// debug
 	router.HandlerFunc("GET", "/debug/pprof/", pprof.Index)
@@ -125,6 +142,10 @@ func (s *httpServer) doInfo(w http.ResponseWriter, req *http.Request, ps httprou
 	if err != nil {
 		return nil, http_api.Err{500, err.Error()}
 	}
+	var drainDeadline int64
+	if s.ctx.nsqd.IsDraining() {
+		drainDeadline = s.ctx.nsqd.GetDrainDeadline().Unix()
+	}
 	return struct {
 		Version          string `json:"version"`
 		BroadcastAddress string `json:"broadcast_address"`
@@ -132,6 +153,8 @@ func (s *httpServer) doInfo(w http.ResponseWriter, req *http.Request, ps httprou
 		HTTPPort         int    `json:"http_port"`
 		TCPPort          int    `json:"tcp_port"`
 		StartTime        int64  `json:"start_time"`
+		Draining         bool   `json:"draining"`
+		DrainDeadline    int64  `json:"drain_deadline,omitempty"`
 	}{
 		Version:          version.Binary,
 		BroadcastAddress: s.ctx.nsqd.getOpts().BroadcastAddress,
@@ -139,9 +162,66 @@ func (s *httpServer) doInfo(w http.ResponseWriter, req *http.Request, ps httprou
 		TCPPort:          s.ctx.nsqd.RealTCPAddr().Port,
 		HTTPPort:         s.ctx.nsqd.RealHTTPAddr().Port,
 		StartTime:        s.ctx.nsqd.GetStartTime().Unix(),
+		Draining:         s.ctx.nsqd.IsDraining(),
+		DrainDeadline:    drainDeadline,
 	}, nil
 }
 
+func (s *httpServer) doDrain(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		s.ctx.nsqd.logf(LOG_ERROR, "failed to parse request params - %s", err)
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	timeout := s.ctx.nsqd.getOpts().DrainTimeout
+	if ts, err := reqParams.Get("timeout"); err == nil {
+		timeout, err = time.ParseDuration(ts)
+		if err != nil || timeout <= 0 {
+			return nil, http_api.Err{400, "INVALID_TIMEOUT"}
+		}
+	}
+
+	s.ctx.nsqd.Drain(timeout)
+	return nil, nil
+}
+
+// doSnapshot streams a snapshot archive, it is built in a temporary file first
+// so that a slow client does not hold up publishing. The file is kept in the
+// data path, next to the queues it copies, rather than in a shared directory.
+func (s *httpServer) doSnapshot(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	dataPath := s.ctx.nsqd.getOpts().DataPath
+	if dataPath == "" {
+		dataPath = "."
+	}
+	f, err := ioutil.TempFile(dataPath, "nsqd-snapshot")
+	if err != nil {
+		s.ctx.nsqd.logf(LOG_ERROR, "failed to create snapshot file - %s", err)
+		err = http_api.Err{500, "INTERNAL_ERROR"}
+		http_api.RespondV1(w, 500, err)
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	err = s.ctx.nsqd.Snapshot(f)
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		s.ctx.nsqd.logf(LOG_ERROR, "failed to snapshot - %s", err)
+		err = http_api.Err{500, "SNAPSHOT_FAILED"}
+		http_api.RespondV1(w, 500, err)
+		return nil, err
+	}
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=nsqd-snapshot-%d.tar.gz", time.Now().Unix()))
+	io.Copy(w, f)
+	return nil, nil
+}
+
 func (s *httpServer) getExistingTopicFromQuery(req *http.Request) (*http_api.ReqParams, *Topic, string, error) {
 	reqParams, err := http_api.NewReqParams(req)
 	if err != nil {
@@ -186,13 +266,19 @@ func (s *httpServer) doPUB(w http.ResponseWriter, req *http.Request, ps httprout
 	// TODO: one day I'd really like to just error on chunked requests
 	// to be able to fail "too big" requests before we even read
 
-	if req.ContentLength > s.ctx.nsqd.getOpts().MaxMsgSize {
+	if s.ctx.nsqd.IsDraining() {
+		return nil, http_api.Err{503, "DRAINING"}
+	}
+
+	// the topic may have a limit of its own
+	maxMsgSize := s.ctx.nsqd.maxMsgSize(req.URL.Query().Get("topic"))
+	if req.ContentLength > maxMsgSize {
 		return nil, http_api.Err{413, "MSG_TOO_BIG"}
 	}
 
 	// add 1 so that it's greater than our max when we test for it
 	// (LimitReader returns a "fake" EOF)
-	readMax := s.ctx.nsqd.getOpts().MaxMsgSize + 1
+	readMax := maxMsgSize + 1
 	body, err := ioutil.ReadAll(io.LimitReader(req.Body, readMax))
 	if err != nil {
 		return nil, http_api.Err{500, "INTERNAL_ERROR"}
@@ -220,6 +306,9 @@ func (s *httpServer) doPUB(w http.ResponseWriter, req *http.Request, ps httprout
 		if deferred < 0 || deferred > s.ctx.nsqd.getOpts().MaxReqTimeout {
 			return nil, http_api.Err{400, "INVALID_DEFER"}
 		}
+		if deferred > 0 && topic.durable() {
+			return nil, http_api.Err{400, "DEFER_NOT_SUPPORTED"}
+		}
 	}
 
 	msg := NewMessage(topic.GenerateID(), body)
@@ -239,6 +328,10 @@ func (s *httpServer) doMPUB(w http.ResponseWriter, req *http.Request, ps httprou
 	// TODO: one day I'd really like to just error on chunked requests
 	// to be able to fail "too big" requests before we even read
 
+	if s.ctx.nsqd.IsDraining() {
+		return nil, http_api.Err{503, "DRAINING"}
+	}
+
 	if req.ContentLength > s.ctx.nsqd.getOpts().MaxBodySize {
 		return nil, http_api.Err{413, "BODY_TOO_BIG"}
 	}
@@ -259,7 +352,7 @@ func (s *httpServer) doMPUB(w http.ResponseWriter, req *http.Request, ps httprou
 	if binaryMode {
 		tmp := make([]byte, 4)
 		msgs, err = readMPUB(req.Body, tmp, topic,
-			s.ctx.nsqd.getOpts().MaxMsgSize, s.ctx.nsqd.getOpts().MaxBodySize)
+			topic.MaxMsgSize(), s.ctx.nsqd.getOpts().MaxBodySize)
 		if err != nil {
 			return nil, http_api.Err{413, err.(*protocol.FatalClientErr).Code[2:]}
 		}
@@ -293,7 +386,7 @@ func (s *httpServer) doMPUB(w http.ResponseWriter, req *http.Request, ps httprou
 				continue
 			}
 
-			if int64(len(block)) > s.ctx.nsqd.getOpts().MaxMsgSize {
+			if int64(len(block)) > topic.MaxMsgSize() {
 				return nil, http_api.Err{413, "MSG_TOO_BIG"}
 			}
 
@@ -399,6 +492,186 @@ func (s *httpServer) doPauseTopic(w http.ResponseWriter, req *http.Request, ps h
 	return nil, nil
 }
 
+func (s *httpServer) doTopicCompression(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		s.ctx.nsqd.logf(LOG_ERROR, "failed to parse request params - %s", err)
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	topicName, err := reqParams.Get("topic")
+	if err != nil {
+		return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
+	}
+
+	compressionName, err := reqParams.Get("compression")
+	if err != nil {
+		return nil, http_api.Err{400, "MISSING_ARG_COMPRESSION"}
+	}
+	compression, err := diskqueue.ParseCompression(compressionName)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_ARG_COMPRESSION"}
+	}
+
+	topic, err := s.ctx.nsqd.GetExistingTopic(topicName)
+	if err != nil {
+		return nil, http_api.Err{404, "TOPIC_NOT_FOUND"}
+	}
+
+	topic.SetCompression(compression)
+
+	// persist metadata so that the topic keeps its compression across restarts
+	s.ctx.nsqd.Lock()
+	s.ctx.nsqd.PersistMetadata()
+	s.ctx.nsqd.Unlock()
+	return nil, nil
+}
+
+// doTopicConfig returns the topic's configuration, a POST changes the fields in
+// the JSON body first
+func (s *httpServer) doTopicConfig(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		s.ctx.nsqd.logf(LOG_ERROR, "failed to parse request params - %s", err)
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	topicName, err := reqParams.Get("topic")
+	if err != nil {
+		return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
+	}
+
+	topic, err := s.ctx.nsqd.GetExistingTopic(topicName)
+	if err != nil {
+		return nil, http_api.Err{404, "TOPIC_NOT_FOUND"}
+	}
+
+	cfg := topic.Config()
+	if req.Method != "POST" {
+		return cfg, nil
+	}
+	err = json.Unmarshal(reqParams.Body, &cfg)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_BODY"}
+	}
+	err = topic.SetConfig(cfg)
+	if err != nil {
+		s.ctx.nsqd.logf(LOG_ERROR, "failure in %s - %s", req.URL.Path, err)
+		return nil, http_api.Err{400, "INVALID_CONFIG"}
+	}
+
+	s.ctx.nsqd.Lock()
+	s.ctx.nsqd.PersistMetadata()
+	s.ctx.nsqd.Unlock()
+	return topic.Config(), nil
+}
+
+func (s *httpServer) doMigrateTopic(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		s.ctx.nsqd.logf(LOG_ERROR, "failed to parse request params - %s", err)
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	topicName, err := reqParams.Get("topic")
+	if err != nil {
+		return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
+	}
+
+	if req.Method == "GET" {
+		stats, ok := s.ctx.nsqd.GetMigrationStats(topicName)
+		if !ok {
+			return nil, http_api.Err{404, "MIGRATION_NOT_FOUND"}
+		}
+		return stats, nil
+	}
+
+	target, err := reqParams.Get("target")
+	if err != nil {
+		return nil, http_api.Err{400, "MISSING_ARG_TARGET"}
+	}
+
+	_, err = s.ctx.nsqd.GetExistingTopic(topicName)
+	if err != nil {
+		return nil, http_api.Err{404, "TOPIC_NOT_FOUND"}
+	}
+
+	err = s.ctx.nsqd.MigrateTopic(topicName, target)
+	if err != nil {
+		s.ctx.nsqd.logf(LOG_ERROR, "failure in %s - %s", req.URL.Path, err)
+		return nil, http_api.Err{400, "MIGRATE_FAILED"}
+	}
+	return nil, nil
+}
+
+// decodeImportBody reads the messages sent by a migrating nsqd
+func (s *httpServer) decodeImportBody(body []byte) ([]*Message, error) {
+	if int64(len(body)) > s.ctx.nsqd.getOpts().MaxBodySize {
+		return nil, http_api.Err{413, "BODY_TOO_BIG"}
+	}
+	msgs, err := readImportMessages(bytes.NewReader(body), s.ctx.nsqd.getOpts().MaxMsgSize)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_BODY"}
+	}
+	return msgs, nil
+}
+
+func (s *httpServer) doImportTopic(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	if req.ContentLength > s.ctx.nsqd.getOpts().MaxBodySize {
+		return nil, http_api.Err{413, "BODY_TOO_BIG"}
+	}
+
+	_, topic, err := s.getTopicFromQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// add 1 so that it's greater than our max when we test for it
+	// (LimitReader returns a "fake" EOF)
+	body, err := ioutil.ReadAll(io.LimitReader(req.Body, s.ctx.nsqd.getOpts().MaxBodySize+1))
+	if err != nil {
+		return nil, http_api.Err{500, "INTERNAL_ERROR"}
+	}
+	msgs, err := s.decodeImportBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	err = topic.PutMessages(msgs)
+	if err != nil {
+		return nil, http_api.Err{503, "EXITING"}
+	}
+	return nil, nil
+}
+
+func (s *httpServer) doImportChannel(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	if req.ContentLength > s.ctx.nsqd.getOpts().MaxBodySize {
+		return nil, http_api.Err{413, "BODY_TOO_BIG"}
+	}
+
+	reqParams, topic, channelName, err := s.getExistingTopicFromQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := s.decodeImportBody(reqParams.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	channel := topic.GetChannel(channelName)
+	for _, msg := range msgs {
+		if msg.deferred > 0 {
+			channel.PutMessageDeferred(msg, msg.deferred)
+			continue
+		}
+		err = channel.PutMessage(msg)
+		if err != nil {
+			return nil, http_api.Err{503, "EXITING"}
+		}
+	}
+	return nil, nil
+}
+
 func (s *httpServer) doCreateChannel(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
 	_, topic, channelName, err := s.getExistingTopicFromQuery(req)
 	if err != nil {
@@ -470,6 +743,109 @@ func (s *httpServer) doPauseChannel(w http.ResponseWriter, req *http.Request, ps
 	return nil, nil
 }
 
+func (s *httpServer) doChannelDispatch(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, topic, channelName, err := s.getExistingTopicFromQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := reqParams.Get("policy")
+	if err != nil {
+		return nil, http_api.Err{400, "MISSING_ARG_POLICY"}
+	}
+
+	channel, err := topic.GetExistingChannel(channelName)
+	if err != nil {
+		return nil, http_api.Err{404, "CHANNEL_NOT_FOUND"}
+	}
+
+	err = channel.SetDispatchPolicy(policy)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_ARG_POLICY"}
+	}
+
+	// persist metadata so that the channel keeps its policy across restarts
+	s.ctx.nsqd.Lock()
+	s.ctx.nsqd.PersistMetadata()
+	s.ctx.nsqd.Unlock()
+	return nil, nil
+}
+
+func (s *httpServer) doChannelBackoff(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, topic, channelName, err := s.getExistingTopicFromQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := reqParams.Get("policy")
+	if err != nil {
+		return nil, http_api.Err{400, "MISSING_ARG_POLICY"}
+	}
+	backoff := &RequeueBackoff{Policy: policy}
+	if delay, err := reqParams.Get("delay"); err == nil {
+		backoff.Delay, err = time.ParseDuration(delay)
+		if err != nil {
+			return nil, http_api.Err{400, "INVALID_ARG_DELAY"}
+		}
+	}
+	if maxDelay, err := reqParams.Get("max_delay"); err == nil {
+		backoff.MaxDelay, err = time.ParseDuration(maxDelay)
+		if err != nil {
+			return nil, http_api.Err{400, "INVALID_ARG_MAX_DELAY"}
+		}
+	}
+
+	channel, err := topic.GetExistingChannel(channelName)
+	if err != nil {
+		return nil, http_api.Err{404, "CHANNEL_NOT_FOUND"}
+	}
+
+	err = channel.SetRequeueBackoff(backoff)
+	if err != nil {
+		s.ctx.nsqd.logf(LOG_ERROR, "failure in %s - %s", req.URL.Path, err)
+		return nil, http_api.Err{400, "INVALID_ARG_BACKOFF"}
+	}
+
+	// persist metadata so that the channel keeps its backoff across restarts
+	s.ctx.nsqd.Lock()
+	s.ctx.nsqd.PersistMetadata()
+	s.ctx.nsqd.Unlock()
+	return nil, nil
+}
+
+// doChannelConfig returns the channel's configuration, a POST changes the
+// fields in the JSON body first
+func (s *httpServer) doChannelConfig(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, topic, channelName, err := s.getExistingTopicFromQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := topic.GetExistingChannel(channelName)
+	if err != nil {
+		return nil, http_api.Err{404, "CHANNEL_NOT_FOUND"}
+	}
+
+	cfg := channel.Config()
+	if req.Method != "POST" {
+		return cfg, nil
+	}
+	err = json.Unmarshal(reqParams.Body, &cfg)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_BODY"}
+	}
+	err = channel.SetConfig(cfg)
+	if err != nil {
+		s.ctx.nsqd.logf(LOG_ERROR, "failure in %s - %s", req.URL.Path, err)
+		return nil, http_api.Err{400, "INVALID_CONFIG"}
+	}
+
+	s.ctx.nsqd.Lock()
+	s.ctx.nsqd.PersistMetadata()
+	s.ctx.nsqd.Unlock()
+	return channel.Config(), nil
+}
+
 func (s *httpServer) doStats(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
 	var producerStats []ClientStats
 
@@ -645,6 +645,77 @@ func TestHTTPconfig(t *testing.T) {
 	test.Equal(t, 400, resp.StatusCode)
 }
 
+func TestHTTPAudit(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.AuditTopic = "audit"
+	opts.AdminRateLimit = 2
+	opts.AdminRateLimitWait = 600 * time.Millisecond
+	_, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+	test.Nil(t, client.POSTV1(fmt.Sprintf("http://%s/topic/create?topic=orders", httpAddr)))
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("http://%s/config/log_level", httpAddr), strings.NewReader("debug"))
+	test.Nil(t, err)
+	req.Header.Set("X-Forwarded-User", "ops")
+	resp, err := http.DefaultClient.Do(req)
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 200, resp.StatusCode)
+
+	// queued for its turn in half a second
+	start := time.Now()
+	queued := make(chan error)
+	go func() {
+		queued <- client.POSTV1(fmt.Sprintf("http://%s/topic/delete?topic=orders", httpAddr))
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	// the next turn is in more than --admin-rate-limit-wait
+	err = client.POSTV1(fmt.Sprintf("http://%s/topic/delete?topic=orders", httpAddr))
+	test.NotNil(t, err)
+	test.Equal(t, true, strings.Contains(err.Error(), "429"))
+
+	test.Nil(t, <-queued)
+	test.Equal(t, true, time.Since(start) >= 400*time.Millisecond)
+
+	var audit struct {
+		Entries []struct {
+			Caller        string              `json:"caller"`
+			ClaimedCaller string              `json:"claimed_caller"`
+			Method        string              `json:"method"`
+			Path          string              `json:"path"`
+			Params        map[string][]string `json:"params"`
+			Body          string              `json:"body"`
+			Status        int                 `json:"status"`
+		} `json:"entries"`
+	}
+	test.Nil(t, client.GETV1(fmt.Sprintf("http://%s/audit", httpAddr), &audit))
+	test.Equal(t, 4, len(audit.Entries))
+	test.Equal(t, "/topic/create", audit.Entries[0].Path)
+	test.Equal(t, []string{"orders"}, audit.Entries[0].Params["topic"])
+	test.Equal(t, "PUT", audit.Entries[1].Method)
+	test.Equal(t, "", audit.Entries[1].Caller)
+	test.Equal(t, "ops", audit.Entries[1].ClaimedCaller)
+	test.Equal(t, "debug", audit.Entries[1].Body)
+	test.Equal(t, 429, audit.Entries[2].Status)
+	test.Equal(t, 200, audit.Entries[3].Status)
+
+	// publishing is not audited
+	test.Nil(t, client.POSTV1Body(fmt.Sprintf("http://%s/pub?topic=orders", httpAddr), []byte("order")))
+	test.Nil(t, client.GETV1(fmt.Sprintf("http://%s/audit?path=/pub", httpAddr), &audit))
+	test.Equal(t, 0, len(audit.Entries))
+
+	topic := nsqd.GetTopic("audit")
+	for i := 0; i < 100 && topic.Depth() < 4; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	test.Equal(t, int64(4), topic.Depth())
+}
+
 func TestHTTPerrors(t *testing.T) {
 	opts := NewOptions()
 	opts.Logger = test.NewTestLogger(t)
@@ -922,3 +993,115 @@ func BenchmarkHTTPpub(b *testing.B) {
 	b.StopTimer()
 	nsqd.Exit()
 }
+
+func TestHTTPConfig(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	_, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "test_http_config" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	channel := topic.GetChannel("ch")
+
+	post := func(url string, body string) int {
+		resp, err := http.Post(url, "application/json", strings.NewReader(body))
+		test.Nil(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	url := fmt.Sprintf("http://%s/topic/config?topic=%s", httpAddr, topicName)
+	test.Equal(t, 400, post(url, `{"compression":"zip"}`))
+	test.Equal(t, 200, post(url, `{"compression":"snappy"}`))
+	test.Equal(t, "snappy", topic.Config().Compression)
+
+	// fields that are left out keep their value
+	url = fmt.Sprintf("http://%s/channel/config?topic=%s&channel=ch", httpAddr, topicName)
+	test.Equal(t, 400, post(url, `{"msg_timeout":1}`))
+	test.Equal(t, 200, post(url, `{"max_attempts":3,"dispatch_policy":"round-robin"}`))
+	test.Equal(t, 200, post(url, `{"msg_timeout":2000000000}`))
+	test.Equal(t, ChannelConfig{
+		MsgTimeout:     2 * time.Second,
+		MaxAttempts:    3,
+		DispatchPolicy: "round-robin",
+	}, channel.Config())
+
+	resp, err := http.Get(url)
+	test.Nil(t, err)
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	var cfg ChannelConfig
+	test.Nil(t, json.Unmarshal(body, &cfg))
+	test.Equal(t, channel.Config(), cfg)
+
+	stats := nsqd.GetStats(topicName, "ch", false)
+	test.Equal(t, topic.Config(), stats[0].Config)
+	test.Equal(t, channel.Config(), stats[0].Channels[0].Config)
+
+	m, err := getMetadata(nsqd)
+	test.Nil(t, err)
+	test.Equal(t, topic.Config(), *m.Topics[0].Config)
+	test.Equal(t, channel.Config(), *m.Topics[0].Channels[0].Config)
+}
+
+func TestHTTPTopicQueueLimits(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.MemQueueSize = 100
+	opts.MaxMsgSize = 100
+	_, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "test_http_topic_queue_limits" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	channel := topic.GetChannel("ch")
+	for i := 0; i < 5; i++ {
+		channel.PutMessage(NewMessage(topic.GenerateID(), []byte("test")))
+	}
+
+	url := fmt.Sprintf("http://%s/topic/config?topic=%s", httpAddr, topicName)
+	resp, err := http.Post(url, "application/json",
+		strings.NewReader(`{"mem_queue_size":2,"max_msg_size":200}`))
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 200, resp.StatusCode)
+
+	// the queued messages that do not fit anymore are moved to the backend
+	test.Equal(t, 2, cap(channel.getMemoryMsgChan()))
+	test.Equal(t, 2, len(channel.getMemoryMsgChan()))
+	test.Equal(t, int64(5), channel.Depth())
+	test.Equal(t, 2, cap(topic.getMemoryMsgChan()))
+	test.Equal(t, 2, cap(topic.GetChannel("ch2").getMemoryMsgChan()))
+
+	pub := func(size int) int {
+		url := fmt.Sprintf("http://%s/pub?topic=%s", httpAddr, topicName)
+		resp, err := http.Post(url, "application/octet-stream",
+			bytes.NewReader(make([]byte, size)))
+		test.Nil(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	test.Equal(t, 200, pub(150))
+	test.Equal(t, 413, pub(250))
+
+	// limits above --max-body-size or beyond the int32 message length are refused
+	for _, size := range []int64{opts.MaxBodySize + 1, 1 << 31} {
+		resp, err = http.Post(url, "application/json",
+			strings.NewReader(fmt.Sprintf(`{"max_msg_size":%d}`, size)))
+		test.Nil(t, err)
+		resp.Body.Close()
+		test.Equal(t, 400, resp.StatusCode)
+	}
+	test.Equal(t, int64(200), topic.MaxMsgSize())
+
+	resp, err = http.Post(url, "application/json",
+		strings.NewReader(`{"mem_queue_size":null,"max_msg_size":0}`))
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 200, resp.StatusCode)
+	test.Equal(t, 100, cap(channel.getMemoryMsgChan()))
+	test.Equal(t, 413, pub(150))
+}
@@ -80,8 +80,8 @@ func (pq *inFlightPqueue) up(j int) {
 		if i == j || (*pq)[j].pri >= (*pq)[i].pri {
 			break
 		}
-		pq.Swap(i, j)0-\
-		-*		j = i
+		pq.Swap(i, j)
+		j = i
 	}
 }
 
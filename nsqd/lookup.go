@@ -9,9 +9,52 @@ import (
 	"time"
 
 	"github.com/nsqio/go-nsq"
+	"github.com/nsqio/nsq/internal/protocol"
 	"github.com/nsqio/nsq/internal/version"
 )
 
+// lookupHealth is what nsqd reports to nsqlookupd about itself on IDENTIFY and
+// on every heartbeat
+type lookupHealth struct {
+	Healthy     bool  `json:"healthy"`
+	Depth       int64 `json:"depth"`
+	ClientCount int64 `json:"client_count"`
+	Draining    bool  `json:"draining"`
+}
+
+func (n *NSQD) getLookupHealth() lookupHealth {
+	h := lookupHealth{
+		Healthy:  n.IsHealthy(),
+		Draining: n.IsDraining(),
+	}
+	n.RLock()
+	for _, t := range n.topicMap {
+		h.Depth += t.Depth()
+		t.RLock()
+		for _, c := range t.channelMap {
+			h.Depth += c.Depth()
+		}
+		t.RUnlock()
+	}
+	n.RUnlock()
+	n.clientLock.RLock()
+	h.ClientCount = int64(len(n.clients))
+	n.clientLock.RUnlock()
+	return h
+}
+
+// pingCommand is a PING carrying the health as key=value params, the
+// nsqlookupd that do not know about them ignore them
+func (h lookupHealth) pingCommand() *nsq.Command {
+	params := [][]byte{
+		[]byte("healthy=" + strconv.FormatBool(h.Healthy)),
+		[]byte("depth=" + strconv.FormatInt(h.Depth, 10)),
+		[]byte("clients=" + strconv.FormatInt(h.ClientCount, 10)),
+		[]byte("draining=" + strconv.FormatBool(h.Draining)),
+	}
+	return &nsq.Command{Name: []byte("PING"), Params: params}
+}
+
 func connectCallback(n *NSQD, hostname string) func(*lookupPeer) {
 	return func(lp *lookupPeer) {
 		ci := make(map[string]interface{})
@@ -20,6 +63,13 @@ func connectCallback(n *NSQD, hostname string) func(*lookupPeer) {
 		ci["http_port"] = n.RealHTTPAddr().Port
 		ci["hostname"] = hostname
 		ci["broadcast_address"] = n.getOpts().BroadcastAddress
+		if tags, _ := protocol.ParseTags(n.getOpts().Tags); len(tags) > 0 {
+			ci["tags"] = tags
+		}
+		ci["health"] = n.getLookupHealth()
+		if n.getOpts().NSQLookupdAuthSecret != "" {
+			ci["auth_secret"] = n.getOpts().NSQLookupdAuthSecret
+		}
 
 		cmd, err := nsq.Identify(ci)
 		if err != nil {
@@ -34,6 +84,10 @@ func connectCallback(n *NSQD, hostname string) func(*lookupPeer) {
 			n.logf(LOG_INFO, "LOOKUPD(%s): lookupd returned %s", lp, resp)
 			lp.Close()
 			return
+		} else if bytes.HasPrefix(resp, []byte("E_UNAUTHORIZED")) {
+			n.logf(LOG_ERROR, "LOOKUPD(%s): lookupd returned %s, check --lookupd-auth-secret", lp, resp)
+			lp.Close()
+			return
 		} else {
 			err = json.Unmarshal(resp, &lp.Info)
 			if err != nil {
@@ -79,6 +133,7 @@ func (n *NSQD) lookupLoop() {
 	var lookupPeers []*lookupPeer
 	var lookupAddrs []string
 	connect := true
+	drainChan := n.drainChan
 
 	hostname, err := os.Hostname()
 	if err != nil {
@@ -86,16 +141,38 @@ func (n *NSQD) lookupLoop() {
 		os.Exit(1)
 	}
 
+	// the addresses given as names are resolved again every
+	// --lookupd-resolve-interval, peers are added and removed as they change.
+	// The lookups run in the background so that a slow DNS server does not
+	// hold up the heartbeats and registrations, one at a time
+	var resolvedAddrs []string
+	resolvedChan := make(chan []string)
+	resolving := false
+	resolvePending := false
+	resolve := func() {
+		resolving = true
+		addrs := n.getOpts().NSQLookupdTCPAddresses
+		go func() {
+			select {
+			case resolvedChan <- n.resolver.Resolve(addrs):
+			case <-n.exitChan:
+			}
+		}()
+	}
+	resolve()
+	resolveTicker := time.NewTicker(n.getOpts().NSQLookupdResolveInterval)
+	defer resolveTicker.Stop()
+
 	// for announcements, lookupd determines the host automatically
 	ticker := time.Tick(15 * time.Second)
 	for {
-		if connect {
-			for _, host := range n.getOpts().NSQLookupdTCPAddresses {
+		if connect && !n.IsDraining() {
+			for _, host := range resolvedAddrs {
 				if in(host, lookupAddrs) {
 					continue
 				}
 				n.logf(LOG_INFO, "LOOKUP(%s): adding peer", host)
-				lookupPeer := newLookupPeer(host, n.getOpts().MaxBodySize, n.logf,
+				lookupPeer := newLookupPeer(host, n.lookupdTLSConfig, n.getOpts().MaxBodySize, n.logf,
 					connectCallback(n, hostname))
 				lookupPeer.Command(nil) // start the connection
 				lookupPeers = append(lookupPeers, lookupPeer)
@@ -108,9 +185,9 @@ func (n *NSQD) lookupLoop() {
 		select {
 		case <-ticker:
 			// send a heartbeat and read a response (read detects closed conns)
+			cmd := n.getLookupHealth().pingCommand()
 			for _, lookupPeer := range lookupPeers {
 				n.logf(LOG_DEBUG, "LOOKUPD(%s): sending heartbeat", lookupPeer)
-				cmd := nsq.Ping()
 				_, err := lookupPeer.Command(cmd)
 				if err != nil {
 					n.logf(LOG_ERROR, "LOOKUPD(%s): %s - %s", lookupPeer, cmd, err)
@@ -148,21 +225,60 @@ func (n *NSQD) lookupLoop() {
 					n.logf(LOG_ERROR, "LOOKUPD(%s): %s - %s", lookupPeer, cmd, err)
 				}
 			}
+		case <-resolveTicker.C:
+			if !resolving {
+				resolve()
+			}
 		case <-n.optsNotificationChan:
-			var tmpPeers []*lookupPeer
-			var tmpAddrs []string
-			for _, lp := range lookupPeers {
-				if in(lp.addr, n.getOpts().NSQLookupdTCPAddresses) {
-					tmpPeers = append(tmpPeers, lp)
-					tmpAddrs = append(tmpAddrs, lp.addr)
-					continue
-				}
-				n.logf(LOG_INFO, "LOOKUP(%s): removing peer", lp)
-				lp.Close()
+			// the addresses being resolved may be the old ones
+			if resolving {
+				resolvePending = true
+			} else {
+				resolve()
+			}
+		case addrs := <-resolvedChan:
+			resolving = false
+			if resolvePending {
+				resolvePending = false
+				resolve()
+			}
+			if equalAddrs(addrs, resolvedAddrs) {
+				continue
 			}
-			lookupPeers = tmpPeers
-			lookupAddrs = tmpAddrs
+			resolvedAddrs = addrs
+			lookupPeers, lookupAddrs = n.removeLookupPeers(lookupPeers, resolvedAddrs)
 			connect = true
+		case <-drainChan:
+			// unregister everything and disconnect so that consumers stop discovering
+			// this node, peers are not re-added while draining
+			var commands []*nsq.Command
+			n.RLock()
+			for _, topic := range n.topicMap {
+				topic.RLock()
+				for _, channel := range topic.channelMap {
+					commands = append(commands, nsq.UnRegister(channel.topicName, channel.name))
+				}
+				topic.RUnlock()
+				commands = append(commands, nsq.UnRegister(topic.name, ""))
+			}
+			n.RUnlock()
+
+			for _, lookupPeer := range lookupPeers {
+				for _, cmd := range commands {
+					n.logf(LOG_INFO, "LOOKUPD(%s): %s", lookupPeer, cmd)
+					_, err := lookupPeer.Command(cmd)
+					if err != nil {
+						n.logf(LOG_ERROR, "LOOKUPD(%s): %s - %s", lookupPeer, cmd, err)
+						break
+					}
+				}
+				n.logf(LOG_INFO, "LOOKUP(%s): removing peer", lookupPeer)
+				lookupPeer.Close()
+			}
+			lookupPeers = nil
+			lookupAddrs = nil
+			n.lookupPeers.Store(lookupPeers)
+			drainChan = nil
 		case <-n.exitChan:
 			goto exit
 		}
@@ -172,6 +288,35 @@ exit:
 	n.logf(LOG_INFO, "LOOKUP: closing")
 }
 
+// removeLookupPeers closes the peers whose address is not in addrs and returns
+// the remaining ones
+func (n *NSQD) removeLookupPeers(lookupPeers []*lookupPeer, addrs []string) ([]*lookupPeer, []string) {
+	var tmpPeers []*lookupPeer
+	var tmpAddrs []string
+	for _, lp := range lookupPeers {
+		if in(lp.addr, addrs) {
+			tmpPeers = append(tmpPeers, lp)
+			tmpAddrs = append(tmpAddrs, lp.addr)
+			continue
+		}
+		n.logf(LOG_INFO, "LOOKUP(%s): removing peer", lp)
+		lp.Close()
+	}
+	return tmpPeers, tmpAddrs
+}
+
+func equalAddrs(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func in(s string, lst []string) bool {
 	for _, v := range lst {
 		if s == v {
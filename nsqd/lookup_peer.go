@@ -1,6 +1,7 @@
 package nsqd
 
 import (
+	"crypto/tls"
 	"encoding/binary"
 	"fmt"
 	"io"
@@ -19,6 +20,7 @@ import (
 type lookupPeer struct {
 	logf            lg.AppLogFunc
 	addr            string
+	tlsConfig       *tls.Config //为nil时不使用TLS
 	conn            net.Conn
 	state           int32
 	connectCallback func(*lookupPeer)
@@ -37,10 +39,11 @@ type peerInfo struct {
 // newLookupPeer creates a new lookupPeer instance connecting to the supplied address.
 //
 // The supplied connectCallback will be called *every* time the instance connects.
-func newLookupPeer(addr string, maxBodySize int64, l lg.AppLogFunc, connectCallback func(*lookupPeer)) *lookupPeer {
+func newLookupPeer(addr string, tlsConfig *tls.Config, maxBodySize int64, l lg.AppLogFunc, connectCallback func(*lookupPeer)) *lookupPeer {
 	return &lookupPeer{
 		logf:            l,
 		addr:            addr,
+		tlsConfig:       tlsConfig,
 		state:           stateDisconnected,
 		maxBodySize:     maxBodySize,
 		connectCallback: connectCallback,
@@ -50,7 +53,13 @@ func newLookupPeer(addr string, maxBodySize int64, l lg.AppLogFunc, connectCallb
 // Connect will Dial the specified address, with timeouts
 func (lp *lookupPeer) Connect() error {
 	lp.logf(lg.INFO, "LOOKUP connecting to %s", lp.addr)
-	conn, err := net.DialTimeout("tcp", lp.addr, time.Second)
+	var conn net.Conn
+	var err error
+	if lp.tlsConfig != nil {
+		conn, err = tls.DialWithDialer(&net.Dialer{Timeout: time.Second}, "tcp", lp.addr, lp.tlsConfig)
+	} else {
+		conn, err = net.DialTimeout("tcp", lp.addr, time.Second)
+	}
 	if err != nil {
 		return err
 	}
@@ -5,12 +5,17 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"math"
 	"time"
 )
 
 const (
-	MsgIDLength       = 16
-	minValidMsgLength = MsgIDLength + 8 + 2 // Timestamp + Attempts
+	MsgIDLength = 16
+	// the size of a message with an empty body as it is stored in a backend queue
+	MinValidMsgLength = MsgIDLength + 8 + 2 // Timestamp + Attempts
+	// the largest body whose encoded message size still fits the int32 of the
+	// backend's length prefix
+	maxValidMsgSize = math.MaxInt32 - MinValidMsgLength
 )
 
 type MessageID [MsgIDLength]byte
@@ -83,7 +88,7 @@ func decodeMessage(b []byte) (*Message, error) {
 	var msg Message
 
 	//不够最小的包长
-	if len(b) < minValidMsgLength {
+	if len(b) < MinValidMsgLength {
 		return nil, fmt.Errorf("invalid message buffer size (%d)", len(b))
 	}
 
@@ -95,6 +100,20 @@ func decodeMessage(b []byte) (*Message, error) {
 	return &msg, nil
 }
 
+// DecodeMessage deserializes a message as it is stored in a backend queue
+func DecodeMessage(b []byte) (*Message, error) {
+	return decodeMessage(b)
+}
+
+// BackendMaxMsgSize returns the largest record a backend queue accepts for
+// messages of up to maxMsgSize bytes
+func BackendMaxMsgSize(maxMsgSize int64) int32 {
+	if maxMsgSize > maxValidMsgSize {
+		maxMsgSize = maxValidMsgSize
+	}
+	return int32(maxMsgSize) + MinValidMsgLength
+}
+
 //将消息写入队列
 func writeMessageToBackend(buf *bytes.Buffer, msg *Message, bq BackendQueue) error {
 	buf.Reset()
@@ -104,3 +123,13 @@ func writeMessageToBackend(buf *bytes.Buffer, msg *Message, bq BackendQueue) err
 	}
 	return bq.Put(buf.Bytes())
 }
+
+//将消息写入队列并同步到磁盘
+func writeMessageToBackendSync(buf *bytes.Buffer, msg *Message, bq BackendQueue) error {
+	buf.Reset()
+	_, err := msg.WriteTo(buf)
+	if err != nil {
+		return err
+	}
+	return bq.PutSync(buf.Bytes())
+}
@@ -0,0 +1,485 @@
+package nsqd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/nsqio/nsq/internal/pqueue"
+)
+
+const (
+	MigrationRunning  = "running"
+	MigrationComplete = "complete"
+	MigrationFailed   = "failed"
+
+	// the maximum number of messages sent to the target in one import request
+	migrationBatchSize = 1000
+)
+
+// MigrationStats describes the progress of moving a topic's backlog to another nsqd
+type MigrationStats struct {
+	Target        string            `json:"target"`
+	State         string            `json:"state"`
+	Error         string            `json:"error,omitempty"`
+	StartTime     int64             `json:"start_time"`
+	EndTime       int64             `json:"end_time,omitempty"`
+	MessagesMoved uint64            `json:"messages_moved"`
+	Remaining     int64             `json:"remaining"`
+	ChannelsMoved map[string]uint64 `json:"channels_moved"`
+}
+
+type topicMigration struct {
+	sync.Mutex
+
+	ctx    *context
+	topic  *Topic
+	target string
+
+	stats MigrationStats
+
+	// what the migration paused itself, resumed if it fails
+	paused []interface {
+		UnPause() error
+	}
+}
+
+// MigrateTopic moves the backlog of the named topic (its own queue and every channel's
+// memory, disk and deferred messages) to the nsqd at the target HTTP address and then
+// tombstones this node for the topic in nsqlookupd.
+//
+// The migration runs in the background; its progress is available from GetMigrationStats
+// and in the topic's stats. The topic and its channels are left paused on this node, or
+// are resumed if the migration fails.
+func (n *NSQD) MigrateTopic(topicName string, target string) error {
+	topic, err := n.GetExistingTopic(topicName)
+	if err != nil {
+		return err
+	}
+	if topic.ephemeral {
+		return errors.New("cannot migrate an ephemeral topic")
+	}
+	if _, _, err := net.SplitHostPort(target); err != nil {
+		return fmt.Errorf("invalid target address (%s) - %s", target, err)
+	}
+
+	n.migrationLock.Lock()
+	if m, ok := n.migrations[topicName]; ok && m.getStats().State == MigrationRunning {
+		n.migrationLock.Unlock()
+		return errors.New("migration already in progress")
+	}
+	m := &topicMigration{
+		ctx:    &context{n},
+		topic:  topic,
+		target: target,
+		stats: MigrationStats{
+			Target:        target,
+			State:         MigrationRunning,
+			StartTime:     time.Now().Unix(),
+			ChannelsMoved: make(map[string]uint64),
+		},
+	}
+	n.migrations[topicName] = m
+	n.migrationLock.Unlock()
+
+	n.waitGroup.Wrap(func() {
+		err := m.run()
+		if err != nil {
+			for _, p := range m.paused {
+				p.UnPause()
+			}
+		}
+		m.Lock()
+		if err != nil {
+			n.logf(LOG_ERROR, "TOPIC(%s): migration to %s failed - %s", topicName, target, err)
+			m.stats.State = MigrationFailed
+			m.stats.Error = err.Error()
+		} else {
+			n.logf(LOG_INFO, "TOPIC(%s): migration to %s complete", topicName, target)
+			m.stats.State = MigrationComplete
+		}
+		m.stats.EndTime = time.Now().Unix()
+		m.Unlock()
+	})
+	return nil
+}
+
+// GetMigrationStats returns the progress of the most recent migration of the named topic
+func (n *NSQD) GetMigrationStats(topicName string) (MigrationStats, bool) {
+	n.migrationLock.RLock()
+	m, ok := n.migrations[topicName]
+	n.migrationLock.RUnlock()
+	if !ok {
+		return MigrationStats{}, false
+	}
+	return m.getStats(), true
+}
+
+func (m *topicMigration) getStats() MigrationStats {
+	m.Lock()
+	defer m.Unlock()
+	stats := m.stats
+	stats.ChannelsMoved = make(map[string]uint64, len(m.stats.ChannelsMoved))
+	for k, v := range m.stats.ChannelsMoved {
+		stats.ChannelsMoved[k] = v
+	}
+	return stats
+}
+
+func (m *topicMigration) moved(channelName string, count int) {
+	m.Lock()
+	m.stats.MessagesMoved += uint64(count)
+	if channelName != "" {
+		m.stats.ChannelsMoved[channelName] += uint64(count)
+	}
+	m.Unlock()
+}
+
+func (m *topicMigration) run() error {
+	n := m.ctx.nsqd
+	t := m.topic
+
+	if m.isSelf() {
+		return errors.New("target is this nsqd")
+	}
+
+	// stop delivery, both from the topic into its channels and from channels to clients,
+	// so that everything we drain stays drained
+	if !t.IsPaused() {
+		err := t.Pause()
+		if err != nil {
+			return err
+		}
+		m.paused = append(m.paused, t)
+	}
+	err := m.post("topic/create", url.Values{"topic": []string{t.name}}, nil)
+	if err != nil {
+		return err
+	}
+
+	created := make(map[string]bool)
+	for {
+		select {
+		case <-n.exitChan:
+			return errors.New("exiting")
+		default:
+		}
+
+		var count int
+		var inFlight int
+		var remaining int64
+
+		t.RLock()
+		channels := make([]*Channel, 0, len(t.channelMap))
+		for _, c := range t.channelMap {
+			channels = append(channels, c)
+		}
+		t.RUnlock()
+
+		for _, c := range channels {
+			if !created[c.name] {
+				err = m.createChannel(c)
+				if err != nil {
+					return err
+				}
+				created[c.name] = true
+			}
+			moved, err := m.drainChannel(c)
+			if err != nil {
+				return err
+			}
+			count += moved
+
+			c.inFlightMutex.Lock()
+			inFlight += len(c.inFlightMessages)
+			c.inFlightMutex.Unlock()
+			c.deferredMutex.Lock()
+			remaining += int64(len(c.deferredMessages))
+			c.deferredMutex.Unlock()
+			remaining += c.Depth()
+		}
+
+		moved, err := m.drainTopic()
+		if err != nil {
+			return err
+		}
+		count += moved
+		remaining += t.Depth() + int64(inFlight)
+
+		m.Lock()
+		m.stats.Remaining = remaining
+		m.Unlock()
+
+		// in-flight messages either get FIN'd by their consumer or time out
+		// and are requeued, at which point they'll be picked up next round
+		if count == 0 && remaining == 0 {
+			break
+		}
+		if count == 0 {
+			select {
+			case <-time.After(100 * time.Millisecond):
+			case <-n.exitChan:
+				return errors.New("exiting")
+			}
+		}
+	}
+
+	n.Lock()
+	n.PersistMetadata()
+	n.Unlock()
+
+	lookupdHTTPAddrs := n.lookupdHTTPAddrs()
+	if len(lookupdHTTPAddrs) == 0 {
+		return nil
+	}
+	node := net.JoinHostPort(n.getOpts().BroadcastAddress,
+		strconv.Itoa(n.RealHTTPAddr().Port))
+	return n.ci.TombstoneTopicProducer(t.name, node, lookupdHTTPAddrs)
+}
+
+// isSelf detects a target that is actually this nsqd, in which case draining the
+// topic into itself would never finish
+func (m *topicMigration) isSelf() bool {
+	var info struct {
+		BroadcastAddress string `json:"broadcast_address"`
+		HTTPPort         int    `json:"http_port"`
+	}
+	err := m.ctx.nsqd.httpClient.GETV1(fmt.Sprintf("http://%s/info", m.target), &info)
+	if err != nil {
+		return false
+	}
+	return info.BroadcastAddress == m.ctx.nsqd.getOpts().BroadcastAddress &&
+		info.HTTPPort == m.ctx.nsqd.RealHTTPAddr().Port
+}
+
+func (m *topicMigration) createChannel(c *Channel) error {
+	params := url.Values{"topic": []string{m.topic.name}, "channel": []string{c.name}}
+	err := m.post("channel/create", params, nil)
+	if err != nil {
+		return err
+	}
+	if c.IsPaused() {
+		return m.post("channel/pause", params, nil)
+	}
+	err = c.Pause()
+	if err != nil {
+		return err
+	}
+	m.paused = append(m.paused, c)
+	return nil
+}
+
+func (m *topicMigration) post(endpoint string, params url.Values, body []byte) error {
+	return m.ctx.nsqd.httpClient.POSTV1Body(
+		fmt.Sprintf("http://%s/%s?%s", m.target, endpoint, params.Encode()), body)
+}
+
+// migrationBatch accumulates messages for a single import request
+type migrationBatch struct {
+	buf  bytes.Buffer
+	msgs []*Message
+	// the remaining time for each deferred message, parallel to msgs
+	deferred []time.Duration
+}
+
+func (b *migrationBatch) add(msg *Message, deferred time.Duration) {
+	b.msgs = append(b.msgs, msg)
+	b.deferred = append(b.deferred, deferred)
+	WriteImportMessage(&b.buf, msg, deferred)
+}
+
+func (b *migrationBatch) full(maxBodySize int64) bool {
+	return len(b.msgs) >= migrationBatchSize || int64(b.buf.Len()) >= maxBodySize/2
+}
+
+func (b *migrationBatch) reset() {
+	b.buf.Reset()
+	b.msgs = b.msgs[:0]
+	b.deferred = b.deferred[:0]
+}
+
+func (m *topicMigration) drainChannel(c *Channel) (int, error) {
+	var count int
+	var b migrationBatch
+	params := url.Values{"topic": []string{m.topic.name}, "channel": []string{c.name}}
+
+	flush := func() error {
+		if len(b.msgs) == 0 {
+			return nil
+		}
+		err := m.post("channel/import", params, b.buf.Bytes())
+		if err != nil {
+			// put back what we took so that nothing is lost
+			for i, msg := range b.msgs {
+				if b.deferred[i] > 0 {
+					c.StartDeferredTimeout(msg, b.deferred[i])
+				} else {
+					c.put(msg)
+				}
+			}
+			return err
+		}
+		count += len(b.msgs)
+		m.moved(c.name, len(b.msgs))
+		b.reset()
+		return nil
+	}
+
+	// take every deferred message at once, processDeferredQueue only requeues
+	// messages it can still pop from deferredMessages
+	now := time.Now().UnixNano()
+	c.deferredMutex.Lock()
+	deferred := make([]*pqueue.Item, 0, len(c.deferredMessages))
+	for _, item := range c.deferredMessages {
+		deferred = append(deferred, item)
+	}
+	c.deferredMessages = make(map[MessageID]*pqueue.Item)
+	c.deferredPQ = pqueue.New(int(math.Max(1, float64(m.ctx.nsqd.getOpts().MemQueueSize)/10)))
+	c.deferredMutex.Unlock()
+	for i, item := range deferred {
+		remaining := time.Duration(item.Priority - now)
+		if remaining < time.Millisecond {
+			remaining = time.Millisecond
+		}
+		b.add(item.Value.(*Message), remaining)
+		if b.full(m.ctx.nsqd.getOpts().MaxBodySize) {
+			if err := flush(); err != nil {
+				// flush put back the batch, the rest were not sent yet
+				for _, item := range deferred[i+1:] {
+					c.StartDeferredTimeout(item.Value.(*Message), time.Duration(item.Priority-now))
+				}
+				return count, err
+			}
+		}
+	}
+
+	err := m.drainQueue(c.getMemoryMsgChan(), c.backend, &b, flush)
+	if err != nil {
+		return count, err
+	}
+	return count, flush()
+}
+
+func (m *topicMigration) drainTopic() (int, error) {
+	var count int
+	var b migrationBatch
+	t := m.topic
+	params := url.Values{"topic": []string{t.name}}
+
+	flush := func() error {
+		if len(b.msgs) == 0 {
+			return nil
+		}
+		err := m.post("topic/import", params, b.buf.Bytes())
+		if err != nil {
+			for _, msg := range b.msgs {
+				t.put(msg)
+			}
+			return err
+		}
+		count += len(b.msgs)
+		m.moved("", len(b.msgs))
+		b.reset()
+		return nil
+	}
+
+	err := m.drainQueue(t.getMemoryMsgChan(), t.backend, &b, flush)
+	if err != nil {
+		return count, err
+	}
+	return count, flush()
+}
+
+// drainQueue moves everything currently in a memory queue and its backend into b
+func (m *topicMigration) drainQueue(memoryMsgChan chan *Message, backend BackendQueue,
+	b *migrationBatch, flush func() error) error {
+	maxBodySize := m.ctx.nsqd.getOpts().MaxBodySize
+	for {
+		select {
+		case msg := <-memoryMsgChan:
+			b.add(msg, msg.deferred)
+		default:
+			goto backend
+		}
+		if b.full(maxBodySize) {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+backend:
+	for backend.Depth() > 0 {
+		select {
+		case buf := <-backend.ReadChan():
+			msg, err := decodeMessage(buf)
+			if err != nil {
+				m.ctx.nsqd.logf(LOG_ERROR, "failed to decode message - %s", err)
+				continue
+			}
+			b.add(msg, 0)
+		case <-time.After(100 * time.Millisecond):
+			return nil
+		}
+		if b.full(maxBodySize) {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteImportMessage frames a message for /topic/import and /channel/import
+//
+// import message format:
+// [x][x][x][x][x][x][x][x][x][x][x][x]...
+// |  (int32) ||      (int64)        || (binary)
+// |  4-byte  ||      8-byte         || N-byte
+// ------------------------------------...
+//
+//	size      deferred milliseconds   message, as written by Message.WriteTo
+func WriteImportMessage(w io.Writer, msg *Message, deferred time.Duration) {
+	var buf [12]byte
+	binary.BigEndian.PutUint32(buf[:4], uint32(8+MinValidMsgLength+len(msg.Body)))
+	binary.BigEndian.PutUint64(buf[4:], uint64(deferred/time.Millisecond))
+	w.Write(buf[:])
+	msg.WriteTo(w)
+}
+
+func readImportMessages(r io.Reader, maxMsgSize int64) ([]*Message, error) {
+	var msgs []*Message
+	var sizeBuf [4]byte
+	for {
+		_, err := io.ReadFull(r, sizeBuf[:])
+		if err == io.EOF {
+			return msgs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		size := int64(binary.BigEndian.Uint32(sizeBuf[:]))
+		if size < 8+MinValidMsgLength || size > 8+MinValidMsgLength+maxMsgSize {
+			return nil, fmt.Errorf("invalid message size (%d)", size)
+		}
+		buf := make([]byte, size)
+		_, err = io.ReadFull(r, buf)
+		if err != nil {
+			return nil, err
+		}
+		msg, err := decodeMessage(buf[8:])
+		if err != nil {
+			return nil, err
+		}
+		msg.deferred = time.Duration(binary.BigEndian.Uint64(buf[:8])) * time.Millisecond
+		msgs = append(msgs, msg)
+	}
+}
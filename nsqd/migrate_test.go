@@ -0,0 +1,153 @@
+package nsqd
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nsqio/nsq/internal/test"
+)
+
+func TestImportMessages(t *testing.T) {
+	var buf bytes.Buffer
+	msg := NewMessage(MessageID{'a'}, []byte("test body"))
+	msg.Attempts = 3
+	WriteImportMessage(&buf, msg, 0)
+	WriteImportMessage(&buf, NewMessage(MessageID{'b'}, []byte("deferred")), 5*time.Second)
+
+	msgs, err := readImportMessages(bytes.NewReader(buf.Bytes()), 1024)
+	test.Nil(t, err)
+	test.Equal(t, 2, len(msgs))
+	test.Equal(t, msg.ID, msgs[0].ID)
+	test.Equal(t, msg.Timestamp, msgs[0].Timestamp)
+	test.Equal(t, uint16(3), msgs[0].Attempts)
+	test.Equal(t, []byte("test body"), msgs[0].Body)
+	test.Equal(t, 5*time.Second, msgs[1].deferred)
+
+	_, err = readImportMessages(bytes.NewReader(buf.Bytes()[:buf.Len()-1]), 1024)
+	test.NotNil(t, err)
+	_, err = readImportMessages(bytes.NewReader(buf.Bytes()), 4)
+	test.NotNil(t, err)
+}
+
+func TestMigrateTopic(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.MemQueueSize = 5
+	_, _, source := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer source.Exit()
+
+	targetOpts := NewOptions()
+	targetOpts.Logger = test.NewTestLogger(t)
+	_, targetHTTPAddr, target := mustStartNSQD(targetOpts)
+	defer os.RemoveAll(targetOpts.DataPath)
+	defer target.Exit()
+
+	topicName := "test_migrate" + strconv.Itoa(int(time.Now().Unix()))
+	topic := source.GetTopic(topicName)
+	channel := topic.GetChannel("ch")
+	topic.GetChannel("paused").Pause()
+
+	// half of these overflow to disk
+	for i := 0; i < 10; i++ {
+		topic.PutMessage(NewMessage(topic.GenerateID(), []byte(fmt.Sprintf("msg%d", i))))
+	}
+	channel.PutMessageDeferred(NewMessage(topic.GenerateID(), []byte("deferred")), time.Hour)
+
+	// wait for the topic to distribute its messages
+	for i := 0; i < 100 && topic.Depth() > 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	// and leave some in the topic itself
+	topic.Pause()
+	topic.PutMessage(NewMessage(topic.GenerateID(), []byte("undistributed")))
+
+	err := source.MigrateTopic(topicName, targetHTTPAddr.String())
+	test.Nil(t, err)
+	err = source.MigrateTopic(topicName, targetHTTPAddr.String())
+	test.NotNil(t, err)
+
+	var stats MigrationStats
+	for i := 0; i < 100; i++ {
+		stats, _ = source.GetMigrationStats(topicName)
+		if stats.State != MigrationRunning {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	test.Equal(t, MigrationComplete, stats.State)
+	test.Equal(t, uint64(22), stats.MessagesMoved)
+	test.Equal(t, uint64(11), stats.ChannelsMoved["ch"])
+	test.Equal(t, int64(0), stats.Remaining)
+	test.Equal(t, int64(0), topic.Depth())
+	test.Equal(t, int64(0), channel.Depth())
+
+	movedTopic, err := target.GetExistingTopic(topicName)
+	test.Nil(t, err)
+	movedChannel, err := movedTopic.GetExistingChannel("ch")
+	test.Nil(t, err)
+	pausedChannel, err := movedTopic.GetExistingChannel("paused")
+	test.Nil(t, err)
+	test.Equal(t, true, pausedChannel.IsPaused())
+
+	// the undistributed message goes to both channels via the target's topic
+	for i := 0; i < 100 && movedChannel.Depth() < 11; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	test.Equal(t, int64(11), movedChannel.Depth())
+	test.Equal(t, int64(11), pausedChannel.Depth())
+	movedChannel.deferredMutex.Lock()
+	test.Equal(t, 1, len(movedChannel.deferredMessages))
+	movedChannel.deferredMutex.Unlock()
+}
+
+func TestMigrateTopicFailure(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	_, _, source := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer source.Exit()
+
+	// creates the topic and channel but fails every import
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if strings.HasSuffix(req.URL.Path, "/import") {
+			w.WriteHeader(500)
+		}
+	}))
+	defer target.Close()
+
+	topicName := "test_migrate_failure" + strconv.Itoa(int(time.Now().Unix()))
+	topic := source.GetTopic(topicName)
+	channel := topic.GetChannel("ch")
+	// more than a batch
+	for i := 0; i < migrationBatchSize+500; i++ {
+		channel.PutMessageDeferred(NewMessage(topic.GenerateID(), []byte("deferred")), time.Hour)
+	}
+
+	err := source.MigrateTopic(topicName, strings.TrimPrefix(target.URL, "http://"))
+	test.Nil(t, err)
+	var stats MigrationStats
+	for i := 0; i < 100; i++ {
+		stats, _ = source.GetMigrationStats(topicName)
+		if stats.State != MigrationRunning {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	test.Equal(t, MigrationFailed, stats.State)
+
+	// nothing is lost and delivery resumes
+	channel.deferredMutex.Lock()
+	test.Equal(t, migrationBatchSize+500, len(channel.deferredMessages))
+	test.Equal(t, migrationBatchSize+500, channel.deferredPQ.Len())
+	channel.deferredMutex.Unlock()
+	test.Equal(t, false, topic.IsPaused())
+	test.Equal(t, false, channel.IsPaused())
+}
@@ -17,8 +17,11 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/nsqio/go-diskqueue"
+	"github.com/nsqio/nsq/internal/audit"
 	"github.com/nsqio/nsq/internal/clusterinfo"
 	"github.com/nsqio/nsq/internal/dirlock"
+	"github.com/nsqio/nsq/internal/discovery"
 	"github.com/nsqio/nsq/internal/http_api"
 	"github.com/nsqio/nsq/internal/protocol"
 	"github.com/nsqio/nsq/internal/statsd"
@@ -65,8 +68,19 @@ type NSQD struct {
 	tcpListener   net.Listener
 	httpListener  net.Listener
 	httpsListener net.Listener
+	mqttListener  net.Listener
+	kafkaListener net.Listener
 	tlsConfig     *tls.Config
 
+	lookupdTLSConfig *tls.Config //连接nsqlookupd用的，没有--lookupd-tls时为nil
+
+	resolver *discovery.Cache //解析srv://和dns://形式的nsqlookupd地址
+
+	audit          *audit.Log         //修改topic、channel和配置的HTTP请求
+	adminRateLimit http_api.Decorator //HTTP和HTTPS共用
+
+	keyring *diskqueue.Keyring //磁盘队列的加密密钥，没有配置时为nil
+
 	poolSize int
 
 	notifyChan           chan interface{}
@@ -74,7 +88,16 @@ type NSQD struct {
 	exitChan             chan int
 	waitGroup            util.WaitGroupWrapper
 
-	ci *clusterinfo.ClusterInfo
+	ci         *clusterinfo.ClusterInfo
+	httpClient *http_api.Client
+
+	migrationLock sync.RWMutex
+	migrations    map[string]*topicMigration
+
+	//排空状态(不再接受发布,消费完后退出)
+	draining      int32
+	drainDeadline atomic.Value
+	drainChan     chan struct{}
 }
 
 func New(opts *Options) (*NSQD, error) {
@@ -97,10 +120,9 @@ func New(opts *Options) (*NSQD, error) {
 		notifyChan:           make(chan interface{}),
 		optsNotificationChan: make(chan struct{}, 1),
 		dl:                   dirlock.New(dataPath),
+		migrations:           make(map[string]*topicMigration),
+		drainChan:            make(chan struct{}),
 	}
-	httpcli := http_api.NewClient(nil, opts.HTTPClientConnectTimeout, opts.HTTPClientRequestTimeout)
-	n.ci = clusterinfo.New(n.logf, httpcli)
-
 	n.lookupPeers.Store([]*lookupPeer{})
 
 	n.swapOpts(opts)
@@ -146,6 +168,61 @@ func New(opts *Options) (*NSQD, error) {
 	}
 	n.tlsConfig = tlsConfig
 
+	lookupdTLSConfig, err := buildLookupdTLSConfig(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build lookupd TLS config - %s", err)
+	}
+	n.lookupdTLSConfig = lookupdTLSConfig
+	n.httpClient = http_api.NewClient(lookupdTLSConfig, opts.HTTPClientConnectTimeout, opts.HTTPClientRequestTimeout)
+	n.ci = clusterinfo.New(n.logf, n.httpClient)
+	n.ci.SetLookupdAuth(opts.NSQLookupdTLS, opts.NSQLookupdAuthSecret)
+
+	if err := discovery.Validate(opts.NSQLookupdTCPAddresses); err != nil {
+		return nil, fmt.Errorf("invalid --lookupd-tcp-address - %s", err)
+	}
+	if opts.NSQLookupdResolveInterval <= 0 {
+		return nil, errors.New("--lookupd-resolve-interval must be positive")
+	}
+	n.resolver = discovery.NewCache(opts.Resolver, opts.NSQLookupdResolveInterval, n.logf)
+	n.ci.SetResolver(n.resolver)
+
+	if opts.AuditTopic != "" && !protocol.IsValidTopicName(opts.AuditTopic) {
+		return nil, fmt.Errorf("--audit-topic %q is not a valid topic name", opts.AuditTopic)
+	}
+	if opts.AdminRateLimit < 0 {
+		return nil, errors.New("--admin-rate-limit must not be negative")
+	}
+	if opts.AdminRateLimitWait < 0 {
+		return nil, errors.New("--admin-rate-limit-wait must not be negative")
+	}
+	if opts.AuditLogMaxSize < 0 {
+		return nil, errors.New("--audit-log-max-size must not be negative")
+	}
+	n.adminRateLimit = http_api.RateLimit(opts.AdminRateLimit, opts.AdminRateLimitWait)
+
+	if opts.KafkaAddress != "" && len(opts.AuthHTTPAddresses) != 0 {
+		return nil, errors.New("--kafka-address cannot be used with --auth-http-address (Kafka clients cannot authenticate)")
+	}
+
+	if _, err := protocol.ParseTags(opts.Tags); err != nil {
+		return nil, fmt.Errorf("invalid --tag - %s", err)
+	}
+	if opts.MaxMsgSize > maxValidMsgSize {
+		return nil, fmt.Errorf("--max-msg-size (%d) is greater than %d", opts.MaxMsgSize, maxValidMsgSize)
+	}
+	if _, err := diskqueue.ParseCompression(opts.DiskCompression); err != nil {
+		return nil, fmt.Errorf("invalid --disk-compression - %s", err)
+	}
+	if _, err := parseDispatchPolicy(opts.ChannelDispatchPolicy); err != nil {
+		return nil, fmt.Errorf("invalid --channel-dispatch-policy - %s", err)
+	}
+	if opts.EncryptionKeyFile != "" {
+		n.keyring, err = diskqueue.LoadKeyring(opts.EncryptionKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load --encryption-key-file - %s", err)
+		}
+	}
+
 	for _, v := range opts.E2EProcessingLatencyPercentiles {
 		if v <= 0 || v > 1 {
 			return nil, fmt.Errorf("invalid E2E processing latency percentile: %v", v)
@@ -155,6 +232,18 @@ func New(opts *Options) (*NSQD, error) {
 	n.logf(LOG_INFO, version.String("nsqd"))
 	n.logf(LOG_INFO, "ID: %d", opts.ID)
 
+	var forward func([]byte) error
+	if opts.AuditTopic != "" {
+		forward = func(data []byte) error {
+			topic := n.GetTopic(opts.AuditTopic)
+			return topic.PutMessage(NewMessage(topic.GenerateID(), data))
+		}
+	}
+	n.audit, err = audit.New(opts.AuditLogPath, opts.AuditLogMaxSize, audit.DefaultSize, forward, n.logf)
+	if err != nil {
+		return nil, err
+	}
+
 	n.tcpListener, err = net.Listen("tcp", opts.TCPAddress)
 	if err != nil {
 		return nil, fmt.Errorf("listen (%s) failed - %s", opts.TCPAddress, err)
@@ -169,6 +258,18 @@ func New(opts *Options) (*NSQD, error) {
 			return nil, fmt.Errorf("listen (%s) failed - %s", opts.HTTPSAddress, err)
 		}
 	}
+	if opts.MQTTAddress != "" {
+		n.mqttListener, err = net.Listen("tcp", opts.MQTTAddress)
+		if err != nil {
+			return nil, fmt.Errorf("listen (%s) failed - %s", opts.MQTTAddress, err)
+		}
+	}
+	if opts.KafkaAddress != "" {
+		n.kafkaListener, err = net.Listen("tcp", opts.KafkaAddress)
+		if err != nil {
+			return nil, fmt.Errorf("listen (%s) failed - %s", opts.KafkaAddress, err)
+		}
+	}
 
 	return n, nil
 }
@@ -177,6 +278,32 @@ func (n *NSQD) getOpts() *Options {
 	return n.opts.Load().(*Options)
 }
 
+// diskCompression returns the compression of disk queues set by --disk-compression
+func (n *NSQD) diskCompression() diskqueue.Compression {
+	c, _ := diskqueue.ParseCompression(n.getOpts().DiskCompression)
+	return c
+}
+
+// maxMsgSize returns the size limit of the messages published to topicName, a
+// topic that does not exist yet is limited by --max-msg-size
+func (n *NSQD) maxMsgSize(topicName string) int64 {
+	n.RLock()
+	t, ok := n.topicMap[topicName]
+	n.RUnlock()
+	if ok {
+		return t.MaxMsgSize()
+	}
+	return n.getOpts().MaxMsgSize
+}
+
+// diskQueueOptions returns the options of new topic and channel disk queues
+func (n *NSQD) diskQueueOptions() []diskqueue.Option {
+	return []diskqueue.Option{
+		diskqueue.WithCompression(n.diskCompression()),
+		diskqueue.WithKeyring(n.keyring),
+	}
+}
+
 func (n *NSQD) swapOpts(opts *Options) {
 	n.opts.Store(opts)
 }
@@ -200,6 +327,14 @@ func (n *NSQD) RealHTTPSAddr() *net.TCPAddr {
 	return n.httpsListener.Addr().(*net.TCPAddr)
 }
 
+func (n *NSQD) RealMQTTAddr() *net.TCPAddr {
+	return n.mqttListener.Addr().(*net.TCPAddr)
+}
+
+func (n *NSQD) RealKafkaAddr() *net.TCPAddr {
+	return n.kafkaListener.Addr().(*net.TCPAddr)
+}
+
 func (n *NSQD) SetHealth(err error) {
 	n.errValue.Store(errStore{err: err})
 }
@@ -275,8 +410,29 @@ func (n *NSQD) Main() error {
 		})
 	}
 
+	//监听mqtt(可选)
+	if n.mqttListener != nil {
+		mqttServer := &mqttServer{ctx: ctx}
+		n.waitGroup.Wrap(func() {
+			exitFunc(protocol.TCPServer(n.mqttListener, mqttServer, n.logf))
+		})
+	}
+	//监听kafka生产者(可选)
+	if n.kafkaListener != nil {
+		kafkaServer := &kafkaServer{ctx: ctx}
+		n.waitGroup.Wrap(func() {
+			exitFunc(protocol.TCPServer(n.kafkaListener, kafkaServer, n.logf))
+		})
+	}
+
 	n.waitGroup.Wrap(n.queueScanLoop)
 	n.waitGroup.Wrap(n.lookupLoop)
+	//排空完成后退出
+	n.waitGroup.Wrap(func() {
+		if n.drainLoop() {
+			exitFunc(nil)
+		}
+	})
 	if n.getOpts().StatsdAddress != "" {
 		n.waitGroup.Wrap(n.statsdLoop)
 	}
@@ -288,11 +444,13 @@ func (n *NSQD) Main() error {
 
 type meta struct {
 	Topics []struct {
-		Name     string `json:"name"`   //topic名字
-		Paused   bool   `json:"paused"` //topic是否暂停
+		Name     string       `json:"name"`   //topic名字
+		Paused   bool         `json:"paused"` //topic是否暂停
+		Config   *TopicConfig `json:"config"` //topic的配置
 		Channels []struct {
-			Name   string `json:"name"`   //channel的名字
-			Paused bool   `json:"paused"` //channel是否暂停
+			Name   string         `json:"name"`   //channel的名字
+			Paused bool           `json:"paused"` //channel是否暂停
+			Config *ChannelConfig `json:"config"` //channel的配置
 		} `json:"channels"` //topic下面channel的数组
 	} `json:"topics"` //topic的数组
 }
@@ -361,10 +519,22 @@ func (n *NSQD) LoadMetadata() error {
 			n.logf(LOG_WARN, "skipping creation of invalid topic %s", t.Name)
 			continue
 		}
-		topic := n.GetTopic(t.Name)
+		// the backends are created with the topic's limit right away, they
+		// start reading the messages written under it before SetConfig
+		var maxMsgSize int64
+		if t.Config != nil && checkMaxMsgSize(t.Config.MaxMsgSize, n.getOpts().MaxBodySize) == nil {
+			maxMsgSize = t.Config.MaxMsgSize
+		}
+		topic := n.getTopic(t.Name, maxMsgSize)
 		if t.Paused {
 			topic.Pause()
 		}
+		if t.Config != nil {
+			err := topic.SetConfig(*t.Config)
+			if err != nil {
+				n.logf(LOG_WARN, "ignoring config of topic %s - %s", t.Name, err)
+			}
+		}
 		//检测channel
 		for _, c := range t.Channels {
 			if !protocol.IsValidChannelName(c.Name) {
@@ -376,6 +546,12 @@ func (n *NSQD) LoadMetadata() error {
 			if c.Paused {
 				channel.Pause()
 			}
+			if c.Config != nil {
+				err := channel.SetConfig(*c.Config)
+				if err != nil {
+					n.logf(LOG_WARN, "ignoring config of channel %s - %s", c.Name, err)
+				}
+			}
 		}
 		//开启topic
 		topic.Start()
@@ -399,6 +575,7 @@ func (n *NSQD) PersistMetadata() error {
 		topicData := make(map[string]interface{})
 		topicData["name"] = topic.name
 		topicData["paused"] = topic.IsPaused()
+		topicData["config"] = topic.Config()
 		channels := []interface{}{}
 		topic.Lock()
 		for _, channel := range topic.channelMap {
@@ -410,6 +587,7 @@ func (n *NSQD) PersistMetadata() error {
 			channelData := make(map[string]interface{})
 			channelData["name"] = channel.name
 			channelData["paused"] = channel.IsPaused()
+			channelData["config"] = channel.Config()
 			channels = append(channels, channelData)
 			channel.Unlock()
 		}
@@ -459,6 +637,21 @@ func (n *NSQD) Exit() {
 		n.httpsListener.Close()
 	}
 
+	//关闭mqtt监听
+	if n.mqttListener != nil {
+		n.mqttListener.Close()
+	}
+
+	//关闭kafka监听
+	if n.kafkaListener != nil {
+		n.kafkaListener.Close()
+	}
+
+	//发布完排队的审计记录，要在关闭topic之前
+	if n.audit != nil {
+		n.audit.Close()
+	}
+
 	n.Lock()
 	err := n.PersistMetadata()
 	if err != nil {
@@ -482,6 +675,11 @@ func (n *NSQD) Exit() {
 // GetTopic performs a thread safe operation
 // to return a pointer to a Topic object (potentially new)
 func (n *NSQD) GetTopic(topicName string) *Topic {
+	return n.getTopic(topicName, 0)
+}
+
+// getTopic creates a missing topic with maxMsgSize, 0 is --max-msg-size
+func (n *NSQD) getTopic(topicName string, maxMsgSize int64) *Topic {
 	// most likely, we already have this topic, so try read lock first.
 	n.RLock()
 	t, ok := n.topicMap[topicName]
@@ -502,7 +700,7 @@ func (n *NSQD) GetTopic(topicName string) *Topic {
 		n.DeleteExistingTopic(t.name)
 	}
 	//创建一个新的topic
-	t = NewTopic(topicName, &context{n}, deleteCallback)
+	t = NewTopic(topicName, maxMsgSize, &context{n}, deleteCallback)
 	n.topicMap[topicName] = t
 
 	n.Unlock()
@@ -786,6 +984,40 @@ func buildTLSConfig(opts *Options) (*tls.Config, error) {
 	return tlsConfig, nil
 }
 
+// buildLookupdTLSConfig returns the config to connect to nsqlookupd with,
+// --tls-cert is presented as the client certificate
+func buildLookupdTLSConfig(opts *Options) (*tls.Config, error) {
+	if !opts.NSQLookupdTLS {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: opts.TLSMinVersion,
+	}
+
+	if opts.TLSCert != "" || opts.TLSKey != "" {
+		cert, err := tls.LoadX509KeyPair(opts.TLSCert, opts.TLSKey)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if opts.TLSRootCAFile != "" {
+		tlsCertPool := x509.NewCertPool()
+		caCertFile, err := ioutil.ReadFile(opts.TLSRootCAFile)
+		if err != nil {
+			return nil, err
+		}
+		if !tlsCertPool.AppendCertsFromPEM(caCertFile) {
+			return nil, errors.New("failed to append certificate to pool")
+		}
+		tlsConfig.RootCAs = tlsCertPool
+	}
+
+	return tlsConfig, nil
+}
+
 func (n *NSQD) IsAuthEnabled() bool {
 	return len(n.getOpts().AuthHTTPAddresses) != 0
 }
@@ -1,6 +1,7 @@
 package nsqd
 
 import (
+	gocontext "context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"net"
 	"os"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -240,6 +242,45 @@ func TestPauseMetadata(t *testing.T) {
 	test.Equal(t, false, isPaused(nsqd, 0, 0))
 }
 
+func TestLoadMetadataMaxMsgSize(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.MemQueueSize = 0
+	opts.MaxMsgSize = 100
+	_, _, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+
+	topicName := "load_metadata_max_msg_size" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	channel := topic.GetChannel("ch")
+	test.Nil(t, topic.SetConfig(TopicConfig{MaxMsgSize: 200}))
+	body := make([]byte, 150)
+	test.Nil(t, channel.PutMessage(NewMessage(topic.GenerateID(), body)))
+	topic.Pause()
+	test.Nil(t, topic.PutMessage(NewMessage(topic.GenerateID(), body)))
+	nsqd.Exit()
+
+	// the messages above --max-msg-size are read back right after the restart
+	restarted, err := New(opts)
+	test.Nil(t, err)
+	defer restarted.Exit()
+	test.Nil(t, restarted.LoadMetadata())
+	topic, err = restarted.GetExistingTopic(topicName)
+	test.Nil(t, err)
+	channel, err = topic.GetExistingChannel("ch")
+	test.Nil(t, err)
+	for _, backend := range []BackendQueue{topic.backend, channel.backend} {
+		select {
+		case b := <-backend.ReadChan():
+			msg, err := decodeMessage(b)
+			test.Nil(t, err)
+			test.Equal(t, body, msg.Body)
+		case <-time.After(time.Second):
+			t.Fatal("timed out reading the message")
+		}
+	}
+}
+
 func mustStartNSQLookupd(opts *nsqlookupd.Options) (*net.TCPAddr, *net.TCPAddr, *nsqlookupd.NSQLookupd) {
 	opts.TCPAddress = "127.0.0.1:0"
 	opts.HTTPAddress = "127.0.0.1:0"
@@ -415,6 +456,140 @@ func TestCluster(t *testing.T) {
 	test.Equal(t, 0, len(dd["channel:"+topicName+":ch"]))
 }
 
+func TestClusterTLS(t *testing.T) {
+	lopts := nsqlookupd.NewOptions()
+	lopts.Logger = test.NewTestLogger(t)
+	lopts.BroadcastAddress = "127.0.0.1"
+	lopts.TLSCert = "../nsqlookupd/test/certs/server.pem"
+	lopts.TLSKey = "../nsqlookupd/test/certs/server.key"
+	lopts.AuthSecret = "secret"
+	_, _, lookupd := mustStartNSQLookupd(lopts)
+	defer lookupd.Exit()
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.NSQLookupdTCPAddresses = []string{lookupd.RealTCPAddr().String()}
+	opts.NSQLookupdTLS = true
+	opts.NSQLookupdAuthSecret = "secret"
+	opts.TLSRootCAFile = "../nsqlookupd/test/certs/ca.pem"
+	opts.BroadcastAddress = "127.0.0.1"
+	_, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "cluster_tls_test" + strconv.Itoa(int(time.Now().Unix()))
+	nsqd.GetTopic(topicName)
+
+	// allow some time for nsqd to push info to nsqlookupd
+	time.Sleep(350 * time.Millisecond)
+
+	producers := lookupd.DB.FindProducers("topic", topicName, "")
+	test.Equal(t, 1, len(producers))
+
+	// changing the registrations over HTTPS needs the secret too
+	node := fmt.Sprintf("127.0.0.1:%d", httpAddr.Port)
+	err := nsqd.ci.TombstoneTopicProducer(topicName, node, nsqd.lookupdHTTPAddrs())
+	test.Nil(t, err)
+	producers = lookupd.DB.FindProducers("topic", topicName, "")
+	test.Equal(t, true, producers[0].IsTombstoned(lopts.TombstoneLifetime))
+}
+
+type stubResolver struct {
+	sync.Mutex
+	srv   []*net.SRV
+	delay chan struct{} // when set, the lookups wait for it to be closed
+}
+
+func (r *stubResolver) LookupSRV(ctx gocontext.Context, service, proto, name string) (string, []*net.SRV, error) {
+	r.Lock()
+	delay := r.delay
+	r.Unlock()
+	if delay != nil {
+		select {
+		case <-delay:
+		case <-ctx.Done():
+			return "", nil, ctx.Err()
+		}
+	}
+	r.Lock()
+	defer r.Unlock()
+	return name, r.srv, nil
+}
+
+func (r *stubResolver) LookupHost(ctx gocontext.Context, host string) ([]string, error) {
+	return nil, errors.New("no such host")
+}
+
+func (r *stubResolver) set(srv ...*net.SRV) {
+	r.Lock()
+	defer r.Unlock()
+	r.srv = srv
+}
+
+func TestClusterDiscovery(t *testing.T) {
+	lopts := nsqlookupd.NewOptions()
+	lopts.Logger = test.NewTestLogger(t)
+	lopts.BroadcastAddress = "127.0.0.1"
+	tcpAddr1, _, lookupd1 := mustStartNSQLookupd(lopts)
+	defer lookupd1.Exit()
+	lopts2 := nsqlookupd.NewOptions()
+	lopts2.Logger = test.NewTestLogger(t)
+	lopts2.BroadcastAddress = "127.0.0.1"
+	tcpAddr2, _, lookupd2 := mustStartNSQLookupd(lopts2)
+	defer lookupd2.Exit()
+
+	resolver := &stubResolver{}
+	resolver.set(&net.SRV{Target: "127.0.0.1.", Port: uint16(tcpAddr1.Port)})
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.NSQLookupdTCPAddresses = []string{"srv://_nsqlookupd._tcp.nsq.test"}
+	opts.NSQLookupdResolveInterval = 50 * time.Millisecond
+	opts.Resolver = resolver
+	opts.BroadcastAddress = "127.0.0.1"
+	_, _, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "cluster_discovery_test" + strconv.Itoa(int(time.Now().Unix()))
+	nsqd.GetTopic(topicName)
+
+	peerAddrs := func() []string {
+		var addrs []string
+		for _, lp := range nsqd.lookupPeers.Load().([]*lookupPeer) {
+			addrs = append(addrs, lp.addr)
+		}
+		return addrs
+	}
+
+	time.Sleep(350 * time.Millisecond)
+	test.Equal(t, []string{tcpAddr1.String()}, peerAddrs())
+	test.Equal(t, 1, len(lookupd1.DB.FindProducers("topic", topicName, "")))
+
+	// the record moves to the other nsqlookupd
+	resolver.set(&net.SRV{Target: "127.0.0.1.", Port: uint16(tcpAddr2.Port)})
+	time.Sleep(350 * time.Millisecond)
+	test.Equal(t, []string{tcpAddr2.String()}, peerAddrs())
+	test.Equal(t, 1, len(lookupd2.DB.FindProducers("topic", topicName, "")))
+
+	// a failed resolution keeps the peers
+	resolver.set()
+	time.Sleep(200 * time.Millisecond)
+	test.Equal(t, []string{tcpAddr2.String()}, peerAddrs())
+
+	// a slow resolution does not hold up the registrations
+	delay := make(chan struct{})
+	defer close(delay)
+	resolver.Lock()
+	resolver.delay = delay
+	resolver.Unlock()
+	time.Sleep(100 * time.Millisecond)
+	topicName2 := "cluster_discovery_test2" + strconv.Itoa(int(time.Now().Unix()))
+	nsqd.GetTopic(topicName2)
+	time.Sleep(100 * time.Millisecond)
+	test.Equal(t, 1, len(lookupd2.DB.FindProducers("topic", topicName2, "")))
+}
+
 func TestSetHealth(t *testing.T) {
 	opts := NewOptions()
 	opts.Logger = test.NewTestLogger(t)
@@ -439,3 +614,48 @@ func TestSetHealth(t *testing.T) {
 	test.Equal(t, "OK", nsqd.GetHealth())
 	test.Equal(t, true, nsqd.IsHealthy())
 }
+
+func TestLookupHealth(t *testing.T) {
+	lopts := nsqlookupd.NewOptions()
+	lopts.Logger = test.NewTestLogger(t)
+	_, lookupdHTTPAddr, lookupd := mustStartNSQLookupd(lopts)
+	defer lookupd.Exit()
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.NSQLookupdTCPAddresses = []string{lookupd.RealTCPAddr().String()}
+	_, _, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topic := nsqd.GetTopic("lookup_health" + strconv.Itoa(int(time.Now().Unix())))
+	for i := 0; i < 3; i++ {
+		topic.PutMessage(NewMessage(topic.GenerateID(), []byte("test")))
+	}
+
+	h := nsqd.getLookupHealth()
+	test.Equal(t, true, h.Healthy)
+	test.Equal(t, int64(3), h.Depth)
+	test.Equal(t, false, h.Draining)
+	test.Equal(t, "PING healthy=true depth=3 clients=0 draining=false", h.pingCommand().String())
+
+	// allow some time for nsqd to IDENTIFY with nsqlookupd
+	time.Sleep(350 * time.Millisecond)
+
+	var nodes struct {
+		Producers []struct {
+			Health *struct {
+				Healthy bool `json:"healthy"`
+			} `json:"health"`
+		} `json:"producers"`
+	}
+	endpoint := fmt.Sprintf("http://%s/nodes", lookupdHTTPAddr)
+	err := http_api.NewClient(nil, ConnectTimeout, RequestTimeout).GETV1(endpoint, &nodes)
+	test.Nil(t, err)
+	test.Equal(t, 1, len(nodes.Producers))
+	test.NotNil(t, nodes.Producers[0].Health)
+	test.Equal(t, true, nodes.Producers[0].Health.Healthy)
+
+	nsqd.SetHealth(errors.New("health error"))
+	test.Equal(t, false, nsqd.getLookupHealth().Healthy)
+}
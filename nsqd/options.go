@@ -9,6 +9,8 @@ import (
 	"os"
 	"time"
 
+	"github.com/nsqio/nsq/internal/audit"
+	"github.com/nsqio/nsq/internal/discovery"
 	"github.com/nsqio/nsq/internal/lg"
 )
 
@@ -18,22 +20,39 @@ type Options struct {
 	LogLevel  lg.LogLevel `flag:"log-level"`        //日志等级
 	LogPrefix string      `flag:"log-prefix"`       //日志前缀
 	Logger    Logger
+	Resolver  discovery.Resolver //解析srv://和dns://形式的nsqlookupd地址，为nil时用net.DefaultResolver
 
 	TCPAddress               string        `flag:"tcp-address"`                                        //tcp地址
 	HTTPAddress              string        `flag:"http-address"`                                       //http地址
 	HTTPSAddress             string        `flag:"https-address"`                                      //https地址
+	MQTTAddress              string        `flag:"mqtt-address"`                                       //mqtt地址(为空则不开启)
+	KafkaAddress             string        `flag:"kafka-address"`                                      //kafka协议地址(为空则不开启)
 	BroadcastAddress         string        `flag:"broadcast-address"`                                  //广播地址
+	Tags                     []string      `flag:"tag" cfg:"tags"`                                     //注册到nsqlookupd的标签，key:value
 	NSQLookupdTCPAddresses   []string      `flag:"lookupd-tcp-address" cfg:"nsqlookupd_tcp_addresses"` //nsqlookupd的地址
+	NSQLookupdTLS            bool          `flag:"lookupd-tls"`                                        //用TLS连接nsqlookupd
+	NSQLookupdAuthSecret     string        `flag:"lookupd-auth-secret"`                                //nsqlookupd的--auth-secret
 	AuthHTTPAddresses        []string      `flag:"auth-http-address" cfg:"auth_http_addresses"`
 	HTTPClientConnectTimeout time.Duration `flag:"http-client-connect-timeout" cfg:"http_client_connect_timeout"` //http连接时间
 	HTTPClientRequestTimeout time.Duration `flag:"http-client-request-timeout" cfg:"http_client_request_timeout"` //http的请求时间
 
+	NSQLookupdResolveInterval time.Duration `flag:"lookupd-resolve-interval"` //重新解析srv://和dns://形式的nsqlookupd地址的间隔
+
+	AuditLogPath       string        `flag:"audit-log"`             //记录修改topic、channel和配置的HTTP请求，为空则只保留在内存
+	AuditLogMaxSize    int64         `flag:"audit-log-max-size"`    //超过后轮转到<audit-log>.1，0为不轮转
+	AuditTopic         string        `flag:"audit-topic"`           //同时发布到本nsqd的这个topic
+	AdminRateLimit     float64       `flag:"admin-rate-limit"`      //每秒接受的管理请求数，0为不限制
+	AdminRateLimitWait time.Duration `flag:"admin-rate-limit-wait"` //超过限制的请求最多排队等待多久，0为直接返回429
+
 	// diskqueue options
-	DataPath        string        `flag:"data-path"`
-	MemQueueSize    int64         `flag:"mem-queue-size"`
-	MaxBytesPerFile int64         `flag:"max-bytes-per-file"`
-	SyncEvery       int64         `flag:"sync-every"`
-	SyncTimeout     time.Duration `flag:"sync-timeout"`
+	DataPath          string        `flag:"data-path"`
+	MemQueueSize      int64         `flag:"mem-queue-size"`
+	MaxBytesPerFile   int64         `flag:"max-bytes-per-file"`
+	SyncEvery         int64         `flag:"sync-every"`
+	SyncTimeout       time.Duration `flag:"sync-timeout"`
+	DurableWrites     bool          `flag:"durable-writes"`      //消息同步到磁盘后才应答
+	DiskCompression   string        `flag:"disk-compression"`    //磁盘队列的压缩方式
+	EncryptionKeyFile string        `flag:"encryption-key-file"` //磁盘队列加密密钥文件
 
 	QueueScanInterval        time.Duration
 	QueueScanRefreshInterval time.Duration
@@ -48,6 +67,7 @@ type Options struct {
 	MaxBodySize   int64         `flag:"max-body-size"`
 	MaxReqTimeout time.Duration `flag:"max-req-timeout"`
 	ClientTimeout time.Duration
+	DrainTimeout  time.Duration `flag:"drain-timeout"` //排空的最长等待时间
 
 	// client overridable configuration options
 	MaxHeartbeatInterval   time.Duration `flag:"max-heartbeat-interval"`
@@ -57,6 +77,7 @@ type Options struct {
 	MinOutputBufferTimeout time.Duration `flag:"min-output-buffer-timeout"`
 	OutputBufferTimeout    time.Duration `flag:"output-buffer-timeout"`
 	MaxChannelConsumers    int           `flag:"max-channel-consumers"`
+	ChannelDispatchPolicy  string        `flag:"channel-dispatch-policy"` //channel分发消息给消费者的策略
 
 	// statsd integration
 	StatsdAddress       string        `flag:"statsd-address"`
@@ -106,9 +127,15 @@ func NewOptions() *Options {
 		HTTPSAddress:     "0.0.0.0:4152",
 		BroadcastAddress: hostname,
 
+		Tags:                   make([]string, 0),
 		NSQLookupdTCPAddresses: make([]string, 0),
 		AuthHTTPAddresses:      make([]string, 0),
 
+		NSQLookupdResolveInterval: discovery.DefaultInterval,
+
+		AuditLogMaxSize:    audit.DefaultMaxFileSize,
+		AdminRateLimitWait: time.Second,
+
 		HTTPClientConnectTimeout: 2 * time.Second,
 		HTTPClientRequestTimeout: 5 * time.Second,
 
@@ -129,6 +156,7 @@ func NewOptions() *Options {
 		MaxBodySize:   5 * 1024 * 1024,
 		MaxReqTimeout: 1 * time.Hour,
 		ClientTimeout: 60 * time.Second,
+		DrainTimeout:  5 * time.Minute,
 
 		MaxHeartbeatInterval:   60 * time.Second,
 		MaxRdyCount:            2500,
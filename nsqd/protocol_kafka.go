@@ -0,0 +1,588 @@
+package nsqd
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"net"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/nsqio/nsq/internal/protocol"
+)
+
+// Kafka API keys
+const (
+	kafkaProduce     = 0
+	kafkaMetadata    = 3
+	kafkaApiVersions = 18
+)
+
+// Kafka error codes
+const (
+	kafkaNoError                 = 0
+	kafkaCorruptMessage          = 2
+	kafkaUnknownTopicOrPartition = 3
+	kafkaNotLeaderForPartition   = 6
+	kafkaMessageTooLarge         = 10
+	kafkaInvalidTopic            = 17
+	kafkaUnsupportedVersion      = 35
+	kafkaInvalidRequest          = 42
+	kafkaUnsupportedCompression  = 76
+)
+
+// the supported [min, max] version of each API, newer versions introduce
+// record batches and flexible encodings which are not implemented
+var kafkaApiVersionRanges = map[int16][2]int16{
+	kafkaProduce:     {0, 2},
+	kafkaMetadata:    {0, 1},
+	kafkaApiVersions: {0, 1},
+}
+
+// every NSQ topic is presented as a single partition led by this node
+const kafkaPartition = 0
+
+// kafkaServer accepts Kafka protocol connections on --kafka-address
+type kafkaServer struct {
+	ctx *context
+}
+
+func (s *kafkaServer) Handle(clientConn net.Conn) {
+	s.ctx.nsqd.logf(LOG_INFO, "KAFKA: new client(%s)", clientConn.RemoteAddr())
+
+	prot := &protocolKafka{ctx: s.ctx}
+	err := prot.IOLoop(clientConn)
+	if err != nil {
+		s.ctx.nsqd.logf(LOG_ERROR, "client(%s) - %s", clientConn.RemoteAddr(), err)
+		return
+	}
+}
+
+type kafkaRequestHeader struct {
+	apiKey        int16
+	apiVersion    int16
+	correlationID int32
+	clientID      string
+}
+
+// protocolKafka implements the subset of the Kafka protocol needed by
+// producers (ApiVersions, Metadata and Produce with v0/v1 message sets).
+//
+// Kafka topics map onto NSQ topics of the same name, each exposed as a
+// single partition (0) whose leader is this nsqd. Produce requests are
+// written with Topic.PutMessages, the message key is discarded and the
+// value becomes the message body.
+type protocolKafka struct {
+	ctx *context
+}
+
+func (p *protocolKafka) IOLoop(conn net.Conn) error {
+	var err error
+	var zeroTime time.Time
+
+	clientID := atomic.AddInt64(&p.ctx.nsqd.clientIDSequence, 1)
+	client := newKafkaClient(clientID, conn)
+	p.ctx.nsqd.AddClient(client.ID, client)
+
+	reader := bufio.NewReaderSize(conn, defaultBufferSize)
+	sizeBuf := make([]byte, 4)
+	for {
+		conn.SetReadDeadline(zeroTime)
+
+		_, err = io.ReadFull(reader, sizeBuf)
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			} else {
+				err = fmt.Errorf("failed to read request size - %s", err)
+			}
+			break
+		}
+		size := int32(binary.BigEndian.Uint32(sizeBuf))
+		if size <= 0 || int64(size) > p.ctx.nsqd.getOpts().MaxBodySize {
+			err = fmt.Errorf("invalid request size %d", size)
+			break
+		}
+
+		conn.SetReadDeadline(time.Now().Add(p.ctx.nsqd.getOpts().ClientTimeout))
+		buf := make([]byte, size)
+		_, err = io.ReadFull(reader, buf)
+		if err != nil {
+			err = fmt.Errorf("failed to read request - %s", err)
+			break
+		}
+
+		d := &kafkaDecoder{b: buf}
+		var hdr kafkaRequestHeader
+		hdr.apiKey = d.readInt16()
+		hdr.apiVersion = d.readInt16()
+		hdr.correlationID = d.readInt32()
+		hdr.clientID = d.readString()
+		if d.err != nil {
+			err = fmt.Errorf("malformed request header - %s", d.err)
+			break
+		}
+		client.SetClientID(hdr.clientID)
+
+		p.ctx.nsqd.logf(LOG_DEBUG, "PROTOCOL(KAFKA): [%s] api_key:%d api_version:%d",
+			client, hdr.apiKey, hdr.apiVersion)
+
+		var resp []byte
+		resp, err = p.Exec(client, hdr, d)
+		if err != nil {
+			break
+		}
+		if resp == nil {
+			// ie. Produce with acks=0
+			continue
+		}
+
+		err = p.Send(conn, hdr.correlationID, resp)
+		if err != nil {
+			err = fmt.Errorf("failed to send response - %s", err)
+			break
+		}
+	}
+
+	p.ctx.nsqd.logf(LOG_INFO, "PROTOCOL(KAFKA): [%s] exiting ioloop", client)
+	conn.Close()
+	p.ctx.nsqd.RemoveClient(client.ID)
+	return err
+}
+
+func (p *protocolKafka) Exec(client *kafkaClient, hdr kafkaRequestHeader, d *kafkaDecoder) ([]byte, error) {
+	versions, ok := kafkaApiVersionRanges[hdr.apiKey]
+	if !ok {
+		return nil, fmt.Errorf("unsupported api key %d", hdr.apiKey)
+	}
+	if hdr.apiVersion < versions[0] || hdr.apiVersion > versions[1] {
+		if hdr.apiKey == kafkaApiVersions {
+			// clients retry with a supported version after
+			// receiving a v0 UNSUPPORTED_VERSION response
+			return p.apiVersionsResponse(0, kafkaUnsupportedVersion), nil
+		}
+		return nil, fmt.Errorf("unsupported version %d for api key %d", hdr.apiVersion, hdr.apiKey)
+	}
+
+	switch hdr.apiKey {
+	case kafkaApiVersions:
+		return p.apiVersionsResponse(hdr.apiVersion, kafkaNoError), nil
+	case kafkaMetadata:
+		return p.Metadata(client, hdr, d)
+	case kafkaProduce:
+		return p.Produce(client, hdr, d)
+	}
+	return nil, fmt.Errorf("unsupported api key %d", hdr.apiKey)
+}
+
+// Send writes a response frame (size, correlation id, body)
+func (p *protocolKafka) Send(conn net.Conn, correlationID int32, body []byte) error {
+	buf := make([]byte, 8+len(body))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(4+len(body)))
+	binary.BigEndian.PutUint32(buf[4:8], uint32(correlationID))
+	copy(buf[8:], body)
+	conn.SetWriteDeadline(time.Now().Add(time.Second))
+	_, err := conn.Write(buf)
+	return err
+}
+
+func (p *protocolKafka) apiVersionsResponse(version int16, errorCode int16) []byte {
+	keys := make([]int, 0, len(kafkaApiVersionRanges))
+	for k := range kafkaApiVersionRanges {
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+
+	e := &kafkaEncoder{}
+	e.putInt16(errorCode)
+	e.putInt32(int32(len(keys)))
+	for _, k := range keys {
+		versions := kafkaApiVersionRanges[int16(k)]
+		e.putInt16(int16(k))
+		e.putInt16(versions[0])
+		e.putInt16(versions[1])
+	}
+	if version >= 1 {
+		// throttle_time_ms
+		e.putInt32(0)
+	}
+	return e.Bytes()
+}
+
+func (p *protocolKafka) Metadata(client *kafkaClient, hdr kafkaRequestHeader, d *kafkaDecoder) ([]byte, error) {
+	var topicNames []string
+	n := d.readInt32()
+	allTopics := n == 0 && hdr.apiVersion == 0 || n == -1
+	for i := int32(0); i < n && d.err == nil; i++ {
+		topicNames = append(topicNames, d.readString())
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("malformed Metadata request - %s", d.err)
+	}
+
+	if allTopics {
+		p.ctx.nsqd.RLock()
+		for name := range p.ctx.nsqd.topicMap {
+			topicNames = append(topicNames, name)
+		}
+		p.ctx.nsqd.RUnlock()
+		sort.Strings(topicNames)
+	}
+
+	opts := p.ctx.nsqd.getOpts()
+	nodeID := int32(opts.ID)
+	port := int32(p.ctx.nsqd.RealKafkaAddr().Port)
+
+	e := &kafkaEncoder{}
+	// brokers
+	e.putInt32(1)
+	e.putInt32(nodeID)
+	e.putString(opts.BroadcastAddress)
+	e.putInt32(port)
+	if hdr.apiVersion >= 1 {
+		// rack
+		e.putInt16(-1)
+		// controller_id
+		e.putInt32(nodeID)
+	}
+
+	// topics
+	e.putInt32(int32(len(topicNames)))
+	for _, name := range topicNames {
+		if !protocol.IsValidTopicName(name) {
+			e.putInt16(kafkaInvalidTopic)
+			e.putString(name)
+			if hdr.apiVersion >= 1 {
+				e.putBool(false)
+			}
+			e.putInt32(0)
+			continue
+		}
+		e.putInt16(kafkaNoError)
+		e.putString(name)
+		if hdr.apiVersion >= 1 {
+			// is_internal
+			e.putBool(false)
+		}
+		e.putInt32(1)
+		e.putInt16(kafkaNoError)
+		e.putInt32(kafkaPartition)
+		e.putInt32(nodeID)
+		// replicas and isr
+		e.putInt32(1)
+		e.putInt32(nodeID)
+		e.putInt32(1)
+		e.putInt32(nodeID)
+	}
+	return e.Bytes(), nil
+}
+
+type kafkaPartitionResult struct {
+	partition  int32
+	errorCode  int16
+	baseOffset int64
+}
+
+type kafkaTopicResult struct {
+	topic      string
+	partitions []kafkaPartitionResult
+}
+
+func (p *protocolKafka) Produce(client *kafkaClient, hdr kafkaRequestHeader, d *kafkaDecoder) ([]byte, error) {
+	acks := d.readInt16()
+	d.readInt32() // timeout_ms
+	numTopics := d.readInt32()
+	if d.err != nil {
+		return nil, fmt.Errorf("malformed Produce request - %s", d.err)
+	}
+
+	var results []kafkaTopicResult
+	for i := int32(0); i < numTopics; i++ {
+		topicName := d.readString()
+		numPartitions := d.readInt32()
+		if d.err != nil {
+			return nil, fmt.Errorf("malformed Produce request - %s", d.err)
+		}
+		result := kafkaTopicResult{topic: topicName}
+		for j := int32(0); j < numPartitions; j++ {
+			partition := d.readInt32()
+			messageSet := d.readBytes()
+			if d.err != nil {
+				return nil, fmt.Errorf("malformed Produce request - %s", d.err)
+			}
+			offset, errorCode := p.produce(client, topicName, partition, messageSet)
+			result.partitions = append(result.partitions, kafkaPartitionResult{
+				partition:  partition,
+				errorCode:  errorCode,
+				baseOffset: offset,
+			})
+		}
+		results = append(results, result)
+	}
+
+	if acks == 0 {
+		return nil, nil
+	}
+
+	e := &kafkaEncoder{}
+	e.putInt32(int32(len(results)))
+	for _, result := range results {
+		e.putString(result.topic)
+		e.putInt32(int32(len(result.partitions)))
+		for _, pr := range result.partitions {
+			e.putInt32(pr.partition)
+			e.putInt16(pr.errorCode)
+			e.putInt64(pr.baseOffset)
+			if hdr.apiVersion >= 2 {
+				// log_append_time
+				e.putInt64(-1)
+			}
+		}
+	}
+	if hdr.apiVersion >= 1 {
+		// throttle_time_ms
+		e.putInt32(0)
+	}
+	return e.Bytes(), nil
+}
+
+// produce publishes a single partition's message set, returning the base
+// offset (the topic's message count prior to the write) and an error code
+func (p *protocolKafka) produce(client *kafkaClient, topicName string, partition int32, messageSet []byte) (int64, int16) {
+	if !protocol.IsValidTopicName(topicName) {
+		return -1, kafkaInvalidTopic
+	}
+	if partition != kafkaPartition {
+		return -1, kafkaUnknownTopicOrPartition
+	}
+	// retriable, clients refresh metadata and try again
+	if p.ctx.nsqd.IsDraining() {
+		return -1, kafkaNotLeaderForPartition
+	}
+
+	bodies, err := decodeKafkaMessageSet(messageSet, p.ctx.nsqd.getOpts().MaxBodySize, 0)
+	if err != nil {
+		p.ctx.nsqd.logf(LOG_ERROR, "PROTOCOL(KAFKA): [%s] PRODUCE %s failed - %s", client, topicName, err)
+		switch err {
+		case errKafkaUnsupportedCompression:
+			return -1, kafkaUnsupportedCompression
+		case errKafkaMessageSetTooLarge:
+			return -1, kafkaMessageTooLarge
+		}
+		return -1, kafkaCorruptMessage
+	}
+	if len(bodies) == 0 {
+		return -1, kafkaInvalidRequest
+	}
+
+	topic := p.ctx.nsqd.GetTopic(topicName)
+	maxMsgSize := topic.MaxMsgSize()
+	messages := make([]*Message, 0, len(bodies))
+	for _, body := range bodies {
+		if len(body) == 0 {
+			return -1, kafkaCorruptMessage
+		}
+		if int64(len(body)) > maxMsgSize {
+			return -1, kafkaMessageTooLarge
+		}
+		messages = append(messages, NewMessage(topic.GenerateID(), body))
+	}
+
+	offset := int64(atomic.LoadUint64(&topic.messageCount))
+	err = topic.PutMessages(messages)
+	if err != nil {
+		p.ctx.nsqd.logf(LOG_ERROR, "PROTOCOL(KAFKA): [%s] PRODUCE %s failed - %s", client, topicName, err)
+		return -1, kafkaCorruptMessage
+	}
+
+	client.PublishedMessage(topicName, uint64(len(messages)))
+
+	return offset, kafkaNoError
+}
+
+var errKafkaUnsupportedCompression = errors.New("unsupported compression codec")
+
+var errKafkaMessageSetTooLarge = errors.New("decompressed message set too large")
+
+// decodeKafkaMessageSet returns the values of a v0/v1 message set
+//
+//	[offset int64][message_size int32][crc int32][magic int8][attributes int8]
+//	[timestamp int64 (magic 1 only)][key bytes][value bytes]
+//
+// a trailing partial message is permitted (and ignored) by the protocol,
+// gzip compressed wrapper messages are expanded up to maxSize bytes
+func decodeKafkaMessageSet(b []byte, maxSize int64, depth int) ([][]byte, error) {
+	var bodies [][]byte
+	for len(b) >= 12 {
+		size := int(int32(binary.BigEndian.Uint32(b[8:12])))
+		if size < 0 {
+			return nil, fmt.Errorf("invalid message size %d", size)
+		}
+		if len(b) < 12+size {
+			break
+		}
+		msg := b[12 : 12+size]
+		b = b[12+size:]
+
+		d := &kafkaDecoder{b: msg}
+		crc := uint32(d.readInt32())
+		if d.err == nil && crc32.ChecksumIEEE(d.b) != crc {
+			return nil, errors.New("message CRC mismatch")
+		}
+		magic := d.readInt8()
+		attributes := d.readInt8()
+		if magic > 1 {
+			return nil, fmt.Errorf("unsupported message magic %d", magic)
+		}
+		if magic == 1 {
+			d.readInt64() // timestamp
+		}
+		d.readBytes() // key
+		value := d.readBytes()
+		if d.err != nil {
+			return nil, fmt.Errorf("malformed message - %s", d.err)
+		}
+
+		switch attributes & 0x07 {
+		case 0:
+			bodies = append(bodies, value)
+		case 1:
+			if depth > 0 {
+				return nil, errors.New("nested compressed message set")
+			}
+			zr, err := gzip.NewReader(bytes.NewReader(value))
+			if err != nil {
+				return nil, err
+			}
+			inner, err := ioutil.ReadAll(io.LimitReader(zr, maxSize+1))
+			if err != nil {
+				return nil, err
+			}
+			if int64(len(inner)) > maxSize {
+				return nil, errKafkaMessageSetTooLarge
+			}
+			innerBodies, err := decodeKafkaMessageSet(inner, maxSize, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			bodies = append(bodies, innerBodies...)
+		default:
+			return nil, errKafkaUnsupportedCompression
+		}
+	}
+	return bodies, nil
+}
+
+// kafkaDecoder decodes the primitive Kafka field types, the first error
+// is sticky and subsequent reads return zero values
+type kafkaDecoder struct {
+	b   []byte
+	err error
+}
+
+var errKafkaShortRequest = errors.New("request too short")
+
+func (d *kafkaDecoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if len(d.b) < n {
+		d.err = errKafkaShortRequest
+		return nil
+	}
+	v := d.b[:n]
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *kafkaDecoder) readInt8() int8 {
+	b := d.next(1)
+	if b == nil {
+		return 0
+	}
+	return int8(b[0])
+}
+
+func (d *kafkaDecoder) readInt16() int16 {
+	b := d.next(2)
+	if b == nil {
+		return 0
+	}
+	return int16(binary.BigEndian.Uint16(b))
+}
+
+func (d *kafkaDecoder) readInt32() int32 {
+	b := d.next(4)
+	if b == nil {
+		return 0
+	}
+	return int32(binary.BigEndian.Uint32(b))
+}
+
+func (d *kafkaDecoder) readInt64() int64 {
+	b := d.next(8)
+	if b == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+// readString reads a (nullable) int16 length prefixed string
+func (d *kafkaDecoder) readString() string {
+	n := d.readInt16()
+	if n < 0 {
+		return ""
+	}
+	return string(d.next(int(n)))
+}
+
+// readBytes reads a (nullable) int32 length prefixed byte array
+func (d *kafkaDecoder) readBytes() []byte {
+	n := d.readInt32()
+	if n < 0 {
+		return nil
+	}
+	return d.next(int(n))
+}
+
+type kafkaEncoder struct {
+	bytes.Buffer
+}
+
+func (e *kafkaEncoder) putBool(v bool) {
+	if v {
+		e.WriteByte(1)
+	} else {
+		e.WriteByte(0)
+	}
+}
+
+func (e *kafkaEncoder) putInt16(v int16) {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], uint16(v))
+	e.Write(b[:])
+}
+
+func (e *kafkaEncoder) putInt32(v int32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(v))
+	e.Write(b[:])
+}
+
+func (e *kafkaEncoder) putInt64(v int64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(v))
+	e.Write(b[:])
+}
+
+func (e *kafkaEncoder) putString(v string) {
+	e.putInt16(int16(len(v)))
+	e.WriteString(v)
+}
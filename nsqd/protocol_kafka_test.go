@@ -0,0 +1,226 @@
+package nsqd
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nsqio/nsq/internal/test"
+)
+
+func mustStartKafkaNSQD(t *testing.T) (*net.TCPAddr, *NSQD) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.KafkaAddress = "127.0.0.1:0"
+	_, _, nsqd := mustStartNSQD(opts)
+	return nsqd.RealKafkaAddr(), nsqd
+}
+
+func kafkaRequest(t *testing.T, conn net.Conn, apiKey int16, apiVersion int16, correlationID int32, body []byte) {
+	e := &kafkaEncoder{}
+	e.putInt32(int32(2 + 2 + 4 + 2 + len("test") + len(body)))
+	e.putInt16(apiKey)
+	e.putInt16(apiVersion)
+	e.putInt32(correlationID)
+	e.putString("test")
+	e.Write(body)
+	_, err := conn.Write(e.Bytes())
+	test.Nil(t, err)
+}
+
+func kafkaResponse(t *testing.T, conn net.Conn, correlationID int32) *kafkaDecoder {
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	sizeBuf := make([]byte, 4)
+	_, err := io.ReadFull(conn, sizeBuf)
+	test.Nil(t, err)
+	buf := make([]byte, binary.BigEndian.Uint32(sizeBuf))
+	_, err = io.ReadFull(conn, buf)
+	test.Nil(t, err)
+	d := &kafkaDecoder{b: buf}
+	test.Equal(t, correlationID, d.readInt32())
+	return d
+}
+
+// closeKafka waits for the connection to be closed on the nsqd side too, so
+// that it does not log after the test
+func closeKafka(conn net.Conn, nsqd *NSQD) {
+	conn.Close()
+	for i := 0; i < 100; i++ {
+		nsqd.clientLock.RLock()
+		n := len(nsqd.clients)
+		nsqd.clientLock.RUnlock()
+		if n == 0 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func kafkaMessageSet(values ...string) []byte {
+	e := &kafkaEncoder{}
+	for i, v := range values {
+		kafkaPutMessage(e, int64(i), 0, []byte(v))
+	}
+	return e.Bytes()
+}
+
+func kafkaPutMessage(e *kafkaEncoder, offset int64, attributes byte, value []byte) {
+	m := &kafkaEncoder{}
+	m.WriteByte(1) // magic
+	m.WriteByte(attributes)
+	m.putInt64(time.Now().UnixNano() / int64(time.Millisecond))
+	m.putInt32(-1) // null key
+	m.putInt32(int32(len(value)))
+	m.Write(value)
+
+	e.putInt64(offset)
+	e.putInt32(int32(4 + m.Len()))
+	e.putInt32(int32(crc32.ChecksumIEEE(m.Bytes())))
+	e.Write(m.Bytes())
+}
+
+// kafkaGzipMessageSet wraps a message set in a gzip compressed message
+func kafkaGzipMessageSet(set []byte) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write(set)
+	zw.Close()
+	e := &kafkaEncoder{}
+	kafkaPutMessage(e, 0, 1, buf.Bytes())
+	return e.Bytes()
+}
+
+func kafkaProduceBody(acks int16, topicName string, partition int32, messageSet []byte) []byte {
+	e := &kafkaEncoder{}
+	e.putInt16(acks)
+	e.putInt32(1000)
+	e.putInt32(1)
+	e.putString(topicName)
+	e.putInt32(1)
+	e.putInt32(partition)
+	e.putInt32(int32(len(messageSet)))
+	e.Write(messageSet)
+	return e.Bytes()
+}
+
+func TestKafkaApiVersionsAndMetadata(t *testing.T) {
+	addr, nsqd := mustStartKafkaNSQD(t)
+	defer os.RemoveAll(nsqd.getOpts().DataPath)
+	defer nsqd.Exit()
+
+	conn, err := net.DialTimeout("tcp", addr.String(), time.Second)
+	test.Nil(t, err)
+	defer closeKafka(conn, nsqd)
+
+	// an unsupported version gets a v0 UNSUPPORTED_VERSION response
+	kafkaRequest(t, conn, kafkaApiVersions, 3, 1, nil)
+	d := kafkaResponse(t, conn, 1)
+	test.Equal(t, int16(kafkaUnsupportedVersion), d.readInt16())
+
+	kafkaRequest(t, conn, kafkaApiVersions, 1, 2, nil)
+	d = kafkaResponse(t, conn, 2)
+	test.Equal(t, int16(kafkaNoError), d.readInt16())
+	test.Equal(t, int32(len(kafkaApiVersionRanges)), d.readInt32())
+	for i := 0; i < len(kafkaApiVersionRanges); i++ {
+		apiKey := d.readInt16()
+		versions := kafkaApiVersionRanges[apiKey]
+		test.Equal(t, versions[0], d.readInt16())
+		test.Equal(t, versions[1], d.readInt16())
+	}
+	test.Equal(t, int32(0), d.readInt32())
+	test.Nil(t, d.err)
+
+	e := &kafkaEncoder{}
+	e.putInt32(1)
+	e.putString("orders")
+	kafkaRequest(t, conn, kafkaMetadata, 0, 3, e.Bytes())
+	d = kafkaResponse(t, conn, 3)
+	test.Equal(t, int32(1), d.readInt32())
+	test.Equal(t, int32(nsqd.getOpts().ID), d.readInt32())
+	test.Equal(t, nsqd.getOpts().BroadcastAddress, d.readString())
+	test.Equal(t, int32(addr.Port), d.readInt32())
+	test.Equal(t, int32(1), d.readInt32())
+	test.Equal(t, int16(kafkaNoError), d.readInt16())
+	test.Equal(t, "orders", d.readString())
+	test.Equal(t, int32(1), d.readInt32())
+	test.Equal(t, int16(kafkaNoError), d.readInt16())
+	test.Equal(t, int32(kafkaPartition), d.readInt32())
+	test.Equal(t, int32(nsqd.getOpts().ID), d.readInt32())
+	test.Nil(t, d.err)
+}
+
+func TestKafkaProduce(t *testing.T) {
+	addr, nsqd := mustStartKafkaNSQD(t)
+	defer os.RemoveAll(nsqd.getOpts().DataPath)
+	defer nsqd.Exit()
+
+	conn, err := net.DialTimeout("tcp", addr.String(), time.Second)
+	test.Nil(t, err)
+	defer closeKafka(conn, nsqd)
+
+	kafkaRequest(t, conn, kafkaProduce, 2, 1,
+		kafkaProduceBody(1, "orders", 0, kafkaMessageSet("a", "b")))
+	d := kafkaResponse(t, conn, 1)
+	test.Equal(t, int32(1), d.readInt32())
+	test.Equal(t, "orders", d.readString())
+	test.Equal(t, int32(1), d.readInt32())
+	test.Equal(t, int32(0), d.readInt32())
+	test.Equal(t, int16(kafkaNoError), d.readInt16())
+	test.Equal(t, int64(0), d.readInt64())
+	test.Equal(t, int64(-1), d.readInt64())
+	test.Equal(t, int32(0), d.readInt32())
+	test.Nil(t, d.err)
+
+	// acks=0 has no response
+	kafkaRequest(t, conn, kafkaProduce, 2, 2,
+		kafkaProduceBody(0, "orders", 0, kafkaMessageSet("c")))
+
+	kafkaRequest(t, conn, kafkaProduce, 0, 3,
+		kafkaProduceBody(1, "orders", 1, kafkaMessageSet("d")))
+	d = kafkaResponse(t, conn, 3)
+	d.readInt32()
+	d.readString()
+	d.readInt32()
+	d.readInt32()
+	test.Equal(t, int16(kafkaUnknownTopicOrPartition), d.readInt16())
+
+	topic, err := nsqd.GetExistingTopic("orders")
+	test.Nil(t, err)
+	test.Equal(t, int64(3), topic.Depth())
+
+	msg := <-topic.memoryMsgChan
+	test.Equal(t, []byte("a"), msg.Body)
+}
+
+func TestKafkaMessageSetCRC(t *testing.T) {
+	set := kafkaMessageSet("a")
+	set[len(set)-1] = 'b'
+	_, err := decodeKafkaMessageSet(set, 1024, 0)
+	test.NotNil(t, err)
+
+	// a trailing partial message is ignored
+	set = kafkaMessageSet("a", "b")
+	bodies, err := decodeKafkaMessageSet(set[:len(set)-3], 1024, 0)
+	test.Nil(t, err)
+	test.Equal(t, 1, len(bodies))
+}
+
+func TestKafkaMessageSetGzip(t *testing.T) {
+	set := kafkaGzipMessageSet(kafkaMessageSet("a", "b"))
+	bodies, err := decodeKafkaMessageSet(set, 1024, 0)
+	test.Nil(t, err)
+	test.Equal(t, [][]byte{[]byte("a"), []byte("b")}, bodies)
+
+	// expands beyond the limit
+	set = kafkaGzipMessageSet(kafkaMessageSet(strings.Repeat("x", 64*1024)))
+	test.Equal(t, true, len(set) < 1024)
+	_, err = decodeKafkaMessageSet(set, 1024, 0)
+	test.Equal(t, errKafkaMessageSetTooLarge, err)
+}
@@ -0,0 +1,786 @@
+package nsqd
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/nsqio/nsq/internal/protocol"
+)
+
+// MQTT 3.1.1 control packet types
+const (
+	mqttConnect     = 1
+	mqttConnack     = 2
+	mqttPublish     = 3
+	mqttPuback      = 4
+	mqttSubscribe   = 8
+	mqttSuback      = 9
+	mqttUnsubscribe = 10
+	mqttUnsuback    = 11
+	mqttPingreq     = 12
+	mqttPingresp    = 13
+	mqttDisconnect  = 14
+)
+
+// CONNACK return codes
+const (
+	mqttConnAccepted           = 0x00
+	mqttConnBadProtocol        = 0x01
+	mqttConnIdentifierRejected = 0x02
+	mqttConnBadCredentials     = 0x04
+	mqttConnNotAuthorized      = 0x05
+)
+
+const mqttSubackFailure = 0x80
+
+// subscriptions of the form $share/<channel>/<topic> consume from a named
+// (load balanced) channel, see parseMQTTFilter
+const mqttSharePrefix = "$share/"
+
+var errMQTTDisconnect = errors.New("client sent DISCONNECT")
+
+var mqttInvalidChannelChars = regexp.MustCompile(`[^\.a-zA-Z0-9_-]`)
+
+// mqttServer accepts MQTT 3.1.1 connections on --mqtt-address
+type mqttServer struct {
+	ctx *context
+}
+
+func (s *mqttServer) Handle(clientConn net.Conn) {
+	s.ctx.nsqd.logf(LOG_INFO, "MQTT: new client(%s)", clientConn.RemoteAddr())
+
+	prot := &protocolMQTT{ctx: s.ctx}
+	err := prot.IOLoop(clientConn)
+	if err != nil {
+		s.ctx.nsqd.logf(LOG_ERROR, "client(%s) - %s", clientConn.RemoteAddr(), err)
+		return
+	}
+}
+
+type mqttPacket struct {
+	header byte
+	body   []byte
+}
+
+func (p mqttPacket) Type() byte {
+	return p.header >> 4
+}
+
+func (p mqttPacket) Flags() byte {
+	return p.header & 0x0f
+}
+
+type mqttWill struct {
+	topic string
+	body  []byte
+}
+
+// protocolMQTT bridges MQTT 3.1.1 onto topics and channels
+//
+// PUBLISH topic names map onto NSQ topic names by replacing the MQTT level
+// separator '/' with '.'. A SUBSCRIBE topic filter maps onto a topic in the
+// same way and onto a channel named after the MQTT client identifier (an
+// #ephemeral channel for clean sessions), or onto a named channel shared by
+// every subscriber when using the $share/<channel>/<topic> form.
+//
+// QoS 0 deliveries are FIN'd as soon as they are written, QoS 1 deliveries
+// are FIN'd when the client sends PUBACK (and are otherwise requeued after
+// --msg-timeout). QoS 2 is not supported, subscriptions requesting it are
+// granted QoS 1 and QoS 2 PUBLISH packets close the connection.
+type protocolMQTT struct {
+	ctx *context
+}
+
+func (p *protocolMQTT) IOLoop(conn net.Conn) error {
+	var err error
+	var pkt mqttPacket
+	var zeroTime time.Time
+	var will *mqttWill
+
+	clientID := atomic.AddInt64(&p.ctx.nsqd.clientIDSequence, 1)
+	client := newMQTTClient(clientID, conn, p.ctx)
+
+	// the first packet must be CONNECT
+	client.SetReadDeadline(time.Now().Add(p.ctx.nsqd.getOpts().ClientTimeout))
+	pkt, err = p.readPacket(client)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to read CONNECT - %s", err)
+	}
+	if pkt.Type() != mqttConnect {
+		conn.Close()
+		return fmt.Errorf("expected CONNECT, got packet type %d", pkt.Type())
+	}
+	will, err = p.CONNECT(client, pkt)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	p.ctx.nsqd.AddClient(client.ID, client)
+
+	for {
+		if client.KeepAlive > 0 {
+			// the server must disconnect after one and a half keep alive periods
+			client.SetReadDeadline(time.Now().Add(client.KeepAlive * 3 / 2))
+		} else {
+			client.SetReadDeadline(zeroTime)
+		}
+
+		pkt, err = p.readPacket(client)
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			} else {
+				err = fmt.Errorf("failed to read packet - %s", err)
+			}
+			break
+		}
+
+		p.ctx.nsqd.logf(LOG_DEBUG, "PROTOCOL(MQTT): [%s] packet type %d", client, pkt.Type())
+
+		err = p.Exec(client, pkt)
+		if err != nil {
+			break
+		}
+	}
+
+	if err == errMQTTDisconnect {
+		// a clean DISCONNECT discards the will
+		err = nil
+		will = nil
+	}
+	if err != nil {
+		p.ctx.nsqd.logf(LOG_ERROR, "PROTOCOL(MQTT): [%s] - %s", client, err)
+	}
+
+	p.ctx.nsqd.logf(LOG_INFO, "PROTOCOL(MQTT): [%s] exiting ioloop", client)
+	atomic.StoreInt32(&client.State, stateClosing)
+	conn.Close()
+
+	client.metaLock.Lock()
+	subs := client.subscriptions
+	client.subscriptions = make(map[string]*mqttSubscription)
+	client.metaLock.Unlock()
+	for _, sub := range subs {
+		close(sub.exitChan)
+		sub.channel.RemoveClient(client.ID)
+	}
+
+	if will != nil && p.ctx.nsqd.IsDraining() {
+		p.ctx.nsqd.logf(LOG_WARN, "PROTOCOL(MQTT): [%s] not publishing will, nsqd is draining", client)
+		will = nil
+	}
+	if will != nil {
+		topic := p.ctx.nsqd.GetTopic(will.topic)
+		if err := topic.PutMessage(NewMessage(topic.GenerateID(), will.body)); err != nil {
+			p.ctx.nsqd.logf(LOG_ERROR, "PROTOCOL(MQTT): [%s] failed to publish will - %s", client, err)
+		}
+	}
+
+	p.ctx.nsqd.RemoveClient(client.ID)
+	return err
+}
+
+func (p *protocolMQTT) Exec(client *mqttClient, pkt mqttPacket) error {
+	switch pkt.Type() {
+	case mqttPublish:
+		return p.PUBLISH(client, pkt)
+	case mqttPuback:
+		return p.PUBACK(client, pkt)
+	case mqttSubscribe:
+		return p.SUBSCRIBE(client, pkt)
+	case mqttUnsubscribe:
+		return p.UNSUBSCRIBE(client, pkt)
+	case mqttPingreq:
+		return p.Send(client, mqttPingresp<<4, nil)
+	case mqttDisconnect:
+		return errMQTTDisconnect
+	}
+	return fmt.Errorf("invalid packet type %d", pkt.Type())
+}
+
+// readPacket reads a single control packet (fixed header, variable length
+// remaining length and body)
+func (p *protocolMQTT) readPacket(client *mqttClient) (mqttPacket, error) {
+	var pkt mqttPacket
+	var err error
+
+	pkt.header, err = client.Reader.ReadByte()
+	if err != nil {
+		return pkt, err
+	}
+
+	var length int
+	var multiplier = 1
+	for i := 0; ; i++ {
+		if i == 4 {
+			return pkt, errors.New("malformed remaining length")
+		}
+		b, err := client.Reader.ReadByte()
+		if err != nil {
+			return pkt, err
+		}
+		length += int(b&0x7f) * multiplier
+		multiplier *= 128
+		if b&0x80 == 0 {
+			break
+		}
+	}
+
+	if int64(length) > p.ctx.nsqd.getOpts().MaxBodySize {
+		return pkt, fmt.Errorf("packet too big %d > %d", length, p.ctx.nsqd.getOpts().MaxBodySize)
+	}
+
+	pkt.body = make([]byte, length)
+	_, err = io.ReadFull(client.Reader, pkt.body)
+	return pkt, err
+}
+
+// Send writes a single control packet to the client
+func (p *protocolMQTT) Send(client *mqttClient, header byte, body []byte) error {
+	var buf [5]byte
+	buf[0] = header
+	n := 1
+	length := len(body)
+	for {
+		b := byte(length % 128)
+		length /= 128
+		if length > 0 {
+			b |= 0x80
+		}
+		buf[n] = b
+		n++
+		if length == 0 {
+			break
+		}
+	}
+
+	client.writeLock.Lock()
+	defer client.writeLock.Unlock()
+
+	client.SetWriteDeadline(time.Now().Add(time.Second))
+	_, err := client.Writer.Write(buf[:n])
+	if err != nil {
+		return err
+	}
+	_, err = client.Writer.Write(body)
+	if err != nil {
+		return err
+	}
+	return client.Writer.Flush()
+}
+
+func (p *protocolMQTT) CONNECT(client *mqttClient, pkt mqttPacket) (*mqttWill, error) {
+	r := &mqttReader{b: pkt.body}
+
+	protoName := r.readString()
+	protoLevel := r.readByte()
+	flags := r.readByte()
+	keepAlive := r.readUint16()
+	if r.err != nil {
+		return nil, fmt.Errorf("malformed CONNECT - %s", r.err)
+	}
+
+	if protoName != "MQTT" || protoLevel != 4 {
+		p.sendConnack(client, mqttConnBadProtocol)
+		return nil, fmt.Errorf("unsupported protocol %q level %d", protoName, protoLevel)
+	}
+
+	cleanSession := flags&0x02 != 0
+	hasWill := flags&0x04 != 0
+	hasPassword := flags&0x40 != 0
+	hasUsername := flags&0x80 != 0
+
+	clientID := r.readString()
+
+	var will *mqttWill
+	if hasWill {
+		willTopic := r.readString()
+		willBody := r.readBytes()
+		if r.err == nil {
+			topicName, ok := mqttTopicToNSQ(willTopic)
+			if !ok {
+				p.sendConnack(client, mqttConnBadProtocol)
+				return nil, fmt.Errorf("invalid will topic %q", willTopic)
+			}
+			will = &mqttWill{topic: topicName, body: willBody}
+		}
+	}
+	if hasUsername {
+		r.readString()
+	}
+	var password string
+	if hasPassword {
+		password = string(r.readBytes())
+	}
+	if r.err != nil {
+		return nil, fmt.Errorf("malformed CONNECT - %s", r.err)
+	}
+
+	if clientID == "" {
+		if !cleanSession {
+			p.sendConnack(client, mqttConnIdentifierRejected)
+			return nil, errors.New("empty client identifier requires a clean session")
+		}
+		clientID = fmt.Sprintf("mqtt-%d", client.ID)
+	}
+
+	if p.ctx.nsqd.IsAuthEnabled() {
+		if !hasPassword {
+			p.sendConnack(client, mqttConnNotAuthorized)
+			return nil, errors.New("CONNECT without password when auth is enabled")
+		}
+		if err := client.Auth(password); err != nil {
+			// we don't want to leak errors contacting the auth server to untrusted clients
+			p.ctx.nsqd.logf(LOG_WARN, "PROTOCOL(MQTT): [%s] AUTH failed %s", client, err)
+			p.sendConnack(client, mqttConnBadCredentials)
+			return nil, errors.New("AUTH failed")
+		}
+		if !client.HasAuthorizations() {
+			p.sendConnack(client, mqttConnNotAuthorized)
+			return nil, errors.New("AUTH no authorizations found")
+		}
+	}
+
+	// the will is published on behalf of the client when it disconnects, so it
+	// is checked like a PUBLISH up front
+	if will != nil {
+		if len(will.body) == 0 {
+			p.sendConnack(client, mqttConnBadProtocol)
+			return nil, errors.New("invalid empty will message")
+		}
+		if maxMsgSize := p.ctx.nsqd.maxMsgSize(will.topic); int64(len(will.body)) > maxMsgSize {
+			p.sendConnack(client, mqttConnBadProtocol)
+			return nil, fmt.Errorf("will message too big %d > %d", len(will.body), maxMsgSize)
+		}
+		if err := p.CheckAuth(client, "PUBLISH", will.topic, ""); err != nil {
+			p.sendConnack(client, mqttConnNotAuthorized)
+			return nil, err
+		}
+	}
+
+	client.metaLock.Lock()
+	client.ClientID = clientID
+	client.CleanSession = cleanSession
+	client.KeepAlive = time.Duration(keepAlive) * time.Second
+	client.metaLock.Unlock()
+	atomic.StoreInt32(&client.State, stateConnected)
+
+	p.ctx.nsqd.logf(LOG_INFO, "PROTOCOL(MQTT): [%s] CONNECT client_id:%s clean_session:%t keep_alive:%d",
+		client, clientID, cleanSession, keepAlive)
+
+	return will, p.sendConnack(client, mqttConnAccepted)
+}
+
+func (p *protocolMQTT) sendConnack(client *mqttClient, code byte) error {
+	return p.Send(client, mqttConnack<<4, []byte{0, code})
+}
+
+func (p *protocolMQTT) PUBLISH(client *mqttClient, pkt mqttPacket) error {
+	qos := (pkt.Flags() >> 1) & 0x03
+	if qos > 1 {
+		return fmt.Errorf("PUBLISH QoS %d not supported", qos)
+	}
+
+	r := &mqttReader{b: pkt.body}
+	mqttTopic := r.readString()
+	var packetID uint16
+	if qos > 0 {
+		packetID = r.readUint16()
+	}
+	if r.err != nil {
+		return fmt.Errorf("malformed PUBLISH - %s", r.err)
+	}
+	body := r.b
+
+	topicName, ok := mqttTopicToNSQ(mqttTopic)
+	if !ok {
+		return fmt.Errorf("PUBLISH topic name %q is not valid", mqttTopic)
+	}
+
+	if len(body) == 0 {
+		return errors.New("PUBLISH invalid empty message body")
+	}
+
+	if maxMsgSize := p.ctx.nsqd.maxMsgSize(topicName); int64(len(body)) > maxMsgSize {
+		return fmt.Errorf("PUBLISH message too big %d > %d", len(body), maxMsgSize)
+	}
+
+	// MQTT 3.1.1 has no negative acknowledgement for PUBLISH, the
+	// connection is closed instead
+	if err := p.CheckAuth(client, "PUBLISH", topicName, ""); err != nil {
+		return err
+	}
+
+	// the client will reconnect (to another node) and retry
+	if p.ctx.nsqd.IsDraining() {
+		return errors.New("PUBLISH failed, nsqd is draining")
+	}
+
+	topic := p.ctx.nsqd.GetTopic(topicName)
+	msg := NewMessage(topic.GenerateID(), append([]byte(nil), body...))
+	err := topic.PutMessage(msg)
+	if err != nil {
+		return fmt.Errorf("PUBLISH failed %s", err)
+	}
+
+	client.PublishedMessage(topicName, 1)
+
+	if qos == 1 {
+		return p.Send(client, mqttPuback<<4, []byte{byte(packetID >> 8), byte(packetID)})
+	}
+	return nil
+}
+
+func (p *protocolMQTT) PUBACK(client *mqttClient, pkt mqttPacket) error {
+	if len(pkt.body) != 2 {
+		return errors.New("malformed PUBACK")
+	}
+	packetID := binary.BigEndian.Uint16(pkt.body)
+
+	pending, ok := client.popPending(packetID)
+	if !ok {
+		return nil
+	}
+	err := pending.channel.FinishMessage(client.ID, pending.id)
+	if err != nil {
+		// the message most likely timed out and was requeued
+		p.ctx.nsqd.logf(LOG_DEBUG, "PROTOCOL(MQTT): [%s] PUBACK %s failed %s", client, pending.id, err)
+		return nil
+	}
+	client.FinishedMessage()
+	return nil
+}
+
+func (p *protocolMQTT) SUBSCRIBE(client *mqttClient, pkt mqttPacket) error {
+	if pkt.Flags() != 0x02 {
+		return errors.New("malformed SUBSCRIBE flags")
+	}
+
+	r := &mqttReader{b: pkt.body}
+	packetID := r.readUint16()
+	if r.err != nil {
+		return fmt.Errorf("malformed SUBSCRIBE - %s", r.err)
+	}
+
+	resp := []byte{byte(packetID >> 8), byte(packetID)}
+	for len(r.b) > 0 {
+		filter := r.readString()
+		qos := r.readByte()
+		if r.err != nil {
+			return fmt.Errorf("malformed SUBSCRIBE - %s", r.err)
+		}
+		if qos > 1 {
+			qos = 1
+		}
+		if err := p.subscribe(client, filter, qos); err != nil {
+			p.ctx.nsqd.logf(LOG_WARN, "PROTOCOL(MQTT): [%s] SUBSCRIBE %q failed - %s", client, filter, err)
+			resp = append(resp, mqttSubackFailure)
+			continue
+		}
+		resp = append(resp, qos)
+	}
+	if len(resp) == 2 {
+		return errors.New("SUBSCRIBE must contain at least one topic filter")
+	}
+
+	return p.Send(client, mqttSuback<<4, resp)
+}
+
+func (p *protocolMQTT) subscribe(client *mqttClient, filter string, qos byte) error {
+	topicName, channelName, err := parseMQTTFilter(filter, client.ClientID, client.CleanSession)
+	if err != nil {
+		return err
+	}
+
+	if err := p.CheckAuth(client, "SUBSCRIBE", topicName, channelName); err != nil {
+		return err
+	}
+
+	client.metaLock.Lock()
+	if sub, ok := client.subscriptions[filter]; ok {
+		// a repeated SUBSCRIBE replaces the QoS of the existing subscription
+		sub.qos = qos
+		client.metaLock.Unlock()
+		return nil
+	}
+	client.metaLock.Unlock()
+
+	// see protocolV2.SUB for the reason for this retry loop
+	var channel *Channel
+	for {
+		topic := p.ctx.nsqd.GetTopic(topicName)
+		channel = topic.GetChannel(channelName)
+		if err := channel.AddClient(client.ID, client); err != nil {
+			return fmt.Errorf("channel consumers for %s:%s exceeds limit of %d",
+				topicName, channelName, p.ctx.nsqd.getOpts().MaxChannelConsumers)
+		}
+
+		if (channel.ephemeral && channel.Exiting()) || (topic.ephemeral && topic.Exiting()) {
+			channel.RemoveClient(client.ID)
+			time.Sleep(1 * time.Millisecond)
+			continue
+		}
+		break
+	}
+
+	sub := &mqttSubscription{
+		filter:         filter,
+		qos:            qos,
+		channel:        channel,
+		readyStateChan: make(chan int, 1),
+		exitChan:       make(chan int),
+	}
+	client.metaLock.Lock()
+	client.subscriptions[filter] = sub
+	client.metaLock.Unlock()
+	atomic.StoreInt32(&client.State, stateSubscribed)
+
+	go p.messagePump(client, sub)
+
+	return nil
+}
+
+func (p *protocolMQTT) UNSUBSCRIBE(client *mqttClient, pkt mqttPacket) error {
+	if pkt.Flags() != 0x02 {
+		return errors.New("malformed UNSUBSCRIBE flags")
+	}
+
+	r := &mqttReader{b: pkt.body}
+	packetID := r.readUint16()
+	for r.err == nil && len(r.b) > 0 {
+		filter := r.readString()
+		if r.err != nil {
+			break
+		}
+		client.metaLock.Lock()
+		sub, ok := client.subscriptions[filter]
+		delete(client.subscriptions, filter)
+		client.metaLock.Unlock()
+		if ok {
+			close(sub.exitChan)
+			sub.channel.RemoveClient(client.ID)
+		}
+	}
+	if r.err != nil {
+		return fmt.Errorf("malformed UNSUBSCRIBE - %s", r.err)
+	}
+
+	return p.Send(client, mqttUnsuback<<4, []byte{byte(packetID >> 8), byte(packetID)})
+}
+
+func (p *protocolMQTT) CheckAuth(client *mqttClient, cmd, topicName, channelName string) error {
+	if client.ctx.nsqd.IsAuthEnabled() {
+		ok, err := client.IsAuthorized(topicName, channelName)
+		if err != nil {
+			// we don't want to leak errors contacting the auth server to untrusted clients
+			p.ctx.nsqd.logf(LOG_WARN, "PROTOCOL(MQTT): [%s] AUTH failed %s", client, err)
+			return errors.New("AUTH failed")
+		}
+		if !ok {
+			return fmt.Errorf("AUTH failed for %s on %q %q", cmd, topicName, channelName)
+		}
+	}
+	return nil
+}
+
+// messagePump delivers messages from a single subscription's channel
+func (p *protocolMQTT) messagePump(client *mqttClient, sub *mqttSubscription) {
+	var err error
+	var memoryMsgChan chan *Message
+	var backendMsgChan chan []byte
+	var dispatchChan chan *Message
+	var dispatchUpdateChan chan int
+
+	for {
+		dispatchChan = nil
+		dispatchUpdateChan = nil
+		if sub.channel.IsPaused() || !client.IsReadyForMessages() {
+			memoryMsgChan = nil
+			backendMsgChan = nil
+		} else if dispatchUpdateChan = sub.channel.dispatchUpdate(); sub.channel.isDispatching() {
+			memoryMsgChan = nil
+			backendMsgChan = nil
+			dispatchChan = sub.channel.dispatchChan(client.ID)
+			sub.channel.dispatchReady()
+		} else {
+			memoryMsgChan = sub.channel.getMemoryMsgChan()
+			backendMsgChan = sub.channel.backend.ReadChan()
+		}
+
+		var msg *Message
+		select {
+		case <-sub.readyStateChan:
+			continue
+		case b := <-backendMsgChan:
+			msg, err = decodeMessage(b)
+			if err != nil {
+				p.ctx.nsqd.logf(LOG_ERROR, "failed to decode message - %s", err)
+				continue
+			}
+		case msg = <-memoryMsgChan:
+		case msg = <-dispatchChan:
+		case <-dispatchUpdateChan:
+			continue
+		case <-sub.exitChan:
+			goto exit
+		}
+
+		msg.Attempts++
+		sub.channel.StartInFlightTimeout(msg, client.ID, sub.channel.getMsgTimeout())
+		client.SendingMessage()
+		err = p.SendMessage(client, sub, msg)
+		if err != nil {
+			goto exit
+		}
+	}
+
+exit:
+	p.ctx.nsqd.logf(LOG_INFO, "PROTOCOL(MQTT): [%s] exiting messagePump for %q", client, sub.filter)
+	if err != nil {
+		p.ctx.nsqd.logf(LOG_ERROR, "PROTOCOL(MQTT): [%s] messagePump error - %s", client, err)
+		client.Close()
+	}
+}
+
+// SendMessage writes a message as a PUBLISH packet, QoS 0 deliveries are
+// FIN'd immediately since the client will never acknowledge them
+func (p *protocolMQTT) SendMessage(client *mqttClient, sub *mqttSubscription, msg *Message) error {
+	client.metaLock.RLock()
+	qos := sub.qos
+	client.metaLock.RUnlock()
+
+	mqttTopic := strings.Replace(sub.channel.topicName, ".", "/", -1)
+
+	header := byte(mqttPublish<<4) | qos<<1
+	if qos > 0 && msg.Attempts > 1 {
+		// DUP
+		header |= 0x08
+	}
+
+	body := make([]byte, 0, 2+len(mqttTopic)+2+len(msg.Body))
+	body = append(body, byte(len(mqttTopic)>>8), byte(len(mqttTopic)))
+	body = append(body, mqttTopic...)
+	if qos > 0 {
+		packetID := client.addPending(sub.channel, msg.ID)
+		body = append(body, byte(packetID>>8), byte(packetID))
+	}
+	body = append(body, msg.Body...)
+
+	err := p.Send(client, header, body)
+	if err != nil {
+		return err
+	}
+
+	if qos == 0 {
+		if err := sub.channel.FinishMessage(client.ID, msg.ID); err == nil {
+			client.FinishedMessage()
+		}
+	}
+	return nil
+}
+
+// mqttTopicToNSQ maps an MQTT topic name onto an NSQ topic name
+func mqttTopicToNSQ(name string) (string, bool) {
+	if strings.ContainsAny(name, "+#") {
+		return "", false
+	}
+	topicName := strings.Replace(name, "/", ".", -1)
+	return topicName, protocol.IsValidTopicName(topicName)
+}
+
+// parseMQTTFilter maps an MQTT topic filter onto a topic and channel
+//
+//	a/b            -> topic "a.b", channel "<client id>" (or "<client id>#ephemeral")
+//	$share/g/a/b   -> topic "a.b", channel "g"
+//
+// wildcards are not supported
+func parseMQTTFilter(filter string, clientID string, cleanSession bool) (string, string, error) {
+	var channelName string
+	if strings.HasPrefix(filter, mqttSharePrefix) {
+		parts := strings.SplitN(filter[len(mqttSharePrefix):], "/", 2)
+		if len(parts) != 2 {
+			return "", "", fmt.Errorf("invalid shared subscription %q", filter)
+		}
+		channelName = parts[0]
+		filter = parts[1]
+	} else {
+		channelName = mqttInvalidChannelChars.ReplaceAllString(clientID, "_")
+		if len(channelName) > 54 {
+			channelName = channelName[:54]
+		}
+		if cleanSession {
+			channelName += "#ephemeral"
+		}
+	}
+
+	topicName, ok := mqttTopicToNSQ(filter)
+	if !ok {
+		return "", "", fmt.Errorf("topic filter %q is not valid", filter)
+	}
+	if !protocol.IsValidChannelName(channelName) {
+		return "", "", fmt.Errorf("channel name %q is not valid", channelName)
+	}
+	return topicName, channelName, nil
+}
+
+// mqttReader decodes the primitive MQTT field types, the first error
+// is sticky and subsequent reads return zero values
+type mqttReader struct {
+	b   []byte
+	err error
+}
+
+var errMQTTShortPacket = errors.New("packet too short")
+
+func (r *mqttReader) readByte() byte {
+	if r.err != nil {
+		return 0
+	}
+	if len(r.b) < 1 {
+		r.err = errMQTTShortPacket
+		return 0
+	}
+	v := r.b[0]
+	r.b = r.b[1:]
+	return v
+}
+
+func (r *mqttReader) readUint16() uint16 {
+	if r.err != nil {
+		return 0
+	}
+	if len(r.b) < 2 {
+		r.err = errMQTTShortPacket
+		return 0
+	}
+	v := binary.BigEndian.Uint16(r.b)
+	r.b = r.b[2:]
+	return v
+}
+
+func (r *mqttReader) readBytes() []byte {
+	n := int(r.readUint16())
+	if r.err != nil {
+		return nil
+	}
+	if len(r.b) < n {
+		r.err = errMQTTShortPacket
+		return nil
+	}
+	v := r.b[:n]
+	r.b = r.b[n:]
+	return v
+}
+
+func (r *mqttReader) readString() string {
+	return string(r.readBytes())
+}
@@ -0,0 +1,359 @@
+package nsqd
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nsqio/nsq/internal/test"
+)
+
+func mustStartMQTTNSQD(t *testing.T) (*net.TCPAddr, *NSQD) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.MQTTAddress = "127.0.0.1:0"
+	_, _, nsqd := mustStartNSQD(opts)
+	return nsqd.RealMQTTAddr(), nsqd
+}
+
+func mqttPacketBytes(header byte, body []byte) []byte {
+	buf := []byte{header}
+	length := len(body)
+	for {
+		b := byte(length % 128)
+		length /= 128
+		if length > 0 {
+			b |= 0x80
+		}
+		buf = append(buf, b)
+		if length == 0 {
+			break
+		}
+	}
+	return append(buf, body...)
+}
+
+func mqttString(s string) []byte {
+	return append([]byte{byte(len(s) >> 8), byte(len(s))}, s...)
+}
+
+func mqttConnectBody(clientID string, cleanSession bool) []byte {
+	var flags byte
+	if cleanSession {
+		flags |= 0x02
+	}
+	body := mqttString("MQTT")
+	body = append(body, 4, flags, 0, 60)
+	return append(body, mqttString(clientID)...)
+}
+
+func mqttRead(t *testing.T, r *bufio.Reader) (byte, []byte) {
+	header, err := r.ReadByte()
+	test.Nil(t, err)
+	var length, multiplier = 0, 1
+	for {
+		b, err := r.ReadByte()
+		test.Nil(t, err)
+		length += int(b&0x7f) * multiplier
+		multiplier *= 128
+		if b&0x80 == 0 {
+			break
+		}
+	}
+	body := make([]byte, length)
+	_, err = io.ReadFull(r, body)
+	test.Nil(t, err)
+	return header, body
+}
+
+func mustConnectMQTT(t *testing.T, addr *net.TCPAddr, clientID string) (net.Conn, *bufio.Reader) {
+	conn, err := net.DialTimeout("tcp", addr.String(), time.Second)
+	test.Nil(t, err)
+	_, err = conn.Write(mqttPacketBytes(mqttConnect<<4, mqttConnectBody(clientID, true)))
+	test.Nil(t, err)
+	r := bufio.NewReader(conn)
+	header, body := mqttRead(t, r)
+	test.Equal(t, byte(mqttConnack<<4), header)
+	test.Equal(t, []byte{0, mqttConnAccepted}, body)
+	return conn, r
+}
+
+func TestMQTTPublish(t *testing.T) {
+	addr, nsqd := mustStartMQTTNSQD(t)
+	defer os.RemoveAll(nsqd.getOpts().DataPath)
+	defer nsqd.Exit()
+
+	conn, r := mustConnectMQTT(t, addr, "publisher")
+	defer conn.Close()
+
+	// QoS 1
+	body := append(mqttString("sensors/temp"), 0, 7)
+	body = append(body, "21.5"...)
+	_, err := conn.Write(mqttPacketBytes(mqttPublish<<4|1<<1, body))
+	test.Nil(t, err)
+	header, resp := mqttRead(t, r)
+	test.Equal(t, byte(mqttPuback<<4), header)
+	test.Equal(t, []byte{0, 7}, resp)
+
+	// QoS 0 has no acknowledgement, follow with a PINGREQ to synchronize
+	body = append(mqttString("sensors/temp"), "22.0"...)
+	_, err = conn.Write(mqttPacketBytes(mqttPublish<<4, body))
+	test.Nil(t, err)
+	_, err = conn.Write(mqttPacketBytes(mqttPingreq<<4, nil))
+	test.Nil(t, err)
+	header, _ = mqttRead(t, r)
+	test.Equal(t, byte(mqttPingresp<<4), header)
+
+	topic, err := nsqd.GetExistingTopic("sensors.temp")
+	test.Nil(t, err)
+	test.Equal(t, int64(2), topic.Depth())
+}
+
+func TestMQTTSubscribe(t *testing.T) {
+	addr, nsqd := mustStartMQTTNSQD(t)
+	defer os.RemoveAll(nsqd.getOpts().DataPath)
+	defer nsqd.Exit()
+
+	topic := nsqd.GetTopic("sensors.temp")
+	channel := topic.GetChannel("readers")
+
+	conn, r := mustConnectMQTT(t, addr, "subscriber")
+	defer conn.Close()
+
+	body := []byte{0, 1}
+	body = append(body, mqttString("$share/readers/sensors/temp")...)
+	body = append(body, 1)
+	body = append(body, mqttString("sensors/+")...)
+	body = append(body, 1)
+	_, err := conn.Write(mqttPacketBytes(mqttSubscribe<<4|0x02, body))
+	test.Nil(t, err)
+	header, resp := mqttRead(t, r)
+	test.Equal(t, byte(mqttSuback<<4), header)
+	test.Equal(t, []byte{0, 1, 1, mqttSubackFailure}, resp)
+
+	msg := NewMessage(topic.GenerateID(), []byte("21.5"))
+	topic.PutMessage(msg)
+
+	header, resp = mqttRead(t, r)
+	test.Equal(t, byte(mqttPublish<<4|1<<1), header)
+	rd := &mqttReader{b: resp}
+	test.Equal(t, "sensors/temp", rd.readString())
+	packetID := rd.readUint16()
+	test.Nil(t, rd.err)
+	test.Equal(t, []byte("21.5"), rd.b)
+
+	channel.inFlightMutex.Lock()
+	test.Equal(t, 1, len(channel.inFlightMessages))
+	channel.inFlightMutex.Unlock()
+
+	ack := make([]byte, 2)
+	binary.BigEndian.PutUint16(ack, packetID)
+	_, err = conn.Write(mqttPacketBytes(mqttPuback<<4, ack))
+	test.Nil(t, err)
+	_, err = conn.Write(mqttPacketBytes(mqttPingreq<<4, nil))
+	test.Nil(t, err)
+	header, _ = mqttRead(t, r)
+	test.Equal(t, byte(mqttPingresp<<4), header)
+
+	channel.inFlightMutex.Lock()
+	test.Equal(t, 0, len(channel.inFlightMessages))
+	channel.inFlightMutex.Unlock()
+}
+
+func TestMQTTFilter(t *testing.T) {
+	var tests = []struct {
+		filter       string
+		clientID     string
+		cleanSession bool
+		topic        string
+		channel      string
+		valid        bool
+	}{
+		{"a/b", "dev:1", false, "a.b", "dev_1", true},
+		{"a/b", "dev1", true, "a.b", "dev1#ephemeral", true},
+		{"$share/group/a/b", "dev1", true, "a.b", "group", true},
+		{"$share/group", "dev1", true, "", "", false},
+		{"a/#", "dev1", true, "", "", false},
+		{"a/+/c", "dev1", true, "", "", false},
+	}
+	for _, tt := range tests {
+		topic, channel, err := parseMQTTFilter(tt.filter, tt.clientID, tt.cleanSession)
+		test.Equal(t, tt.valid, err == nil)
+		test.Equal(t, tt.topic, topic)
+		test.Equal(t, tt.channel, channel)
+	}
+}
+
+func mqttConnectWillBody(clientID string, willTopic string, will string, password string) []byte {
+	body := mqttString("MQTT")
+	body = append(body, 4, 0x02|0x04|0x80|0x40, 0, 60)
+	body = append(body, mqttString(clientID)...)
+	body = append(body, mqttString(willTopic)...)
+	body = append(body, mqttString(will)...)
+	body = append(body, mqttString("user")...)
+	return append(body, mqttString(password)...)
+}
+
+func TestMQTTWill(t *testing.T) {
+	authd := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		permission := "subscribe"
+		if r.Form.Get("secret") == "publisher" {
+			permission = "publish"
+		}
+		fmt.Fprintf(w, `{"ttl":30,"authorizations":[{"permissions":["%s"],"topic":".*","channels":[".*"]}]}`, permission)
+	}))
+	defer authd.Close()
+	addr, err := url.Parse(authd.URL)
+	test.Nil(t, err)
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.MQTTAddress = "127.0.0.1:0"
+	opts.AuthHTTPAddresses = []string{addr.Host}
+	opts.MaxMsgSize = 16
+	_, _, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	connect := func(body []byte) (net.Conn, byte) {
+		conn, err := net.DialTimeout("tcp", nsqd.RealMQTTAddr().String(), time.Second)
+		test.Nil(t, err)
+		_, err = conn.Write(mqttPacketBytes(mqttConnect<<4, body))
+		test.Nil(t, err)
+		_, resp := mqttRead(t, bufio.NewReader(conn))
+		return conn, resp[1]
+	}
+
+	// may only subscribe, the will would be a PUBLISH
+	conn, code := connect(mqttConnectWillBody("device", "alerts", "gone", "subscriber"))
+	conn.Close()
+	test.Equal(t, byte(mqttConnNotAuthorized), code)
+	conn, code = connect(mqttConnectWillBody("device", "alerts", strings.Repeat("x", 17), "publisher"))
+	conn.Close()
+	test.Equal(t, byte(mqttConnBadProtocol), code)
+	conn, code = connect(mqttConnectWillBody("device", "alerts", "", "publisher"))
+	conn.Close()
+	test.Equal(t, byte(mqttConnBadProtocol), code)
+
+	topic := nsqd.GetTopic("alerts")
+	conn, code = connect(mqttConnectWillBody("device", "alerts", "gone", "publisher"))
+	test.Equal(t, byte(mqttConnAccepted), code)
+	conn.Close()
+	for i := 0; i < 100 && topic.Depth() == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	test.Equal(t, int64(1), topic.Depth())
+
+	// not published while draining
+	conn, code = connect(mqttConnectWillBody("device", "alerts", "gone", "publisher"))
+	test.Equal(t, byte(mqttConnAccepted), code)
+	atomic.StoreInt32(&nsqd.draining, 1)
+	conn.Close()
+	clients := func() int {
+		nsqd.clientLock.RLock()
+		defer nsqd.clientLock.RUnlock()
+		return len(nsqd.clients)
+	}
+	for i := 0; i < 100 && clients() > 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	atomic.StoreInt32(&nsqd.draining, 0)
+	test.Equal(t, int64(1), topic.Depth())
+}
+
+func TestMQTTPendingTimeout(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.MQTTAddress = "127.0.0.1:0"
+	opts.MsgTimeout = 100 * time.Millisecond
+	opts.QueueScanInterval = 10 * time.Millisecond
+	_, _, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topic := nsqd.GetTopic("sensors.temp")
+	conn, r := mustConnectMQTT(t, nsqd.RealMQTTAddr(), "subscriber")
+	defer conn.Close()
+
+	body := append([]byte{0, 1}, mqttString("$share/readers/sensors/temp")...)
+	body = append(body, 1)
+	_, err := conn.Write(mqttPacketBytes(mqttSubscribe<<4|0x02, body))
+	test.Nil(t, err)
+	mqttRead(t, r)
+
+	topic.PutMessage(NewMessage(topic.GenerateID(), []byte("21.5")))
+	header, _ := mqttRead(t, r)
+	test.Equal(t, byte(mqttPublish<<4|1<<1), header)
+
+	// not acknowledged, the redelivery has its own packet identifier
+	header, _ = mqttRead(t, r)
+	test.Equal(t, byte(mqttPublish<<4|1<<1|0x08), header)
+
+	nsqd.clientLock.RLock()
+	var client *mqttClient
+	for _, c := range nsqd.clients {
+		client = c.(*mqttClient)
+	}
+	nsqd.clientLock.RUnlock()
+	client.metaLock.RLock()
+	pending := len(client.pending)
+	client.metaLock.RUnlock()
+	test.Equal(t, 1, pending)
+}
+
+func TestMQTTEmptyChannel(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.MQTTAddress = "127.0.0.1:0"
+	_, _, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topic := nsqd.GetTopic("sensors.temp")
+	conn, r := mustConnectMQTT(t, nsqd.RealMQTTAddr(), "subscriber")
+	defer conn.Close()
+
+	body := append([]byte{0, 1}, mqttString("$share/a/sensors/temp")...)
+	body = append(body, 1)
+	body = append(body, mqttString("$share/b/sensors/temp")...)
+	body = append(body, 1)
+	_, err := conn.Write(mqttPacketBytes(mqttSubscribe<<4|0x02, body))
+	test.Nil(t, err)
+	mqttRead(t, r)
+
+	topic.PutMessage(NewMessage(topic.GenerateID(), []byte("21.5")))
+	mqttRead(t, r)
+	mqttRead(t, r)
+
+	nsqd.clientLock.RLock()
+	var client *mqttClient
+	for _, c := range nsqd.clients {
+		client = c.(*mqttClient)
+	}
+	nsqd.clientLock.RUnlock()
+	test.Equal(t, int64(2), atomic.LoadInt64(&client.InFlightCount))
+
+	// only the deliveries of the emptied channel are forgotten
+	channel, err := topic.GetExistingChannel("b")
+	test.Nil(t, err)
+	channel.Empty()
+	test.Equal(t, int64(1), atomic.LoadInt64(&client.InFlightCount))
+	client.metaLock.RLock()
+	for _, p := range client.pending {
+		test.Equal(t, "a", p.channel.name)
+	}
+	pending := len(client.pending)
+	client.metaLock.RUnlock()
+	test.Equal(t, 1, pending)
+}
@@ -204,6 +204,8 @@ func (p *protocolV2) messagePump(client *clientV2, startedChan chan bool) {
 	var err error
 	var memoryMsgChan chan *Message
 	var backendMsgChan chan []byte
+	var dispatchChan chan *Message
+	var dispatchUpdateChan chan int
 	var subChannel *Channel
 	// NOTE: `flusherChan` is used to bound message latency for
 	// the pathological case of a channel on a low volume topic
@@ -216,7 +218,6 @@ func (p *protocolV2) messagePump(client *clientV2, startedChan chan bool) {
 	outputBufferTicker := time.NewTicker(client.OutputBufferTimeout)
 	heartbeatTicker := time.NewTicker(client.HeartbeatInterval)
 	heartbeatChan := heartbeatTicker.C
-	msgTimeout := client.MsgTimeout
 
 	// v2 opportunistically buffers data to clients to reduce write system calls
 	// we force flush in two cases:
@@ -247,17 +248,31 @@ func (p *protocolV2) messagePump(client *clientV2, startedChan chan bool) {
 		} else if flushed {
 			// last iteration we flushed...
 			// do not select on the flusher ticker channel
-			memoryMsgChan = subChannel.memoryMsgChan
+			memoryMsgChan = subChannel.getMemoryMsgChan()
 			backendMsgChan = subChannel.backend.ReadChan()
 			flusherChan = nil
 		} else {
 			// we're buffered (if there isn't any more data we should flush)...
 			// select on the flusher ticker channel, too
-			memoryMsgChan = subChannel.memoryMsgChan
+			memoryMsgChan = subChannel.getMemoryMsgChan()
 			backendMsgChan = subChannel.backend.ReadChan()
 			flusherChan = outputBufferTicker.C
 		}
 
+		dispatchChan = nil
+		dispatchUpdateChan = nil
+		if memoryMsgChan != nil {
+			// wake up when the channel starts or stops dispatching
+			dispatchUpdateChan = subChannel.dispatchUpdate()
+			if subChannel.isDispatching() {
+				// the channel's dispatcher picks the consumer of each message
+				memoryMsgChan = nil
+				backendMsgChan = nil
+				dispatchChan = subChannel.dispatchChan(client.ID)
+				subChannel.dispatchReady()
+			}
+		}
+
 		select {
 		case <-flusherChan:
 			// if this case wins, we're either starved
@@ -271,6 +286,7 @@ func (p *protocolV2) messagePump(client *clientV2, startedChan chan bool) {
 			}
 			flushed = true
 		case <-client.ReadyStateChan:
+		case <-dispatchUpdateChan:
 		case subChannel = <-subEventChan:
 			// you can't SUB anymore
 			subEventChan = nil
@@ -293,8 +309,6 @@ func (p *protocolV2) messagePump(client *clientV2, startedChan chan bool) {
 			if identifyData.SampleRate > 0 {
 				sampleRate = identifyData.SampleRate
 			}
-
-			msgTimeout = identifyData.MsgTimeout
 		case <-heartbeatChan:
 			err = p.Send(client, frameTypeResponse, heartbeatBytes)
 			if err != nil {
@@ -312,7 +326,7 @@ func (p *protocolV2) messagePump(client *clientV2, startedChan chan bool) {
 			}
 			msg.Attempts++
 
-			subChannel.StartInFlightTimeout(msg, client.ID, msgTimeout)
+			subChannel.StartInFlightTimeout(msg, client.ID, client.msgTimeout())
 			client.SendingMessage()
 			err = p.SendMessage(client, msg)
 			if err != nil {
@@ -325,7 +339,20 @@ func (p *protocolV2) messagePump(client *clientV2, startedChan chan bool) {
 			}
 			msg.Attempts++
 
-			subChannel.StartInFlightTimeout(msg, client.ID, msgTimeout)
+			subChannel.StartInFlightTimeout(msg, client.ID, client.msgTimeout())
+			client.SendingMessage()
+			err = p.SendMessage(client, msg)
+			if err != nil {
+				goto exit
+			}
+			flushed = false
+		case msg := <-dispatchChan:
+			if sampleRate > 0 && rand.Int31n(100) > sampleRate {
+				continue
+			}
+			msg.Attempts++
+
+			subChannel.StartInFlightTimeout(msg, client.ID, client.msgTimeout())
 			client.SendingMessage()
 			err = p.SendMessage(client, msg)
 			if err != nil {
@@ -629,8 +656,8 @@ func (p *protocolV2) SUB(client *clientV2, params [][]byte) ([]byte, error) {
 		}
 		break
 	}
-	atomic.StoreInt32(&client.State, stateSubscribed)
 	client.Channel = channel
+	atomic.StoreInt32(&client.State, stateSubscribed)
 	// update message pump
 	client.SubEventChan <- channel
 
@@ -715,25 +742,29 @@ func (p *protocolV2) REQ(client *clientV2, params [][]byte) ([]byte, error) {
 		return nil, protocol.NewFatalClientErr(nil, "E_INVALID", err.Error())
 	}
 
-	timeoutMs, err := protocol.ByteToBase10(params[2])
-	if err != nil {
-		return nil, protocol.NewFatalClientErr(err, "E_INVALID",
-			fmt.Sprintf("REQ could not parse timeout %s", params[2]))
-	}
-	timeoutDuration := time.Duration(timeoutMs) * time.Millisecond
+	// a timeout of -1 leaves it to the channel's requeue backoff
+	timeoutDuration := time.Duration(-1)
+	if !bytes.Equal(params[2], []byte("-1")) {
+		timeoutMs, err := protocol.ByteToBase10(params[2])
+		if err != nil {
+			return nil, protocol.NewFatalClientErr(err, "E_INVALID",
+				fmt.Sprintf("REQ could not parse timeout %s", params[2]))
+		}
+		timeoutDuration = time.Duration(timeoutMs) * time.Millisecond
 
-	maxReqTimeout := p.ctx.nsqd.getOpts().MaxReqTimeout
-	clampedTimeout := timeoutDuration
+		maxReqTimeout := p.ctx.nsqd.getOpts().MaxReqTimeout
+		clampedTimeout := timeoutDuration
 
-	if timeoutDuration < 0 {
-		clampedTimeout = 0
-	} else if timeoutDuration > maxReqTimeout {
-		clampedTimeout = maxReqTimeout
-	}
-	if clampedTimeout != timeoutDuration {
-		p.ctx.nsqd.logf(LOG_INFO, "PROTOCOL(V2): [%s] REQ timeout %d out of range 0-%d. Setting to %d",
-			client, timeoutDuration, maxReqTimeout, clampedTimeout)
-		timeoutDuration = clampedTimeout
+		if timeoutDuration < 0 {
+			clampedTimeout = 0
+		} else if timeoutDuration > maxReqTimeout {
+			clampedTimeout = maxReqTimeout
+		}
+		if clampedTimeout != timeoutDuration {
+			p.ctx.nsqd.logf(LOG_INFO, "PROTOCOL(V2): [%s] REQ timeout %d out of range 0-%d. Setting to %d",
+				client, timeoutDuration, maxReqTimeout, clampedTimeout)
+			timeoutDuration = clampedTimeout
+		}
 	}
 
 	err = client.Channel.RequeueMessage(client.ID, *id, timeoutDuration)
@@ -784,9 +815,10 @@ func (p *protocolV2) PUB(client *clientV2, params [][]byte) ([]byte, error) {
 			fmt.Sprintf("PUB invalid message body size %d", bodyLen))
 	}
 
-	if int64(bodyLen) > p.ctx.nsqd.getOpts().MaxMsgSize {
+	maxMsgSize := p.ctx.nsqd.maxMsgSize(topicName)
+	if int64(bodyLen) > maxMsgSize {
 		return nil, protocol.NewFatalClientErr(nil, "E_BAD_MESSAGE",
-			fmt.Sprintf("PUB message too big %d > %d", bodyLen, p.ctx.nsqd.getOpts().MaxMsgSize))
+			fmt.Sprintf("PUB message too big %d > %d", bodyLen, maxMsgSize))
 	}
 
 	messageBody := make([]byte, bodyLen)
@@ -799,6 +831,10 @@ func (p *protocolV2) PUB(client *clientV2, params [][]byte) ([]byte, error) {
 		return nil, err
 	}
 
+	if p.ctx.nsqd.IsDraining() {
+		return nil, protocol.NewClientErr(nil, "E_DRAINING", "PUB failed, nsqd is draining")
+	}
+
 	topic := p.ctx.nsqd.GetTopic(topicName)
 	msg := NewMessage(topic.GenerateID(), messageBody)
 	err = topic.PutMessage(msg)
@@ -846,11 +882,15 @@ func (p *protocolV2) MPUB(client *clientV2, params [][]byte) ([]byte, error) {
 	}
 
 	messages, err := readMPUB(client.Reader, client.lenSlice, topic,
-		p.ctx.nsqd.getOpts().MaxMsgSize, p.ctx.nsqd.getOpts().MaxBodySize)
+		topic.MaxMsgSize(), p.ctx.nsqd.getOpts().MaxBodySize)
 	if err != nil {
 		return nil, err
 	}
 
+	if p.ctx.nsqd.IsDraining() {
+		return nil, protocol.NewClientErr(nil, "E_DRAINING", "MPUB failed, nsqd is draining")
+	}
+
 	// if we've made it this far we've validated all the input,
 	// the only possible error is that the topic is exiting during
 	// this next call (and no messages will be queued in that case)
@@ -900,9 +940,10 @@ func (p *protocolV2) DPUB(client *clientV2, params [][]byte) ([]byte, error) {
 			fmt.Sprintf("DPUB invalid message body size %d", bodyLen))
 	}
 
-	if int64(bodyLen) > p.ctx.nsqd.getOpts().MaxMsgSize {
+	maxMsgSize := p.ctx.nsqd.maxMsgSize(topicName)
+	if int64(bodyLen) > maxMsgSize {
 		return nil, protocol.NewFatalClientErr(nil, "E_BAD_MESSAGE",
-			fmt.Sprintf("DPUB message too big %d > %d", bodyLen, p.ctx.nsqd.getOpts().MaxMsgSize))
+			fmt.Sprintf("DPUB message too big %d > %d", bodyLen, maxMsgSize))
 	}
 
 	messageBody := make([]byte, bodyLen)
@@ -915,7 +956,15 @@ func (p *protocolV2) DPUB(client *clientV2, params [][]byte) ([]byte, error) {
 		return nil, err
 	}
 
+	if p.ctx.nsqd.IsDraining() {
+		return nil, protocol.NewClientErr(nil, "E_DRAINING", "DPUB failed, nsqd is draining")
+	}
+
 	topic := p.ctx.nsqd.GetTopic(topicName)
+	if timeoutDuration > 0 && topic.durable() {
+		return nil, protocol.NewFatalClientErr(nil, "E_INVALID",
+			"DPUB is not supported with durable writes")
+	}
 	msg := NewMessage(topic.GenerateID(), messageBody)
 	msg.deferred = timeoutDuration
 	err = topic.PutMessage(msg)
@@ -944,7 +993,7 @@ func (p *protocolV2) TOUCH(client *clientV2, params [][]byte) ([]byte, error) {
 	}
 
 	client.writeLock.RLock()
-	msgTimeout := client.MsgTimeout
+	msgTimeout := client.msgTimeout()
 	client.writeLock.RUnlock()
 	err = client.Channel.TouchMessage(client.ID, *id, msgTimeout)
 	if err != nil {
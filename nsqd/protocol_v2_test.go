@@ -18,6 +18,7 @@ import (
 	"net/url"
 	"os"
 	"runtime"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -121,10 +122,12 @@ func TestChannelTopicNames(t *testing.T) {
 	test.Equal(t, true, protocol.IsValidChannelName("test"))
 	test.Equal(t, true, protocol.IsValidChannelName("test-with_period."))
 	test.Equal(t, true, protocol.IsValidChannelName("test#ephemeral"))
+	test.Equal(t, true, protocol.IsValidChannelName("test#broadcast"))
 	test.Equal(t, true, protocol.IsValidTopicName("test"))
 	test.Equal(t, true, protocol.IsValidTopicName("test-with_period."))
 	test.Equal(t, true, protocol.IsValidTopicName("test#ephemeral"))
 	test.Equal(t, false, protocol.IsValidTopicName("test:ephemeral"))
+	test.Equal(t, false, protocol.IsValidTopicName("test#broadcast"))
 }
 
 // exercise the basic operations of the V2 protocol
@@ -210,6 +213,283 @@ func TestMultipleConsumerV2(t *testing.T) {
 	test.Equal(t, uint16(1), msgOut.Attempts)
 }
 
+// dispatchCounts connects consumers with the given capacities to a channel
+// using policy, publishes num messages and returns how many each one got
+func dispatchCounts(t *testing.T, policy string, capacities []int64, finish []bool, num int) []int64 {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.ChannelDispatchPolicy = policy
+	tcpAddr, _, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "test_dispatch" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	channel := topic.GetChannel("ch")
+
+	clientIDs := make([]int64, len(capacities))
+	for i, capacity := range capacities {
+		conn, err := mustConnectNSQD(tcpAddr)
+		test.Nil(t, err)
+		defer conn.Close()
+
+		identify(t, conn, map[string]interface{}{
+			"capacity":           capacity,
+			"output_buffer_size": -1,
+		}, frameTypeResponse)
+		sub(t, conn, topicName, "ch")
+		_, err = nsq.Ready(num).WriteTo(conn)
+		test.Nil(t, err)
+
+		channel.RLock()
+		for id, client := range channel.clients {
+			if client.(*clientV2).capacity() == capacity {
+				clientIDs[i] = id
+			}
+		}
+		channel.RUnlock()
+
+		go func(conn net.Conn, finish bool) {
+			for {
+				resp, err := nsq.ReadResponse(conn)
+				if err != nil {
+					return
+				}
+				frameType, data, _ := nsq.UnpackResponse(resp)
+				if frameType != frameTypeMessage || !finish {
+					continue
+				}
+				msg, _ := decodeMessage(data)
+				nsq.Finish(nsq.MessageID(msg.ID)).WriteTo(conn)
+			}
+		}(conn, finish[i])
+	}
+
+	// RDY has no response
+	for _, id := range clientIDs {
+		for i := 0; i < 100; i++ {
+			channel.RLock()
+			rdy := channel.clients[id].Stats().ReadyCount
+			channel.RUnlock()
+			if rdy == int64(num) {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	// one message at a time, once the previous one was delivered and finished
+	// if its consumer finishes them
+	counts := make([]int64, len(capacities))
+	for n := 1; n <= num; n++ {
+		topic.PutMessage(NewMessage(topic.GenerateID(), []byte("test body")))
+		for i := 0; i < 100; i++ {
+			var total int64
+			settled := true
+			channel.RLock()
+			for j, id := range clientIDs {
+				stats := channel.clients[id].Stats()
+				counts[j] = int64(stats.MessageCount)
+				total += counts[j]
+				if finish[j] && stats.InFlightCount > 0 {
+					settled = false
+				}
+			}
+			channel.RUnlock()
+			if total == int64(n) && settled {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	return counts
+}
+
+func TestChannelDispatch(t *testing.T) {
+	counts := dispatchCounts(t, "round-robin", []int64{1, 2}, []bool{false, false}, 40)
+	test.Equal(t, true, counts[0] >= 15 && counts[0] <= 25)
+	test.Equal(t, int64(40), counts[0]+counts[1])
+
+	// the slow consumer that does not finish its messages falls behind
+	counts = dispatchCounts(t, "least-in-flight", []int64{1, 2}, []bool{false, true}, 40)
+	test.Equal(t, true, counts[1] > 3*counts[0])
+	test.Equal(t, int64(40), counts[0]+counts[1])
+
+	counts = dispatchCounts(t, "weighted", []int64{3, 1}, []bool{false, false}, 40)
+	test.Equal(t, true, counts[0] >= 24 && counts[0] <= 36)
+	test.Equal(t, int64(40), counts[0]+counts[1])
+}
+
+func TestChannelDispatchPolicy(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	tcpAddr, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "test_dispatch_policy" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	channel := topic.GetChannel("ch")
+	test.Equal(t, "any", channel.DispatchPolicy())
+
+	conn, err := mustConnectNSQD(tcpAddr)
+	test.Nil(t, err)
+	defer conn.Close()
+	identify(t, conn, nil, frameTypeResponse)
+	sub(t, conn, topicName, "ch")
+	_, err = nsq.Ready(1).WriteTo(conn)
+	test.Nil(t, err)
+
+	url := fmt.Sprintf("http://%s/channel/dispatch?topic=%s&channel=ch&policy=fastest", httpAddr, topicName)
+	resp, err := http.Post(url, "application/octet-stream", nil)
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 400, resp.StatusCode)
+
+	// a consumer that is already waiting switches over
+	url = fmt.Sprintf("http://%s/channel/dispatch?topic=%s&channel=ch&policy=round-robin", httpAddr, topicName)
+	resp, err = http.Post(url, "application/octet-stream", nil)
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 200, resp.StatusCode)
+	test.Equal(t, "round-robin", channel.DispatchPolicy())
+
+	msg := NewMessage(topic.GenerateID(), []byte("test body"))
+	topic.PutMessage(msg)
+	frame, err := nsq.ReadResponse(conn)
+	test.Nil(t, err)
+	frameType, data, err := nsq.UnpackResponse(frame)
+	test.Nil(t, err)
+	test.Equal(t, frameTypeMessage, frameType)
+	msgOut, _ := decodeMessage(data)
+	test.Equal(t, msg.ID, msgOut.ID)
+
+	m, err := getMetadata(nsqd)
+	test.Nil(t, err)
+	test.Equal(t, "round-robin", m.Topics[0].Channels[0].Config.DispatchPolicy)
+}
+
+func TestBroadcastChannel(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	tcpAddr, _, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "test_broadcast" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	channel := topic.GetChannel("ch#broadcast")
+	test.Equal(t, "broadcast", channel.DispatchPolicy())
+	test.NotNil(t, channel.SetDispatchPolicy("round-robin"))
+
+	var conns []net.Conn
+	for i := 0; i < 2; i++ {
+		conn, err := mustConnectNSQD(tcpAddr)
+		test.Nil(t, err)
+		defer conn.Close()
+		identify(t, conn, nil, frameTypeResponse)
+		sub(t, conn, topicName, "ch#broadcast")
+		_, err = nsq.Ready(10).WriteTo(conn)
+		test.Nil(t, err)
+		conns = append(conns, conn)
+	}
+
+	readMsg := func(conn net.Conn) *Message {
+		resp, err := nsq.ReadResponse(conn)
+		test.Nil(t, err)
+		frameType, data, err := nsq.UnpackResponse(resp)
+		test.Nil(t, err)
+		test.Equal(t, frameTypeMessage, frameType)
+		msg, err := decodeMessage(data)
+		test.Nil(t, err)
+		return msg
+	}
+
+	// every consumer gets every message, each its own copy
+	bodies := []string{"a", "b", "c"}
+	for _, body := range bodies {
+		topic.PutMessage(NewMessage(topic.GenerateID(), []byte(body)))
+	}
+	var msgs [2][]*Message
+	for i, conn := range conns {
+		for _, body := range bodies {
+			msg := readMsg(conn)
+			test.Equal(t, body, string(msg.Body))
+			msgs[i] = append(msgs[i], msg)
+		}
+	}
+	test.NotEqual(t, msgs[0][0].ID, msgs[1][0].ID)
+
+	// a requeued copy only goes back to its consumer
+	for _, msg := range msgs[0] {
+		_, err := nsq.Finish(nsq.MessageID(msg.ID)).WriteTo(conns[0])
+		test.Nil(t, err)
+	}
+	_, err := nsq.Requeue(nsq.MessageID(msgs[1][0].ID), 0).WriteTo(conns[1])
+	test.Nil(t, err)
+	msg := readMsg(conns[1])
+	test.Equal(t, msgs[1][0].ID, msg.ID)
+	test.Equal(t, uint16(2), msg.Attempts)
+
+	time.Sleep(50 * time.Millisecond)
+	channel.inFlightMutex.Lock()
+	test.Equal(t, 3, len(channel.inFlightMessages))
+	channel.inFlightMutex.Unlock()
+	var counts []int
+	channel.RLock()
+	for _, client := range channel.clients {
+		counts = append(counts, int(client.Stats().MessageCount))
+	}
+	channel.RUnlock()
+	sort.Ints(counts)
+	test.Equal(t, []int{3, 4}, counts)
+}
+
+func TestBroadcastSlowConsumer(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.MemQueueSize = 2
+	tcpAddr, _, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "test_broadcast_slow" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	channel := topic.GetChannel("ch#broadcast")
+
+	// the 2nd consumer is not ready, its queue holds 2 copies
+	var conns []net.Conn
+	for i := 0; i < 2; i++ {
+		conn, err := mustConnectNSQD(tcpAddr)
+		test.Nil(t, err)
+		defer conn.Close()
+		identify(t, conn, nil, frameTypeResponse)
+		sub(t, conn, topicName, "ch#broadcast")
+		conns = append(conns, conn)
+	}
+	_, err := nsq.Ready(10).WriteTo(conns[0])
+	test.Nil(t, err)
+	time.Sleep(25 * time.Millisecond)
+
+	// the 1st consumer gets every message
+	for i := 0; i < 5; i++ {
+		topic.PutMessage(NewMessage(topic.GenerateID(), []byte("test")))
+		resp, err := nsq.ReadResponse(conns[0])
+		test.Nil(t, err)
+		frameType, _, err := nsq.UnpackResponse(resp)
+		test.Nil(t, err)
+		test.Equal(t, frameTypeMessage, frameType)
+	}
+
+	for i := 0; i < 20 && atomic.LoadUint64(&channel.broadcastDropCount) < 3; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	test.Equal(t, uint64(3), atomic.LoadUint64(&channel.broadcastDropCount))
+	test.Equal(t, int64(2), channel.Depth())
+	channel.Empty()
+	test.Equal(t, int64(0), channel.Depth())
+}
+
 func TestClientTimeout(t *testing.T) {
 	topicName := "test_client_timeout_v2" + strconv.Itoa(int(time.Now().Unix()))
 
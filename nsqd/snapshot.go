@@ -0,0 +1,521 @@
+package nsqd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/nsqio/go-diskqueue"
+	"github.com/nsqio/nsq/internal/dirlock"
+	"github.com/nsqio/nsq/internal/lg"
+	"github.com/nsqio/nsq/internal/version"
+)
+
+// messages that live outside of the diskqueue files (in memory, in-flight or
+// deferred) are stored alongside them as <backend name><snapshotMessagesSuffix>,
+// a data file in the diskqueue format encrypted like the queues, and written to
+// the queue on restore
+const snapshotMessagesSuffix = ".snapshot.msgs"
+
+// snapshotter is implemented by backends that can be copied consistently
+type snapshotter interface {
+	Snapshot(fn func(diskqueue.SnapshotState) error) error
+}
+
+type snapshotWriter struct {
+	tw   *tar.Writer
+	keys *diskqueue.Keyring
+}
+
+func (sw *snapshotWriter) writeData(name string, data []byte) error {
+	err := sw.tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = sw.tw.Write(data)
+	return err
+}
+
+// writeFile copies the first size bytes of fileName, the whole file when size < 0,
+// files that do not exist are skipped
+func (sw *snapshotWriter) writeFile(fileName string, size int64) error {
+	f, err := os.Open(fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if size < 0 || size > fi.Size() {
+		size = fi.Size()
+	}
+	err = sw.tw.WriteHeader(&tar.Header{
+		Name:    filepath.Base(fileName),
+		Mode:    0600,
+		Size:    size,
+		ModTime: fi.ModTime(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.CopyN(sw.tw, f, size)
+	return err
+}
+
+func (sw *snapshotWriter) writeMessages(backendName string, msgs []*Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	records := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		var buf bytes.Buffer
+		msg.WriteTo(&buf)
+		records[i] = buf.Bytes()
+	}
+	var buf bytes.Buffer
+	err := diskqueue.WriteSegment(&buf, sw.keys, records)
+	if err != nil {
+		return err
+	}
+	return sw.writeData(backendName+snapshotMessagesSuffix, buf.Bytes())
+}
+
+// writeBackend copies the files of a backend, the read file is copied whole
+// (the meta file holds the read position) and the write file up to the write position
+func (sw *snapshotWriter) writeBackend(backend BackendQueue) error {
+	s, ok := backend.(snapshotter)
+	if !ok {
+		return nil
+	}
+	return s.Snapshot(func(state diskqueue.SnapshotState) error {
+		for i, fileName := range state.FileNames {
+			size := int64(-1)
+			if i == len(state.FileNames)-1 {
+				size = state.WritePos
+			}
+			err := sw.writeFile(fileName, size)
+			if err != nil {
+				return err
+			}
+		}
+		return sw.writeFile(state.MetaFileName, -1)
+	})
+}
+
+// Snapshot writes a gzipped tar archive of the metadata and every topic and
+// channel queue to w.
+//
+// Each topic is captured at a single point in time: publishing to it and
+// distribution to its channels is held off while its queues are copied.
+// Messages that are in memory, in-flight or deferred are included, a message
+// that changes hands while a channel is copied may be included twice.
+func (n *NSQD) Snapshot(w io.Writer) error {
+	gw := gzip.NewWriter(w)
+	sw := &snapshotWriter{tw: tar.NewWriter(gw), keys: n.keyring}
+
+	n.RLock()
+	topics := make([]*Topic, 0, len(n.topicMap))
+	for _, t := range n.topicMap {
+		if t.ephemeral {
+			continue
+		}
+		topics = append(topics, t)
+	}
+	n.RUnlock()
+
+	metaTopics := []interface{}{}
+	for _, t := range topics {
+		topicData, err := n.snapshotTopic(sw, t)
+		if err != nil {
+			return err
+		}
+		if topicData != nil {
+			metaTopics = append(metaTopics, topicData)
+		}
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"version": version.Binary,
+		"topics":  metaTopics,
+	})
+	if err != nil {
+		return err
+	}
+	err = sw.writeData(filepath.Base(newMetadataFile(n.getOpts())), data)
+	if err != nil {
+		return err
+	}
+
+	err = sw.tw.Close()
+	if err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// snapshotTopic writes a topic and its channels, it returns nil metadata when
+// the topic has since been deleted
+func (n *NSQD) snapshotTopic(sw *snapshotWriter, t *Topic) (map[string]interface{}, error) {
+	var topicData map[string]interface{}
+	var err error
+	ran := t.runInMessagePump(func() {
+		// block publishers
+		t.Lock()
+		defer t.Unlock()
+
+		var msgs []*Message
+		memoryMsgChan := t.getMemoryMsgChan()
+	drain:
+		for {
+			select {
+			case msg := <-memoryMsgChan:
+				msgs = append(msgs, msg)
+			default:
+				break drain
+			}
+		}
+		defer func() {
+			// the queue may have been resized in the meantime
+			for _, msg := range msgs {
+				t.put(msg)
+			}
+		}()
+
+		var channels []*Channel
+		channelsData := []interface{}{}
+		defer func() {
+			for _, c := range channels {
+				c.stopRecording()
+			}
+		}()
+		for _, c := range t.channelMap {
+			if c.ephemeral {
+				continue
+			}
+			c.startRecording()
+			channels = append(channels, c)
+			channelData := map[string]interface{}{
+				"name":   c.name,
+				"paused": c.IsPaused(),
+				"config": c.Config(),
+			}
+			channelsData = append(channelsData, channelData)
+		}
+
+		for _, c := range channels {
+			err = c.snapshotBackend(sw)
+			if err != nil {
+				return
+			}
+		}
+		err = sw.writeBackend(t.backend)
+		if err != nil {
+			return
+		}
+		err = sw.writeMessages(t.name, msgs)
+		if err != nil {
+			return
+		}
+
+		// capture what the channels hold outside of their backends last so that
+		// messages being handed to clients are recorded
+		for _, c := range channels {
+			err = sw.writeMessages(getBackendName(t.name, c.name), c.snapshotMessages())
+			if err != nil {
+				return
+			}
+		}
+
+		topicData = map[string]interface{}{
+			"name":     t.name,
+			"paused":   t.IsPaused(),
+			"config":   t.Config(),
+			"channels": channelsData,
+		}
+	})
+	if !ran {
+		return nil, nil
+	}
+	return topicData, err
+}
+
+// runInMessagePump runs fn in the topic's messagePump so that no message is in
+// transit to the channels, it returns false when the topic is exiting
+func (t *Topic) runInMessagePump(fn func()) bool {
+	done := make(chan struct{})
+	select {
+	case t.snapshotChan <- func() {
+		fn()
+		close(done)
+	}:
+	case <-t.exitChan:
+		return false
+	}
+	<-done
+	return true
+}
+
+func (c *Channel) startRecording() {
+	c.recordMutex.Lock()
+	c.recordMessages = nil
+	atomic.StoreInt32(&c.recording, 1)
+	c.recordMutex.Unlock()
+}
+
+func (c *Channel) stopRecording() []*Message {
+	c.recordMutex.Lock()
+	msgs := c.recordMessages
+	c.recordMessages = nil
+	atomic.StoreInt32(&c.recording, 0)
+	c.recordMutex.Unlock()
+	return msgs
+}
+
+func (c *Channel) record(msg *Message) {
+	if atomic.LoadInt32(&c.recording) == 0 {
+		return
+	}
+	c.recordMutex.Lock()
+	if atomic.LoadInt32(&c.recording) == 1 {
+		c.recordMessages = append(c.recordMessages, msg)
+	}
+	c.recordMutex.Unlock()
+}
+
+// snapshotBackend copies the channel's backend, messages in memory are moved
+// through put() so that they are recorded
+func (c *Channel) snapshotBackend(sw *snapshotWriter) error {
+	var msgs []*Message
+	memoryMsgChan := c.getMemoryMsgChan()
+	for {
+		select {
+		case msg := <-memoryMsgChan:
+			msgs = append(msgs, msg)
+			continue
+		default:
+		}
+		break
+	}
+	for _, msg := range msgs {
+		c.put(msg)
+	}
+	return sw.writeBackend(c.backend)
+}
+
+// snapshotMessages returns the in-flight, deferred and recorded messages and
+// stops recording
+func (c *Channel) snapshotMessages() []*Message {
+	var msgs []*Message
+	c.inFlightMutex.Lock()
+	for _, msg := range c.inFlightMessages {
+		msgs = append(msgs, msg)
+	}
+	c.inFlightMutex.Unlock()
+	c.deferredMutex.Lock()
+	for _, item := range c.deferredMessages {
+		msgs = append(msgs, item.Value.(*Message))
+	}
+	c.deferredMutex.Unlock()
+	msgs = append(msgs, c.stopRecording()...)
+
+	seen := make(map[MessageID]bool, len(msgs))
+	unique := msgs[:0]
+	for _, msg := range msgs {
+		if seen[msg.ID] || c.isBroadcastCopy(msg) {
+			continue
+		}
+		seen[msg.ID] = true
+		unique = append(unique, msg)
+	}
+	return unique
+}
+
+// RestoreSnapshot rebuilds the data directory opts.DataPath from an archive
+// written by Snapshot, the directory must not contain any nsqd data.
+func RestoreSnapshot(r io.Reader, opts *Options) error {
+	dataPath := opts.DataPath
+	if dataPath == "" {
+		cwd, _ := os.Getwd()
+		dataPath = cwd
+	}
+	if opts.Logger == nil {
+		opts.Logger = log.New(os.Stderr, opts.LogPrefix, log.Ldate|log.Ltime|log.Lmicroseconds)
+	}
+
+	dl := dirlock.New(dataPath)
+	err := dl.Lock()
+	if err != nil {
+		return fmt.Errorf("failed to lock data-path: %v", err)
+	}
+	defer dl.Unlock()
+
+	// messages stored alongside the queues are written like nsqd would
+	compression, err := diskqueue.ParseCompression(opts.DiskCompression)
+	if err != nil {
+		return fmt.Errorf("invalid --disk-compression - %s", err)
+	}
+	dqOpts := []diskqueue.Option{diskqueue.WithCompression(compression)}
+	var keys *diskqueue.Keyring
+	if opts.EncryptionKeyFile != "" {
+		keys, err = diskqueue.LoadKeyring(opts.EncryptionKeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load --encryption-key-file - %s", err)
+		}
+		dqOpts = append(dqOpts, diskqueue.WithKeyring(keys))
+	}
+
+	existing, err := filepath.Glob(filepath.Join(dataPath, "*.dat"))
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		return fmt.Errorf("data-path %s is not empty", dataPath)
+	}
+
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(gr)
+	var backendNames []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := filepath.Base(hdr.Name)
+		if name != hdr.Name || strings.HasPrefix(name, ".") {
+			return fmt.Errorf("invalid file name %q in snapshot", hdr.Name)
+		}
+		err = restoreFile(filepath.Join(dataPath, name), tr)
+		if err != nil {
+			return err
+		}
+		if strings.HasSuffix(name, snapshotMessagesSuffix) {
+			backendNames = append(backendNames, strings.TrimSuffix(name, snapshotMessagesSuffix))
+		}
+	}
+
+	maxMsgSizes, err := backendMaxMsgSizes(filepath.Join(dataPath, filepath.Base(newMetadataFile(opts))), opts)
+	if err != nil {
+		return err
+	}
+	for _, backendName := range backendNames {
+		maxMsgSize, ok := maxMsgSizes[backendName]
+		if !ok {
+			maxMsgSize = opts.MaxMsgSize
+		}
+		err := restoreMessages(backendName, dataPath, maxMsgSize, opts, keys, dqOpts)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backendMaxMsgSizes returns the size limit of the backends of the topics and
+// channels in the restored metadata, like Topic.backendMsgSize
+func backendMaxMsgSizes(fileName string, opts *Options) (map[string]int64, error) {
+	data, err := readOrEmpty(fileName)
+	if err != nil {
+		return nil, err
+	}
+	sizes := make(map[string]int64)
+	if data == nil {
+		return sizes, nil
+	}
+	var m meta
+	err = json.Unmarshal(data, &m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metadata in %s - %s", fileName, err)
+	}
+	for _, t := range m.Topics {
+		size := opts.MaxMsgSize
+		if t.Config != nil && t.Config.MaxMsgSize > size {
+			size = t.Config.MaxMsgSize
+		}
+		sizes[t.Name] = size
+		for _, c := range t.Channels {
+			sizes[getBackendName(t.Name, c.Name)] = size
+		}
+	}
+	return sizes, nil
+}
+
+func restoreFile(fileName string, r io.Reader) error {
+	f, err := os.OpenFile(fileName, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	if err == nil {
+		err = f.Sync()
+	}
+	f.Close()
+	return err
+}
+
+// restoreMessages writes the messages stored alongside a backend to the end of it
+func restoreMessages(backendName string, dataPath string, maxMsgSize int64, opts *Options,
+	keys *diskqueue.Keyring, dqOpts []diskqueue.Option) error {
+	fileName := filepath.Join(dataPath, backendName+snapshotMessagesSuffix)
+
+	dqLogf := func(level diskqueue.LogLevel, f string, args ...interface{}) {
+		lg.Logf(opts.Logger, opts.LogLevel, lg.LogLevel(level), f, args...)
+	}
+	backend := diskqueue.New(
+		backendName,
+		dataPath,
+		opts.MaxBytesPerFile,
+		int32(MinValidMsgLength),
+		BackendMaxMsgSize(maxMsgSize),
+		opts.SyncEvery,
+		opts.SyncTimeout,
+		dqLogf,
+		dqOpts...,
+	)
+	var buf bytes.Buffer
+	err := diskqueue.ReadSegment(fileName, int32(MinValidMsgLength), BackendMaxMsgSize(maxMsgSize), keys,
+		func(offset int64, data []byte) error {
+			msg, err := decodeMessage(data)
+			if err != nil {
+				return err
+			}
+			return writeMessageToBackend(&buf, msg, backend)
+		})
+	if err != nil {
+		backend.Close()
+		return fmt.Errorf("failed to read %s - %s", fileName, err)
+	}
+	err = backend.Close()
+	if err != nil {
+		return err
+	}
+	return os.Remove(fileName)
+}
@@ -0,0 +1,213 @@
+package nsqd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nsqio/go-nsq"
+	"github.com/nsqio/nsq/internal/test"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.MemQueueSize = 5
+	tcpAddr, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "test_snapshot" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	channel := topic.GetChannel("ch")
+	topic.GetChannel("paused").Pause()
+	topic.GetChannel("ch#ephemeral")
+	nsqd.GetTopic("test_snapshot#ephemeral")
+
+	// half of these overflow to disk
+	for i := 0; i < 10; i++ {
+		topic.PutMessage(NewMessage(topic.GenerateID(), []byte(fmt.Sprintf("msg%d", i))))
+	}
+	channel.PutMessageDeferred(NewMessage(topic.GenerateID(), []byte("deferred")), time.Hour)
+	for i := 0; i < 100 && topic.Depth() > 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	topic.Pause()
+	topic.PutMessage(NewMessage(topic.GenerateID(), []byte("undistributed")))
+
+	// one message in-flight
+	conn, err := mustConnectNSQD(tcpAddr)
+	test.Nil(t, err)
+	defer conn.Close()
+	identify(t, conn, nil, frameTypeResponse)
+	sub(t, conn, topicName, "ch")
+	_, err = nsq.Ready(1).WriteTo(conn)
+	test.Nil(t, err)
+	frame, err := nsq.ReadResponse(conn)
+	test.Nil(t, err)
+	frameType, _, err := nsq.UnpackResponse(frame)
+	test.Nil(t, err)
+	test.Equal(t, frameTypeMessage, frameType)
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/snapshot", httpAddr))
+	test.Nil(t, err)
+	archive, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	test.Nil(t, err)
+	test.Equal(t, 200, resp.StatusCode)
+	test.Equal(t, "application/gzip", resp.Header.Get("Content-Type"))
+
+	// the source keeps working
+	test.Equal(t, int64(9), channel.Depth())
+	test.Equal(t, int64(1), topic.Depth())
+
+	err = RestoreSnapshot(bytes.NewReader(archive), opts)
+	test.NotNil(t, err)
+
+	restoreOpts := NewOptions()
+	restoreOpts.Logger = test.NewTestLogger(t)
+	restoreOpts.MemQueueSize = 5
+	restoreOpts.DataPath, err = ioutil.TempDir("", "nsq-test-")
+	test.Nil(t, err)
+	defer os.RemoveAll(restoreOpts.DataPath)
+	err = RestoreSnapshot(bytes.NewReader(archive), restoreOpts)
+	test.Nil(t, err)
+
+	restored, err := New(restoreOpts)
+	test.Nil(t, err)
+	err = restored.LoadMetadata()
+	test.Nil(t, err)
+	defer restored.Exit()
+
+	_, err = restored.GetExistingTopic("test_snapshot#ephemeral")
+	test.NotNil(t, err)
+	restoredTopic, err := restored.GetExistingTopic(topicName)
+	test.Nil(t, err)
+	test.Equal(t, true, restoredTopic.IsPaused())
+	test.Equal(t, int64(1), restoredTopic.Depth())
+	restoredChannel, err := restoredTopic.GetExistingChannel("ch")
+	test.Nil(t, err)
+	// in-flight and deferred messages are restored to the queue
+	test.Equal(t, int64(11), restoredChannel.Depth())
+	pausedChannel, err := restoredTopic.GetExistingChannel("paused")
+	test.Nil(t, err)
+	test.Equal(t, true, pausedChannel.IsPaused())
+	test.Equal(t, int64(10), pausedChannel.Depth())
+	_, err = restoredTopic.GetExistingChannel("ch#ephemeral")
+	test.NotNil(t, err)
+}
+
+func TestSnapshotEncryption(t *testing.T) {
+	keyFile, err := ioutil.TempFile("", "nsqd-keys")
+	test.Nil(t, err)
+	defer os.Remove(keyFile.Name())
+	fmt.Fprintf(keyFile, "1:%s\n", base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)))
+	keyFile.Close()
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.EncryptionKeyFile = keyFile.Name()
+	_, _, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	// without channels the message stays in memory
+	topicName := "test_snapshot_encryption" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	body := []byte("regulated payload")
+	topic.PutMessage(NewMessage(topic.GenerateID(), body))
+
+	var archive bytes.Buffer
+	err = nsqd.Snapshot(&archive)
+	test.Nil(t, err)
+
+	gr, err := gzip.NewReader(bytes.NewReader(archive.Bytes()))
+	test.Nil(t, err)
+	tr := tar.NewReader(gr)
+	var found bool
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		test.Nil(t, err)
+		data, err := ioutil.ReadAll(tr)
+		test.Nil(t, err)
+		test.Equal(t, false, bytes.Contains(data, body))
+		if hdr.Name == topicName+snapshotMessagesSuffix {
+			found = true
+		}
+	}
+	test.Equal(t, true, found)
+
+	restoreOpts := NewOptions()
+	restoreOpts.Logger = test.NewTestLogger(t)
+	restoreOpts.DataPath, err = ioutil.TempDir("", "nsq-test-")
+	test.Nil(t, err)
+	defer os.RemoveAll(restoreOpts.DataPath)
+	err = RestoreSnapshot(bytes.NewReader(archive.Bytes()), restoreOpts)
+	test.NotNil(t, err)
+
+	restoreOpts.DataPath, err = ioutil.TempDir("", "nsq-test-")
+	test.Nil(t, err)
+	defer os.RemoveAll(restoreOpts.DataPath)
+	restoreOpts.EncryptionKeyFile = keyFile.Name()
+	err = RestoreSnapshot(bytes.NewReader(archive.Bytes()), restoreOpts)
+	test.Nil(t, err)
+
+	restored, err := New(restoreOpts)
+	test.Nil(t, err)
+	err = restored.LoadMetadata()
+	test.Nil(t, err)
+	defer restored.Exit()
+	restoredTopic, err := restored.GetExistingTopic(topicName)
+	test.Nil(t, err)
+	test.Equal(t, int64(1), restoredTopic.Depth())
+}
+
+func TestSnapshotMaxMsgSize(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.MaxMsgSize = 100
+	_, _, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	// the message in memory is stored alongside the topic's backend
+	topicName := "test_snapshot_max_msg_size" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	test.Nil(t, topic.SetConfig(TopicConfig{MaxMsgSize: 200}))
+	topic.PutMessage(NewMessage(topic.GenerateID(), make([]byte, 150)))
+
+	var archive bytes.Buffer
+	err := nsqd.Snapshot(&archive)
+	test.Nil(t, err)
+
+	restoreOpts := NewOptions()
+	restoreOpts.Logger = test.NewTestLogger(t)
+	restoreOpts.MaxMsgSize = 100
+	restoreOpts.DataPath, err = ioutil.TempDir("", "nsq-test-")
+	test.Nil(t, err)
+	defer os.RemoveAll(restoreOpts.DataPath)
+	err = RestoreSnapshot(bytes.NewReader(archive.Bytes()), restoreOpts)
+	test.Nil(t, err)
+
+	restored, err := New(restoreOpts)
+	test.Nil(t, err)
+	err = restored.LoadMetadata()
+	test.Nil(t, err)
+	defer restored.Exit()
+	restoredTopic, err := restored.GetExistingTopic(topicName)
+	test.Nil(t, err)
+	test.Equal(t, int64(200), restoredTopic.MaxMsgSize())
+	test.Equal(t, int64(1), restoredTopic.Depth())
+}
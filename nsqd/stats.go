@@ -17,10 +17,19 @@ type TopicStats struct {
 	MessageBytes uint64         `json:"message_bytes"`
 	Paused       bool           `json:"paused"`
 
+	Compression      string      `json:"compression,omitempty"`
+	CompressionRatio float64     `json:"compression_ratio,omitempty"`
+	Config           TopicConfig `json:"config"`
+
 	E2eProcessingLatency *quantile.Result `json:"e2e_processing_latency"`
+	Migration            *MigrationStats  `json:"migration,omitempty"`
 }
 
 func NewTopicStats(t *Topic, channels []ChannelStats) TopicStats {
+	var migration *MigrationStats
+	if stats, ok := t.ctx.nsqd.GetMigrationStats(t.name); ok {
+		migration = &stats
+	}
 	return TopicStats{
 		TopicName:    t.name,
 		Channels:     channels,
@@ -30,7 +39,12 @@ func NewTopicStats(t *Topic, channels []ChannelStats) TopicStats {
 		MessageBytes: atomic.LoadUint64(&t.messageBytes),
 		Paused:       t.IsPaused(),
 
+		Compression:      t.Compression().String(),
+		CompressionRatio: backendCompressionRatio(t.backend),
+		Config:           t.Config(),
+
 		E2eProcessingLatency: t.AggregateChannelE2eProcessingLatency().Result(),
+		Migration:            migration,
 	}
 }
 
@@ -43,10 +57,15 @@ type ChannelStats struct {
 	MessageCount  uint64        `json:"message_count"`
 	RequeueCount  uint64        `json:"requeue_count"`
 	TimeoutCount  uint64        `json:"timeout_count"`
+	DropCount     uint64        `json:"drop_count,omitempty"`
 	ClientCount   int           `json:"client_count"`
 	Clients       []ClientStats `json:"clients"`
 	Paused        bool          `json:"paused"`
 
+	DispatchPolicy   string        `json:"dispatch_policy"`
+	CompressionRatio float64       `json:"compression_ratio,omitempty"`
+	Config           ChannelConfig `json:"config"`
+
 	E2eProcessingLatency *quantile.Result `json:"e2e_processing_latency"`
 }
 
@@ -67,10 +86,15 @@ func NewChannelStats(c *Channel, clients []ClientStats, clientCount int) Channel
 		MessageCount:  atomic.LoadUint64(&c.messageCount),
 		RequeueCount:  atomic.LoadUint64(&c.requeueCount),
 		TimeoutCount:  atomic.LoadUint64(&c.timeoutCount),
+		DropCount:     atomic.LoadUint64(&c.broadcastDropCount),
 		ClientCount:   clientCount,
 		Clients:       clients,
 		Paused:        c.IsPaused(),
 
+		DispatchPolicy:   c.DispatchPolicy(),
+		CompressionRatio: backendCompressionRatio(c.backend),
+		Config:           c.Config(),
+
 		E2eProcessingLatency: c.e2eProcessingLatencyStream.Result(),
 	}
 }
@@ -88,6 +112,7 @@ type ClientStats struct {
 	State           int32  `json:"state"`
 	ReadyCount      int64  `json:"ready_count"`
 	InFlightCount   int64  `json:"in_flight_count"`
+	Capacity        int64  `json:"capacity,omitempty"`
 	MessageCount    uint64 `json:"message_count"`
 	FinishCount     uint64 `json:"finish_count"`
 	RequeueCount    uint64 `json:"requeue_count"`
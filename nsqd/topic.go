@@ -25,6 +25,7 @@ type Topic struct {
 	channelMap        map[string]*Channel
 	backend           BackendQueue
 	memoryMsgChan     chan *Message
+	memoryMsgMutex    sync.RWMutex //memoryMsgChan可以在运行时改变长度
 	startChan         chan int
 	exitChan          chan int
 	channelUpdateChan chan int
@@ -39,11 +40,47 @@ type Topic struct {
 	paused    int32
 	pauseChan chan int
 
+	snapshotChan chan func()
+
+	compression  int32 //磁盘队列的压缩方式，-1表示使用--disk-compression
+	memQueueSize int64 //内存队列的长度，-1表示使用--mem-queue-size
+	maxMsgSize   int64 //消息的最大长度，0表示使用--max-msg-size
+
 	ctx *context
 }
 
-// Topic constructor
-func NewTopic(topicName string, ctx *context, deleteCallback func(*Topic)) *Topic {
+// compressor is implemented by backends that can compress what they write
+type compressor interface {
+	SetCompression(c diskqueue.Compression)
+	CompressionRatio() float64
+}
+
+func setBackendCompression(backend BackendQueue, c diskqueue.Compression) {
+	if bc, ok := backend.(compressor); ok {
+		bc.SetCompression(c)
+	}
+}
+
+// msgSizer is implemented by backends that limit the size of what they write
+type msgSizer interface {
+	SetMaxMsgSize(maxMsgSize int32)
+}
+
+func setBackendMaxMsgSize(backend BackendQueue, maxMsgSize int64) {
+	if bs, ok := backend.(msgSizer); ok {
+		bs.SetMaxMsgSize(BackendMaxMsgSize(maxMsgSize))
+	}
+}
+
+func backendCompressionRatio(backend BackendQueue) float64 {
+	if bc, ok := backend.(compressor); ok {
+		return bc.CompressionRatio()
+	}
+	return 0
+}
+
+// Topic constructor, a maxMsgSize of 0 is --max-msg-size
+func NewTopic(topicName string, maxMsgSize int64, ctx *context, deleteCallback func(*Topic)) *Topic {
 	t := &Topic{
 		name:              topicName,
 		channelMap:        make(map[string]*Channel),
@@ -54,6 +91,10 @@ func NewTopic(topicName string, ctx *context, deleteCallback func(*Topic)) *Topi
 		ctx:               ctx,
 		paused:            0,
 		pauseChan:         make(chan int),
+		snapshotChan:      make(chan func()),
+		compression:       -1,
+		memQueueSize:      -1,
+		maxMsgSize:        maxMsgSize,
 		deleteCallback:    deleteCallback,
 		idFactory:         NewGUIDFactory(ctx.nsqd.getOpts().ID),
 	}
@@ -70,11 +111,12 @@ func NewTopic(topicName string, ctx *context, deleteCallback func(*Topic)) *Topi
 			topicName,
 			ctx.nsqd.getOpts().DataPath,
 			ctx.nsqd.getOpts().MaxBytesPerFile,
-			int32(minValidMsgLength),
-			int32(ctx.nsqd.getOpts().MaxMsgSize)+minValidMsgLength,
+			int32(MinValidMsgLength),
+			BackendMaxMsgSize(t.backendMsgSize()),
 			ctx.nsqd.getOpts().SyncEvery,
 			ctx.nsqd.getOpts().SyncTimeout,
 			dqLogf,
+			ctx.nsqd.diskQueueOptions()...,
 		)
 	}
 
@@ -123,7 +165,11 @@ func (t *Topic) getOrCreateChannel(channelName string) (*Channel, bool) {
 		deleteCallback := func(c *Channel) {
 			t.DeleteExistingChannel(c.name)
 		}
-		channel = NewChannel(t.name, channelName, t.ctx, deleteCallback)
+		channel = NewChannel(t.name, channelName, t.backendMsgSize(), t.ctx, deleteCallback)
+		setBackendCompression(channel.backend, t.Compression())
+		if size := t.MemQueueSize(); size != t.ctx.nsqd.getOpts().MemQueueSize {
+			channel.resizeMemoryMsgChan(size)
+		}
 		t.channelMap[channelName] = channel
 		t.ctx.nsqd.logf(LOG_INFO, "TOPIC(%s): new channel(%s)", t.name, channel.name)
 		return channel, true
@@ -200,7 +246,8 @@ func (t *Topic) PutMessages(msgs []*Message) error {
 	messageTotalBytes := 0
 
 	for i, m := range msgs {
-		err := t.put(m)
+		// with durable writes a single fsync covers the whole batch
+		err := t.putWithSync(m, i == len(msgs)-1)
 		if err != nil {
 			atomic.AddUint64(&t.messageCount, uint64(i))
 			atomic.AddUint64(&t.messageBytes, uint64(messageTotalBytes))
@@ -215,25 +262,87 @@ func (t *Topic) PutMessages(msgs []*Message) error {
 }
 
 func (t *Topic) put(m *Message) error {
-	select {
-	case t.memoryMsgChan <- m:
-	default:
-		b := bufferPoolGet()
-		err := writeMessageToBackend(b, m, t.backend)
-		bufferPoolPut(b)
-		t.ctx.nsqd.SetHealth(err)
-		if err != nil {
-			t.ctx.nsqd.logf(LOG_ERROR,
-				"TOPIC(%s) ERROR: failed to write message to backend - %s",
-				t.name, err)
-			return err
+	return t.putWithSync(m, true)
+}
+
+// putWithSync writes straight to the backend with durable writes, fsyncing
+// unless sync is false (the fsync of a later message covers it)
+func (t *Topic) putWithSync(m *Message, sync bool) error {
+	durable := t.durable()
+	if !durable {
+		t.memoryMsgMutex.RLock()
+		select {
+		case t.memoryMsgChan <- m:
+			t.memoryMsgMutex.RUnlock()
+			return nil
+		default:
 		}
+		t.memoryMsgMutex.RUnlock()
+	}
+
+	var err error
+	b := bufferPoolGet()
+	if durable && sync {
+		err = writeMessageToBackendSync(b, m, t.backend)
+	} else {
+		err = writeMessageToBackend(b, m, t.backend)
+	}
+	bufferPoolPut(b)
+	t.ctx.nsqd.SetHealth(err)
+	if err != nil {
+		t.ctx.nsqd.logf(LOG_ERROR,
+			"TOPIC(%s) ERROR: failed to write message to backend - %s",
+			t.name, err)
+		return err
 	}
 	return nil
 }
 
+// durable returns whether messages are written straight to disk, a deferred
+// message can not be published then as its timeout is not stored
+func (t *Topic) durable() bool {
+	return t.ctx.nsqd.getOpts().DurableWrites && !t.ephemeral
+}
+
 func (t *Topic) Depth() int64 {
-	return int64(len(t.memoryMsgChan)) + t.backend.Depth()
+	return int64(len(t.getMemoryMsgChan())) + t.backend.Depth()
+}
+
+// getMemoryMsgChan returns the in-memory queue, it is replaced when the queue
+// is resized
+func (t *Topic) getMemoryMsgChan() chan *Message {
+	t.memoryMsgMutex.RLock()
+	defer t.memoryMsgMutex.RUnlock()
+	return t.memoryMsgChan
+}
+
+// resizeMemoryMsgChan replaces the in-memory queue with one of size messages,
+// the queued messages are moved to it or to the backend when it is full
+func (t *Topic) resizeMemoryMsgChan(size int64) {
+	t.memoryMsgMutex.Lock()
+	old := t.memoryMsgChan
+	if int64(cap(old)) == size {
+		t.memoryMsgMutex.Unlock()
+		return
+	}
+	t.memoryMsgChan = make(chan *Message, size)
+	t.memoryMsgMutex.Unlock()
+
+	for {
+		select {
+		case msg := <-old:
+			t.put(msg)
+			continue
+		default:
+		}
+		break
+	}
+
+	// messagePump selects on the new queue from now on
+	select {
+	case t.channelUpdateChan <- 1:
+	case <-t.exitChan:
+	}
 }
 
 // messagePump selects over the in-memory and backend queue and
@@ -253,6 +362,9 @@ func (t *Topic) messagePump() {
 			continue
 		case <-t.pauseChan:
 			continue
+		case fn := <-t.snapshotChan:
+			fn()
+			continue
 		case <-t.exitChan:
 			goto exit
 		case <-t.startChan:
@@ -265,7 +377,7 @@ func (t *Topic) messagePump() {
 	}
 	t.RUnlock()
 	if len(chans) > 0 && !t.IsPaused() {
-		memoryMsgChan = t.memoryMsgChan
+		memoryMsgChan = t.getMemoryMsgChan()
 		backendChan = t.backend.ReadChan()
 	}
 
@@ -290,7 +402,7 @@ func (t *Topic) messagePump() {
 				memoryMsgChan = nil
 				backendChan = nil
 			} else {
-				memoryMsgChan = t.memoryMsgChan
+				memoryMsgChan = t.getMemoryMsgChan()
 				backendChan = t.backend.ReadChan()
 			}
 			continue
@@ -299,10 +411,13 @@ func (t *Topic) messagePump() {
 				memoryMsgChan = nil
 				backendChan = nil
 			} else {
-				memoryMsgChan = t.memoryMsgChan
+				memoryMsgChan = t.getMemoryMsgChan()
 				backendChan = t.backend.ReadChan()
 			}
 			continue
+		case fn := <-t.snapshotChan:
+			fn()
+			continue
 		case <-t.exitChan:
 			goto exit
 		}
@@ -393,9 +508,10 @@ func (t *Topic) exit(deleted bool) error {
 }
 
 func (t *Topic) Empty() error {
+	memoryMsgChan := t.getMemoryMsgChan()
 	for {
 		select {
-		case <-t.memoryMsgChan:
+		case <-memoryMsgChan:
 		default:
 			goto finish
 		}
@@ -408,15 +524,16 @@ finish:
 func (t *Topic) flush() error {
 	var msgBuf bytes.Buffer
 
-	if len(t.memoryMsgChan) > 0 {
+	memoryMsgChan := t.getMemoryMsgChan()
+	if len(memoryMsgChan) > 0 {
 		t.ctx.nsqd.logf(LOG_INFO,
 			"TOPIC(%s): flushing %d memory messages to backend",
-			t.name, len(t.memoryMsgChan))
+			t.name, len(memoryMsgChan))
 	}
 
 	for {
 		select {
-		case msg := <-t.memoryMsgChan:
+		case msg := <-memoryMsgChan:
 			err := writeMessageToBackend(&msgBuf, msg, t.backend)
 			if err != nil {
 				t.ctx.nsqd.logf(LOG_ERROR,
@@ -482,6 +599,32 @@ func (t *Topic) IsPaused() bool {
 	return atomic.LoadInt32(&t.paused) == 1
 }
 
+// Compression returns the compression of the topic's and its channels' disk queues
+func (t *Topic) Compression() diskqueue.Compression {
+	c := atomic.LoadInt32(&t.compression)
+	if c < 0 {
+		return t.ctx.nsqd.diskCompression()
+	}
+	return diskqueue.Compression(c)
+}
+
+// hasCompression reports whether the compression was set for this topic
+func (t *Topic) hasCompression() bool {
+	return atomic.LoadInt32(&t.compression) >= 0
+}
+
+// SetCompression changes the compression of the topic's and its channels' disk
+// queues, it applies to what they write from now on
+func (t *Topic) SetCompression(c diskqueue.Compression) {
+	atomic.StoreInt32(&t.compression, int32(c))
+	setBackendCompression(t.backend, c)
+	t.RLock()
+	for _, channel := range t.channelMap {
+		setBackendCompression(channel.backend, c)
+	}
+	t.RUnlock()
+}
+
 func (t *Topic) GenerateID() MessageID {
 retry:
 	id, err := t.idFactory.NewGUID()
@@ -1,6 +1,8 @@
 package nsqd
 
 import (
+	"bytes"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -11,6 +13,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/nsqio/go-diskqueue"
+	"github.com/nsqio/go-nsq"
 	"github.com/nsqio/nsq/internal/test"
 )
 
@@ -55,6 +59,7 @@ func TestGetChannel(t *testing.T) {
 type errorBackendQueue struct{}
 
 func (d *errorBackendQueue) Put([]byte) error      { return errors.New("never gonna happen") }
+func (d *errorBackendQueue) PutSync([]byte) error  { return errors.New("never gonna happen") }
 func (d *errorBackendQueue) ReadChan() chan []byte { return nil }
 func (d *errorBackendQueue) Close() error          { return nil }
 func (d *errorBackendQueue) Delete() error         { return nil }
@@ -63,7 +68,155 @@ func (d *errorBackendQueue) Empty() error          { return nil }
 
 type errorRecoveredBackendQueue struct{ errorBackendQueue }
 
-func (d *errorRecoveredBackendQueue) Put([]byte) error { return nil }
+func (d *errorRecoveredBackendQueue) Put([]byte) error     { return nil }
+func (d *errorRecoveredBackendQueue) PutSync([]byte) error { return nil }
+
+func TestDurableWrites(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.DurableWrites = true
+	opts.SyncEvery = 1 << 20
+	opts.SyncTimeout = time.Hour
+	tcpAddr, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "test_durable_writes" + strconv.Itoa(int(time.Now().Unix()))
+	url := fmt.Sprintf("http://%s/pub?topic=%s", httpAddr, topicName)
+	resp, err := http.Post(url, "application/octet-stream", bytes.NewBufferString("test"))
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 200, resp.StatusCode)
+	url = fmt.Sprintf("http://%s/mpub?topic=%s", httpAddr, topicName)
+	resp, err = http.Post(url, "application/octet-stream", bytes.NewBufferString("test\ntest\ntest"))
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 200, resp.StatusCode)
+
+	// acknowledged messages are on disk, not in memory
+	topic, err := nsqd.GetExistingTopic(topicName)
+	test.Nil(t, err)
+	test.Equal(t, 0, len(topic.memoryMsgChan))
+	test.Equal(t, int64(4), topic.backend.Depth())
+	md, err := diskqueue.ReadMetaData(diskqueue.MetaDataFileName(opts.DataPath, topicName))
+	test.Nil(t, err)
+	test.Equal(t, int64(4), md.Depth)
+
+	channel := topic.GetChannel("ch")
+	for i := 0; i < 100 && channel.Depth() < 4; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	test.Equal(t, 0, len(channel.memoryMsgChan))
+	test.Equal(t, int64(4), channel.backend.Depth())
+	md, err = diskqueue.ReadMetaData(diskqueue.MetaDataFileName(opts.DataPath,
+		getBackendName(topicName, "ch")))
+	test.Nil(t, err)
+	test.Equal(t, int64(4), md.Depth)
+
+	// the timeout of a deferred message would not be written to disk
+	url = fmt.Sprintf("http://%s/pub?topic=%s&defer=1000", httpAddr, topicName)
+	resp, err = http.Post(url, "application/octet-stream", bytes.NewBufferString("test"))
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 400, resp.StatusCode)
+
+	conn, err := mustConnectNSQD(tcpAddr)
+	test.Nil(t, err)
+	defer conn.Close()
+	identify(t, conn, nil, frameTypeResponse)
+	nsq.DeferredPublish(topicName, time.Second, []byte("test")).WriteTo(conn)
+	resp2, _ := nsq.ReadResponse(conn)
+	frameType, data, _ := nsq.UnpackResponse(resp2)
+	test.Equal(t, frameTypeError, frameType)
+	test.Equal(t, "E_INVALID DPUB is not supported with durable writes", string(data))
+	test.Equal(t, int64(4), channel.Depth())
+}
+
+func TestTopicCompression(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.MemQueueSize = 0
+	_, httpAddr, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "test_topic_compression" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	channel := topic.GetChannel("ch")
+	test.Equal(t, diskqueue.CompressionNone, topic.Compression())
+
+	url := fmt.Sprintf("http://%s/topic/compression?topic=%s&compression=zip", httpAddr, topicName)
+	resp, err := http.Post(url, "application/octet-stream", nil)
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 400, resp.StatusCode)
+
+	url = fmt.Sprintf("http://%s/topic/compression?topic=%s&compression=snappy", httpAddr, topicName)
+	resp, err = http.Post(url, "application/octet-stream", nil)
+	test.Nil(t, err)
+	resp.Body.Close()
+	test.Equal(t, 200, resp.StatusCode)
+	test.Equal(t, diskqueue.CompressionSnappy, topic.Compression())
+
+	body := bytes.Repeat([]byte("compressible "), 100)
+	for i := 0; i < 10; i++ {
+		topic.PutMessage(NewMessage(topic.GenerateID(), body))
+	}
+	for i := 0; i < 100 && channel.Depth() < 10; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// channels inherit the compression of their topic
+	stats := nsqd.GetStats(topicName, "", false)
+	test.Equal(t, 1, len(stats))
+	test.Equal(t, "snappy", stats[0].Compression)
+	test.Equal(t, true, stats[0].CompressionRatio > 1)
+	test.Equal(t, true, stats[0].Channels[0].CompressionRatio > 1)
+
+	m, err := getMetadata(nsqd)
+	test.Nil(t, err)
+	test.Equal(t, "snappy", m.Topics[0].Config.Compression)
+}
+
+func TestEncryptionAtRest(t *testing.T) {
+	keyFile, err := ioutil.TempFile("", "nsqd-keys")
+	test.Nil(t, err)
+	defer os.Remove(keyFile.Name())
+	fmt.Fprintf(keyFile, "1:%s\n", base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)))
+	keyFile.Close()
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.MemQueueSize = 0
+	opts.EncryptionKeyFile = keyFile.Name()
+	_, _, nsqd := mustStartNSQD(opts)
+	defer os.RemoveAll(opts.DataPath)
+	defer nsqd.Exit()
+
+	topicName := "test_encryption_at_rest" + strconv.Itoa(int(time.Now().Unix()))
+	topic := nsqd.GetTopic(topicName)
+	body := []byte("regulated payload")
+	topic.PutMessage(NewMessage(topic.GenerateID(), body))
+
+	data, err := ioutil.ReadFile(diskqueue.DataFileName(opts.DataPath, topicName, 0))
+	test.Nil(t, err)
+	test.Equal(t, false, bytes.Contains(data, body))
+
+	channel := topic.GetChannel("ch")
+	msg := <-channel.backend.ReadChan()
+	m, err := decodeMessage(msg)
+	test.Nil(t, err)
+	test.Equal(t, body, m.Body)
+
+	opts = NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.DataPath, err = ioutil.TempDir("", "nsq-test-")
+	test.Nil(t, err)
+	defer os.RemoveAll(opts.DataPath)
+	opts.EncryptionKeyFile = keyFile.Name() + ".missing"
+	_, err = New(opts)
+	test.NotNil(t, err)
+}
 
 func TestHealth(t *testing.T) {
 	opts := NewOptions()
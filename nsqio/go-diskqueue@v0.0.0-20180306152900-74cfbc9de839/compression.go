@@ -0,0 +1,108 @@
+package diskqueue
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/golang/snappy"
+)
+
+// Compression is the codec records are compressed with before they are written,
+// each record is flagged with its codec so that it can change at any time
+type Compression int32
+
+const (
+	CompressionNone Compression = iota
+	CompressionSnappy
+	CompressionDeflate
+)
+
+// record flags
+const (
+	flagSnappy  = 1 << 0
+	flagDeflate = 1 << 1
+
+	flagsCompressed = flagSnappy | flagDeflate
+)
+
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionSnappy:
+		return "snappy"
+	case CompressionDeflate:
+		return "deflate"
+	}
+	return fmt.Sprintf("Compression(%d)", int32(c))
+}
+
+// ParseCompression returns the Compression named s ("" is none)
+func ParseCompression(s string) (Compression, error) {
+	switch s {
+	case "", "none":
+		return CompressionNone, nil
+	case "snappy":
+		return CompressionSnappy, nil
+	case "deflate":
+		return CompressionDeflate, nil
+	}
+	return CompressionNone, fmt.Errorf("invalid compression %q", s)
+}
+
+// compress returns data compressed with c and the record flag for it, data is
+// returned as is when it does not get smaller
+func compress(c Compression, data []byte) ([]byte, byte) {
+	var out []byte
+	var flag byte
+	switch c {
+	case CompressionSnappy:
+		out = snappy.Encode(nil, data)
+		flag = flagSnappy
+	case CompressionDeflate:
+		var buf bytes.Buffer
+		w, _ := flate.NewWriter(&buf, flate.DefaultCompression)
+		w.Write(data)
+		w.Close()
+		out = buf.Bytes()
+		flag = flagDeflate
+	default:
+		return data, 0
+	}
+	if len(out) >= len(data) {
+		return data, 0
+	}
+	return out, flag
+}
+
+// decompress reverses compress, the result may not be larger than maxSize
+func decompress(flags byte, data []byte, maxSize int32) ([]byte, error) {
+	switch flags & flagsCompressed {
+	case 0:
+		return data, nil
+	case flagSnappy:
+		n, err := snappy.DecodedLen(data)
+		if err != nil {
+			return nil, err
+		}
+		if n > int(maxSize) {
+			return nil, fmt.Errorf("invalid decompressed size (%d)", n)
+		}
+		return snappy.Decode(nil, data)
+	case flagDeflate:
+		r := flate.NewReader(bytes.NewReader(data))
+		out, err := ioutil.ReadAll(io.LimitReader(r, int64(maxSize)+1))
+		r.Close()
+		if err != nil {
+			return nil, err
+		}
+		if len(out) > int(maxSize) {
+			return nil, fmt.Errorf("invalid decompressed size (> %d)", maxSize)
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("unknown record flags (%d)", flags)
+}
@@ -3,13 +3,12 @@ package diskqueue
 import (
 	"bufio"
 	"bytes"
-	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
 	"math/rand"
 	"os"
-	"path"
+	"runtime"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -48,6 +47,7 @@ func (l LogLevel) String() string {
 //队列的接口定义
 type Interface interface {
 	Put([]byte) error
+	PutSync([]byte) error
 	ReadChan() chan []byte // this is expected to be an *unbuffered* channel
 	Close() error
 	Delete() error
@@ -67,6 +67,12 @@ type diskQueue struct {
 	writeFileNum int64 //正在写入的文件索引
 	depth        int64 //当前消息的数量(队列的大小)
 
+	// bytes of the records written since start, before and after compression
+	rawBytes    int64
+	storedBytes int64
+
+	compression int32 //写入时的压缩方式
+
 	sync.RWMutex
 
 	// instantiation time metadata
@@ -90,26 +96,55 @@ type diskQueue struct {
 	reader    *bufio.Reader
 	writeBuf  bytes.Buffer
 
+	readVersion int //读文件的格式版本
+
+	// set when a record is encrypted with a key that is not in keys, nothing
+	// more is read until the queue is opened with the key (or emptied)
+	readErr error //停止读取的原因
+
+	keys *Keyring //加密的密钥，为nil时不加密
+
 	// exposed via ReadChan()
 	readChan chan []byte //读取消息的chan通过ReadChan()暴露
 
 	// internal channels
 	writeChan         chan []byte
 	writeResponseChan chan error
+	writeSyncChan     chan syncWrite
 	emptyChan         chan int
 	emptyResponseChan chan error
+	snapshotChan         chan func(SnapshotState) error
+	snapshotResponseChan chan error
 	exitChan          chan int
 	exitSyncChan      chan int
 
 	logf AppLogFunc	//日志函数
 }
 
+// Option configures a diskQueue
+type Option func(*diskQueue)
+
+// WithCompression sets the compression of the records written
+func WithCompression(c Compression) Option {
+	return func(d *diskQueue) {
+		d.compression = int32(c)
+	}
+}
+
+// WithKeyring encrypts the records written with the active key of keys and
+// decrypts the records read with the key they were written with
+func WithKeyring(keys *Keyring) Option {
+	return func(d *diskQueue) {
+		d.keys = keys
+	}
+}
+
 // New instantiates an instance of diskQueue, retrieving metadata
 // from the filesystem and starting the read ahead goroutine
 //创建diskQueue的实例，并且恢复元素据，然后进行消息循环
 func New(name string, dataPath string, maxBytesPerFile int64,
 	minMsgSize int32, maxMsgSize int32,
-	syncEvery int64, syncTimeout time.Duration, logf AppLogFunc) Interface {
+	syncEvery int64, syncTimeout time.Duration, logf AppLogFunc, opts ...Option) Interface {
 	d := diskQueue{
 		name:              name,
 		dataPath:          dataPath,
@@ -119,20 +154,27 @@ func New(name string, dataPath string, maxBytesPerFile int64,
 		readChan:          make(chan []byte),
 		writeChan:         make(chan []byte),
 		writeResponseChan: make(chan error),	//写的应答channel
+		writeSyncChan:     make(chan syncWrite),
 		emptyChan:         make(chan int),
 		emptyResponseChan: make(chan error),	//清空的应答channel
+		snapshotChan:         make(chan func(SnapshotState) error),
+		snapshotResponseChan: make(chan error),
 		exitChan:          make(chan int),
 		exitSyncChan:      make(chan int),
 		syncEvery:         syncEvery,
 		syncTimeout:       syncTimeout,
 		logf:              logf,
 	}
+	for _, opt := range opts {
+		opt(&d)
+	}
 
 	// no need to lock here, nothing else could possibly be touching this instance
 	err := d.retrieveMetaData()
 	if err != nil && !os.IsNotExist(err) {
 		d.logf(ERROR, "DISKQUEUE(%s) failed to retrieveMetaData - %s", d.name, err)
 	}
+	d.rollVersion1WriteFile()
 
 	go d.ioLoop()
 	return &d
@@ -149,6 +191,33 @@ func (d *diskQueue) ReadChan() chan []byte {
 	return d.readChan
 }
 
+// SetCompression changes the compression of the records written from now on,
+// records already written are read back regardless
+func (d *diskQueue) SetCompression(c Compression) {
+	atomic.StoreInt32(&d.compression, int32(c))
+}
+
+// SetMaxMsgSize raises the size limit of the records, it is never lowered so
+// that the records already written can still be read
+func (d *diskQueue) SetMaxMsgSize(maxMsgSize int32) {
+	for {
+		old := atomic.LoadInt32(&d.maxMsgSize)
+		if maxMsgSize <= old || atomic.CompareAndSwapInt32(&d.maxMsgSize, old, maxMsgSize) {
+			return
+		}
+	}
+}
+
+// CompressionRatio returns the ratio of the size of the data written since the
+// queue was opened to the space it took up on disk, 0 when nothing was written
+func (d *diskQueue) CompressionRatio() float64 {
+	stored := atomic.LoadInt64(&d.storedBytes)
+	if stored == 0 {
+		return 0
+	}
+	return float64(atomic.LoadInt64(&d.rawBytes)) / float64(stored)
+}
+
 // Put writes a []byte to the queue
 //将一个[]byte数据推入队列
 func (d *diskQueue) Put(data []byte) error {
@@ -165,6 +234,29 @@ func (d *diskQueue) Put(data []byte) error {
 	return <-d.writeResponseChan
 }
 
+type syncWrite struct {
+	data         []byte
+	responseChan chan error
+}
+
+// PutSync writes a []byte to the queue and returns once it (and everything
+// written before it) has been fsynced along with the metadata.
+//
+// Concurrent calls are committed together with a single fsync.
+//写入并同步到磁盘后返回（并发的写入合并成一次同步）
+func (d *diskQueue) PutSync(data []byte) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.exitFlag == 1 {
+		return errors.New("exiting")
+	}
+
+	w := syncWrite{data: data, responseChan: make(chan error, 1)}
+	d.writeSyncChan <- w
+	return <-w.responseChan
+}
+
 // Close cleans up the queue and persists metadata
 //关闭队列并持久化元数据
 func (d *diskQueue) Close() error {
@@ -229,6 +321,57 @@ func (d *diskQueue) Empty() error {
 	return <-d.emptyResponseChan
 }
 
+// SnapshotState is the on-disk state of a diskQueue at a point in time
+type SnapshotState struct {
+	Depth        int64
+	ReadFileNum  int64
+	ReadPos      int64
+	WriteFileNum int64
+	WritePos     int64
+
+	MaxBytesPerFile int64
+	// the data files from ReadFileNum to WriteFileNum, inclusive
+	// (the write file may not have been created yet)
+	FileNames    []string
+	MetaFileName string
+}
+
+// Snapshot syncs the queue and calls fn with its on-disk state, no reads
+// or writes happen until fn returns so the files can be safely copied
+//获取队列的快照(fn执行期间队列不会读写)
+func (d *diskQueue) Snapshot(fn func(SnapshotState) error) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.exitFlag == 1 {
+		return errors.New("exiting")
+	}
+
+	d.snapshotChan <- fn
+	return <-d.snapshotResponseChan
+}
+
+func (d *diskQueue) snapshot(fn func(SnapshotState) error) error {
+	err := d.sync()
+	if err != nil {
+		return err
+	}
+
+	state := SnapshotState{
+		Depth:           atomic.LoadInt64(&d.depth),
+		ReadFileNum:     d.readFileNum,
+		ReadPos:         d.readPos,
+		WriteFileNum:    d.writeFileNum,
+		WritePos:        d.writePos,
+		MaxBytesPerFile: d.maxBytesPerFile,
+		MetaFileName:    d.metaDataFileName(),
+	}
+	for i := d.readFileNum; i <= d.writeFileNum; i++ {
+		state.FileNames = append(state.FileNames, d.fileName(i))
+	}
+	return fn(state)
+}
+
 //删除所有落地文件
 func (d *diskQueue) deleteAllFiles() error {
 	//删除数据文件
@@ -274,15 +417,20 @@ func (d *diskQueue) skipToNextRWFile() error {
 	d.nextReadFileNum = d.writeFileNum
 	d.nextReadPos = 0
 	atomic.StoreInt64(&d.depth, 0)
+	d.readErr = nil
 
 	return err
 }
 
+var errRetryRead = errors.New("retry read")
+
 // readOne performs a low level filesystem read for a single []byte
 // while advancing read positions and rolling files, if necessary
+//
+// errRetryRead is returned when no data was read but the read position moved
+// past a corrupt record or to the next file
 func (d *diskQueue) readOne() ([]byte, error) {
 	var err error
-	var msgSize int32
 
 	if d.readFile == nil {
 		curFileName := d.fileName(d.readFileNum)
@@ -293,9 +441,22 @@ func (d *diskQueue) readOne() ([]byte, error) {
 		}
 
 		d.logf(INFO, "DISKQUEUE(%s): readOne() opened %s", d.name, curFileName)
+
+		//文件格式版本
+		d.readVersion, err = readFileVersion(d.readFile)
+		if err != nil {
+			d.readFile.Close()
+			d.readFile = nil
+			return nil, err
+		}
+
 		//移动到要读的位置
-		if d.readPos > 0 {
-			_, err = d.readFile.Seek(d.readPos, 0)
+		pos := d.readPos
+		if pos < firstRecordPos(d.readVersion) {
+			pos = firstRecordPos(d.readVersion)
+		}
+		if pos > 0 {
+			_, err = d.readFile.Seek(pos, 0)
 			if err != nil {
 				d.readFile.Close()
 				d.readFile = nil
@@ -306,38 +467,35 @@ func (d *diskQueue) readOne() ([]byte, error) {
 		d.reader = bufio.NewReader(d.readFile)
 	}
 
-	//读取消息的长度(不包含这个uint32)
-	err = binary.Read(d.reader, binary.BigEndian, &msgSize)
-	if err != nil {
-		d.readFile.Close()
-		d.readFile = nil
-		return nil, err
+	recordPos := d.readPos
+	if recordPos < firstRecordPos(d.readVersion) {
+		recordPos = firstRecordPos(d.readVersion)
 	}
 
-	//检测文件是损坏
-	if msgSize < d.minMsgSize || msgSize > d.maxMsgSize {
-		// this file is corrupt and we have no reasonable guarantee on
-		// where a new message should begin
-		d.readFile.Close()
-		d.readFile = nil
-		return nil, fmt.Errorf("invalid message read size (%d)", msgSize)
-	}
-	//读取消息内容
-	readBuf := make([]byte, msgSize)
-	_, err = io.ReadFull(d.reader, readBuf)
-	if err != nil {
+	readBuf, flags, totalBytes, err := readRecord(d.reader, d.readVersion, d.minMsgSize, atomic.LoadInt32(&d.maxMsgSize))
+	switch err.(type) {
+	case nil:
+	case recordSizeError:
+		// the position of the next record is unknown
+		return nil, d.resyncRead(recordPos, err)
+	default:
+		if err == errChecksum {
+			// the size is valid so the next record can still be found
+			break
+		}
 		d.readFile.Close()
 		d.readFile = nil
+		if err == io.EOF && d.readFileNum < d.writeFileNum {
+			// the writer rolled before this file was full (e.g. a version 1 file)
+			return nil, d.rollRead()
+		}
 		return nil, err
 	}
 
-	//消息和头的总长度
-	totalBytes := int64(4 + msgSize)
-
 	// we only advance next* because we have not yet sent this to consumers
 	// (where readFileNum, readPos will actually be advanced)
 	//更新下次的阅读位置
-	d.nextReadPos = d.readPos + totalBytes
+	d.nextReadPos = recordPos + totalBytes
 	d.nextReadFileNum = d.readFileNum
 
 	// TODO: each data file should embed the maxBytesPerFile
@@ -354,9 +512,104 @@ func (d *diskQueue) readOne() ([]byte, error) {
 		d.nextReadPos = 0
 	}
 
+	if err == nil {
+		readBuf, err = decodeRecord(flags, readBuf, atomic.LoadInt32(&d.maxMsgSize), d.keys)
+	}
+	if _, ok := err.(UnknownKeyError); ok {
+		// the record is intact, it is read again once the key is supplied
+		if d.readFile != nil {
+			d.readFile.Close()
+			d.readFile = nil
+		}
+		d.nextReadPos = d.readPos
+		d.nextReadFileNum = d.readFileNum
+		return nil, err
+	}
+	if err != nil {
+		// skip just this record
+		d.logf(ERROR, "DISKQUEUE(%s) skipping record at %d of %s - %s",
+			d.name, recordPos, d.fileName(d.readFileNum), err)
+		d.moveForward()
+		return nil, errRetryRead
+	}
+
 	return readBuf, nil
 }
 
+// resyncWindow is the number of offsets resyncRead checks for each read
+const resyncWindow = 64 * 1024
+
+// resyncRead moves the read position to the next valid record in the current
+// file after a record with an invalid size, when there is none the file is
+// handled as a read error. The skipped record is taken off the depth.
+//
+// The file is scanned a window at a time, each read holds the window and room
+// for the largest record that can start in it.
+func (d *diskQueue) resyncRead(recordPos int64, readErr error) error {
+	d.readFile.Close()
+	d.readFile = nil
+
+	fn := d.fileName(d.readFileNum)
+	if d.readVersion == fileVersion1 {
+		// no checksums, there is no reasonable guarantee on where a new message
+		// should begin
+		return readErr
+	}
+
+	f, err := os.Open(fn)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	maxMsgSize := atomic.LoadInt32(&d.maxMsgSize)
+	buf := make([]byte, resyncWindow+recordHeaderSize+int(maxMsgSize)+encryptionOverhead)
+	// windows overlap by one byte as resync skips the first offset
+	for pos := recordPos; ; pos += resyncWindow - 1 {
+		n, err := f.ReadAt(buf, pos)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		end := resyncWindow
+		if err == io.EOF {
+			end = n
+		}
+		offset := resync(buf[:n], end, d.readVersion, d.minMsgSize, maxMsgSize, nil, nil)
+		if offset >= 0 {
+			skipped := pos + offset - recordPos
+			d.logf(ERROR, "DISKQUEUE(%s) skipping %d bytes at %d of %s - %s",
+				d.name, skipped, recordPos, fn, readErr)
+			d.readPos = recordPos + skipped
+			d.nextReadPos = d.readPos
+			if skipped >= recordHeaderSize {
+				// there was room for the header of the corrupt record
+				atomic.AddInt64(&d.depth, -1)
+			}
+			d.needSync = true
+			return errRetryRead
+		}
+		if err == io.EOF {
+			return readErr
+		}
+	}
+}
+
+// rollRead moves the read position to the start of the next file
+func (d *diskQueue) rollRead() error {
+	fn := d.fileName(d.readFileNum)
+	d.readFileNum++
+	d.readPos = 0
+	d.nextReadFileNum = d.readFileNum
+	d.nextReadPos = 0
+	d.needSync = true
+
+	err := os.Remove(fn)
+	if err != nil {
+		d.logf(ERROR, "DISKQUEUE(%s) failed to Remove(%s) - %s", d.name, fn, err)
+	}
+	return errRetryRead
+}
+
 // writeOne performs a low level filesystem write for a single []byte
 // while advancing write positions and rolling files, if necessary
 func (d *diskQueue) writeOne(data []byte) error {
@@ -384,22 +637,24 @@ func (d *diskQueue) writeOne(data []byte) error {
 	}
 
 	dataLen := int32(len(data))
+	maxMsgSize := atomic.LoadInt32(&d.maxMsgSize)
 	//判断消息长度的合法性
-	if dataLen < d.minMsgSize || dataLen > d.maxMsgSize {
-		return fmt.Errorf("invalid message write size (%d) maxMsgSize=%d", dataLen, d.maxMsgSize)
+	if dataLen < d.minMsgSize || dataLen > maxMsgSize {
+		return fmt.Errorf("invalid message write size (%d) maxMsgSize=%d", dataLen, maxMsgSize)
 	}
 
 	d.writeBuf.Reset()
-	//写入消息长度
-	err = binary.Write(&d.writeBuf, binary.BigEndian, dataLen)
-	if err != nil {
-		return err
+	//新文件先写入文件头
+	var headerBytes int64
+	if d.writePos == 0 {
+		d.writeBuf.Write(fileHeader())
+		headerBytes = fileHeaderSize
 	}
-	//写入消息内容
-	_, err = d.writeBuf.Write(data)
+	stored, flags, err := encodeRecord(Compression(atomic.LoadInt32(&d.compression)), d.keys, data)
 	if err != nil {
 		return err
 	}
+	appendRecord(&d.writeBuf, flags, stored)
 
 	// only write to the file once
 	//只写入一次
@@ -410,8 +665,10 @@ func (d *diskQueue) writeOne(data []byte) error {
 		return err
 	}
 
-	totalBytes := int64(4 + dataLen)
+	totalBytes := headerBytes + int64(recordHeaderSize) + int64(len(stored))
 	d.writePos += totalBytes
+	atomic.AddInt64(&d.rawBytes, int64(dataLen))
+	atomic.AddInt64(&d.storedBytes, int64(len(stored)))
 	//增加写入条数
 	atomic.AddInt64(&d.depth, 1)
 
@@ -436,6 +693,76 @@ func (d *diskQueue) writeOne(data []byte) error {
 	return err
 }
 
+// groupCommit writes w along with every other PutSync that is waiting and
+// then syncs once for all of them
+func (d *diskQueue) groupCommit(w syncWrite) {
+	writes := []syncWrite{w}
+	errs := []error{d.writeOne(w.data)}
+	for {
+		select {
+		case w := <-d.writeSyncChan:
+			writes = append(writes, w)
+			errs = append(errs, d.writeOne(w.data))
+			continue
+		default:
+		}
+		break
+	}
+
+	err := d.sync()
+	if err == nil {
+		// the metadata file was renamed and data files may have been created
+		err = syncDir(d.dataPath)
+	}
+	if err != nil {
+		d.logf(ERROR, "DISKQUEUE(%s) failed to sync - %s", d.name, err)
+	}
+	for i, w := range writes {
+		if errs[i] == nil {
+			errs[i] = err
+		}
+		w.responseChan <- errs[i]
+	}
+}
+
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		// directories can not be fsynced on windows
+		return nil
+	}
+	f, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = f.Sync()
+	f.Close()
+	return err
+}
+
+// rollVersion1WriteFile starts a new write file when the current one was
+// written in the version 1 format so that files are never mixed, version 1
+// files are still read
+func (d *diskQueue) rollVersion1WriteFile() {
+	if d.writePos == 0 {
+		return
+	}
+	f, err := os.Open(d.fileName(d.writeFileNum))
+	if err != nil {
+		return
+	}
+	version, err := readFileVersion(f)
+	f.Close()
+	if err != nil || version != fileVersion1 {
+		return
+	}
+
+	d.logf(INFO, "DISKQUEUE(%s): rolling version 1 write file %s",
+		d.name, d.fileName(d.writeFileNum))
+	d.writeFileNum++
+	d.writePos = 0
+	d.needSync = true
+}
+
 // sync fsyncs the current writeFile and persists metadata
 func (d *diskQueue) sync() error {
 	if d.writeFile != nil {
@@ -460,25 +787,13 @@ func (d *diskQueue) sync() error {
 // retrieveMetaData initializes state from the filesystem
 //读取元数据来恢复状态
 func (d *diskQueue) retrieveMetaData() error {
-	var f *os.File
-	var err error
-
-	fileName := d.metaDataFileName()
-	f, err = os.OpenFile(fileName, os.O_RDONLY, 0600)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-
-	var depth int64
-	_, err = fmt.Fscanf(f, "%d\n%d,%d\n%d,%d\n",
-		&depth,
-		&d.readFileNum, &d.readPos,
-		&d.writeFileNum, &d.writePos)
+	md, err := ReadMetaData(d.metaDataFileName())
 	if err != nil {
 		return err
 	}
-	atomic.StoreInt64(&d.depth, depth)
+	d.readFileNum, d.readPos = md.ReadFileNum, md.ReadPos
+	d.writeFileNum, d.writePos = md.WriteFileNum, md.WritePos
+	atomic.StoreInt64(&d.depth, md.Depth)
 	d.nextReadFileNum = d.readFileNum
 	d.nextReadPos = d.readPos
 
@@ -522,12 +837,12 @@ func (d *diskQueue) persistMetaData() error {
 
 //获取元数据文件名
 func (d *diskQueue) metaDataFileName() string {
-	return fmt.Sprintf(path.Join(d.dataPath, "%s.diskqueue.meta.dat"), d.name)
+	return MetaDataFileName(d.dataPath, d.name)
 }
 
 //获取数据文件的名字
 func (d *diskQueue) fileName(fileNum int64) string {
-	return fmt.Sprintf(path.Join(d.dataPath, "%s.diskqueue.%06d.dat"), d.name, fileNum)
+	return DataFileName(d.dataPath, d.name, fileNum)
 }
 
 //检查读取状态是否正确
@@ -672,10 +987,20 @@ func (d *diskQueue) ioLoop() {
 		}
 
 		//读写位置合法判断
-		if (d.readFileNum < d.writeFileNum) || (d.readPos < d.writePos) {
+		if d.readErr == nil && ((d.readFileNum < d.writeFileNum) || (d.readPos < d.writePos)) {
 			if d.nextReadPos == d.readPos {
 				dataRead, err = d.readOne()
 				//读取出现异常
+				if err == errRetryRead {
+					continue
+				}
+				if _, ok := err.(UnknownKeyError); ok {
+					// rather than setting the file aside with its unread messages
+					d.logf(ERROR, "DISKQUEUE(%s) stopped reading at %d of %s - %s, the key is missing from the keyring",
+						d.name, d.readPos, d.fileName(d.readFileNum), err)
+					d.readErr = err
+					continue
+				}
 				if err != nil {
 					d.logf(ERROR, "DISKQUEUE(%s) reading at %d of %s - %s",
 						d.name, d.readPos, d.fileName(d.readFileNum), err)
@@ -706,6 +1031,12 @@ func (d *diskQueue) ioLoop() {
 		case dataWrite := <-d.writeChan:
 			count++
 			d.writeResponseChan <- d.writeOne(dataWrite)
+		case w := <-d.writeSyncChan:
+			d.groupCommit(w)
+			count = 0
+			//快照
+		case fn := <-d.snapshotChan:
+			d.snapshotResponseChan <- d.snapshot(fn)
 		case <-syncTicker.C:
 			if count == 0 {
 				// avoid sync when there's no activity
@@ -3,6 +3,9 @@ package diskqueue
 import (
 	"bufio"
 	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -11,6 +14,7 @@ import (
 	"reflect"
 	"runtime"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -110,7 +114,7 @@ func TestDiskQueueRoll(t *testing.T) {
 	defer os.RemoveAll(tmpDir)
 	msg := bytes.Repeat([]byte{0}, 10)
 	ml := int64(len(msg))
-	dq := New(dqName, tmpDir, 9*(ml+4), int32(ml), 1<<10, 2500, 2*time.Second, l)
+	dq := New(dqName, tmpDir, fileHeaderSize+9*(ml+recordHeaderSize), int32(ml), 1<<10, 2500, 2*time.Second, l)
 	defer dq.Close()
 	NotNil(t, dq)
 	Equal(t, int64(0), dq.Depth())
@@ -125,6 +129,48 @@ func TestDiskQueueRoll(t *testing.T) {
 	Equal(t, int64(0), dq.(*diskQueue).writePos)
 }
 
+func TestDiskQueueSnapshot(t *testing.T) {
+	l := NewTestLogger(t)
+	dqName := "test_disk_queue_snapshot" + strconv.Itoa(int(time.Now().Unix()))
+	tmpDir, err := ioutil.TempDir("", fmt.Sprintf("nsq-test-%d", time.Now().UnixNano()))
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	msg := bytes.Repeat([]byte{0}, 10)
+	ml := int64(len(msg))
+	dq := New(dqName, tmpDir, fileHeaderSize+9*(ml+recordHeaderSize), int32(ml), 1<<10, 2500, 2*time.Second, l)
+	defer dq.Close()
+
+	for i := 0; i < 12; i++ {
+		err := dq.Put(msg)
+		Nil(t, err)
+	}
+	<-dq.ReadChan()
+
+	var state SnapshotState
+	err = dq.(*diskQueue).Snapshot(func(s SnapshotState) error {
+		state = s
+		// synced before fn is called
+		md := readMetaDataFile(s.MetaFileName, 0)
+		Equal(t, s.Depth, md.depth)
+		Equal(t, s.WritePos, md.writePos)
+		return nil
+	})
+	Nil(t, err)
+	Equal(t, int64(11), state.Depth)
+	Equal(t, int64(0), state.ReadFileNum)
+	Equal(t, fileHeaderSize+ml+recordHeaderSize, state.ReadPos)
+	Equal(t, int64(1), state.WriteFileNum)
+	Equal(t, fileHeaderSize+2*(ml+recordHeaderSize), state.WritePos)
+	Equal(t, []string{dq.(*diskQueue).fileName(0), dq.(*diskQueue).fileName(1)}, state.FileNames)
+
+	err = dq.(*diskQueue).Snapshot(func(s SnapshotState) error {
+		return errors.New("failed")
+	})
+	NotNil(t, err)
+}
+
 func assertFileNotExist(t *testing.T, fn string) {
 	f, err := os.OpenFile(fn, os.O_RDONLY, 0600)
 	Equal(t, (*os.File)(nil), f)
@@ -211,7 +257,7 @@ func TestDiskQueueCorruption(t *testing.T) {
 	dq := New(dqName, tmpDir, 1000, 10, 1<<10, 5, 2*time.Second, l)
 	defer dq.Close()
 
-	msg := make([]byte, 123) // 127 bytes per message, 8 (1016 bytes) messages per file
+	msg := make([]byte, 123) // 132 bytes per message, 8 (8+1056 bytes) messages per file
 	for i := 0; i < 25; i++ {
 		dq.Put(msg)
 	}
@@ -226,22 +272,23 @@ func TestDiskQueueCorruption(t *testing.T) {
 		Equal(t, msg, <-dq.ReadChan())
 	}
 
-	// corrupt the 4th (current) file
+	// corrupt the 4th (current) file, the leftover message fails its checksum
 	dqFn = dq.(*diskQueue).fileName(3)
 	os.Truncate(dqFn, 100)
 
-	dq.Put(msg) // in 5th file
+	dq.Put(msg) // after the skipped message in the 4th file
 
 	Equal(t, msg, <-dq.ReadChan())
 
-	// write a corrupt (len 0) message at the 5th (current) file
+	// write a corrupt (len 0) message at the 4th (current) file
 	dq.(*diskQueue).writeFile.Write([]byte{0, 0, 0, 0})
 
-	// force a new 6th file - put into 5th, then readOne errors, then put into 6th
+	// readOne resyncs to the message after it
 	dq.Put(msg)
 	dq.Put(msg)
 
 	Equal(t, msg, <-dq.ReadChan())
+	Equal(t, msg, <-dq.ReadChan())
 }
 
 type md struct {
@@ -277,6 +324,130 @@ func readMetaDataFile(fileName string, retried int) md {
 	return ret
 }
 
+func TestDiskQueueChecksum(t *testing.T) {
+	l := NewTestLogger(t)
+	dqName := "test_disk_queue_checksum" + strconv.Itoa(int(time.Now().Unix()))
+	tmpDir, err := ioutil.TempDir("", fmt.Sprintf("nsq-test-%d", time.Now().UnixNano()))
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	dq := New(dqName, tmpDir, 1000, 4, 1<<10, 1, 2*time.Second, l)
+	defer dq.Close()
+
+	for i := 0; i < 3; i++ {
+		dq.Put([]byte(fmt.Sprintf("msg%d", i)))
+	}
+
+	// flip a bit in the data of the 2nd message, only it is skipped
+	f, _ := os.OpenFile(dq.(*diskQueue).fileName(0), os.O_RDWR, 0600)
+	f.WriteAt([]byte{'M'}, fileHeaderSize+(recordHeaderSize+4)+recordHeaderSize)
+	f.Close()
+
+	Equal(t, []byte("msg0"), <-dq.ReadChan())
+	Equal(t, []byte("msg2"), <-dq.ReadChan())
+}
+
+func TestDiskQueueResync(t *testing.T) {
+	l := NewTestLogger(t)
+	dqName := "test_disk_queue_resync" + strconv.Itoa(int(time.Now().Unix()))
+	tmpDir, err := ioutil.TempDir("", fmt.Sprintf("nsq-test-%d", time.Now().UnixNano()))
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	dq := New(dqName, tmpDir, 1<<20, 4, 1<<20, 1, 2*time.Second, l)
+	defer dq.Close()
+
+	// the message after the corrupt one is beyond the first resync window
+	dq.Put([]byte("msg0"))
+	dq.Put(make([]byte, 3*resyncWindow))
+	dq.Put([]byte("msg2"))
+	Equal(t, int64(3), dq.Depth())
+
+	// invalid size of the 2nd message
+	f, _ := os.OpenFile(dq.(*diskQueue).fileName(0), os.O_RDWR, 0600)
+	f.WriteAt([]byte{0xff, 0xff, 0xff, 0xff}, fileHeaderSize+recordHeaderSize+4)
+	f.Close()
+
+	Equal(t, []byte("msg0"), <-dq.ReadChan())
+	Equal(t, []byte("msg2"), <-dq.ReadChan())
+	for i := 0; i < 20 && dq.Depth() != 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	Equal(t, int64(0), dq.Depth())
+}
+
+func TestDiskQueueVersion1(t *testing.T) {
+	l := NewTestLogger(t)
+	dqName := "test_disk_queue_version1" + strconv.Itoa(int(time.Now().Unix()))
+	tmpDir, err := ioutil.TempDir("", fmt.Sprintf("nsq-test-%d", time.Now().UnixNano()))
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// a partially written version 1 file
+	var buf bytes.Buffer
+	for i := 0; i < 2; i++ {
+		binary.Write(&buf, binary.BigEndian, int32(4))
+		fmt.Fprintf(&buf, "old%d", i)
+	}
+	ioutil.WriteFile(DataFileName(tmpDir, dqName, 0), buf.Bytes(), 0600)
+	ioutil.WriteFile(MetaDataFileName(tmpDir, dqName), []byte("2\n0,0\n0,16\n"), 0600)
+
+	dq := New(dqName, tmpDir, 1000, 4, 1<<10, 2500, 2*time.Second, l)
+	defer dq.Close()
+	Equal(t, int64(1), dq.(*diskQueue).writeFileNum)
+	Equal(t, int64(0), dq.(*diskQueue).writePos)
+
+	dq.Put([]byte("new0"))
+	Equal(t, int64(3), dq.Depth())
+	Equal(t, []byte("old0"), <-dq.ReadChan())
+	Equal(t, []byte("old1"), <-dq.ReadChan())
+	Equal(t, []byte("new0"), <-dq.ReadChan())
+	assertFileNotExist(t, DataFileName(tmpDir, dqName, 0))
+
+	f, _ := os.Open(DataFileName(tmpDir, dqName, 1))
+	version, err := readFileVersion(f)
+	f.Close()
+	Nil(t, err)
+	Equal(t, fileVersion2, version)
+}
+
+func TestDiskQueuePutSync(t *testing.T) {
+	l := NewTestLogger(t)
+	dqName := "test_disk_queue_put_sync" + strconv.Itoa(int(time.Now().Unix()))
+	tmpDir, err := ioutil.TempDir("", fmt.Sprintf("nsq-test-%d", time.Now().UnixNano()))
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	// never sync on its own
+	dq := New(dqName, tmpDir, 1<<10, 4, 1<<10, 1<<20, time.Hour, l)
+	defer dq.Close()
+
+	msg := []byte("test")
+	dq.Put(msg)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := dq.PutSync(msg)
+			Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// the metadata of every acknowledged write is on disk
+	d := readMetaDataFile(dq.(*diskQueue).metaDataFileName(), 0)
+	Equal(t, int64(51), d.depth)
+	Equal(t, dq.(*diskQueue).writeFileNum, d.writeFileNum)
+	Equal(t, dq.(*diskQueue).writePos, d.writePos)
+}
+
 func TestDiskQueueSyncAfterRead(t *testing.T) {
 	l := NewTestLogger(t)
 	dqName := "test_disk_queue_read_after_sync" + strconv.Itoa(int(time.Now().Unix()))
@@ -297,7 +468,7 @@ func TestDiskQueueSyncAfterRead(t *testing.T) {
 			d.readFileNum == 0 &&
 			d.writeFileNum == 0 &&
 			d.readPos == 0 &&
-			d.writePos == 1004 {
+			d.writePos == 1017 {
 			// success
 			goto next
 		}
@@ -314,8 +485,8 @@ next:
 		if d.depth == 1 &&
 			d.readFileNum == 0 &&
 			d.writeFileNum == 0 &&
-			d.readPos == 1004 &&
-			d.writePos == 2008 {
+			d.readPos == 1017 &&
+			d.writePos == 2026 {
 			// success
 			goto done
 		}
@@ -615,3 +786,141 @@ func benchmarkDiskQueueGet(size int64, b *testing.B) {
 		<-dq.ReadChan()
 	}
 }
+
+func TestDiskQueueCompression(t *testing.T) {
+	l := NewTestLogger(t)
+	dqName := "test_disk_queue_compression" + strconv.Itoa(int(time.Now().Unix()))
+	tmpDir, err := ioutil.TempDir("", fmt.Sprintf("nsq-test-%d", time.Now().UnixNano()))
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	dq := New(dqName, tmpDir, 1<<20, 4, 1<<10, 2500, 2*time.Second, l, WithCompression(CompressionSnappy))
+	defer dq.Close()
+
+	msg := bytes.Repeat([]byte("compressible "), 50)
+	dq.Put(msg)
+	// switching compression does not affect the records already written
+	dq.(*diskQueue).SetCompression(CompressionDeflate)
+	dq.Put(msg)
+	dq.(*diskQueue).SetCompression(CompressionNone)
+	dq.Put(msg)
+
+	for i := 0; i < 3; i++ {
+		Equal(t, msg, <-dq.ReadChan())
+	}
+	ratio := dq.(*diskQueue).CompressionRatio()
+	if ratio <= 1 {
+		t.Fatalf("expected compression ratio > 1, got %f", ratio)
+	}
+
+	// incompressible data is stored as is
+	_, flags := compress(CompressionSnappy, []byte("abcd"))
+	Equal(t, byte(0), flags)
+
+	for _, s := range []string{"none", "snappy", "deflate"} {
+		c, err := ParseCompression(s)
+		Nil(t, err)
+		Equal(t, s, c.String())
+	}
+	_, err = ParseCompression("zip")
+	NotNil(t, err)
+}
+
+func TestDiskQueueSetMaxMsgSize(t *testing.T) {
+	l := NewTestLogger(t)
+	dqName := "test_disk_queue_set_max_msg_size" + strconv.Itoa(int(time.Now().Unix()))
+	tmpDir, err := ioutil.TempDir("", fmt.Sprintf("nsq-test-%d", time.Now().UnixNano()))
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	dq := New(dqName, tmpDir, 1<<20, 4, 10, 2500, 2*time.Second, l)
+	defer dq.Close()
+
+	msg := bytes.Repeat([]byte("a"), 20)
+	NotNil(t, dq.Put(msg))
+	dq.(*diskQueue).SetMaxMsgSize(20)
+	Nil(t, dq.Put(msg))
+	// the limit is never lowered
+	dq.(*diskQueue).SetMaxMsgSize(10)
+	Nil(t, dq.Put(msg))
+
+	Equal(t, msg, <-dq.ReadChan())
+	Equal(t, msg, <-dq.ReadChan())
+}
+
+func TestDiskQueueEncryption(t *testing.T) {
+	l := NewTestLogger(t)
+	dqName := "test_disk_queue_encryption" + strconv.Itoa(int(time.Now().Unix()))
+	tmpDir, err := ioutil.TempDir("", fmt.Sprintf("nsq-test-%d", time.Now().UnixNano()))
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	key1 := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	key2 := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 16))
+	keys1, err := ParseKeyring(strings.NewReader("1:" + key1 + "\n"))
+	Nil(t, err)
+	keys2, err := ParseKeyring(strings.NewReader("# rotated\n1:" + key1 + "\n2:" + key2 + "\n"))
+	Nil(t, err)
+	Equal(t, uint32(2), keys2.ActiveKeyID())
+
+	msg := []byte("plaintext message")
+	dq := New(dqName, tmpDir, 1<<20, 4, 1<<10, 1, 2*time.Second, l,
+		WithKeyring(keys1), WithCompression(CompressionSnappy))
+	dq.Put(msg)
+	dq.Close()
+
+	// rotated keys read what the old key wrote
+	dq = New(dqName, tmpDir, 1<<20, 4, 1<<10, 1, 2*time.Second, l, WithKeyring(keys2))
+	dq.Put(msg)
+	Equal(t, msg, <-dq.ReadChan())
+	Equal(t, msg, <-dq.ReadChan())
+	dq.Put(msg)
+	dq.Close()
+
+	fileName := DataFileName(tmpDir, dqName, 0)
+	buf, err := ioutil.ReadFile(fileName)
+	Nil(t, err)
+	Equal(t, false, bytes.Contains(buf, msg))
+
+	// the segment readers need the keys
+	err = ReadSegment(fileName, 4, 1<<10, nil, func(offset int64, data []byte) error {
+		return nil
+	})
+	NotNil(t, err)
+	Equal(t, UnknownKeyError(1), err.(*CorruptRecordError).Err)
+	var n int
+	err = ReadSegment(fileName, 4, 1<<10, keys2, func(offset int64, data []byte) error {
+		Equal(t, msg, data)
+		n++
+		return nil
+	})
+	Nil(t, err)
+	Equal(t, 3, n)
+
+	// reading stops at a record with an unknown key, nothing is dropped
+	dq = New(dqName, tmpDir, 1<<20, 4, 1<<10, 1, 2*time.Second, l, WithKeyring(keys1))
+	select {
+	case <-dq.ReadChan():
+		t.Fatal("read a record with an unknown key")
+	case <-time.After(100 * time.Millisecond):
+	}
+	Equal(t, int64(1), dq.Depth())
+	dq.Close()
+	_, err = os.Stat(fileName + ".bad")
+	Equal(t, true, os.IsNotExist(err))
+
+	// and continues once the key is supplied
+	dq = New(dqName, tmpDir, 1<<20, 4, 1<<10, 1, 2*time.Second, l, WithKeyring(keys2))
+	defer dq.Close()
+	Equal(t, msg, <-dq.ReadChan())
+
+	for _, s := range []string{"", "1", "x:" + key1, "1:!!", "1:" + base64.StdEncoding.EncodeToString([]byte("short")),
+		"1:" + key1 + "\n1:" + key2} {
+		_, err := ParseKeyring(strings.NewReader(s))
+		NotNil(t, err)
+	}
+}
@@ -0,0 +1,140 @@
+package diskqueue
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// record flag of encrypted records, their data is:
+//
+//	[x][x][x][x][x][x][x][x][x][x][x][x][x][x][x][x]...
+//	|  (uint32) ||           nonce              || sealed data
+//	|  key id   |
+const flagEncrypted = 1 << 2
+
+const (
+	keyIDSize          = 4
+	nonceSize          = 12
+	encryptionOverhead = keyIDSize + nonceSize + 16 // AES-GCM tag
+)
+
+// UnknownKeyError is returned for a record encrypted with a key that is not in
+// the Keyring, the record is intact and can be read once the key is supplied
+type UnknownKeyError uint32
+
+func (e UnknownKeyError) Error() string {
+	return fmt.Sprintf("record encrypted with unknown key %d", uint32(e))
+}
+
+// Keyring holds the AES keys records are encrypted with, records are written
+// with the active key and read with whichever key they were written with so
+// that keys can be rotated by adding a new one
+type Keyring struct {
+	keys     map[uint32]cipher.AEAD
+	activeID uint32
+}
+
+// LoadKeyring reads a key file, see ParseKeyring
+func LoadKeyring(fileName string) (*Keyring, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	k, err := ParseKeyring(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", fileName, err)
+	}
+	return k, nil
+}
+
+// ParseKeyring reads keys as lines of <id>:<base64 key>, the id is a number and
+// the key 16, 24 or 32 bytes (AES-128, AES-192 or AES-256). The last key is the
+// active one. Empty lines and lines starting with # are ignored.
+func ParseKeyring(r io.Reader) (*Keyring, error) {
+	k := &Keyring{keys: make(map[uint32]cipher.AEAD)}
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		parts := strings.SplitN(text, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("line %d: expected <id>:<base64 key>", line)
+		}
+		id, err := strconv.ParseUint(parts[0], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid key id %q", line, parts[0])
+		}
+		key, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid key - %s", line, err)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line, err)
+		}
+		if _, ok := k.keys[uint32(id)]; ok {
+			return nil, fmt.Errorf("line %d: duplicate key id %d", line, id)
+		}
+		k.keys[uint32(id)] = aead
+		k.activeID = uint32(id)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(k.keys) == 0 {
+		return nil, fmt.Errorf("no keys")
+	}
+	return k, nil
+}
+
+// ActiveKeyID returns the id of the key records are written with
+func (k *Keyring) ActiveKeyID() uint32 {
+	return k.activeID
+}
+
+// seal encrypts data with the active key, flags are authenticated with it
+func (k *Keyring) seal(flags byte, data []byte) ([]byte, error) {
+	out := make([]byte, keyIDSize+nonceSize, encryptionOverhead+len(data))
+	binary.BigEndian.PutUint32(out[:keyIDSize], k.activeID)
+	nonce := out[keyIDSize : keyIDSize+nonceSize]
+	_, err := io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return k.keys[k.activeID].Seal(out, nonce, data, []byte{flags}), nil
+}
+
+// open reverses seal
+func (k *Keyring) open(flags byte, data []byte) ([]byte, error) {
+	if len(data) < encryptionOverhead {
+		return nil, fmt.Errorf("encrypted record too short (%d)", len(data))
+	}
+	id := binary.BigEndian.Uint32(data[:keyIDSize])
+	if k == nil {
+		return nil, UnknownKeyError(id)
+	}
+	aead, ok := k.keys[id]
+	if !ok {
+		return nil, UnknownKeyError(id)
+	}
+	nonce := data[keyIDSize : keyIDSize+nonceSize]
+	return aead.Open(nil, nonce, data[keyIDSize+nonceSize:], []byte{flags})
+}
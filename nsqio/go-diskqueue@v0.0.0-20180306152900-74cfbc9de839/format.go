@@ -0,0 +1,238 @@
+package diskqueue
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+)
+
+// Data files start with an 8 byte header:
+//
+//	[N][S][Q][Q][x][x][x][x]
+//	|  magic   || ver||    | reserved
+//
+// and hold records of:
+//
+//	[x][x][x][x][x][x][x][x][x][x]...
+//	|  (int32) ||  (uint32) || || data
+//	|   size   ||  crc32c   ||  flags
+//
+// where size is the length of data and the checksum covers the flags and data.
+//
+// Files written before the header was introduced are version 1, they have no
+// header and their records are [int32 size][data].
+//数据文件格式
+const (
+	fileHeaderSize   = 8
+	recordHeaderSize = 4 + 4 + 1
+
+	fileVersion1 = 1
+	fileVersion2 = 2
+)
+
+var fileMagic = []byte("NSQQ")
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// errChecksum is returned for a record whose size is valid but whose data is not
+var errChecksum = errors.New("checksum mismatch")
+
+type recordSizeError int32
+
+func (e recordSizeError) Error() string {
+	return fmt.Sprintf("invalid message read size (%d)", int32(e))
+}
+
+func fileHeader() []byte {
+	hdr := make([]byte, fileHeaderSize)
+	copy(hdr, fileMagic)
+	binary.BigEndian.PutUint16(hdr[4:6], fileVersion2)
+	return hdr
+}
+
+// readFileVersion returns the format version of a data file
+func readFileVersion(f *os.File) (int, error) {
+	hdr := make([]byte, fileHeaderSize)
+	n, err := f.ReadAt(hdr, 0)
+	if err != nil && err != io.EOF {
+		return 0, err
+	}
+	return parseFileVersion(hdr[:n])
+}
+
+// parseFileVersion returns the format version of a data file starting with
+// hdr, files that are too short to hold a header are version 1
+func parseFileVersion(hdr []byte) (int, error) {
+	if len(hdr) < fileHeaderSize || !bytes.Equal(hdr[:4], fileMagic) {
+		return fileVersion1, nil
+	}
+	version := int(binary.BigEndian.Uint16(hdr[4:6]))
+	if version != fileVersion2 {
+		return 0, fmt.Errorf("unsupported file version %d", version)
+	}
+	return version, nil
+}
+
+// firstRecordPos returns the offset of the first record of a data file
+func firstRecordPos(version int) int64 {
+	if version == fileVersion1 {
+		return 0
+	}
+	return fileHeaderSize
+}
+
+// appendRecord appends a version 2 record to buf
+func appendRecord(buf *bytes.Buffer, flags byte, data []byte) {
+	var hdr [recordHeaderSize]byte
+	binary.BigEndian.PutUint32(hdr[0:4], uint32(len(data)))
+	crc := crc32.Update(0, crcTable, []byte{flags})
+	crc = crc32.Update(crc, crcTable, data)
+	binary.BigEndian.PutUint32(hdr[4:8], crc)
+	hdr[8] = flags
+	buf.Write(hdr[:])
+	buf.Write(data)
+}
+
+// encodeRecord returns data as it is stored in a record and the record flags,
+// it is compressed with c and then encrypted when keys is not nil
+func encodeRecord(c Compression, keys *Keyring, data []byte) ([]byte, byte, error) {
+	stored, flags := compress(c, data)
+	if keys == nil {
+		return stored, flags, nil
+	}
+	flags |= flagEncrypted
+	stored, err := keys.seal(flags, stored)
+	return stored, flags, err
+}
+
+// decodeRecord reverses encodeRecord, the result may not be larger than maxSize
+func decodeRecord(flags byte, data []byte, maxSize int32, keys *Keyring) ([]byte, error) {
+	if flags&^(flagsCompressed|flagEncrypted) != 0 {
+		return nil, fmt.Errorf("unknown record flags (%d)", flags)
+	}
+	if flags&flagEncrypted != 0 {
+		var err error
+		data, err = keys.open(flags, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return decompress(flags, data, maxSize)
+}
+
+// readRecord reads a single record of the given version from r, it returns the
+// number of bytes the record takes up in the file.
+//
+// A recordSizeError means that the position of the next record is unknown, for
+// errChecksum it is known (the size is valid).
+func readRecord(r io.Reader, version int, minMsgSize int32, maxMsgSize int32) ([]byte, byte, int64, error) {
+	hdrSize := recordHeaderSize
+	if version == fileVersion1 {
+		hdrSize = 4
+	}
+	var hdr [recordHeaderSize]byte
+	_, err := io.ReadFull(r, hdr[:hdrSize])
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	msgSize := int32(binary.BigEndian.Uint32(hdr[0:4]))
+	if version != fileVersion1 && hdr[8]&(flagsCompressed|flagEncrypted) != 0 {
+		// compressed data can be smaller than any message
+		minMsgSize = 1
+		if hdr[8]&flagEncrypted != 0 {
+			maxMsgSize += encryptionOverhead
+		}
+	}
+	if msgSize < minMsgSize || msgSize > maxMsgSize {
+		return nil, 0, 0, recordSizeError(msgSize)
+	}
+	data := make([]byte, msgSize)
+	_, err = io.ReadFull(r, data)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	totalBytes := int64(hdrSize) + int64(msgSize)
+
+	if version == fileVersion1 {
+		return data, 0, totalBytes, nil
+	}
+	flags := hdr[8]
+	crc := crc32.Update(0, crcTable, hdr[8:9])
+	crc = crc32.Update(crc, crcTable, data)
+	if crc != binary.BigEndian.Uint32(hdr[4:8]) {
+		return nil, flags, totalBytes, errChecksum
+	}
+	return data, flags, totalBytes, nil
+}
+
+// recordAt returns the data and flags of the record at the start of buf when it
+// is fully contained in buf and has a valid size (and checksum), it does not
+// allocate so that it can be tried at every offset
+func recordAt(buf []byte, version int, minMsgSize int32, maxMsgSize int32) ([]byte, byte, bool) {
+	hdrSize := recordHeaderSize
+	if version == fileVersion1 {
+		hdrSize = 4
+	}
+	if len(buf) < hdrSize {
+		return nil, 0, false
+	}
+	msgSize := int32(binary.BigEndian.Uint32(buf[0:4]))
+	var flags byte
+	if version != fileVersion1 {
+		flags = buf[8]
+		if flags&^(flagsCompressed|flagEncrypted) != 0 {
+			return nil, 0, false
+		}
+		if flags != 0 {
+			minMsgSize = 1
+			if flags&flagEncrypted != 0 {
+				maxMsgSize += encryptionOverhead
+			}
+		}
+	}
+	if msgSize < minMsgSize || msgSize > maxMsgSize || int64(len(buf)-hdrSize) < int64(msgSize) {
+		return nil, 0, false
+	}
+	data := buf[hdrSize : hdrSize+int(msgSize)]
+	if version == fileVersion1 {
+		return data, 0, true
+	}
+	crc := crc32.Update(0, crcTable, buf[8:9])
+	crc = crc32.Update(crc, crcTable, data)
+	if crc != binary.BigEndian.Uint32(buf[4:8]) {
+		return nil, 0, false
+	}
+	return data, flags, true
+}
+
+// resync returns the offset of the first valid record in buf that starts after
+// the start of buf and before end, or -1 when there is none. When valid is not
+// nil the decoded data must pass it.
+func resync(buf []byte, end int, version int, minMsgSize int32, maxMsgSize int32,
+	keys *Keyring, valid func([]byte) bool) int64 {
+	if end > len(buf) {
+		end = len(buf)
+	}
+	for i := 1; i < end; i++ {
+		data, flags, ok := recordAt(buf[i:], version, minMsgSize, maxMsgSize)
+		if !ok {
+			continue
+		}
+		if valid != nil {
+			data, err := decodeRecord(flags, data, maxMsgSize, keys)
+			if err != nil || !valid(data) {
+				continue
+			}
+		}
+		return int64(i)
+	}
+	return -1
+}
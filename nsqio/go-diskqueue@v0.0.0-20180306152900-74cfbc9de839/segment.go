@@ -0,0 +1,171 @@
+package diskqueue
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+)
+
+// MetaData is the queue state persisted in the meta file
+type MetaData struct {
+	Depth        int64
+	ReadFileNum  int64
+	ReadPos      int64
+	WriteFileNum int64
+	WritePos     int64
+}
+
+// MetaDataFileName returns the name of the meta file of the queue name in dataPath
+// 获取元数据文件名
+func MetaDataFileName(dataPath string, name string) string {
+	return fmt.Sprintf(path.Join(dataPath, "%s.diskqueue.meta.dat"), name)
+}
+
+// DataFileName returns the name of data file fileNum of the queue name in dataPath
+// 获取数据文件的名字
+func DataFileName(dataPath string, name string, fileNum int64) string {
+	return fmt.Sprintf(path.Join(dataPath, "%s.diskqueue.%06d.dat"), name, fileNum)
+}
+
+// ReadMetaData reads a meta file
+func ReadMetaData(fileName string) (MetaData, error) {
+	var md MetaData
+	f, err := os.OpenFile(fileName, os.O_RDONLY, 0600)
+	if err != nil {
+		return md, err
+	}
+	defer f.Close()
+
+	_, err = fmt.Fscanf(f, "%d\n%d,%d\n%d,%d\n",
+		&md.Depth,
+		&md.ReadFileNum, &md.ReadPos,
+		&md.WriteFileNum, &md.WritePos)
+	return md, err
+}
+
+// CorruptRecordError describes a record in a data file that can not be read
+type CorruptRecordError struct {
+	FileName string
+	Offset   int64
+	Err      error
+}
+
+func (e *CorruptRecordError) Error() string {
+	return fmt.Sprintf("%s: corrupt record at offset %d - %s", e.FileName, e.Offset, e.Err)
+}
+
+// WriteSegment writes a data file holding a record for each of records to w,
+// they are encrypted when keys is not nil
+func WriteSegment(w io.Writer, keys *Keyring, records [][]byte) error {
+	var buf bytes.Buffer
+	buf.Write(fileHeader())
+	for _, data := range records {
+		stored, flags, err := encodeRecord(CompressionNone, keys, data)
+		if err != nil {
+			return err
+		}
+		appendRecord(&buf, flags, stored)
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// ReadSegment calls fn with the offset and data of every record in a data file.
+// It stops at the first record that can not be read (including one cut short by
+// the end of the file or one that fails its checksum) and returns it as a
+// *CorruptRecordError. keys decrypts encrypted records, it can be nil.
+func ReadSegment(fileName string, minMsgSize int32, maxMsgSize int32, keys *Keyring,
+	fn func(offset int64, data []byte) error) error {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	version, err := readFileVersion(f)
+	if err != nil {
+		return err
+	}
+	offset := firstRecordPos(version)
+	_, err = f.Seek(offset, 0)
+	if err != nil {
+		return err
+	}
+
+	r := bufio.NewReader(f)
+	for {
+		data, flags, totalBytes, err := readRecord(r, version, minMsgSize, maxMsgSize)
+		if err == io.EOF {
+			return nil
+		}
+		if err == nil {
+			data, err = decodeRecord(flags, data, maxMsgSize, keys)
+		}
+		if err != nil {
+			return &CorruptRecordError{fileName, offset, err}
+		}
+		err = fn(offset, data)
+		if err != nil {
+			return err
+		}
+		offset += totalBytes
+	}
+}
+
+// ScanSegment reads a (possibly corrupt) data file like ReadSegment but does not
+// stop at a corrupt record. A record with a valid size that fails its checksum,
+// can not be decoded or is not accepted by valid is skipped, after any other
+// corruption it searches forward one byte at a time for the next offset holding
+// a valid record whose data is accepted by valid. It returns the corrupt records
+// that were skipped.
+func ScanSegment(fileName string, minMsgSize int32, maxMsgSize int32, keys *Keyring,
+	valid func([]byte) bool, fn func(offset int64, data []byte) error) ([]*CorruptRecordError, error) {
+	buf, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	version, err := parseFileVersion(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	var corrupt []*CorruptRecordError
+	offset := firstRecordPos(version)
+	for offset < int64(len(buf)) {
+		data, flags, totalBytes, err := readRecord(bytes.NewReader(buf[offset:]),
+			version, minMsgSize, maxMsgSize)
+		// the size is valid, the next record follows this one (version 1
+		// records have no checksum to tell a valid size from garbage)
+		sized := version != fileVersion1 && (err == nil || err == errChecksum)
+		if err == nil {
+			data, err = decodeRecord(flags, data, maxMsgSize, keys)
+		}
+		if err == nil && !valid(data) {
+			err = fmt.Errorf("invalid message")
+		}
+		if err == nil {
+			err = fn(offset, data)
+			if err != nil {
+				return corrupt, err
+			}
+			offset += totalBytes
+			continue
+		}
+
+		corrupt = append(corrupt, &CorruptRecordError{fileName, offset, err})
+		if sized {
+			offset += totalBytes
+			continue
+		}
+		next := resync(buf[offset:], len(buf), version, minMsgSize, maxMsgSize, keys, valid)
+		if next < 0 {
+			break
+		}
+		offset += next
+	}
+	return corrupt, nil
+}
@@ -0,0 +1,68 @@
+package diskqueue
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestReadScanSegment(t *testing.T) {
+	l := NewTestLogger(t)
+	dqName := "test_disk_queue_segment" + strconv.Itoa(int(time.Now().Unix()))
+	tmpDir, err := ioutil.TempDir("", fmt.Sprintf("nsq-test-%d", time.Now().UnixNano()))
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	dq := New(dqName, tmpDir, 1<<20, 4, 1<<10, 2500, 2*time.Second, l)
+	for i := 0; i < 5; i++ {
+		err := dq.Put([]byte(fmt.Sprintf("msg%d", i)))
+		Nil(t, err)
+	}
+	dq.Close()
+
+	md, err := ReadMetaData(MetaDataFileName(tmpDir, dqName))
+	Nil(t, err)
+	Equal(t, MetaData{Depth: 5, WritePos: fileHeaderSize + 5*(recordHeaderSize+4)}, md)
+
+	fileName := DataFileName(tmpDir, dqName, 0)
+	var offsets []int64
+	err = ReadSegment(fileName, 4, 1<<10, nil, func(offset int64, data []byte) error {
+		offsets = append(offsets, offset)
+		return nil
+	})
+	Nil(t, err)
+	Equal(t, []int64{8, 21, 34, 47, 60}, offsets)
+
+	// corrupt the size of the second record and the data of the fourth
+	buf, _ := ioutil.ReadFile(fileName)
+	buf[21] = 0xff
+	buf[47+recordHeaderSize] = 'x'
+	ioutil.WriteFile(fileName, buf, 0600)
+
+	var msgs [][]byte
+	err = ReadSegment(fileName, 4, 1<<10, nil, func(offset int64, data []byte) error {
+		msgs = append(msgs, data)
+		return nil
+	})
+	NotNil(t, err)
+	Equal(t, int64(21), err.(*CorruptRecordError).Offset)
+	Equal(t, 1, len(msgs))
+
+	msgs = nil
+	valid := func(data []byte) bool { return bytes.HasPrefix(data, []byte("msg")) }
+	corrupt, err := ScanSegment(fileName, 4, 1<<10, nil, valid, func(offset int64, data []byte) error {
+		msgs = append(msgs, data)
+		return nil
+	})
+	Nil(t, err)
+	Equal(t, 2, len(corrupt))
+	Equal(t, int64(21), corrupt[0].Offset)
+	Equal(t, int64(47), corrupt[1].Offset)
+	Equal(t, errChecksum, corrupt[1].Err)
+	Equal(t, [][]byte{[]byte("msg0"), []byte("msg2"), []byte("msg4")}, msgs)
+}
@@ -1,6 +1,7 @@
 package nsq
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -47,11 +48,19 @@ type wrappedResp struct {
 
 // stores the result in the value pointed to by ret(must be a pointer)
 func apiRequestNegotiateV1(method string, endpoint string, body io.Reader, ret interface{}) error {
-	httpclient := &http.Client{Transport: newDeadlineTransport(2 * time.Second)}
+	return apiRequestNegotiateV1Context(context.Background(), method, endpoint, body, ret, 2*time.Second)
+}
+
+// apiRequestNegotiateV1Context is apiRequestNegotiateV1 for requests that take
+// longer than the default timeout, or that are cancelled along with ctx
+func apiRequestNegotiateV1Context(ctx context.Context, method string, endpoint string,
+	body io.Reader, ret interface{}, timeout time.Duration) error {
+	httpclient := &http.Client{Transport: newDeadlineTransport(timeout)}
 	req, err := http.NewRequest(method, endpoint, body)
 	if err != nil {
 		return err
 	}
+	req = req.WithContext(ctx)
 
 	req.Header.Add("Accept", "application/vnd.nsq; version=1.0")
 
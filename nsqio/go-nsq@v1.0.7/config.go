@@ -111,6 +111,20 @@ type Config struct {
 	LookupdPollInterval time.Duration `opt:"lookupd_poll_interval" min:"10ms" max:"5m" default:"60s"`
 	LookupdPollJitter   float64       `opt:"lookupd_poll_jitter" min:"0" max:"1" default:"0.3"`
 
+	// Long-poll nsqlookupd's /watch endpoint to discover new producers as soon as they
+	// register, polling every LookupdPollInterval continues as a fallback
+	LookupdWatch bool `opt:"lookupd_watch"`
+
+	// Comma separated key:value tags (such as "zone:us-east-1a") of the nsqds to prefer.
+	// Of the discovered nsqds, only the ones with the most of these tags are connected to.
+	// When none of them can be connected to, or there are none, the ones with the next
+	// most are tried, and so on. The nsqds that nsqlookupd reports as unhealthy are
+	// tried last.
+	//
+	// Connections to the less preferred nsqds are kept after the preferred ones come
+	// back. The nsqds that are never preferred need consumers of their own.
+	LookupdPreferTags string `opt:"lookupd_prefer_tags"`
+
 	// Maximum duration when REQueueing (for doubling of deferred requeue)
 	MaxRequeueDelay     time.Duration `opt:"max_requeue_delay" min:"0" max:"60m" default:"15m"`
 	DefaultRequeueDelay time.Duration `opt:"default_requeue_delay" min:"0" max:"60m" default:"90s"`
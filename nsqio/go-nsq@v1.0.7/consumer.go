@@ -2,6 +2,7 @@ package nsq
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -10,6 +11,7 @@ import (
 	"net"
 	"net/url"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -127,6 +129,7 @@ type Consumer struct {
 	lookupdRecheckChan chan int
 	lookupdHTTPAddrs   []string
 	lookupdQueryIndex  int
+	preferTags         map[string]string
 
 	wg              sync.WaitGroup
 	runningHandlers int32
@@ -159,6 +162,11 @@ func NewConsumer(topic string, channel string, config *Config) (*Consumer, error
 		return nil, errors.New("invalid channel name")
 	}
 
+	preferTags, err := parseTags(config.LookupdPreferTags)
+	if err != nil {
+		return nil, err
+	}
+
 	r := &Consumer{
 		id: atomic.AddInt64(&instCount, 1),
 
@@ -177,6 +185,7 @@ func NewConsumer(topic string, channel string, config *Config) (*Consumer, error
 		connections:        make(map[string]*Conn),
 
 		lookupdRecheckChan: make(chan int, 1),
+		preferTags:         preferTags,
 
 		rng: rand.New(rand.NewSource(time.Now().UnixNano())),
 
@@ -331,6 +340,10 @@ func (r *Consumer) ConnectToNSQLookupd(addr string) error {
 		r.queryLookupd()
 		r.wg.Add(1)
 		go r.lookupdLoop()
+		if r.config.LookupdWatch {
+			r.wg.Add(1)
+			go r.lookupdWatchLoop()
+		}
 	}
 
 	return nil
@@ -430,10 +443,41 @@ func (r *Consumer) nextLookupdEndpoint() string {
 
 	v, err := url.ParseQuery(u.RawQuery)
 	v.Add("topic", r.topic)
+	for k, tv := range r.preferTags {
+		v.Add("prefer", k+":"+tv)
+	}
 	u.RawQuery = v.Encode()
 	return u.String()
 }
 
+// parse comma separated key:value tags
+func parseTags(s string) (map[string]string, error) {
+	tags := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		i := strings.Index(pair, ":")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid tag %q, expected key:value", pair)
+		}
+		tags[pair[:i]] = pair[i+1:]
+	}
+	return tags, nil
+}
+
+// return how many of the preferred tags the producer has
+func (r *Consumer) preferScore(producer *peerInfo) int {
+	n := 0
+	for k, v := range r.preferTags {
+		if tv, ok := producer.Tags[k]; ok && tv == v {
+			n++
+		}
+	}
+	return n
+}
+
 type lookupResp struct {
 	Channels  []string    `json:"channels"`
 	Producers []*peerInfo `json:"producers"`
@@ -441,12 +485,16 @@ type lookupResp struct {
 }
 
 type peerInfo struct {
-	RemoteAddress    string `json:"remote_address"`
-	Hostname         string `json:"hostname"`
-	BroadcastAddress string `json:"broadcast_address"`
-	TCPPort          int    `json:"tcp_port"`
-	HTTPPort         int    `json:"http_port"`
-	Version          string `json:"version"`
+	RemoteAddress    string            `json:"remote_address"`
+	Hostname         string            `json:"hostname"`
+	BroadcastAddress string            `json:"broadcast_address"`
+	TCPPort          int               `json:"tcp_port"`
+	HTTPPort         int               `json:"http_port"`
+	Version          string            `json:"version"`
+	Tags             map[string]string `json:"tags"`
+	Health           *struct {
+		Healthy bool `json:"healthy"`
+	} `json:"health"`
 }
 
 // make an HTTP req to one of the configured nsqlookupd instances to discover
@@ -473,24 +521,161 @@ retry:
 		return
 	}
 
-	var nsqdAddrs []string
+	// group the producers by how preferred they are, the unhealthy ones last
+	tiers := make(map[int][]string)
+	var scores []int
 	for _, producer := range data.Producers {
+		score := 0
+		if len(r.preferTags) > 0 {
+			score = r.preferScore(producer)
+			if producer.Health != nil && !producer.Health.Healthy {
+				score = -1
+			}
+		}
+		if _, ok := tiers[score]; !ok {
+			scores = append(scores, score)
+		}
 		broadcastAddress := producer.BroadcastAddress
 		port := producer.TCPPort
 		joined := net.JoinHostPort(broadcastAddress, strconv.Itoa(port))
-		nsqdAddrs = append(nsqdAddrs, joined)
+		tiers[score] = append(tiers[score], joined)
 	}
+	sort.Sort(sort.Reverse(sort.IntSlice(scores)))
+
+	// fall back to the less preferred producers while none can be connected to
+	for _, score := range scores {
+		if r.connectToProducers(tiers[score]) > 0 {
+			break
+		}
+	}
+}
+
+// connect to the producers discovered via nsqlookupd that pass the DiscoveryFilter,
+// returns how many of them are connected to
+func (r *Consumer) connectToProducers(nsqdAddrs []string) int {
 	// apply filter
 	if discoveryFilter, ok := r.behaviorDelegate.(DiscoveryFilter); ok {
 		nsqdAddrs = discoveryFilter.Filter(nsqdAddrs)
 	}
+	connected := 0
 	for _, addr := range nsqdAddrs {
-		err = r.ConnectToNSQD(addr)
+		err := r.ConnectToNSQD(addr)
 		if err != nil && err != ErrAlreadyConnected {
 			r.log(LogLevelError, "(%s) error connecting to nsqd - %s", addr, err)
 			continue
 		}
+		connected++
+	}
+	return connected
+}
+
+const (
+	lookupdWatchTimeout    = 30 * time.Second // how long a /watch request waits for events
+	lookupdWatchRetryDelay = 5 * time.Second  // wait after a /watch request failed
+)
+
+type watchResp struct {
+	Events []*watchEvent `json:"events"`
+	LastID uint64        `json:"last_id"`
+	Reset  bool          `json:"reset"`
+}
+
+type watchEvent struct {
+	Type     string    `json:"type"`
+	Category string    `json:"category"`
+	Topic    string    `json:"topic"`
+	Producer *peerInfo `json:"producer"`
+}
+
+// return the /watch endpoint of the index-th lookupd, without since it returns
+// the id of the latest event right away
+func (r *Consumer) lookupdWatchEndpoint(index int, since uint64, watching bool) string {
+	r.mtx.RLock()
+	addr := r.lookupdHTTPAddrs[index%len(r.lookupdHTTPAddrs)]
+	r.mtx.RUnlock()
+
+	urlString := addr
+	if !strings.Contains(urlString, "://") {
+		urlString = "http://" + addr
+	}
+
+	u, err := url.Parse(urlString)
+	if err != nil {
+		panic(err)
+	}
+	u.Path = "/watch"
+
+	v := url.Values{}
+	v.Add("topic", r.topic)
+	v.Add("timeout", lookupdWatchTimeout.String())
+	if watching {
+		v.Add("since", strconv.FormatUint(since, 10))
+	}
+	u.RawQuery = v.Encode()
+	return u.String()
+}
+
+// long-poll nsqlookupd for the producers of the topic that register, and
+// connect to them right away rather than on the next LookupdPollInterval
+func (r *Consumer) lookupdWatchLoop() {
+	var since uint64
+	var index int
+	watching := false
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-r.exitChan
+		cancel()
+	}()
+
+	for {
+		endpoint := r.lookupdWatchEndpoint(index, since, watching)
+
+		var data watchResp
+		err := apiRequestNegotiateV1Context(ctx, "GET", endpoint, nil, &data,
+			lookupdWatchTimeout+2*time.Second)
+		if err != nil {
+			if ctx.Err() != nil {
+				goto exit
+			}
+			r.log(LogLevelError, "error watching nsqlookupd (%s) - %s", endpoint, err)
+			// event ids are per nsqlookupd, start over with the next one
+			watching = false
+			index++
+			select {
+			case <-time.After(lookupdWatchRetryDelay):
+			case <-r.exitChan:
+				goto exit
+			}
+			continue
+		}
+
+		if !watching || data.Reset {
+			// catch up with the producers that registered while not watching
+			r.queryLookupd()
+		}
+		since = data.LastID
+		watching = true
+
+		var nsqdAddrs []string
+		for _, e := range data.Events {
+			if e.Type != "register" || e.Category != "topic" || e.Producer == nil {
+				continue
+			}
+			port := strconv.Itoa(e.Producer.TCPPort)
+			nsqdAddrs = append(nsqdAddrs, net.JoinHostPort(e.Producer.BroadcastAddress, port))
+		}
+		if len(nsqdAddrs) > 0 && len(r.preferTags) > 0 {
+			// whether they are preferred depends on the other producers
+			r.queryLookupd()
+		} else if len(nsqdAddrs) > 0 {
+			r.connectToProducers(nsqdAddrs)
+		}
 	}
+
+exit:
+	r.log(LogLevelInfo, "exiting lookupdWatchLoop")
+	r.wg.Done()
 }
 
 // ConnectToNSQDs takes multiple nsqd addresses to connect directly to.
@@ -0,0 +1,5 @@
+module github.com/nsqio/go-nsq
+
+go 1.27.1
+
+require github.com/golang/snappy v0.0.0-20180518054509-2e65f85255db
@@ -21,22 +21,24 @@ const (
 )
 
 var validTopicChannelNameRegex = regexp.MustCompile(`^[\.a-zA-Z0-9_-]+(#ephemeral)?$`)
+var validChannelNameRegex = regexp.MustCompile(`^[\.a-zA-Z0-9_-]+(#ephemeral|#broadcast)?$`)
 
 // IsValidTopicName checks a topic name for correctness
 func IsValidTopicName(name string) bool {
-	return isValidName(name)
+	return isValidName(name, validTopicChannelNameRegex)
 }
 
-// IsValidChannelName checks a channel name for correctness
+// IsValidChannelName checks a channel name for correctness, a #broadcast
+// channel delivers every message to each of its consumers
 func IsValidChannelName(name string) bool {
-	return isValidName(name)
+	return isValidName(name, validChannelNameRegex)
 }
 
-func isValidName(name string) bool {
+func isValidName(name string, re *regexp.Regexp) bool {
 	if len(name) > 64 || len(name) < 1 {
 		return false
 	}
-	return validTopicChannelNameRegex.MatchString(name)
+	return re.MatchString(name)
 }
 
 // ReadResponse is a client-side utility function to read from the supplied Reader
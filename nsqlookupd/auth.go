@@ -0,0 +1,84 @@
+package nsqlookupd
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/nsqio/nsq/internal/http_api"
+)
+
+func buildTLSConfig(opts *Options) (*tls.Config, error) {
+	if opts.TLSCert == "" && opts.TLSKey == "" {
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(opts.TLSCert, opts.TLSKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// a verified client certificate authorizes the peer just like --auth-secret
+	tlsClientAuthPolicy := tls.VerifyClientCertIfGiven
+	switch opts.TLSClientAuthPolicy {
+	case "require":
+		tlsClientAuthPolicy = tls.RequireAnyClientCert
+	case "require-verify":
+		tlsClientAuthPolicy = tls.RequireAndVerifyClientCert
+	case "":
+		if opts.TLSRootCAFile == "" {
+			tlsClientAuthPolicy = tls.NoClientCert
+		}
+	default:
+		return nil, errors.New("--tls-client-auth-policy must be require or require-verify")
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tlsClientAuthPolicy,
+		MinVersion:   tls.VersionTLS10,
+	}
+
+	if opts.TLSRootCAFile != "" {
+		tlsCertPool := x509.NewCertPool()
+		caCertFile, err := ioutil.ReadFile(opts.TLSRootCAFile)
+		if err != nil {
+			return nil, err
+		}
+		if !tlsCertPool.AppendCertsFromPEM(caCertFile) {
+			return nil, errors.New("failed to append certificate to pool")
+		}
+		tlsConfig.ClientCAs = tlsCertPool
+	}
+
+	return tlsConfig, nil
+}
+
+// isAuthorized reports whether a peer may change the registrations, with
+// --auth-secret it has to know the secret or present a verified certificate
+func (l *NSQLookupd) isAuthorized(state *tls.ConnectionState, secret string) bool {
+	if l.opts.AuthSecret == "" {
+		return true
+	}
+	if state != nil && len(state.VerifiedChains) > 0 {
+		return true
+	}
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(l.opts.AuthSecret)) == 1
+}
+
+// authorized rejects the HTTP requests that may not change the registrations,
+// the secret is sent as "Authorization: Bearer <secret>"
+func (s *httpServer) authorized(f http_api.APIHandler) http_api.APIHandler {
+	return func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+		secret := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if !s.ctx.nsqlookupd.isAuthorized(req.TLS, secret) {
+			return nil, http_api.Err{401, "UNAUTHORIZED"}
+		}
+		return f(w, req, ps)
+	}
+}
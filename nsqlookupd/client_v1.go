@@ -2,11 +2,13 @@ package nsqlookupd
 
 import (
 	"net"
+	"time"
 )
 
 type ClientV1 struct {
 	net.Conn
-	peerInfo *PeerInfo
+	peerInfo   *PeerInfo
+	tombstones map[string]time.Time //恢复的tombstone，在REGISTER topic时设置
 }
 
 func NewClientV1(conn net.Conn) *ClientV1 {
@@ -0,0 +1,337 @@
+package nsqlookupd
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// the DNS responder answers from the same registrations as /lookup, for the
+// clients that can only use DNS:
+//
+//	SRV       <topic>.topic.<domain>  priority 0, weight 0, TCP port and target
+//	A/AAAA    <topic>.topic.<domain>  the producers broadcasting an IP address
+//	A/AAAA    <ip>.node.<domain>      the targets of the SRV records for the
+//	                                  producers broadcasting an IP address, such
+//	                                  as 10-0-0-1.node.nsq. or 2001-db8--1.node.nsq.
+//
+// DNS names are case-insensitive and resolvers may randomize the case of the
+// queries (0x20), so <topic> is matched ignoring case: the topics that only
+// differ in case share a name and are answered together.
+
+const (
+	dnsTypeA    = 1
+	dnsTypeAAAA = 28
+	dnsTypeSRV  = 33
+	dnsTypeANY  = 255
+	dnsClassIN  = 1
+
+	dnsRcodeFormErr  = 1
+	dnsRcodeNXDomain = 3
+	dnsRcodeNotImp   = 4
+	dnsRcodeRefused  = 5
+
+	dnsHeaderLen = 12
+	dnsUDPSize   = 512 //没有EDNS时UDP应答的上限，超过的设置TC让客户端改用TCP
+
+	// the registrations change within seconds, the answers should not be
+	// cached for longer
+	dnsTTL = 5
+
+	dnsTCPIdleTimeout = 10 * time.Second
+)
+
+var errDNSFormat = errors.New("malformed DNS message")
+
+type dnsQuestion struct {
+	name   string
+	qtype  uint16
+	qclass uint16
+}
+
+type dnsRecord struct {
+	name  string
+	rtype uint16
+	data  []byte
+}
+
+// parseDNSQuery returns the id, the flags and the only question of msg
+func parseDNSQuery(msg []byte) (uint16, uint16, dnsQuestion, error) {
+	var q dnsQuestion
+	if len(msg) < dnsHeaderLen {
+		return 0, 0, q, errDNSFormat
+	}
+	id := binary.BigEndian.Uint16(msg[0:])
+	flags := binary.BigEndian.Uint16(msg[2:])
+	if flags&0x8000 != 0 || binary.BigEndian.Uint16(msg[4:]) != 1 {
+		return id, flags, q, errDNSFormat
+	}
+
+	// queries do not compress the name of their only question
+	var labels []string
+	off := dnsHeaderLen
+	for {
+		if off >= len(msg) {
+			return id, flags, q, errDNSFormat
+		}
+		n := int(msg[off])
+		off++
+		if n == 0 {
+			break
+		}
+		if n > 63 || off+n > len(msg) {
+			return id, flags, q, errDNSFormat
+		}
+		labels = append(labels, string(msg[off:off+n]))
+		off += n
+	}
+	if off+4 > len(msg) {
+		return id, flags, q, errDNSFormat
+	}
+	q.name = strings.Join(labels, ".") + "."
+	q.qtype = binary.BigEndian.Uint16(msg[off:])
+	q.qclass = binary.BigEndian.Uint16(msg[off+2:])
+	return id, flags, q, nil
+}
+
+func appendDNSName(b []byte, name string) []byte {
+	for _, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		if label == "" {
+			continue
+		}
+		b = append(b, byte(len(label)))
+		b = append(b, label...)
+	}
+	return append(b, 0)
+}
+
+func appendDNSRecord(b []byte, r dnsRecord) []byte {
+	b = appendDNSName(b, r.name)
+	b = append(b, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+	binary.BigEndian.PutUint16(b[len(b)-10:], r.rtype)
+	binary.BigEndian.PutUint16(b[len(b)-8:], dnsClassIN)
+	binary.BigEndian.PutUint32(b[len(b)-6:], dnsTTL)
+	binary.BigEndian.PutUint16(b[len(b)-2:], uint16(len(r.data)))
+	return append(b, r.data...)
+}
+
+// buildDNSResponse answers q, dropping the records and setting TC if the
+// message is longer than maxLen
+func buildDNSResponse(id uint16, flags uint16, q *dnsQuestion, rcode int,
+	answers []dnsRecord, extra []dnsRecord, maxLen int) []byte {
+	b := make([]byte, dnsHeaderLen, dnsUDPSize)
+	binary.BigEndian.PutUint16(b[0:], id)
+	// QR, the opcode and RD of the query, AA
+	binary.BigEndian.PutUint16(b[2:], 0x8000|flags&0x7900|0x0400|uint16(rcode))
+	if q == nil {
+		return b
+	}
+	binary.BigEndian.PutUint16(b[4:], 1)
+	b = appendDNSName(b, q.name)
+	b = append(b, 0, 0, 0, 0)
+	binary.BigEndian.PutUint16(b[len(b)-4:], q.qtype)
+	binary.BigEndian.PutUint16(b[len(b)-2:], q.qclass)
+
+	withQuestion := len(b)
+	for _, r := range answers {
+		b = appendDNSRecord(b, r)
+	}
+	for _, r := range extra {
+		b = appendDNSRecord(b, r)
+	}
+	if len(b) > maxLen {
+		b = b[:withQuestion]
+		b[2] |= 0x02
+		return b
+	}
+	binary.BigEndian.PutUint16(b[6:], uint16(len(answers)))
+	binary.BigEndian.PutUint16(b[10:], uint16(len(extra)))
+	return b
+}
+
+// dnsNodeName returns the name that resolves to ip under domain
+func dnsNodeName(ip net.IP, domain string) string {
+	s := ip.String()
+	if ip.To4() != nil {
+		s = strings.Replace(s, ".", "-", -1)
+	} else {
+		s = strings.Replace(s, ":", "-", -1)
+	}
+	return s + ".node." + domain
+}
+
+// parseDNSNodeName is the reverse of dnsNodeName
+func parseDNSNodeName(label string) net.IP {
+	if ip := net.ParseIP(strings.Replace(label, "-", ".", -1)); ip != nil && ip.To4() != nil {
+		return ip
+	}
+	return net.ParseIP(strings.Replace(label, "-", ":", -1))
+}
+
+func dnsIPRecord(name string, ip net.IP, qtype uint16) (dnsRecord, bool) {
+	if ip4 := ip.To4(); ip4 != nil {
+		return dnsRecord{name, dnsTypeA, ip4}, qtype == dnsTypeA || qtype == dnsTypeANY
+	}
+	return dnsRecord{name, dnsTypeAAAA, ip.To16()}, qtype == dnsTypeAAAA || qtype == dnsTypeANY
+}
+
+// dnsTopicProducers returns the producers of the topics named label ignoring
+// case, false if there are none
+func (l *NSQLookupd) dnsTopicProducers(label string) (Producers, bool) {
+	found := false
+	seen := make(map[string]struct{})
+	var producers Producers
+	for _, k := range l.DB.FindRegistrations("topic", "*", "") {
+		if !strings.EqualFold(k.Key, label) {
+			continue
+		}
+		found = true
+		for _, p := range l.DB.FindProducers("topic", k.Key, "") {
+			if _, ok := seen[p.peerInfo.id]; ok {
+				continue
+			}
+			seen[p.peerInfo.id] = struct{}{}
+			producers = append(producers, p)
+		}
+	}
+	return producers, found
+}
+
+// answerDNS looks q up in the DB like /lookup does, the producers that are
+// inactive or tombstoned are left out and the unhealthy ones are answered last
+func (l *NSQLookupd) answerDNS(q dnsQuestion) (int, []dnsRecord, []dnsRecord) {
+	if q.qclass != dnsClassIN {
+		return dnsRcodeNotImp, nil, nil
+	}
+	switch q.qtype {
+	case dnsTypeA, dnsTypeAAAA, dnsTypeSRV, dnsTypeANY:
+	default:
+		return dnsRcodeNotImp, nil, nil
+	}
+
+	domain := l.opts.DNSDomain
+	if !strings.HasSuffix(strings.ToLower(q.name), "."+strings.ToLower(domain)) {
+		return dnsRcodeRefused, nil, nil
+	}
+	rest := q.name[:len(q.name)-len(domain)-1]
+
+	var answers []dnsRecord
+	var extra []dnsRecord
+	switch {
+	case strings.HasSuffix(strings.ToLower(rest), ".node"):
+		ip := parseDNSNodeName(rest[:len(rest)-len(".node")])
+		if ip == nil {
+			return dnsRcodeNXDomain, nil, nil
+		}
+		if r, ok := dnsIPRecord(q.name, ip, q.qtype); ok {
+			answers = append(answers, r)
+		}
+	case strings.HasSuffix(strings.ToLower(rest), ".topic"):
+		producers, ok := l.dnsTopicProducers(rest[:len(rest)-len(".topic")])
+		if !ok {
+			return dnsRcodeNXDomain, nil, nil
+		}
+		producers = producers.FilterByActive(l.opts.InactiveProducerTimeout, l.opts.TombstoneLifetime)
+		for _, p := range producers.SortByLoad() {
+			ip := net.ParseIP(p.peerInfo.BroadcastAddress)
+			if q.qtype == dnsTypeSRV || q.qtype == dnsTypeANY {
+				target := p.peerInfo.BroadcastAddress + "."
+				if ip != nil {
+					target = dnsNodeName(ip, domain)
+				}
+				data := make([]byte, 6, 6+len(target)+1)
+				binary.BigEndian.PutUint16(data[4:], uint16(p.peerInfo.TCPPort))
+				answers = append(answers, dnsRecord{q.name, dnsTypeSRV, appendDNSName(data, target)})
+				if ip != nil {
+					r, _ := dnsIPRecord(target, ip, dnsTypeANY)
+					extra = append(extra, r)
+				}
+			}
+			if ip != nil {
+				if r, ok := dnsIPRecord(q.name, ip, q.qtype); ok {
+					answers = append(answers, r)
+				}
+			}
+		}
+	default:
+		return dnsRcodeNXDomain, nil, nil
+	}
+	return 0, answers, extra
+}
+
+// handleDNS returns the response to the query msg, nil if it is not worth one
+func (l *NSQLookupd) handleDNS(msg []byte, maxLen int) []byte {
+	id, flags, q, err := parseDNSQuery(msg)
+	if err != nil {
+		if len(msg) < dnsHeaderLen || flags&0x8000 != 0 {
+			return nil
+		}
+		return buildDNSResponse(id, flags, nil, dnsRcodeFormErr, nil, nil, maxLen)
+	}
+	if flags&0x7800 != 0 {
+		// only the standard query opcode
+		return buildDNSResponse(id, flags, &q, dnsRcodeNotImp, nil, nil, maxLen)
+	}
+	rcode, answers, extra := l.answerDNS(q)
+	l.logf(LOG_DEBUG, "DNS: %s type %d - rcode %d, %d answers", q.name, q.qtype, rcode, len(answers))
+	return buildDNSResponse(id, flags, &q, rcode, answers, extra, maxLen)
+}
+
+// serveDNSUDP answers the queries on conn until it is closed
+func (l *NSQLookupd) serveDNSUDP(conn net.PacketConn) error {
+	l.logf(LOG_INFO, "DNS: listening on %s", conn.LocalAddr())
+	buf := make([]byte, 65535)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if strings.Contains(err.Error(), "use of closed network connection") {
+				break
+			}
+			return err
+		}
+		resp := l.handleDNS(buf[:n], dnsUDPSize)
+		if resp == nil {
+			continue
+		}
+		_, err = conn.WriteTo(resp, addr)
+		if err != nil {
+			l.logf(LOG_ERROR, "DNS: failed to answer %s - %s", addr, err)
+		}
+	}
+	l.logf(LOG_INFO, "DNS: closing %s", conn.LocalAddr())
+	return nil
+}
+
+type dnsTCPServer struct {
+	ctx *Context
+}
+
+// Handle answers the length prefixed queries of a DNS over TCP connection
+func (p *dnsTCPServer) Handle(clientConn net.Conn) {
+	defer clientConn.Close()
+	var size [2]byte
+	for {
+		clientConn.SetReadDeadline(time.Now().Add(dnsTCPIdleTimeout))
+		_, err := io.ReadFull(clientConn, size[:])
+		if err != nil {
+			return
+		}
+		msg := make([]byte, binary.BigEndian.Uint16(size[:]))
+		_, err = io.ReadFull(clientConn, msg)
+		if err != nil {
+			return
+		}
+		resp := p.ctx.nsqlookupd.handleDNS(msg, 65535)
+		if resp == nil {
+			return
+		}
+		binary.BigEndian.PutUint16(size[:], uint16(len(resp)))
+		_, err = clientConn.Write(append(size[:], resp...))
+		if err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package nsqlookupd
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nsqio/nsq/internal/http_api"
+)
+
+// scopes of /lookup
+const (
+	ScopeLocal  = "local"  // the nsqd connected to this nsqlookupd
+	ScopeRemote = "remote" // the nsqd imported from --federated-lookupd-http-address
+	ScopeAll    = "all"
+)
+
+// remoteCluster holds the registrations imported from a federated nsqlookupd
+type remoteCluster struct {
+	name          string
+	registrations map[Registration]Producers
+}
+
+// federation keeps the registrations of the remote clusters apart from the DB,
+// so they are neither exported again nor reported by /topics and /channels
+type federation struct {
+	sync.RWMutex
+	clusters map[string]*remoteCluster //按--federated-lookupd-http-address
+}
+
+func newFederation() *federation {
+	return &federation{
+		clusters: make(map[string]*remoteCluster),
+	}
+}
+
+func (f *federation) FindRegistrations(category string, key string, subkey string) Registrations {
+	f.RLock()
+	defer f.RUnlock()
+	found := make(map[Registration]bool)
+	results := Registrations{}
+	for _, c := range f.clusters {
+		for k := range c.registrations {
+			if k.IsMatch(category, key, subkey) && !found[k] {
+				found[k] = true
+				results = append(results, k)
+			}
+		}
+	}
+	return results
+}
+
+func (f *federation) FindProducers(category string, key string, subkey string) Producers {
+	f.RLock()
+	defer f.RUnlock()
+	var results Producers
+	for _, c := range f.clusters {
+		for k, producers := range c.registrations {
+			if k.IsMatch(category, key, subkey) {
+				results = append(results, producers...)
+			}
+		}
+	}
+	return results
+}
+
+// federationVersion is the version of the /federation/registrations format,
+// an nsqlookupd only imports the version it knows
+const federationVersion = 1
+
+// federationDoc is what /federation/registrations returns
+type federationDoc struct {
+	Version       int                      `json:"version"`
+	Cluster       string                   `json:"cluster"`
+	Registrations []federationRegistration `json:"registrations"`
+}
+
+type federationRegistration struct {
+	Category  string               `json:"category"`
+	Key       string               `json:"key"`
+	SubKey    string               `json:"subkey"`
+	Producers []federationProducer `json:"producers"`
+}
+
+type federationProducer struct {
+	ID               string            `json:"id"`
+	Hostname         string            `json:"hostname"`
+	BroadcastAddress string            `json:"broadcast_address"`
+	TCPPort          int               `json:"tcp_port"`
+	HTTPPort         int               `json:"http_port"`
+	Version          string            `json:"version"`
+	Tags             map[string]string `json:"tags,omitempty"`
+}
+
+// exportRegistrations returns the topic and channel registrations of the nsqd
+// connected to this nsqlookupd, leaving out its inactive and tombstoned
+// producers. The imported ones are not exported again
+func (l *NSQLookupd) exportRegistrations() *federationDoc {
+	doc := &federationDoc{
+		Version:       federationVersion,
+		Cluster:       l.opts.ClusterName,
+		Registrations: []federationRegistration{},
+	}
+	for _, category := range []string{"topic", "channel"} {
+		for _, k := range l.DB.FindRegistrations(category, "*", "*") {
+			producers := l.DB.FindProducers(k.Category, k.Key, k.SubKey).FilterByActive(
+				l.opts.InactiveProducerTimeout, l.opts.TombstoneLifetime)
+			fr := federationRegistration{
+				Category:  k.Category,
+				Key:       k.Key,
+				SubKey:    k.SubKey,
+				Producers: make([]federationProducer, 0, len(producers)),
+			}
+			for _, p := range producers {
+				fr.Producers = append(fr.Producers, federationProducer{
+					ID:               p.peerInfo.id,
+					Hostname:         p.peerInfo.Hostname,
+					BroadcastAddress: p.peerInfo.BroadcastAddress,
+					TCPPort:          p.peerInfo.TCPPort,
+					HTTPPort:         p.peerInfo.HTTPPort,
+					Version:          p.peerInfo.Version,
+					Tags:             p.peerInfo.Tags,
+				})
+			}
+			doc.Registrations = append(doc.Registrations, fr)
+		}
+	}
+	return doc
+}
+
+// httpEndpoint returns the base URL of the nsqlookupd at addr, which is
+// http:// unless it has a scheme
+func httpEndpoint(addr string) string {
+	if strings.Contains(addr, "://") {
+		return addr
+	}
+	return "http://" + addr
+}
+
+// importCluster replaces the registrations of the nsqlookupd at addr with what
+// its /federation/registrations currently exports
+func (l *NSQLookupd) importCluster(client *http_api.Client, addr string) error {
+	var doc federationDoc
+	err := client.GETV1(httpEndpoint(addr)+"/federation/registrations", &doc)
+	if err != nil {
+		return err
+	}
+	if doc.Version != federationVersion {
+		return fmt.Errorf("unsupported /federation/registrations version %d", doc.Version)
+	}
+	name := doc.Cluster
+	if name == "" {
+		name = addr
+	}
+	if name == l.opts.ClusterName {
+		return fmt.Errorf("cluster name %s is this nsqlookupd's own", name)
+	}
+
+	now := time.Now()
+	peers := make(map[string]*PeerInfo)
+	registrations := make(map[Registration]Producers)
+	for _, fr := range doc.Registrations {
+		k := Registration{fr.Category, fr.Key, fr.SubKey}
+		registrations[k] = Producers{}
+		for _, p := range fr.Producers {
+			peerInfo, ok := peers[p.ID]
+			if !ok {
+				peerInfo = &PeerInfo{
+					lastUpdate:       now.UnixNano(),
+					id:               name + "/" + p.ID,
+					Hostname:         p.Hostname,
+					BroadcastAddress: p.BroadcastAddress,
+					TCPPort:          p.TCPPort,
+					HTTPPort:         p.HTTPPort,
+					Version:          p.Version,
+					Tags:             p.Tags,
+					Cluster:          name,
+				}
+				peers[p.ID] = peerInfo
+			}
+			registrations[k] = append(registrations[k], &Producer{peerInfo: peerInfo})
+		}
+	}
+
+	l.federation.Lock()
+	l.federation.clusters[addr] = &remoteCluster{
+		name:          name,
+		registrations: registrations,
+	}
+	l.federation.Unlock()
+	return nil
+}
+
+// federationLoop imports the registrations of the remote clusters every
+// --federation-interval, the ones that stop answering age out like inactive
+// producers do
+func (l *NSQLookupd) federationLoop() {
+	client := http_api.NewClient(l.peerTLSConfig, time.Second, l.opts.FederationInterval)
+	ticker := time.NewTicker(l.opts.FederationInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, addr := range l.opts.FederatedLookupdHTTPAddresses {
+			err := l.importCluster(client, addr)
+			if err != nil {
+				l.logf(LOG_ERROR, "FEDERATION: failed to import %s - %s", addr, err)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-l.exitChan:
+			return
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package nsqlookupd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PeerHealth is what nsqd reports about itself on IDENTIFY and PING
+type PeerHealth struct {
+	Healthy     bool  `json:"healthy"`
+	Depth       int64 `json:"depth"`        //所有topic和channel的消息数
+	ClientCount int64 `json:"client_count"` //连接的客户端数
+	Draining    bool  `json:"draining"`
+}
+
+// getHealth returns nil if the nsqd did not report its health, such as the
+// older versions or the ones imported from other clusters
+func (p *PeerInfo) getHealth() *PeerHealth {
+	h, _ := p.health.Load().(*PeerHealth)
+	return h
+}
+
+func (p *PeerInfo) setHealth(h *PeerHealth) {
+	p.health.Store(h)
+}
+
+// parseHealth parses the key=value params of PING, unknown keys are ignored
+func parseHealth(params []string) (*PeerHealth, error) {
+	h := &PeerHealth{Healthy: true}
+	for _, param := range params {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid param %q", param)
+		}
+		var err error
+		switch kv[0] {
+		case "healthy":
+			h.Healthy, err = strconv.ParseBool(kv[1])
+		case "depth":
+			h.Depth, err = strconv.ParseInt(kv[1], 10, 64)
+		case "clients":
+			h.ClientCount, err = strconv.ParseInt(kv[1], 10, 64)
+		case "draining":
+			h.Draining, err = strconv.ParseBool(kv[1])
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid param %q - %s", param, err)
+		}
+	}
+	return h, nil
+}
+
+// SortByLoad orders the producers whose nsqd reported itself unhealthy last,
+// before them the draining ones, and the rest by depth, least first. The
+// unhealthy ones are kept since their messages still need consumers, the ones
+// that did not report their health count as healthy and idle
+func (pp Producers) SortByLoad() Producers {
+	load := func(p *Producer) (bool, bool, int64) {
+		h := p.peerInfo.getHealth()
+		if h == nil {
+			return false, false, 0
+		}
+		return !h.Healthy, h.Draining, h.Depth
+	}
+	sort.SliceStable(pp, func(i, j int) bool {
+		unhealthyI, drainingI, depthI := load(pp[i])
+		unhealthyJ, drainingJ, depthJ := load(pp[j])
+		if unhealthyI != unhealthyJ {
+			return unhealthyJ
+		}
+		if drainingI != drainingJ {
+			return drainingJ
+		}
+		return depthI < depthJ
+	})
+	return pp
+}
+
+// lookupPeer is a producer in the /lookup response, with the health its nsqd
+// reported so that clients can tell the unhealthy ones apart
+type lookupPeer struct {
+	*PeerInfo
+	Health *PeerHealth `json:"health,omitempty"`
+}
+
+func (pp Producers) LookupInfo() []*lookupPeer {
+	results := []*lookupPeer{}
+	for _, p := range pp {
+		results = append(results, &lookupPeer{p.peerInfo, p.peerInfo.getHealth()})
+	}
+	return results
+}
@@ -1,10 +1,13 @@
 package nsqlookupd
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/pprof"
+	"strconv"
 	"sync/atomic"
+	"time"
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/nsqio/nsq/internal/http_api"
@@ -19,6 +22,8 @@ type httpServer struct {
 
 func newHTTPServer(ctx *Context) *httpServer {
 	log := http_api.Log(ctx.nsqlookupd.logf)
+	rateLimit := http_api.RateLimit(ctx.nsqlookupd.opts.AdminRateLimit, ctx.nsqlookupd.opts.AdminRateLimitWait)
+	audited := ctx.nsqlookupd.audit.Audited
 
 	router := httprouter.New()
 	router.HandleMethodNotAllowed = true
@@ -39,13 +44,24 @@ func newHTTPServer(ctx *Context) *httpServer {
 	router.Handle("GET", "/topics", http_api.Decorate(s.doTopics, log, http_api.V1))
 	router.Handle("GET", "/channels", http_api.Decorate(s.doChannels, log, http_api.V1))
 	router.Handle("GET", "/nodes", http_api.Decorate(s.doNodes, log, http_api.V1))
-
-	// only v1
-	router.Handle("POST", "/topic/create", http_api.Decorate(s.doCreateTopic, log, http_api.V1))
-	router.Handle("POST", "/topic/delete", http_api.Decorate(s.doDeleteTopic, log, http_api.V1))
-	router.Handle("POST", "/channel/create", http_api.Decorate(s.doCreateChannel, log, http_api.V1))
-	router.Handle("POST", "/channel/delete", http_api.Decorate(s.doDeleteChannel, log, http_api.V1))
-	router.Handle("POST", "/topic/tombstone", http_api.Decorate(s.doTombstoneTopicProducer, log, http_api.V1))
+	router.Handle("GET", "/watch", http_api.Decorate(s.doWatch, log, http_api.V1))
+	router.Handle("GET", "/federation/registrations", http_api.Decorate(s.doFederationRegistrations, log, http_api.V1))
+	router.Handle("GET", "/topic/metadata", http_api.Decorate(s.doTopicMetadata, log, http_api.V1))
+	router.Handle("GET", "/channel/metadata", http_api.Decorate(s.doChannelMetadata, log, http_api.V1))
+	router.Handle("GET", "/metadata", http_api.Decorate(s.doMetadata, log, http_api.V1))
+	router.Handle("GET", "/audit", http_api.Decorate(ctx.nsqlookupd.audit.DoQuery, s.authorized, log, http_api.V1))
+
+	// only v1, these change the registrations and need --auth-secret, they are
+	// recorded in the audit log including the ones refused
+	router.Handle("POST", "/topic/create", http_api.Decorate(s.doCreateTopic, rateLimit, s.authorized, audited, log, http_api.V1))
+	router.Handle("POST", "/topic/delete", http_api.Decorate(s.doDeleteTopic, rateLimit, s.authorized, audited, log, http_api.V1))
+	router.Handle("POST", "/channel/create", http_api.Decorate(s.doCreateChannel, rateLimit, s.authorized, audited, log, http_api.V1))
+	router.Handle("POST", "/channel/delete", http_api.Decorate(s.doDeleteChannel, rateLimit, s.authorized, audited, log, http_api.V1))
+	router.Handle("POST", "/topic/tombstone", http_api.Decorate(s.doTombstoneTopicProducer, rateLimit, s.authorized, audited, log, http_api.V1))
+	router.Handle("POST", "/topic/metadata", http_api.Decorate(s.doSetTopicMetadata, rateLimit, s.authorized, audited, log, http_api.V1))
+	router.Handle("POST", "/topic/metadata/delete", http_api.Decorate(s.doDeleteTopicMetadata, rateLimit, s.authorized, audited, log, http_api.V1))
+	router.Handle("POST", "/channel/metadata", http_api.Decorate(s.doSetChannelMetadata, rateLimit, s.authorized, audited, log, http_api.V1))
+	router.Handle("POST", "/channel/metadata/delete", http_api.Decorate(s.doDeleteChannelMetadata, rateLimit, s.authorized, audited, log, http_api.V1))
 
 	// debug
 	router.HandlerFunc("GET", "/debug/pprof", pprof.Index)
@@ -72,15 +88,18 @@ func (s *httpServer) pingHandler(w http.ResponseWriter, req *http.Request, ps ht
 func (s *httpServer) doInfo(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
 	return struct {
 		Version string `json:"version"`
+		Cluster string `json:"cluster,omitempty"`
 	}{
 		Version: version.Binary,
+		Cluster: s.ctx.nsqlookupd.opts.ClusterName,
 	}, nil
 }
 
 func (s *httpServer) doTopics(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
 	topics := s.ctx.nsqlookupd.DB.FindRegistrations("topic", "*", "").Keys()
 	return map[string]interface{}{
-		"topics": topics,
+		"topics":   topics,
+		"metadata": pickMetadata(s.ctx.nsqlookupd.DB.FindMetadata("topic", "*", ""), topics),
 	}, nil
 }
 
@@ -98,9 +117,21 @@ func (s *httpServer) doChannels(w http.ResponseWriter, req *http.Request, ps htt
 	channels := s.ctx.nsqlookupd.DB.FindRegistrations("channel", topicName, "*").SubKeys()
 	return map[string]interface{}{
 		"channels": channels,
+		"metadata": pickMetadata(s.ctx.nsqlookupd.DB.FindMetadata("channel", topicName, "*"), channels),
 	}, nil
 }
 
+// pickMetadata returns the metadata of names only
+func pickMetadata(metadata map[string]*Metadata, names []string) map[string]*Metadata {
+	results := make(map[string]*Metadata)
+	for _, name := range names {
+		if m, ok := metadata[name]; ok {
+			results[name] = m
+		}
+	}
+	return results
+}
+
 func (s *httpServer) doLookup(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
 	reqParams, err := http_api.NewReqParams(req)
 	if err != nil {
@@ -112,21 +143,122 @@ func (s *httpServer) doLookup(w http.ResponseWriter, req *http.Request, ps httpr
 		return nil, http_api.Err{400, "MISSING_ARG_TOPIC"}
 	}
 
-	registration := s.ctx.nsqlookupd.DB.FindRegistrations("topic", topicName, "")
-	if len(registration) == 0 {
+	scope, _ := reqParams.Get("scope")
+	if scope == "" {
+		scope = ScopeLocal
+	}
+	if scope != ScopeLocal && scope != ScopeRemote && scope != ScopeAll {
+		return nil, http_api.Err{400, "INVALID_ARG_SCOPE"}
+	}
+
+	tags, prefer, err := getTagParams(reqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	channels := []string{}
+	var producers Producers
+	if scope != ScopeRemote {
+		if len(s.ctx.nsqlookupd.DB.FindRegistrations("topic", topicName, "")) > 0 {
+			found = true
+			channels = s.ctx.nsqlookupd.DB.FindRegistrations("channel", topicName, "*").SubKeys()
+			producers = s.ctx.nsqlookupd.DB.FindProducers("topic", topicName, "")
+		}
+	}
+	if scope != ScopeLocal {
+		fed := s.ctx.nsqlookupd.federation
+		if len(fed.FindRegistrations("topic", topicName, "")) > 0 {
+			found = true
+			seen := make(map[string]bool)
+			for _, channel := range channels {
+				seen[channel] = true
+			}
+			for _, channel := range fed.FindRegistrations("channel", topicName, "*").SubKeys() {
+				if !seen[channel] {
+					channels = append(channels, channel)
+				}
+			}
+			producers = append(producers, fed.FindProducers("topic", topicName, "")...)
+		}
+	}
+	if !found {
 		return nil, http_api.Err{404, "TOPIC_NOT_FOUND"}
 	}
 
-	channels := s.ctx.nsqlookupd.DB.FindRegistrations("channel", topicName, "*").SubKeys()
-	producers := s.ctx.nsqlookupd.DB.FindProducers("topic", topicName, "")
 	producers = producers.FilterByActive(s.ctx.nsqlookupd.opts.InactiveProducerTimeout,
 		s.ctx.nsqlookupd.opts.TombstoneLifetime)
+	// the unhealthy nsqd come last, the preferred tags still come before load
+	producers = producers.SortByLoad()
+	producers = producers.FilterByTags(tags).SortByTags(prefer)
 	return map[string]interface{}{
 		"channels":  channels,
-		"producers": producers.PeerInfo(),
+		"producers": producers.LookupInfo(),
 	}, nil
 }
 
+// doWatch long-polls for the changes of the registrations of a topic, or of all
+// of them without one, after the event id since. Without since it returns the
+// id of the latest event right away
+func (s *httpServer) doWatch(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	topicName, _ := reqParams.Get("topic")
+	if topicName != "" && !protocol.IsValidTopicName(topicName) {
+		return nil, http_api.Err{400, "INVALID_ARG_TOPIC"}
+	}
+
+	var since uint64
+	sinceStr, err := reqParams.Get("since")
+	hasSince := err == nil
+	if hasSince {
+		since, err = strconv.ParseUint(sinceStr, 10, 64)
+		if err != nil {
+			return nil, http_api.Err{400, "INVALID_ARG_SINCE"}
+		}
+	}
+
+	timeout := defaultWatchTimeout
+	if timeoutStr, err := reqParams.Get("timeout"); err == nil {
+		timeout, err = time.ParseDuration(timeoutStr)
+		if err != nil || timeout < 0 || timeout > maxWatchTimeout {
+			return nil, http_api.Err{400, "INVALID_ARG_TIMEOUT"}
+		}
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		events, lastID, ok, update := s.ctx.nsqlookupd.DB.events.since(since, topicName)
+		if !hasSince || !ok || len(events) > 0 {
+			if events == nil {
+				events = []Event{}
+			}
+			return map[string]interface{}{
+				"events":  events,
+				"last_id": lastID,
+				// the watcher missed events and should /lookup again
+				"reset": hasSince && !ok,
+			}, nil
+		}
+
+		select {
+		case <-update:
+		case <-timer.C:
+			return map[string]interface{}{
+				"events":  events,
+				"last_id": lastID,
+				"reset":   false,
+			}, nil
+		case <-req.Context().Done():
+			return nil, http_api.Err{499, "CLIENT_CLOSED_REQUEST"}
+		}
+	}
+}
+
 func (s *httpServer) doCreateTopic(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
 	reqParams, err := http_api.NewReqParams(req)
 	if err != nil {
@@ -192,11 +324,12 @@ func (s *httpServer) doTombstoneTopicProducer(w http.ResponseWriter, req *http.R
 	}
 
 	s.ctx.nsqlookupd.logf(LOG_INFO, "DB: setting tombstone for producer@%s of topic(%s)", node, topicName)
+	key := Registration{"topic", topicName, ""}
 	producers := s.ctx.nsqlookupd.DB.FindProducers("topic", topicName, "")
 	for _, p := range producers {
 		thisNode := fmt.Sprintf("%s:%d", p.peerInfo.BroadcastAddress, p.peerInfo.HTTPPort)
 		if thisNode == node {
-			p.Tombstone()
+			s.ctx.nsqlookupd.DB.TombstoneProducer(key, p)
 		}
 	}
 
@@ -249,21 +382,166 @@ func (s *httpServer) doDeleteChannel(w http.ResponseWriter, req *http.Request, p
 	return nil, nil
 }
 
+func (s *httpServer) doTopicMetadata(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	return s.getMetadata(req, "topic")
+}
+
+func (s *httpServer) doChannelMetadata(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	return s.getMetadata(req, "channel")
+}
+
+func (s *httpServer) doSetTopicMetadata(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	return s.setMetadata(w, req, "topic", false)
+}
+
+func (s *httpServer) doSetChannelMetadata(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	return s.setMetadata(w, req, "channel", false)
+}
+
+func (s *httpServer) doDeleteTopicMetadata(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	return s.setMetadata(w, req, "topic", true)
+}
+
+func (s *httpServer) doDeleteChannelMetadata(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	return s.setMetadata(w, req, "channel", true)
+}
+
+// doMetadata returns all the metadata including the deleted ones, which is
+// what the replicas pull
+func (s *httpServer) doMetadata(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	return map[string]interface{}{
+		"metadata": s.ctx.nsqlookupd.DB.metadataEntries(),
+	}, nil
+}
+
+// getMetadataKey returns the registration of the topic or channel the metadata
+// request is about
+func getMetadataKey(reqParams *http_api.ReqParams, category string) (Registration, error) {
+	if category == "channel" {
+		topicName, channelName, err := http_api.GetTopicChannelArgs(reqParams)
+		if err != nil {
+			return Registration{}, http_api.Err{400, err.Error()}
+		}
+		return Registration{"channel", topicName, channelName}, nil
+	}
+
+	topicName, err := reqParams.Get("topic")
+	if err != nil {
+		return Registration{}, http_api.Err{400, "MISSING_ARG_TOPIC"}
+	}
+	if !protocol.IsValidTopicName(topicName) {
+		return Registration{}, http_api.Err{400, "INVALID_ARG_TOPIC"}
+	}
+	return Registration{"topic", topicName, ""}, nil
+}
+
+func (s *httpServer) getMetadata(req *http.Request, category string) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	key, err := getMetadataKey(reqParams, category)
+	if err != nil {
+		return nil, err
+	}
+
+	m := s.ctx.nsqlookupd.DB.GetMetadata(key)
+	if m == nil {
+		return nil, http_api.Err{404, "METADATA_NOT_FOUND"}
+	}
+	return m, nil
+}
+
+// setMetadata replaces the metadata with the JSON body, or deletes it, as the
+// newest write so that the replicas take it
+func (s *httpServer) setMetadata(w http.ResponseWriter, req *http.Request, category string, deleted bool) (interface{}, error) {
+	req.Body = http.MaxBytesReader(w, req.Body, maxMetadataSize)
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		if _, ok := err.(*http.MaxBytesError); ok {
+			return nil, http_api.Err{413, "METADATA_TOO_BIG"}
+		}
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	key, err := getMetadataKey(reqParams, category)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Metadata{}
+	if deleted {
+		if s.ctx.nsqlookupd.DB.GetMetadata(key) == nil {
+			return nil, http_api.Err{404, "METADATA_NOT_FOUND"}
+		}
+		m.Deleted = true
+	} else {
+		err = json.Unmarshal(reqParams.Body, m)
+		if err != nil {
+			return nil, http_api.Err{400, "INVALID_BODY"}
+		}
+		m.Deleted = false
+	}
+	m.UpdatedAt = time.Now().UnixNano()
+	m.UpdatedBy = s.ctx.nsqlookupd.metadataWriter()
+
+	if !s.ctx.nsqlookupd.DB.SetMetadata(key, m) {
+		// a replica wrote it later, by its clock
+		return nil, http_api.Err{409, "METADATA_CONFLICT"}
+	}
+	if deleted {
+		s.ctx.nsqlookupd.logf(LOG_INFO, "DB: deleting metadata of %s:%s:%s", key.Category, key.Key, key.SubKey)
+		return nil, nil
+	}
+	s.ctx.nsqlookupd.logf(LOG_INFO, "DB: setting metadata of %s:%s:%s", key.Category, key.Key, key.SubKey)
+	return m, nil
+}
+
 type node struct {
-	RemoteAddress    string   `json:"remote_address"`
-	Hostname         string   `json:"hostname"`
-	BroadcastAddress string   `json:"broadcast_address"`
-	TCPPort          int      `json:"tcp_port"`
-	HTTPPort         int      `json:"http_port"`
-	Version          string   `json:"version"`
-	Tombstones       []bool   `json:"tombstones"`
-	Topics           []string `json:"topics"`
+	RemoteAddress    string            `json:"remote_address"`
+	Hostname         string            `json:"hostname"`
+	BroadcastAddress string            `json:"broadcast_address"`
+	TCPPort          int               `json:"tcp_port"`
+	HTTPPort         int               `json:"http_port"`
+	Version          string            `json:"version"`
+	Tags             map[string]string `json:"tags,omitempty"`
+	Health           *PeerHealth       `json:"health,omitempty"`
+	Tombstones       []bool            `json:"tombstones"`
+	Topics           []string          `json:"topics"`
+}
+
+// getTagParams returns the tags the producers must have (tag=key:value) and
+// the ones they are ordered by (prefer=key:value)
+func getTagParams(reqParams *http_api.ReqParams) (map[string]string, map[string]string, error) {
+	pairs, _ := reqParams.GetAll("tag")
+	tags, err := protocol.ParseTags(pairs)
+	if err != nil {
+		return nil, nil, http_api.Err{400, "INVALID_ARG_TAG"}
+	}
+	pairs, _ = reqParams.GetAll("prefer")
+	prefer, err := protocol.ParseTags(pairs)
+	if err != nil {
+		return nil, nil, http_api.Err{400, "INVALID_ARG_PREFER"}
+	}
+	return tags, prefer, nil
 }
 
 func (s *httpServer) doNodes(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	reqParams, err := http_api.NewReqParams(req)
+	if err != nil {
+		return nil, http_api.Err{400, "INVALID_REQUEST"}
+	}
+
+	tags, prefer, err := getTagParams(reqParams)
+	if err != nil {
+		return nil, err
+	}
+
 	// dont filter out tombstoned nodes
 	producers := s.ctx.nsqlookupd.DB.FindProducers("client", "", "").FilterByActive(
 		s.ctx.nsqlookupd.opts.InactiveProducerTimeout, 0)
+	producers = producers.FilterByTags(tags).SortByTags(prefer)
 	nodes := make([]*node, len(producers))
 	topicProducersMap := make(map[string]Producers)
 	for i, p := range producers {
@@ -293,6 +571,8 @@ func (s *httpServer) doNodes(w http.ResponseWriter, req *http.Request, ps httpro
 			TCPPort:          p.peerInfo.TCPPort,
 			HTTPPort:         p.peerInfo.HTTPPort,
 			Version:          p.peerInfo.Version,
+			Tags:             p.peerInfo.Tags,
+			Health:           p.peerInfo.getHealth(),
 			Tombstones:       tombstones,
 			Topics:           topics,
 		}
@@ -303,6 +583,12 @@ func (s *httpServer) doNodes(w http.ResponseWriter, req *http.Request, ps httpro
 	}, nil
 }
 
+// doFederationRegistrations exports the registrations to the nsqlookupd that
+// federate with this one
+func (s *httpServer) doFederationRegistrations(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
+	return s.ctx.nsqlookupd.exportRegistrations(), nil
+}
+
 func (s *httpServer) doDebug(w http.ResponseWriter, req *http.Request, ps httprouter.Params) (interface{}, error) {
 	s.ctx.nsqlookupd.DB.RLock()
 	defer s.ctx.nsqlookupd.DB.RUnlock()
@@ -318,6 +604,7 @@ func (s *httpServer) doDebug(w http.ResponseWriter, req *http.Request, ps httpro
 				"tcp_port":          p.peerInfo.TCPPort,
 				"http_port":         p.peerInfo.HTTPPort,
 				"version":           p.peerInfo.Version,
+				"tags":              p.peerInfo.Tags,
 				"last_update":       atomic.LoadInt64(&p.peerInfo.lastUpdate),
 				"tombstoned":        p.tombstoned,
 				"tombstoned_at":     p.tombstonedAt.UnixNano(),
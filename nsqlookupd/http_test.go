@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/nsqio/go-nsq"
 	"github.com/nsqio/nsq/internal/test"
 	"github.com/nsqio/nsq/internal/version"
 	"github.com/nsqio/nsq/nsqd"
@@ -482,3 +483,82 @@ func TestDeleteChannel(t *testing.T) {
 	t.Logf("%s", body)
 	test.Equal(t, []byte(""), body)
 }
+
+type WatchDoc struct {
+	Events []Event `json:"events"`
+	LastID uint64  `json:"last_id"`
+	Reset  bool    `json:"reset"`
+}
+
+func TestWatch(t *testing.T) {
+	dataPath, nsqds, nsqlookupd1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupd1.Exit()
+
+	watch := func(query string) WatchDoc {
+		url := fmt.Sprintf("http://%s/watch?%s", nsqlookupd1.RealHTTPAddr(), query)
+		resp, err := http.Get(url)
+		test.Nil(t, err)
+		defer resp.Body.Close()
+		test.Equal(t, 200, resp.StatusCode)
+		var doc WatchDoc
+		test.Nil(t, json.NewDecoder(resp.Body).Decode(&doc))
+		return doc
+	}
+
+	topicName := "watch" + strconv.Itoa(int(time.Now().Unix()))
+	cursor := watch("").LastID
+
+	// the watch returns as soon as the nsqd registers the topic
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		nsqds[0].GetTopic(topicName)
+	}()
+	doc := watch(fmt.Sprintf("topic=%s&since=%d", topicName, cursor))
+	test.Equal(t, false, doc.Reset)
+	test.Equal(t, 1, len(doc.Events))
+	test.Equal(t, EventRegister, doc.Events[0].Type)
+	test.Equal(t, topicName, doc.Events[0].Topic)
+	test.Equal(t, nsqds[0].RealTCPAddr().Port, doc.Events[0].Producer.TCPPort)
+
+	doc = watch(fmt.Sprintf("topic=%s&since=%d&timeout=10ms", topicName, doc.LastID))
+	test.Equal(t, 0, len(doc.Events))
+
+	// a cursor from an nsqlookupd that restarted
+	doc = watch(fmt.Sprintf("since=%d", doc.LastID+100))
+	test.Equal(t, true, doc.Reset)
+}
+
+func TestWatchConsumer(t *testing.T) {
+	dataPath, nsqds, nsqlookupd1 := bootstrapNSQCluster(t)
+	defer os.RemoveAll(dataPath)
+	defer nsqds[0].Exit()
+	defer nsqlookupd1.Exit()
+
+	topicName := "watch_consumer" + strconv.Itoa(int(time.Now().Unix()))
+	cfg := nsq.NewConfig()
+	cfg.LookupdWatch = true
+	cfg.LookupdPollInterval = time.Minute
+	consumer, err := nsq.NewConsumer(topicName, "ch", cfg)
+	test.Nil(t, err)
+	consumer.SetLogger(nil, nsq.LogLevelInfo)
+	msgs := make(chan *nsq.Message, 1)
+	consumer.AddHandler(nsq.HandlerFunc(func(m *nsq.Message) error {
+		msgs <- m
+		return nil
+	}))
+	test.Nil(t, consumer.ConnectToNSQLookupd(nsqlookupd1.RealHTTPAddr().String()))
+	defer consumer.Stop()
+	time.Sleep(50 * time.Millisecond)
+
+	// the topic did not exist when the consumer looked it up
+	topic := nsqds[0].GetTopic(topicName)
+	topic.PutMessage(nsqd.NewMessage(topic.GenerateID(), []byte("test")))
+	select {
+	case m := <-msgs:
+		test.Equal(t, []byte("test"), m.Body)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the consumer did not discover the nsqd")
+	}
+}
@@ -2,6 +2,7 @@ package nsqlookupd
 
 import (
 	"bufio"
+	"crypto/tls"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
@@ -134,7 +135,14 @@ func (p *LookupProtocolV1) REGISTER(client *ClientV1, reader *bufio.Reader, para
 		}
 	}
 	key := Registration{"topic", topic, ""}
-	if p.ctx.nsqlookupd.DB.AddProducer(key, &Producer{peerInfo: client.peerInfo}) {
+	producer := &Producer{peerInfo: client.peerInfo}
+	if tombstonedAt, ok := client.tombstones[topic]; ok {
+		// tombstoned before nsqlookupd restarted
+		producer.tombstoned = true
+		producer.tombstonedAt = tombstonedAt
+		delete(client.tombstones, topic)
+	}
+	if p.ctx.nsqlookupd.DB.AddProducer(key, producer) {
 		p.ctx.nsqlookupd.logf(LOG_INFO, "DB: client(%s) REGISTER category:%s key:%s subkey:%s",
 			client, "topic", topic, "")
 	}
@@ -219,17 +227,37 @@ func (p *LookupProtocolV1) IDENTIFY(client *ClientV1, reader *bufio.Reader, para
 
 	peerInfo.RemoteAddress = client.RemoteAddr().String()
 
+	var extra struct {
+		AuthSecret string      `json:"auth_secret"`
+		Health     *PeerHealth `json:"health"`
+	}
+	json.Unmarshal(body, &extra)
+	var tlsState *tls.ConnectionState
+	if tlsConn, ok := client.Conn.(*tls.Conn); ok {
+		state := tlsConn.ConnectionState()
+		tlsState = &state
+	}
+	if !p.ctx.nsqlookupd.isAuthorized(tlsState, extra.AuthSecret) {
+		return nil, protocol.NewFatalClientErr(nil, "E_UNAUTHORIZED", "IDENTIFY unauthorized")
+	}
+
 	// require all fields
 	if peerInfo.BroadcastAddress == "" || peerInfo.TCPPort == 0 || peerInfo.HTTPPort == 0 || peerInfo.Version == "" {
 		return nil, protocol.NewFatalClientErr(nil, "E_BAD_BODY", "IDENTIFY missing fields")
 	}
 
 	atomic.StoreInt64(&peerInfo.lastUpdate, time.Now().UnixNano())
+	if extra.Health != nil {
+		peerInfo.setHealth(extra.Health)
+	}
 
 	p.ctx.nsqlookupd.logf(LOG_INFO, "CLIENT(%s): IDENTIFY Address:%s TCP:%d HTTP:%d Version:%s",
 		client, peerInfo.BroadcastAddress, peerInfo.TCPPort, peerInfo.HTTPPort, peerInfo.Version)
 
 	client.peerInfo = &peerInfo
+	// the producers restored from --data-path are replaced by the live ones
+	// when the nsqd REGISTERs again
+	client.tombstones = p.ctx.nsqlookupd.DB.reclaim(client.peerInfo)
 	if p.ctx.nsqlookupd.DB.AddProducer(Registration{"client", "", ""}, &Producer{peerInfo: client.peerInfo}) {
 		p.ctx.nsqlookupd.logf(LOG_INFO, "DB: client(%s) REGISTER category:%s key:%s subkey:%s", client, "client", "", "")
 	}
@@ -262,6 +290,15 @@ func (p *LookupProtocolV1) PING(client *ClientV1, params []string) ([]byte, erro
 		p.ctx.nsqlookupd.logf(LOG_INFO, "CLIENT(%s): pinged (last ping %s)", client.peerInfo.id,
 			now.Sub(cur))
 		atomic.StoreInt64(&client.peerInfo.lastUpdate, now.UnixNano())
+
+		// nsqd reports its health as key=value params, older ones send none
+		if len(params) > 1 {
+			health, err := parseHealth(params[1:])
+			if err != nil {
+				return nil, protocol.NewClientErr(err, "E_INVALID", fmt.Sprintf("PING %s", err))
+			}
+			client.peerInfo.setHealth(health)
+		}
 	}
 	return []byte("OK"), nil
 }
@@ -0,0 +1,236 @@
+package nsqlookupd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/nsqio/nsq/internal/version"
+)
+
+// how often the registrations are written to --data-path when they changed
+const persistInterval = time.Second
+
+// meta is what nsqlookupd.dat holds, the producers of the registrations refer
+// to the peers by id
+type meta struct {
+	Version       string             `json:"version"`
+	Peers         []peerMeta         `json:"peers"`
+	Registrations []registrationMeta `json:"registrations"`
+	Metadata      []metadataEntry    `json:"metadata,omitempty"` //topic和channel的元数据
+}
+
+type peerMeta struct {
+	ID         string `json:"id"`
+	LastUpdate int64  `json:"last_update"`
+	*PeerInfo
+}
+
+type registrationMeta struct {
+	Category  string         `json:"category"`
+	Key       string         `json:"key"`
+	SubKey    string         `json:"subkey"`
+	Producers []producerMeta `json:"producers,omitempty"`
+}
+
+type producerMeta struct {
+	ID           string `json:"id"`
+	TombstonedAt int64  `json:"tombstoned_at,omitempty"` //UnixNano，0表示没有被tombstone
+}
+
+func newMetadataFile(opts *Options) string {
+	return path.Join(opts.DataPath, "nsqlookupd.dat")
+}
+
+func isEphemeral(k Registration) bool {
+	return strings.HasSuffix(k.Key, "#ephemeral") || strings.HasSuffix(k.SubKey, "#ephemeral")
+}
+
+// snapshot returns the registrations that outlive a restart, ephemeral topics
+// and channels go away with their producers anyway
+func (r *RegistrationDB) snapshot() (*meta, uint64) {
+	r.RLock()
+	defer r.RUnlock()
+
+	m := &meta{Version: version.Binary}
+	peers := make(map[string]bool)
+	for k, producers := range r.registrationMap {
+		if isEphemeral(k) {
+			continue
+		}
+		rm := registrationMeta{Category: k.Category, Key: k.Key, SubKey: k.SubKey}
+		for id, p := range producers {
+			pm := producerMeta{ID: id}
+			if p.tombstoned {
+				pm.TombstonedAt = p.tombstonedAt.UnixNano()
+			}
+			rm.Producers = append(rm.Producers, pm)
+			if !peers[id] {
+				peers[id] = true
+				m.Peers = append(m.Peers, peerMeta{
+					ID:         id,
+					LastUpdate: atomic.LoadInt64(&p.peerInfo.lastUpdate),
+					PeerInfo:   p.peerInfo,
+				})
+			}
+		}
+		m.Registrations = append(m.Registrations, rm)
+	}
+	for k, md := range r.metadataMap {
+		m.Metadata = append(m.Metadata, metadataEntry{k.Category, k.Key, k.SubKey, md})
+	}
+	return m, r.generation
+}
+
+// restore adds the registrations of m, their producers are only kept until the
+// nsqd they belong to connects again or for grace. The grace period starts now
+// rather than at the last update, which may be long gone after an outage
+func (r *RegistrationDB) restore(m *meta, grace time.Duration) {
+	r.Lock()
+	defer r.Unlock()
+	deadline := time.Now().Add(grace)
+
+	peers := make(map[string]*PeerInfo)
+	for _, pm := range m.Peers {
+		if pm.PeerInfo == nil {
+			continue
+		}
+		peerInfo := pm.PeerInfo
+		peerInfo.id = pm.ID
+		peerInfo.lastUpdate = pm.LastUpdate
+		peers[pm.ID] = peerInfo
+	}
+
+	for _, rm := range m.Registrations {
+		k := Registration{rm.Category, rm.Key, rm.SubKey}
+		producers, ok := r.registrationMap[k]
+		if !ok {
+			producers = make(map[string]*Producer)
+			r.registrationMap[k] = producers
+		}
+		for _, pm := range rm.Producers {
+			peerInfo, ok := peers[pm.ID]
+			if !ok {
+				continue
+			}
+			if _, exists := producers[pm.ID]; exists {
+				continue
+			}
+			p := &Producer{peerInfo: peerInfo}
+			if pm.TombstonedAt != 0 {
+				p.tombstoned = true
+				p.tombstonedAt = time.Unix(0, pm.TombstonedAt)
+			}
+			producers[pm.ID] = p
+			r.recovered[peerInfo] = deadline
+		}
+	}
+
+	for _, e := range m.Metadata {
+		if e.Metadata == nil {
+			continue
+		}
+		r.metadataMap[Registration{e.Category, e.Key, e.SubKey}] = e.Metadata
+	}
+	r.generation++
+}
+
+// LoadMetadata restores the registrations persisted to --data-path
+func (l *NSQLookupd) LoadMetadata() error {
+	fn := newMetadataFile(l.opts)
+	data, err := ioutil.ReadFile(fn)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // fresh start
+		}
+		return fmt.Errorf("failed to read metadata from %s - %s", fn, err)
+	}
+
+	var m meta
+	err = json.Unmarshal(data, &m)
+	if err != nil {
+		return fmt.Errorf("failed to parse metadata in %s - %s", fn, err)
+	}
+
+	l.DB.restore(&m, l.opts.InactiveProducerTimeout)
+	l.logf(LOG_INFO, "DB: restored %d registrations of %d peers from %s",
+		len(m.Registrations), len(m.Peers), fn)
+	return nil
+}
+
+// PersistMetadata writes the registrations to --data-path
+func (l *NSQLookupd) PersistMetadata() error {
+	fileName := newMetadataFile(l.opts)
+
+	m, generation := l.DB.snapshot()
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	//先写入临时文件再重命名，防止写失败导致原来的元数据损坏
+	tmpFileName := fmt.Sprintf("%s.%d.tmp", fileName, rand.Int())
+	err = writeSyncFile(tmpFileName, data)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(tmpFileName, fileName)
+	if err != nil {
+		return err
+	}
+
+	l.Lock()
+	l.persistedGeneration = generation
+	l.Unlock()
+	return nil
+}
+
+func writeSyncFile(fn string, data []byte) error {
+	f, err := os.OpenFile(fn, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	f.Close()
+	return err
+}
+
+// persistLoop writes the registrations whenever they changed and drops the
+// restored producers whose nsqd did not connect again in time
+func (l *NSQLookupd) persistLoop() {
+	ticker := time.NewTicker(persistInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-l.exitChan:
+			return
+		}
+
+		if n := l.DB.expireRecovered(); n > 0 {
+			l.logf(LOG_INFO, "DB: removed %d restored producers that did not reconnect", n)
+		}
+
+		l.RLock()
+		persisted := l.persistedGeneration
+		l.RUnlock()
+		if l.DB.Generation() == persisted {
+			continue
+		}
+		err := l.PersistMetadata()
+		if err != nil {
+			l.logf(LOG_ERROR, "failed to persist metadata - %s", err)
+		}
+	}
+}
@@ -1,12 +1,18 @@
 package nsqlookupd
 
 import (
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"log"
 	"net"
+	"net/url"
 	"os"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/nsqio/nsq/internal/audit"
 	"github.com/nsqio/nsq/internal/http_api"
 	"github.com/nsqio/nsq/internal/protocol"
 	"github.com/nsqio/nsq/internal/util"
@@ -15,11 +21,18 @@ import (
 
 type NSQLookupd struct {
 	sync.RWMutex
-	opts         *Options
-	tcpListener  net.Listener
-	httpListener net.Listener
-	waitGroup    util.WaitGroupWrapper
-	DB           *RegistrationDB
+	opts                *Options
+	tcpListener         net.Listener
+	httpListener        net.Listener
+	dnsConn             net.PacketConn //没有--dns-address时为nil
+	dnsListener         net.Listener
+	waitGroup           util.WaitGroupWrapper
+	exitChan            chan int
+	DB                  *RegistrationDB
+	persistedGeneration uint64 //上次写入--data-path时DB的generation
+	federation          *federation
+	peerTLSConfig       *tls.Config //请求其他集群和副本的nsqlookupd，没有配置TLS时为nil
+	audit               *audit.Log
 }
 
 func New(opts *Options) (*NSQLookupd, error) {
@@ -29,12 +42,53 @@ func New(opts *Options) (*NSQLookupd, error) {
 		opts.Logger = log.New(os.Stderr, opts.LogPrefix, log.Ldate|log.Ltime|log.Lmicroseconds)
 	}
 	l := &NSQLookupd{
-		opts: opts,
-		DB:   NewRegistrationDB(),
+		opts:       opts,
+		exitChan:   make(chan int),
+		DB:         NewRegistrationDB(),
+		federation: newFederation(),
 	}
 
 	l.logf(LOG_INFO, version.String("nsqlookupd"))
 
+	if opts.DataPath != "" {
+		err = l.LoadMetadata()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(opts.FederatedLookupdHTTPAddresses) > 0 && opts.FederationInterval <= 0 {
+		return nil, errors.New("--federation-interval must be positive")
+	}
+	if len(opts.ReplicaHTTPAddresses) > 0 && opts.ReplicationInterval <= 0 {
+		return nil, errors.New("--replication-interval must be positive")
+	}
+	if opts.MetadataTombstoneLifetime < 0 {
+		return nil, errors.New("--metadata-tombstone-lifetime must not be negative")
+	}
+	if opts.AuditTopic != "" {
+		if !protocol.IsValidTopicName(opts.AuditTopic) {
+			return nil, fmt.Errorf("--audit-topic %q is not a valid topic name", opts.AuditTopic)
+		}
+		if opts.AuditNSQDHTTPAddress == "" {
+			return nil, errors.New("--audit-topic requires --audit-nsqd-http-address")
+		}
+	}
+	if opts.AdminRateLimit < 0 {
+		return nil, errors.New("--admin-rate-limit must not be negative")
+	}
+	if opts.AdminRateLimitWait < 0 {
+		return nil, errors.New("--admin-rate-limit-wait must not be negative")
+	}
+	if opts.AuditLogMaxSize < 0 {
+		return nil, errors.New("--audit-log-max-size must not be negative")
+	}
+
+	tlsConfig, err := buildTLSConfig(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build TLS config - %s", err)
+	}
+
 	l.tcpListener, err = net.Listen("tcp", opts.TCPAddress)
 	if err != nil {
 		return nil, fmt.Errorf("listen (%s) failed - %s", opts.TCPAddress, err)
@@ -43,6 +97,47 @@ func New(opts *Options) (*NSQLookupd, error) {
 	if err != nil {
 		return nil, fmt.Errorf("listen (%s) failed - %s", opts.TCPAddress, err)
 	}
+	if opts.DNSAddress != "" {
+		opts.DNSDomain = strings.TrimPrefix(opts.DNSDomain, ".")
+		if opts.DNSDomain == "" {
+			return nil, errors.New("--dns-domain must not be empty")
+		}
+		if !strings.HasSuffix(opts.DNSDomain, ".") {
+			opts.DNSDomain += "."
+		}
+		l.dnsConn, err = net.ListenPacket("udp", opts.DNSAddress)
+		if err != nil {
+			return nil, fmt.Errorf("listen (%s) failed - %s", opts.DNSAddress, err)
+		}
+		// the same port for TCP, which is where the truncated answers are asked again
+		l.dnsListener, err = net.Listen("tcp", l.dnsConn.LocalAddr().String())
+		if err != nil {
+			return nil, fmt.Errorf("listen (%s) failed - %s", opts.DNSAddress, err)
+		}
+	}
+	if tlsConfig != nil {
+		l.tcpListener = tls.NewListener(l.tcpListener, tlsConfig)
+		l.httpListener = tls.NewListener(l.httpListener, tlsConfig)
+		// the remote clusters and the replicas are verified with
+		// --tls-root-ca-file and are presented --tls-cert
+		l.peerTLSConfig = &tls.Config{
+			Certificates: tlsConfig.Certificates,
+			RootCAs:      tlsConfig.ClientCAs,
+		}
+	}
+
+	var forward func([]byte) error
+	if opts.AuditTopic != "" {
+		client := http_api.NewClient(l.peerTLSConfig, time.Second, 5*time.Second)
+		endpoint := fmt.Sprintf("%s/pub?topic=%s", httpEndpoint(opts.AuditNSQDHTTPAddress), url.QueryEscape(opts.AuditTopic))
+		forward = func(data []byte) error {
+			return client.POSTV1Body(endpoint, data)
+		}
+	}
+	l.audit, err = audit.New(opts.AuditLogPath, opts.AuditLogMaxSize, audit.DefaultSize, forward, l.logf)
+	if err != nil {
+		return nil, err
+	}
 
 	return l, nil
 }
@@ -71,6 +166,26 @@ func (l *NSQLookupd) Main() error {
 	l.waitGroup.Wrap(func() {
 		exitFunc(http_api.Serve(l.httpListener, httpServer, "HTTP", l.logf))
 	})
+	if l.dnsConn != nil {
+		l.waitGroup.Wrap(func() {
+			exitFunc(l.serveDNSUDP(l.dnsConn))
+		})
+		l.waitGroup.Wrap(func() {
+			exitFunc(protocol.TCPServer(l.dnsListener, &dnsTCPServer{ctx: ctx}, l.logf))
+		})
+	}
+	if l.opts.DataPath != "" {
+		l.waitGroup.Wrap(l.persistLoop)
+	}
+	if len(l.opts.FederatedLookupdHTTPAddresses) > 0 {
+		l.waitGroup.Wrap(l.federationLoop)
+	}
+	if len(l.opts.ReplicaHTTPAddresses) > 0 {
+		l.waitGroup.Wrap(l.replicationLoop)
+	}
+	if l.opts.MetadataTombstoneLifetime > 0 {
+		l.waitGroup.Wrap(l.metadataExpiryLoop)
+	}
 
 	err := <-exitCh
 	return err
@@ -84,6 +199,15 @@ func (l *NSQLookupd) RealHTTPAddr() *net.TCPAddr {
 	return l.httpListener.Addr().(*net.TCPAddr)
 }
 
+// RealDNSAddr returns the UDP address the DNS queries are served on, nil
+// without --dns-address
+func (l *NSQLookupd) RealDNSAddr() *net.UDPAddr {
+	if l.dnsConn == nil {
+		return nil
+	}
+	return l.dnsConn.LocalAddr().(*net.UDPAddr)
+}
+
 func (l *NSQLookupd) Exit() {
 	if l.tcpListener != nil {
 		l.tcpListener.Close()
@@ -92,5 +216,22 @@ func (l *NSQLookupd) Exit() {
 	if l.httpListener != nil {
 		l.httpListener.Close()
 	}
+
+	if l.dnsConn != nil {
+		l.dnsConn.Close()
+		l.dnsListener.Close()
+	}
+	close(l.exitChan)
 	l.waitGroup.Wait()
+
+	if l.audit != nil {
+		l.audit.Close()
+	}
+
+	if l.opts.DataPath != "" {
+		err := l.PersistMetadata()
+		if err != nil {
+			l.logf(LOG_ERROR, "failed to persist metadata - %s", err)
+		}
+	}
 }
@@ -1,8 +1,19 @@
 package nsqlookupd
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -357,3 +368,694 @@ func TestTombstonedNodes(t *testing.T) {
 	test.Equal(t, topicName, producers[0].Topics[0].Topic)
 	test.Equal(t, true, producers[0].Topics[0].Tombstoned)
 }
+
+func TestPersistRegistrations(t *testing.T) {
+	dataPath, err := ioutil.TempDir("", "nsq-test-")
+	test.Nil(t, err)
+	defer os.RemoveAll(dataPath)
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.DataPath = dataPath
+	tcpAddr, httpAddr, nsqlookupd := mustStartLookupd(opts)
+
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+	endpoint := fmt.Sprintf("http://%s/topic/create?topic=created", httpAddr)
+	test.Nil(t, client.POSTV1(endpoint))
+
+	conn := mustConnectLookupd(t, tcpAddr)
+	defer conn.Close()
+	identify(t, conn)
+	nsq.Register("registered", "ch").WriteTo(conn)
+	_, err = nsq.ReadResponse(conn)
+	test.Nil(t, err)
+
+	endpoint = fmt.Sprintf("http://%s/topic/tombstone?topic=registered&node=%s:%d",
+		httpAddr, HostAddr, HTTPPort)
+	test.Nil(t, client.POSTV1(endpoint))
+	nsqlookupd.Exit()
+
+	// the restored producer stands in for the nsqd until it connects again
+	tcpAddr, _, nsqlookupd = mustStartLookupd(opts)
+	defer nsqlookupd.Exit()
+	test.Equal(t, 2, len(nsqlookupd.DB.FindRegistrations("topic", "*", "")))
+	test.Equal(t, 1, len(nsqlookupd.DB.FindRegistrations("channel", "registered", "ch")))
+	producers := nsqlookupd.DB.FindProducers("topic", "registered", "")
+	test.Equal(t, 1, len(producers))
+	test.Equal(t, true, producers[0].IsTombstoned(opts.TombstoneLifetime))
+	restored := producers[0].peerInfo
+
+	conn2 := mustConnectLookupd(t, tcpAddr)
+	defer conn2.Close()
+	identify(t, conn2)
+	nsq.Register("registered", "ch").WriteTo(conn2)
+	_, err = nsq.ReadResponse(conn2)
+	test.Nil(t, err)
+
+	producers = nsqlookupd.DB.FindProducers("topic", "registered", "")
+	test.Equal(t, 1, len(producers))
+	test.NotEqual(t, restored, producers[0].peerInfo)
+	test.Equal(t, true, producers[0].IsTombstoned(opts.TombstoneLifetime))
+	test.Equal(t, 1, len(nsqlookupd.DB.FindProducers("client", "", "")))
+}
+
+func TestPersistRegistrationsOutage(t *testing.T) {
+	dataPath, err := ioutil.TempDir("", "nsq-test-")
+	test.Nil(t, err)
+	defer os.RemoveAll(dataPath)
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.DataPath = dataPath
+	tcpAddr, httpAddr, nsqlookupd := mustStartLookupd(opts)
+
+	conn := mustConnectLookupd(t, tcpAddr)
+	defer conn.Close()
+	identify(t, conn)
+	nsq.Register("registered", "").WriteTo(conn)
+	_, err = nsq.ReadResponse(conn)
+	test.Nil(t, err)
+
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+	endpoint := fmt.Sprintf("http://%s/topic/tombstone?topic=registered&node=%s:%d",
+		httpAddr, HostAddr, HTTPPort)
+	test.Nil(t, client.POSTV1(endpoint))
+	nsqlookupd.Exit()
+
+	// nsqlookupd stays down for longer than --inactive-producer-timeout
+	fn := newMetadataFile(opts)
+	data, err := ioutil.ReadFile(fn)
+	test.Nil(t, err)
+	var m meta
+	test.Nil(t, json.Unmarshal(data, &m))
+	for i := range m.Peers {
+		m.Peers[i].LastUpdate = time.Now().Add(-2 * opts.InactiveProducerTimeout).UnixNano()
+	}
+	data, err = json.Marshal(m)
+	test.Nil(t, err)
+	test.Nil(t, ioutil.WriteFile(fn, data, 0600))
+
+	// the restored producers and their tombstones wait for the nsqd to connect
+	_, _, nsqlookupd = mustStartLookupd(opts)
+	time.Sleep(persistInterval + 500*time.Millisecond)
+	producers := nsqlookupd.DB.FindProducers("topic", "registered", "")
+	test.Equal(t, 1, len(producers))
+	test.Equal(t, true, producers[0].IsTombstoned(opts.TombstoneLifetime))
+	nsqlookupd.Exit()
+
+	// and are dropped when it does not connect within the grace period
+	opts.InactiveProducerTimeout = 100 * time.Millisecond
+	_, _, nsqlookupd = mustStartLookupd(opts)
+	defer nsqlookupd.Exit()
+	time.Sleep(persistInterval + 500*time.Millisecond)
+	test.Equal(t, 0, len(nsqlookupd.DB.FindProducers("topic", "registered", "")))
+}
+
+func TestTagLookup(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	tcpAddr, httpAddr, nsqlookupd := mustStartLookupd(opts)
+	defer nsqlookupd.Exit()
+
+	topicName := "tag_lookup"
+	for i, zone := range []string{"a", "b", "b"} {
+		conn := mustConnectLookupd(t, tcpAddr)
+		defer conn.Close()
+		ci := map[string]interface{}{
+			"tcp_port":          TCPPort + i,
+			"http_port":         HTTPPort + i,
+			"broadcast_address": HostAddr,
+			"hostname":          HostAddr,
+			"version":           NSQDVersion,
+			"tags":              map[string]string{"zone": zone, "rack": strconv.Itoa(i)},
+		}
+		cmd, _ := nsq.Identify(ci)
+		_, err := cmd.WriteTo(conn)
+		test.Nil(t, err)
+		_, err = nsq.ReadResponse(conn)
+		test.Nil(t, err)
+		nsq.Register(topicName, "").WriteTo(conn)
+		_, err = nsq.ReadResponse(conn)
+		test.Nil(t, err)
+	}
+
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+	lr := LookupDoc{}
+	endpoint := fmt.Sprintf("http://%s/lookup?topic=%s&tag=zone:b", httpAddr, topicName)
+	test.Nil(t, client.GETV1(endpoint, &lr))
+	test.Equal(t, 2, len(lr.Producers))
+	for _, p := range lr.Producers {
+		test.Equal(t, "b", p.Tags["zone"])
+	}
+
+	endpoint = fmt.Sprintf("http://%s/lookup?topic=%s&prefer=zone:b&prefer=rack:2", httpAddr, topicName)
+	test.Nil(t, client.GETV1(endpoint, &lr))
+	test.Equal(t, 3, len(lr.Producers))
+	test.Equal(t, "2", lr.Producers[0].Tags["rack"])
+	test.Equal(t, "1", lr.Producers[1].Tags["rack"])
+	test.Equal(t, "0", lr.Producers[2].Tags["rack"])
+
+	pr := ProducersDoc{}
+	endpoint = fmt.Sprintf("http://%s/nodes?tag=zone:a", httpAddr)
+	test.Nil(t, client.GETV1(endpoint, &pr))
+	test.Equal(t, 1, len(pr.Producers))
+
+	endpoint = fmt.Sprintf("http://%s/nodes?tag=zone", httpAddr)
+	err := client.GETV1(endpoint, &pr)
+	test.NotNil(t, err)
+}
+
+func TestTLSAuth(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.TLSCert = "./test/certs/server.pem"
+	opts.TLSKey = "./test/certs/server.key"
+	opts.TLSRootCAFile = "./test/certs/ca.pem"
+	opts.AuthSecret = "secret"
+	tcpAddr, httpAddr, nsqlookupd := mustStartLookupd(opts)
+	defer nsqlookupd.Exit()
+
+	caCert, err := ioutil.ReadFile(opts.TLSRootCAFile)
+	test.Nil(t, err)
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(caCert)
+	clientCert, err := tls.LoadX509KeyPair("./test/certs/client.pem", "./test/certs/client.key")
+	test.Nil(t, err)
+
+	identifyTLS := func(tlsConfig *tls.Config, secret string) []byte {
+		conn, err := tls.Dial("tcp", tcpAddr.String(), tlsConfig)
+		test.Nil(t, err)
+		defer conn.Close()
+		conn.Write(nsq.MagicV1)
+		ci := map[string]interface{}{
+			"tcp_port":          TCPPort,
+			"http_port":         HTTPPort,
+			"broadcast_address": HostAddr,
+			"hostname":          HostAddr,
+			"version":           NSQDVersion,
+			"auth_secret":       secret,
+		}
+		cmd, _ := nsq.Identify(ci)
+		_, err = cmd.WriteTo(conn)
+		test.Nil(t, err)
+		resp, err := nsq.ReadResponse(conn)
+		test.Nil(t, err)
+		return resp
+	}
+
+	resp := identifyTLS(&tls.Config{RootCAs: pool}, "")
+	test.Equal(t, "E_UNAUTHORIZED IDENTIFY unauthorized", string(resp))
+	resp = identifyTLS(&tls.Config{RootCAs: pool}, "wrong")
+	test.Equal(t, "E_UNAUTHORIZED IDENTIFY unauthorized", string(resp))
+	resp = identifyTLS(&tls.Config{RootCAs: pool}, "secret")
+	test.Equal(t, byte('{'), resp[0])
+	resp = identifyTLS(&tls.Config{RootCAs: pool, Certificates: []tls.Certificate{clientCert}}, "")
+	test.Equal(t, byte('{'), resp[0])
+
+	// plain TCP is not served
+	conn := mustConnectLookupd(t, tcpAddr)
+	conn.SetDeadline(time.Now().Add(100 * time.Millisecond))
+	_, err = nsq.ReadResponse(conn)
+	test.NotNil(t, err)
+	conn.Close()
+
+	client := http_api.NewClient(&tls.Config{RootCAs: pool}, ConnectTimeout, RequestTimeout)
+	endpoint := fmt.Sprintf("https://%s/topic/create?topic=tls_auth", httpAddr)
+	err = client.POSTV1(endpoint)
+	test.NotNil(t, err)
+	test.Equal(t, 0, len(nsqlookupd.DB.FindRegistrations("topic", "tls_auth", "")))
+	test.Nil(t, client.POSTV1Auth(endpoint, "secret"))
+	test.Equal(t, 1, len(nsqlookupd.DB.FindRegistrations("topic", "tls_auth", "")))
+
+	// reads do not need the secret
+	tr := TopicsDoc{}
+	endpoint = fmt.Sprintf("https://%s/topics", httpAddr)
+	test.Nil(t, client.GETV1(endpoint, &tr))
+	test.Equal(t, []interface{}{"tls_auth"}, tr.Topics)
+
+	client = http_api.NewClient(&tls.Config{RootCAs: pool, Certificates: []tls.Certificate{clientCert}},
+		ConnectTimeout, RequestTimeout)
+	endpoint = fmt.Sprintf("https://%s/topic/delete?topic=tls_auth", httpAddr)
+	test.Nil(t, client.POSTV1(endpoint))
+	test.Equal(t, 0, len(nsqlookupd.DB.FindRegistrations("topic", "tls_auth", "")))
+}
+
+func TestFederation(t *testing.T) {
+	ropts := NewOptions()
+	ropts.Logger = test.NewTestLogger(t)
+	ropts.ClusterName = "dc2"
+	remoteTCPAddr, remoteHTTPAddr, remote := mustStartLookupd(ropts)
+	defer remote.Exit()
+
+	topicName := "federation"
+	conn := mustConnectLookupd(t, remoteTCPAddr)
+	defer conn.Close()
+	identify(t, conn)
+	nsq.Register(topicName, "remote_ch").WriteTo(conn)
+	_, err := nsq.ReadResponse(conn)
+	test.Nil(t, err)
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.ClusterName = "dc1"
+	opts.FederatedLookupdHTTPAddresses = []string{remoteHTTPAddr.String()}
+	opts.FederationInterval = 50 * time.Millisecond
+	tcpAddr, httpAddr, nsqlookupd := mustStartLookupd(opts)
+	defer nsqlookupd.Exit()
+
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+	lr := LookupDoc{}
+	endpoint := fmt.Sprintf("http://%s/lookup?topic=%s", httpAddr, topicName)
+	err = client.GETV1(endpoint, &lr)
+	test.NotNil(t, err)
+
+	time.Sleep(150 * time.Millisecond)
+
+	endpoint = fmt.Sprintf("http://%s/lookup?topic=%s&scope=remote", httpAddr, topicName)
+	lr = LookupDoc{}
+	test.Nil(t, client.GETV1(endpoint, &lr))
+	test.Equal(t, []interface{}{"remote_ch"}, lr.Channels)
+	test.Equal(t, 1, len(lr.Producers))
+	test.Equal(t, "dc2", lr.Producers[0].Cluster)
+	test.Equal(t, TCPPort, lr.Producers[0].TCPPort)
+
+	localConn := mustConnectLookupd(t, tcpAddr)
+	defer localConn.Close()
+	identify(t, localConn)
+	nsq.Register(topicName, "local_ch").WriteTo(localConn)
+	_, err = nsq.ReadResponse(localConn)
+	test.Nil(t, err)
+
+	endpoint = fmt.Sprintf("http://%s/lookup?topic=%s&scope=local", httpAddr, topicName)
+	lr = LookupDoc{}
+	test.Nil(t, client.GETV1(endpoint, &lr))
+	test.Equal(t, []interface{}{"local_ch"}, lr.Channels)
+	test.Equal(t, 1, len(lr.Producers))
+	test.Equal(t, "", lr.Producers[0].Cluster)
+
+	endpoint = fmt.Sprintf("http://%s/lookup?topic=%s&scope=all", httpAddr, topicName)
+	lr = LookupDoc{}
+	test.Nil(t, client.GETV1(endpoint, &lr))
+	test.Equal(t, []interface{}{"local_ch", "remote_ch"}, lr.Channels)
+	test.Equal(t, 2, len(lr.Producers))
+	test.Equal(t, "", lr.Producers[0].Cluster)
+	test.Equal(t, "dc2", lr.Producers[1].Cluster)
+
+	// imported registrations are not exported again
+	endpoint = fmt.Sprintf("http://%s/lookup?topic=%s&scope=all", remoteHTTPAddr, topicName)
+	lr = LookupDoc{}
+	test.Nil(t, client.GETV1(endpoint, &lr))
+	test.Equal(t, []interface{}{"remote_ch"}, lr.Channels)
+
+	endpoint = fmt.Sprintf("http://%s/lookup?topic=%s&scope=elsewhere", httpAddr, topicName)
+	err = client.GETV1(endpoint, &lr)
+	test.NotNil(t, err)
+
+	var doc federationDoc
+	endpoint = fmt.Sprintf("http://%s/federation/registrations", remoteHTTPAddr)
+	test.Nil(t, client.GETV1(endpoint, &doc))
+	test.Equal(t, federationVersion, doc.Version)
+	test.Equal(t, "dc2", doc.Cluster)
+	test.Equal(t, 2, len(doc.Registrations))
+	for _, fr := range doc.Registrations {
+		test.Equal(t, 1, len(fr.Producers))
+		test.Equal(t, TCPPort, fr.Producers[0].TCPPort)
+	}
+
+	// tombstoned producers are not exported
+	endpoint = fmt.Sprintf("http://%s/topic/tombstone?topic=%s&node=%s:%d",
+		remoteHTTPAddr, topicName, HostAddr, HTTPPort)
+	test.Nil(t, client.POSTV1(endpoint))
+	endpoint = fmt.Sprintf("http://%s/federation/registrations", remoteHTTPAddr)
+	test.Nil(t, client.GETV1(endpoint, &doc))
+	for _, fr := range doc.Registrations {
+		if fr.Category == "topic" {
+			test.Equal(t, 0, len(fr.Producers))
+		}
+	}
+
+	// an export of another version is not imported
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"version":2,"cluster":"dc3","registrations":[]}`))
+	}))
+	defer srv.Close()
+	test.NotNil(t, nsqlookupd.importCluster(client, srv.URL))
+}
+
+func TestHealth(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	tcpAddr, httpAddr, nsqlookupd := mustStartLookupd(opts)
+	defer nsqlookupd.Exit()
+
+	topicName := "health"
+	register := func(tcpPort int, health map[string]interface{}) net.Conn {
+		conn := mustConnectLookupd(t, tcpAddr)
+		ci := map[string]interface{}{
+			"tcp_port":          tcpPort,
+			"http_port":         HTTPPort,
+			"broadcast_address": HostAddr,
+			"hostname":          HostAddr,
+			"version":           NSQDVersion,
+		}
+		if health != nil {
+			ci["health"] = health
+		}
+		cmd, _ := nsq.Identify(ci)
+		_, err := cmd.WriteTo(conn)
+		test.Nil(t, err)
+		_, err = nsq.ReadResponse(conn)
+		test.Nil(t, err)
+		nsq.Register(topicName, "").WriteTo(conn)
+		_, err = nsq.ReadResponse(conn)
+		test.Nil(t, err)
+		return conn
+	}
+	ping := func(conn net.Conn, params ...string) []byte {
+		cmd := &nsq.Command{Name: []byte("PING")}
+		for _, param := range params {
+			cmd.Params = append(cmd.Params, []byte(param))
+		}
+		_, err := cmd.WriteTo(conn)
+		test.Nil(t, err)
+		resp, err := nsq.ReadResponse(conn)
+		test.Nil(t, err)
+		return resp
+	}
+
+	conn1 := register(1, nil)
+	conn2 := register(2, map[string]interface{}{"healthy": true, "depth": 1})
+	conn3 := register(3, nil)
+	conn4 := register(4, nil)
+
+	test.Equal(t, []byte("OK"), ping(conn1, "healthy=false", "depth=0", "clients=0", "draining=false"))
+	test.Equal(t, []byte("OK"), ping(conn3, "healthy=true", "depth=5", "clients=2", "draining=false"))
+	test.Equal(t, []byte("OK"), ping(conn4, "healthy=true", "depth=0", "clients=0", "draining=true"))
+	test.Equal(t, "E_INVALID PING invalid param \"depth=x\" - strconv.ParseInt: parsing \"x\": invalid syntax",
+		string(ping(conn3, "depth=x")))
+
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+	lr := LookupDoc{}
+	endpoint := fmt.Sprintf("http://%s/lookup?topic=%s", httpAddr, topicName)
+	test.Nil(t, client.GETV1(endpoint, &lr))
+	// the unhealthy nsqd comes last instead of being left out
+	test.Equal(t, 4, len(lr.Producers))
+	test.Equal(t, 2, lr.Producers[0].TCPPort)
+	test.Equal(t, 3, lr.Producers[1].TCPPort)
+	test.Equal(t, 4, lr.Producers[2].TCPPort)
+	test.Equal(t, 1, lr.Producers[3].TCPPort)
+
+	var nodes struct {
+		Producers []struct {
+			TCPPort int         `json:"tcp_port"`
+			Health  *PeerHealth `json:"health"`
+		} `json:"producers"`
+	}
+	// the lookup reports the health too, for the clients to fall back on
+	test.Nil(t, client.GETV1(endpoint, &nodes))
+	test.Equal(t, int64(1), nodes.Producers[0].Health.Depth)
+	test.Equal(t, int64(5), nodes.Producers[1].Health.Depth)
+
+	endpoint = fmt.Sprintf("http://%s/nodes", httpAddr)
+	test.Nil(t, client.GETV1(endpoint, &nodes))
+	test.Equal(t, 4, len(nodes.Producers))
+	for _, n := range nodes.Producers {
+		switch n.TCPPort {
+		case 1:
+			test.Equal(t, false, n.Health.Healthy)
+		case 2:
+			test.Equal(t, int64(1), n.Health.Depth)
+		case 3:
+			test.Equal(t, int64(2), n.Health.ClientCount)
+		case 4:
+			test.Equal(t, true, n.Health.Draining)
+		}
+	}
+
+	for _, conn := range []net.Conn{conn1, conn2, conn3, conn4} {
+		conn.Close()
+	}
+	time.Sleep(10 * time.Millisecond)
+}
+
+func TestDNS(t *testing.T) {
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.DNSAddress = "127.0.0.1:0"
+	tcpAddr, _, nsqlookupd := mustStartLookupd(opts)
+	defer nsqlookupd.Exit()
+
+	topicName := "dns"
+	register := func(topicName string, broadcastAddress string, tcpPort int) net.Conn {
+		conn := mustConnectLookupd(t, tcpAddr)
+		ci := map[string]interface{}{
+			"tcp_port":          tcpPort,
+			"http_port":         HTTPPort,
+			"broadcast_address": broadcastAddress,
+			"hostname":          broadcastAddress,
+			"version":           NSQDVersion,
+		}
+		cmd, _ := nsq.Identify(ci)
+		_, err := cmd.WriteTo(conn)
+		test.Nil(t, err)
+		_, err = nsq.ReadResponse(conn)
+		test.Nil(t, err)
+		nsq.Register(topicName, "").WriteTo(conn)
+		_, err = nsq.ReadResponse(conn)
+		test.Nil(t, err)
+		return conn
+	}
+	conn1 := register(topicName, "127.0.0.2", 4150)
+	conn2 := register(topicName, "nsqd.example.com", 4151)
+
+	newResolver := func(network string) *net.Resolver {
+		return &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return net.Dial(network, nsqlookupd.RealDNSAddr().String())
+			},
+		}
+	}
+	ctx := context.Background()
+
+	for _, network := range []string{"udp", "tcp"} {
+		resolver := newResolver(network)
+
+		_, srvs, err := resolver.LookupSRV(ctx, "", "", topicName+".topic.nsq.")
+		test.Nil(t, err)
+		targets := make(map[string]uint16)
+		for _, srv := range srvs {
+			targets[srv.Target] = srv.Port
+		}
+		test.Equal(t, map[string]uint16{
+			"127-0-0-2.node.nsq.": 4150,
+			"nsqd.example.com.":   4151,
+		}, targets)
+
+		addrs, err := resolver.LookupHost(ctx, topicName+".topic.nsq.")
+		test.Nil(t, err)
+		test.Equal(t, []string{"127.0.0.2"}, addrs)
+
+		addrs, err = resolver.LookupHost(ctx, "127-0-0-2.node.nsq.")
+		test.Nil(t, err)
+		test.Equal(t, []string{"127.0.0.2"}, addrs)
+
+		_, _, err = resolver.LookupSRV(ctx, "", "", "unknown.topic.nsq.")
+		test.NotNil(t, err)
+		test.Equal(t, true, err.(*net.DNSError).IsNotFound)
+	}
+
+	// the topics are matched ignoring case, like resolvers randomizing the
+	// case of the queries expect
+	conn3 := register("DNS", "127.0.0.3", 4152)
+	resolver := newResolver("udp")
+	for _, name := range []string{"dns.topic.nsq.", "DnS.tOpIc.NsQ.", "DNS.TOPIC.NSQ."} {
+		addrs, err := resolver.LookupHost(ctx, name)
+		test.Nil(t, err)
+		sort.Strings(addrs)
+		test.Equal(t, []string{"127.0.0.2", "127.0.0.3"}, addrs)
+	}
+
+	conn1.Close()
+	conn2.Close()
+	conn3.Close()
+	time.Sleep(10 * time.Millisecond)
+}
+
+func TestMetadata(t *testing.T) {
+	dataPath, err := ioutil.TempDir("", "nsq-test-")
+	test.Nil(t, err)
+	defer os.RemoveAll(dataPath)
+
+	opts2 := NewOptions()
+	opts2.Logger = test.NewTestLogger(t)
+	_, httpAddr2, nsqlookupd2 := mustStartLookupd(opts2)
+	defer nsqlookupd2.Exit()
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.DataPath = dataPath
+	opts.ReplicaHTTPAddresses = []string{httpAddr2.String()}
+	opts.ReplicationInterval = 50 * time.Millisecond
+	_, httpAddr, nsqlookupd := mustStartLookupd(opts)
+
+	setMetadata := func(addr *net.TCPAddr, uri string, body string) int {
+		resp, err := http.Post(fmt.Sprintf("http://%s/%s", addr, uri), "application/json", strings.NewReader(body))
+		test.Nil(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	test.Equal(t, 200, setMetadata(httpAddr, "topic/metadata?topic=orders",
+		`{"owner":"payments","retention":"72h","tags":["pii"],"attributes":{"schema":"order.v2"}}`))
+	test.Equal(t, 200, setMetadata(httpAddr, "channel/metadata?topic=orders&channel=billing",
+		`{"owner":"billing"}`))
+	test.Equal(t, 400, setMetadata(httpAddr, "topic/metadata?topic=orders", `{"owner":`))
+	test.Equal(t, 400, setMetadata(httpAddr, "topic/metadata?topic=in/valid", `{}`))
+	test.Equal(t, 413, setMetadata(httpAddr, "topic/metadata?topic=orders",
+		`{"description":"`+strings.Repeat("x", maxMetadataSize)+`"}`))
+	test.Equal(t, 404, setMetadata(httpAddr, "topic/metadata/delete?topic=unknown", ""))
+
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+	test.Nil(t, client.POSTV1(fmt.Sprintf("http://%s/topic/create?topic=orders", httpAddr)))
+	test.Nil(t, client.POSTV1(fmt.Sprintf("http://%s/channel/create?topic=orders&channel=billing", httpAddr)))
+
+	var topics struct {
+		Topics   []string             `json:"topics"`
+		Metadata map[string]*Metadata `json:"metadata"`
+	}
+	test.Nil(t, client.GETV1(fmt.Sprintf("http://%s/topics", httpAddr), &topics))
+	test.Equal(t, []string{"orders"}, topics.Topics)
+	test.Equal(t, "payments", topics.Metadata["orders"].Owner)
+	test.Equal(t, "72h", topics.Metadata["orders"].Retention)
+	test.Equal(t, []string{"pii"}, topics.Metadata["orders"].Tags)
+	test.Equal(t, "order.v2", topics.Metadata["orders"].Attributes["schema"])
+
+	var channels struct {
+		Metadata map[string]*Metadata `json:"metadata"`
+	}
+	test.Nil(t, client.GETV1(fmt.Sprintf("http://%s/channels?topic=orders", httpAddr), &channels))
+	test.Equal(t, "billing", channels.Metadata["billing"].Owner)
+
+	// the replicas pull each other's writes, the last one wins
+	test.Equal(t, 200, setMetadata(httpAddr2, "topic/metadata?topic=orders", `{"owner":"checkout"}`))
+	time.Sleep(200 * time.Millisecond)
+	m := Metadata{}
+	test.Nil(t, client.GETV1(fmt.Sprintf("http://%s/topic/metadata?topic=orders", httpAddr), &m))
+	test.Equal(t, "checkout", m.Owner)
+	test.Equal(t, "", m.Retention)
+
+	_, err = nsqlookupd2.replicateMetadata(client, httpAddr.String())
+	test.Nil(t, err)
+	test.Equal(t, "billing", nsqlookupd2.DB.GetMetadata(Registration{"channel", "orders", "billing"}).Owner)
+
+	test.Equal(t, 200, setMetadata(httpAddr, "channel/metadata/delete?topic=orders&channel=billing", ""))
+	_, err = nsqlookupd2.replicateMetadata(client, httpAddr.String())
+	test.Nil(t, err)
+	test.Nil(t, nsqlookupd2.DB.GetMetadata(Registration{"channel", "orders", "billing"}))
+	err = client.GETV1(fmt.Sprintf("http://%s/channel/metadata?topic=orders&channel=billing", httpAddr2), &m)
+	test.NotNil(t, err)
+
+	// older writes are ignored
+	old := *nsqlookupd.DB.GetMetadata(Registration{"topic", "orders", ""})
+	old.UpdatedAt--
+	old.Owner = "stale"
+	test.Equal(t, false, nsqlookupd.DB.SetMetadata(Registration{"topic", "orders", ""}, &old))
+
+	nsqlookupd.Exit()
+	opts.ReplicaHTTPAddresses = nil
+	opts.MetadataTombstoneLifetime = time.Millisecond
+	_, httpAddr, nsqlookupd = mustStartLookupd(opts)
+	defer nsqlookupd.Exit()
+	m = Metadata{}
+	test.Nil(t, client.GETV1(fmt.Sprintf("http://%s/topic/metadata?topic=orders", httpAddr), &m))
+	test.Equal(t, "checkout", m.Owner)
+	test.Nil(t, nsqlookupd.DB.GetMetadata(Registration{"channel", "orders", "billing"}))
+
+	// the deleted metadata is forgotten after the tombstone lifetime, and not
+	// pulled back from a replica that still has it
+	test.Equal(t, 0, nsqlookupd.DB.expireMetadata(time.Hour))
+	test.Equal(t, 2, len(nsqlookupd.DB.metadataEntries()))
+	time.Sleep(10 * time.Millisecond)
+	test.Equal(t, 1, nsqlookupd.DB.expireMetadata(time.Millisecond))
+	test.Equal(t, 1, len(nsqlookupd.DB.metadataEntries()))
+
+	_, err = nsqlookupd.replicateMetadata(client, httpAddr2.String())
+	test.Nil(t, err)
+	test.Equal(t, 1, len(nsqlookupd.DB.metadataEntries()))
+}
+
+func TestAudit(t *testing.T) {
+	published := make(chan string, 10)
+	nsqd := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		published <- req.URL.RequestURI() + " " + string(body)
+	}))
+	defer nsqd.Close()
+
+	opts := NewOptions()
+	opts.Logger = test.NewTestLogger(t)
+	opts.AuthSecret = "secret"
+	opts.AuditTopic = "audit"
+	opts.AuditNSQDHTTPAddress = nsqd.URL
+	opts.AdminRateLimit = 1
+	opts.AdminRateLimitWait = 0
+	_, httpAddr, nsqlookupd := mustStartLookupd(opts)
+	defer nsqlookupd.Exit()
+
+	post := func(uri string, secret string) int {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("http://%s/%s", httpAddr, uri), nil)
+		req.Header.Set("X-Forwarded-User", "ops")
+		if secret != "" {
+			req.Header.Set("Authorization", "Bearer "+secret)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		test.Nil(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	test.Equal(t, 200, post("topic/create?topic=orders", "secret"))
+	// refused without taking from the rate limit
+	test.Equal(t, 401, post("topic/delete?topic=orders", "wrong"))
+	test.Equal(t, 429, post("topic/delete?topic=orders", "secret"))
+
+	client := http_api.NewClient(nil, ConnectTimeout, RequestTimeout)
+	var resp struct {
+		Entries []struct {
+			ClaimedCaller string              `json:"claimed_caller"`
+			RemoteAddr    string              `json:"remote_addr"`
+			Path          string              `json:"path"`
+			Params        map[string][]string `json:"params"`
+			Status        int                 `json:"status"`
+		} `json:"entries"`
+	}
+	endpoint := fmt.Sprintf("http://%s/audit?caller=ops", httpAddr)
+	test.NotNil(t, client.GETV1(endpoint, &resp))
+
+	req, _ := http.NewRequest("GET", endpoint, nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	r, err := http.DefaultClient.Do(req)
+	test.Nil(t, err)
+	body, _ := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	test.Equal(t, 200, r.StatusCode)
+	test.Nil(t, json.Unmarshal(body, &resp))
+	test.Equal(t, 3, len(resp.Entries))
+	test.Equal(t, "/topic/create", resp.Entries[0].Path)
+	test.Equal(t, []string{"orders"}, resp.Entries[0].Params["topic"])
+	test.Equal(t, "ops", resp.Entries[0].ClaimedCaller)
+	test.Equal(t, true, strings.HasPrefix(resp.Entries[0].RemoteAddr, "127.0.0.1:"))
+	test.Equal(t, 200, resp.Entries[0].Status)
+	test.Equal(t, 401, resp.Entries[1].Status)
+	test.Equal(t, 429, resp.Entries[2].Status)
+
+	for i := 0; i < 3; i++ {
+		select {
+		case p := <-published:
+			test.Equal(t, true, strings.HasPrefix(p, "/pub?topic=audit {"))
+		case <-time.After(time.Second):
+			t.Fatal("audit entry not published")
+		}
+	}
+}
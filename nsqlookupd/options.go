@@ -5,6 +5,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/nsqio/nsq/internal/audit"
 	"github.com/nsqio/nsq/internal/lg"
 )
 
@@ -16,9 +17,37 @@ type Options struct {
 	TCPAddress       string `flag:"tcp-address"`
 	HTTPAddress      string `flag:"http-address"`
 	BroadcastAddress string `flag:"broadcast-address"`
+	DNSAddress       string `flag:"dns-address"` //为空则不提供DNS查询，UDP和TCP都监听
+	DNSDomain        string `flag:"dns-domain"`
 
 	InactiveProducerTimeout time.Duration `flag:"inactive-producer-timeout"`
 	TombstoneLifetime       time.Duration `flag:"tombstone-lifetime"`
+
+	DataPath string `flag:"data-path"` //持久化注册信息的目录，为空则只保存在内存
+
+	ClusterName                   string        `flag:"cluster-name"`                                                          //其他集群导入时标记的来源
+	FederatedLookupdHTTPAddresses []string      `flag:"federated-lookupd-http-address" cfg:"federated_lookupd_http_addresses"` //导入注册信息的其他集群的nsqlookupd
+	FederationInterval            time.Duration `flag:"federation-interval"`
+
+	ReplicaHTTPAddresses []string      `flag:"replica-http-address" cfg:"replica_http_addresses"` //同步topic和channel元数据的nsqlookupd
+	ReplicationInterval  time.Duration `flag:"replication-interval"`
+
+	MetadataTombstoneLifetime time.Duration `flag:"metadata-tombstone-lifetime"` //删除的元数据保留多久，0为一直保留
+
+	AuditLogPath         string        `flag:"audit-log"`               //为空则只保留在内存
+	AuditLogMaxSize      int64         `flag:"audit-log-max-size"`      //超过后轮转到<audit-log>.1，0为不轮转
+	AuditTopic           string        `flag:"audit-topic"`             //转发到这个topic，需要--audit-nsqd-http-address
+	AuditNSQDHTTPAddress string        `flag:"audit-nsqd-http-address"` //发布--audit-topic的nsqd
+	AdminRateLimit       float64       `flag:"admin-rate-limit"`        //每秒修改注册信息的请求数，0为不限制
+	AdminRateLimitWait   time.Duration `flag:"admin-rate-limit-wait"`   //超过限制的请求最多排队等待多久，0为直接返回429
+
+	// TLS config
+	TLSCert             string `flag:"tls-cert"` //配置后TCP和HTTP都只接受TLS连接
+	TLSKey              string `flag:"tls-key"`
+	TLSClientAuthPolicy string `flag:"tls-client-auth-policy"`
+	TLSRootCAFile       string `flag:"tls-root-ca-file"`
+
+	AuthSecret string `flag:"auth-secret"` //IDENTIFY和修改注册信息的HTTP请求需要带上，验证过客户端证书的不需要
 }
 
 func NewOptions() *Options {
@@ -33,8 +62,18 @@ func NewOptions() *Options {
 		TCPAddress:       "0.0.0.0:4160",
 		HTTPAddress:      "0.0.0.0:4161",
 		BroadcastAddress: hostname,
+		DNSDomain:        "nsq.",
 
 		InactiveProducerTimeout: 300 * time.Second,
 		TombstoneLifetime:       45 * time.Second,
+
+		FederationInterval: 15 * time.Second,
+
+		ReplicationInterval: 5 * time.Second,
+
+		MetadataTombstoneLifetime: 7 * 24 * time.Hour,
+
+		AuditLogMaxSize:    audit.DefaultMaxFileSize,
+		AdminRateLimitWait: time.Second,
 	}
 }
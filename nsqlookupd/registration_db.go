@@ -2,14 +2,21 @@ package nsqlookupd
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/nsqio/nsq/internal/protocol"
 )
 
 type RegistrationDB struct {
 	sync.RWMutex
 	registrationMap map[Registration]ProducerMap
+	generation      uint64                  //每次修改加1，用于判断是否需要持久化
+	recovered       map[*PeerInfo]time.Time //从--data-path恢复的及其保留期限，nsqd重新连接后被替换
+	events          *eventLog               //注册信息的变化，见/watch
+	metadataMap     map[Registration]*Metadata
 }
 
 type Registration struct {
@@ -22,12 +29,15 @@ type Registrations []Registration
 type PeerInfo struct {
 	lastUpdate       int64
 	id               string
-	RemoteAddress    string `json:"remote_address"`
-	Hostname         string `json:"hostname"`
-	BroadcastAddress string `json:"broadcast_address"`
-	TCPPort          int    `json:"tcp_port"`
-	HTTPPort         int    `json:"http_port"`
-	Version          string `json:"version"`
+	RemoteAddress    string            `json:"remote_address"`
+	Hostname         string            `json:"hostname"`
+	BroadcastAddress string            `json:"broadcast_address"`
+	TCPPort          int               `json:"tcp_port"`
+	HTTPPort         int               `json:"http_port"`
+	Version          string            `json:"version"`
+	Tags             map[string]string `json:"tags,omitempty"`    //nsqd的--tag
+	Cluster          string            `json:"cluster,omitempty"` //从其他集群导入的，为空表示本地
+	health           atomic.Value      //*PeerHealth，nsqd在IDENTIFY和PING时上报
 }
 
 type Producer struct {
@@ -39,6 +49,12 @@ type Producer struct {
 type Producers []*Producer
 type ProducerMap map[string]*Producer
 
+// isSameNode reports whether p and other are the same nsqd
+func (p *PeerInfo) isSameNode(other *PeerInfo) bool {
+	return p.BroadcastAddress == other.BroadcastAddress &&
+		p.TCPPort == other.TCPPort && p.HTTPPort == other.HTTPPort
+}
+
 func (p *Producer) String() string {
 	return fmt.Sprintf("%s [%d, %d]", p.peerInfo.BroadcastAddress, p.peerInfo.TCPPort, p.peerInfo.HTTPPort)
 }
@@ -55,6 +71,9 @@ func (p *Producer) IsTombstoned(lifetime time.Duration) bool {
 func NewRegistrationDB() *RegistrationDB {
 	return &RegistrationDB{
 		registrationMap: make(map[Registration]ProducerMap),
+		recovered:       make(map[*PeerInfo]time.Time),
+		events:          newEventLog(),
+		metadataMap:     make(map[Registration]*Metadata),
 	}
 }
 
@@ -65,6 +84,7 @@ func (r *RegistrationDB) AddRegistration(k Registration) {
 	_, ok := r.registrationMap[k]
 	if !ok {
 		r.registrationMap[k] = make(map[string]*Producer)
+		r.generation++
 	}
 }
 
@@ -80,6 +100,8 @@ func (r *RegistrationDB) AddProducer(k Registration, p *Producer) bool {
 	_, found := producers[p.peerInfo.id]
 	if found == false {
 		producers[p.peerInfo.id] = p
+		r.generation++
+		r.events.append(EventRegister, k, p.peerInfo)
 	}
 	return !found
 }
@@ -93,8 +115,10 @@ func (r *RegistrationDB) RemoveProducer(k Registration, id string) (bool, int) {
 		return false, 0
 	}
 	removed := false
-	if _, exists := producers[id]; exists {
+	if p, exists := producers[id]; exists {
 		removed = true
+		r.generation++
+		r.events.append(EventUnregister, k, p.peerInfo)
 	}
 
 	// Note: this leaves keys in the DB even if they have empty lists
@@ -106,7 +130,88 @@ func (r *RegistrationDB) RemoveProducer(k Registration, id string) (bool, int) {
 func (r *RegistrationDB) RemoveRegistration(k Registration) {
 	r.Lock()
 	defer r.Unlock()
-	delete(r.registrationMap, k)
+	if _, ok := r.registrationMap[k]; ok {
+		delete(r.registrationMap, k)
+		r.generation++
+		r.events.append(EventDelete, k, nil)
+	}
+}
+
+// tombstone a producer of a registration
+func (r *RegistrationDB) TombstoneProducer(k Registration, p *Producer) {
+	r.Lock()
+	defer r.Unlock()
+	p.Tombstone()
+	r.generation++
+	r.events.append(EventTombstone, k, p.peerInfo)
+}
+
+// Generation changes whenever the registrations do
+func (r *RegistrationDB) Generation() uint64 {
+	r.RLock()
+	defer r.RUnlock()
+	return r.generation
+}
+
+// reclaim removes the restored producers of the nsqd that peerInfo identifies,
+// it returns when its topics were tombstoned so that the tombstones survive
+// the restart
+func (r *RegistrationDB) reclaim(peerInfo *PeerInfo) map[string]time.Time {
+	r.Lock()
+	defer r.Unlock()
+	tombstones := make(map[string]time.Time)
+	peers := make(map[*PeerInfo]bool)
+	for pi := range r.recovered {
+		if pi.id == peerInfo.id || pi.isSameNode(peerInfo) {
+			peers[pi] = true
+			delete(r.recovered, pi)
+		}
+	}
+	if len(peers) == 0 {
+		return tombstones
+	}
+	for k, producers := range r.registrationMap {
+		for id, p := range producers {
+			if !peers[p.peerInfo] {
+				continue
+			}
+			if k.Category == "topic" && p.tombstoned {
+				tombstones[k.Key] = p.tombstonedAt
+			}
+			delete(producers, id)
+			r.generation++
+			r.events.append(EventUnregister, k, p.peerInfo)
+		}
+	}
+	return tombstones
+}
+
+// expireRecovered removes the restored producers whose nsqd did not connect
+// again before the deadline they were restored with
+func (r *RegistrationDB) expireRecovered() int {
+	r.Lock()
+	defer r.Unlock()
+	now := time.Now()
+	peers := make(map[*PeerInfo]bool)
+	for pi, deadline := range r.recovered {
+		if now.After(deadline) {
+			peers[pi] = true
+			delete(r.recovered, pi)
+		}
+	}
+	if len(peers) == 0 {
+		return 0
+	}
+	for k, producers := range r.registrationMap {
+		for id, p := range producers {
+			if peers[p.peerInfo] {
+				delete(producers, id)
+				r.generation++
+				r.events.append(EventUnregister, k, p.peerInfo)
+			}
+		}
+	}
+	return len(peers)
 }
 
 func (r *RegistrationDB) needFilter(key string, subkey string) bool {
@@ -222,6 +327,32 @@ func (pp Producers) FilterByActive(inactivityTimeout time.Duration, tombstoneLif
 	return results
 }
 
+// FilterByTags returns the producers that have all of tags
+func (pp Producers) FilterByTags(tags map[string]string) Producers {
+	if len(tags) == 0 {
+		return pp
+	}
+	results := Producers{}
+	for _, p := range pp {
+		if protocol.MatchTags(p.peerInfo.Tags, tags) == len(tags) {
+			results = append(results, p)
+		}
+	}
+	return results
+}
+
+// SortByTags orders the producers by how many of prefer they have, most first
+func (pp Producers) SortByTags(prefer map[string]string) Producers {
+	if len(prefer) == 0 {
+		return pp
+	}
+	sort.SliceStable(pp, func(i, j int) bool {
+		return protocol.MatchTags(pp[i].peerInfo.Tags, prefer) >
+			protocol.MatchTags(pp[j].peerInfo.Tags, prefer)
+	})
+	return pp
+}
+
 func (pp Producers) PeerInfo() []*PeerInfo {
 	results := []*PeerInfo{}
 	for _, p := range pp {
@@ -0,0 +1,202 @@
+package nsqlookupd
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/nsqio/nsq/internal/http_api"
+)
+
+// the largest body of POST /topic/metadata and /channel/metadata
+const maxMetadataSize = 64 * 1024
+
+// how often the deleted metadata is checked for expiry
+const metadataExpiryInterval = time.Minute
+
+// Metadata describes a topic or channel for the people operating it, nsq does
+// not act on any of it
+type Metadata struct {
+	Owner       string            `json:"owner,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Retention   string            `json:"retention,omitempty"`  //保留时间的提示，如72h
+	Attributes  map[string]string `json:"attributes,omitempty"` //其他的，如schema、SLA
+	UpdatedAt   int64             `json:"updated_at"`           //UnixNano，副本之间以最后写入的为准
+	UpdatedBy   string            `json:"updated_by"`           //写入的nsqlookupd
+	Deleted     bool              `json:"deleted,omitempty"`    //删除也要同步给副本，所以保留--metadata-tombstone-lifetime
+}
+
+// expired reports whether m was deleted longer than lifetime ago, 0 keeps the
+// deleted metadata forever
+func (m *Metadata) expired(lifetime time.Duration, now time.Time) bool {
+	return m.Deleted && lifetime > 0 && now.Sub(time.Unix(0, m.UpdatedAt)) > lifetime
+}
+
+// newerThan orders the writes of the replicas, the same time is decided by the
+// nsqlookupd that wrote them so that every replica keeps the same one
+func (m *Metadata) newerThan(other *Metadata) bool {
+	if m.UpdatedAt != other.UpdatedAt {
+		return m.UpdatedAt > other.UpdatedAt
+	}
+	return m.UpdatedBy > other.UpdatedBy
+}
+
+// metadataEntry is the metadata of a registration as it is persisted and
+// replicated
+type metadataEntry struct {
+	Category string `json:"category"`
+	Key      string `json:"key"`
+	SubKey   string `json:"subkey"`
+	*Metadata
+}
+
+// SetMetadata replaces the metadata of k unless it already has a newer one,
+// it reports whether m was kept
+func (r *RegistrationDB) SetMetadata(k Registration, m *Metadata) bool {
+	r.Lock()
+	defer r.Unlock()
+	if old, ok := r.metadataMap[k]; ok && !m.newerThan(old) {
+		return false
+	}
+	r.metadataMap[k] = m
+	r.generation++
+	return true
+}
+
+// GetMetadata returns nil if k has no metadata
+func (r *RegistrationDB) GetMetadata(k Registration) *Metadata {
+	r.RLock()
+	defer r.RUnlock()
+	m, ok := r.metadataMap[k]
+	if !ok || m.Deleted {
+		return nil
+	}
+	return m
+}
+
+// FindMetadata returns the metadata of the registrations matching
+// category/key/subkey by their key, or subkey for channels
+func (r *RegistrationDB) FindMetadata(category string, key string, subkey string) map[string]*Metadata {
+	r.RLock()
+	defer r.RUnlock()
+	results := make(map[string]*Metadata)
+	for k, m := range r.metadataMap {
+		if m.Deleted || !k.IsMatch(category, key, subkey) {
+			continue
+		}
+		if category == "channel" {
+			results[k.SubKey] = m
+		} else {
+			results[k.Key] = m
+		}
+	}
+	return results
+}
+
+// expireMetadata forgets the metadata deleted longer than lifetime ago, it
+// returns how many were removed
+func (r *RegistrationDB) expireMetadata(lifetime time.Duration) int {
+	now := time.Now()
+	r.Lock()
+	defer r.Unlock()
+	n := 0
+	for k, m := range r.metadataMap {
+		if m.expired(lifetime, now) {
+			delete(r.metadataMap, k)
+			n++
+		}
+	}
+	if n > 0 {
+		r.generation++
+	}
+	return n
+}
+
+// metadataEntries returns all the metadata including the deleted ones
+func (r *RegistrationDB) metadataEntries() []metadataEntry {
+	r.RLock()
+	defer r.RUnlock()
+	entries := make([]metadataEntry, 0, len(r.metadataMap))
+	for k, m := range r.metadataMap {
+		entries = append(entries, metadataEntry{k.Category, k.Key, k.SubKey, m})
+	}
+	return entries
+}
+
+// metadataWriter identifies this nsqlookupd in Metadata.UpdatedBy
+func (l *NSQLookupd) metadataWriter() string {
+	return net.JoinHostPort(l.opts.BroadcastAddress, strconv.Itoa(l.RealHTTPAddr().Port))
+}
+
+// replicateMetadata merges the metadata of the replica at addr into the DB
+func (l *NSQLookupd) replicateMetadata(client *http_api.Client, addr string) (int, error) {
+	var resp struct {
+		Metadata []metadataEntry `json:"metadata"`
+	}
+	err := client.GETV1(httpEndpoint(addr)+"/metadata", &resp)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	now := time.Now()
+	for _, e := range resp.Metadata {
+		if e.Metadata == nil || (e.Category != "topic" && e.Category != "channel") {
+			continue
+		}
+		// the replica did not forget it yet
+		if e.expired(l.opts.MetadataTombstoneLifetime, now) {
+			continue
+		}
+		if l.DB.SetMetadata(Registration{e.Category, e.Key, e.SubKey}, e.Metadata) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// metadataExpiryLoop forgets the metadata deleted longer than
+// --metadata-tombstone-lifetime ago, the replicas pull them until then
+func (l *NSQLookupd) metadataExpiryLoop() {
+	ticker := time.NewTicker(metadataExpiryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-l.exitChan:
+			return
+		}
+
+		if n := l.DB.expireMetadata(l.opts.MetadataTombstoneLifetime); n > 0 {
+			l.logf(LOG_INFO, "DB: forgot %d deleted metadata", n)
+		}
+	}
+}
+
+// replicationLoop pulls the metadata of the --replica-http-address every
+// --replication-interval, the newest write of each topic and channel wins
+func (l *NSQLookupd) replicationLoop() {
+	client := http_api.NewClient(l.peerTLSConfig, time.Second, l.opts.ReplicationInterval)
+	ticker := time.NewTicker(l.opts.ReplicationInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, addr := range l.opts.ReplicaHTTPAddresses {
+			n, err := l.replicateMetadata(client, addr)
+			if err != nil {
+				l.logf(LOG_ERROR, "REPLICATION: failed to get metadata from %s - %s", addr, err)
+				continue
+			}
+			if n > 0 {
+				l.logf(LOG_INFO, "REPLICATION: updated %d metadata from %s", n, addr)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-l.exitChan:
+			return
+		}
+	}
+}
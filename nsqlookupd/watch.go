@@ -0,0 +1,93 @@
+package nsqlookupd
+
+import (
+	"sync"
+	"time"
+)
+
+// changes of the registrations that /watch reports
+const (
+	EventRegister   = "register"   // a producer registered
+	EventUnregister = "unregister" // a producer unregistered or went away
+	EventTombstone  = "tombstone"  // a producer of a topic was tombstoned
+	EventDelete     = "delete"     // a topic or channel was deleted
+)
+
+const (
+	watchBacklog        = 1024             // how many events are kept for the watchers that fell behind
+	defaultWatchTimeout = 30 * time.Second // how long /watch waits for an event
+	maxWatchTimeout     = 5 * time.Minute
+)
+
+// Event is a change of the registrations
+type Event struct {
+	ID        uint64    `json:"id"`
+	Type      string    `json:"type"`
+	Category  string    `json:"category"`
+	Topic     string    `json:"topic,omitempty"`
+	Channel   string    `json:"channel,omitempty"`
+	Producer  *PeerInfo `json:"producer,omitempty"`
+	Timestamp int64     `json:"timestamp"`
+}
+
+func (e *Event) isMatch(topic string) bool {
+	return topic == "" || (e.Category != "client" && e.Topic == topic)
+}
+
+// eventLog keeps the latest events and wakes up the watchers waiting for more
+type eventLog struct {
+	sync.Mutex
+	events     []Event //环形缓冲区，最多watchBacklog个
+	lastID     uint64
+	updateChan chan int //有新事件时关闭
+}
+
+func newEventLog() *eventLog {
+	return &eventLog{
+		updateChan: make(chan int),
+	}
+}
+
+func (l *eventLog) append(typ string, k Registration, peerInfo *PeerInfo) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.lastID++
+	e := Event{
+		ID:        l.lastID,
+		Type:      typ,
+		Category:  k.Category,
+		Topic:     k.Key,
+		Channel:   k.SubKey,
+		Producer:  peerInfo,
+		Timestamp: time.Now().UnixNano(),
+	}
+	if len(l.events) < watchBacklog {
+		l.events = append(l.events, e)
+	} else {
+		l.events[(e.ID-1)%watchBacklog] = e
+	}
+
+	close(l.updateChan)
+	l.updateChan = make(chan int)
+}
+
+// since returns the events of topic ("" for all of them) after id, ok is false
+// if some of them are not kept anymore. update is closed on the next event
+func (l *eventLog) since(id uint64, topic string) (events []Event, lastID uint64, ok bool, update chan int) {
+	l.Lock()
+	defer l.Unlock()
+
+	oldest := l.lastID - uint64(len(l.events))
+	if id < oldest || id > l.lastID {
+		// fell behind, or watched an nsqlookupd that restarted since
+		return nil, l.lastID, false, l.updateChan
+	}
+	for i := id + 1; i <= l.lastID; i++ {
+		e := &l.events[(i-1)%watchBacklog]
+		if e.isMatch(topic) {
+			events = append(events, *e)
+		}
+	}
+	return events, l.lastID, true, l.updateChan
+}